@@ -0,0 +1,67 @@
+// import 命令从书签导出文件或 Kindle 标注文件批量导入文章，书签的正文由服务端后台任务抓取
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/gorexlv/cabinet/scissor/internal/config"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+	"github.com/gorexlv/cabinet/scissor/pkg/database"
+)
+
+func main() {
+	userID := flag.Uint("user", 0, "导入到的用户ID")
+	format := flag.String("format", "", "导出格式：netscape、pocket、instapaper、pinboard 或 kindle，为空时自动识别")
+	file := flag.String("file", "", "导出文件路径")
+	verbose := flag.Bool("v", false, "输出每条书签的导入结果")
+	flag.Parse()
+
+	if *userID == 0 || *file == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// 加载配置
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	// 初始化数据库连接
+	db, err := database.NewClient(cfg.Database)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	f, err := os.Open(*file)
+	if err != nil {
+		log.Fatalf("Failed to open %s: %v", *file, err)
+	}
+	defer f.Close()
+
+	// 摘要、标签和检索向量在服务端抓取正文后补全
+	articleRepo := repository.NewArticleRepository(db)
+	db.Article.Use(service.NewRevisionService(articleRepo, repository.NewArticleRevisionRepository(db), nil).Hook())
+	articleService := service.NewArticleService(articleRepo, nil)
+	importService := service.NewImportService(articleService, articleRepo, repository.NewHighlightRepository(db))
+
+	report, err := importService.Import(context.Background(), *userID, *format, f)
+	if err != nil {
+		log.Fatalf("Import failed: %v", err)
+	}
+
+	for _, item := range report.Items {
+		if item.Status == domain.ImportStatusFailed || *verbose {
+			fmt.Printf("%-8s %s %s\n", item.Status, item.URL, item.Error)
+		}
+	}
+	fmt.Printf("total: %d, created: %d, updated: %d, skipped: %d, failed: %d\n",
+		report.Total, report.Created, report.Updated, report.Skipped, report.Failed)
+}
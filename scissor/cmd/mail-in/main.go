@@ -0,0 +1,111 @@
+// mail-in 命令从标准输入读取一封邮件并保存到收件人的文章库，可作为MTA的管道投递程序，例如 Postfix：
+//
+//	scissor unix - n n - - pipe flags=R user=scissor argv=/usr/local/bin/mail-in -rcpt ${recipient}
+//
+// 收件地址未知时以 67（EX_NOUSER）退出，其余错误以 75（EX_TEMPFAIL）退出，由MTA退信或稍后重试。
+package main
+
+import (
+	"context"
+	"errors"
+	"flag"
+	"log"
+	"os"
+
+	"github.com/gorexlv/cabinet/scissor/internal/config"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+	"github.com/gorexlv/cabinet/scissor/pkg/database"
+	"github.com/gorexlv/cabinet/scissor/pkg/embedding"
+	"github.com/gorexlv/cabinet/scissor/pkg/kimi"
+	"github.com/gorexlv/cabinet/scissor/pkg/prompt"
+	"github.com/gorexlv/cabinet/scissor/pkg/storage"
+)
+
+// sysexits.h 中MTA识别的退出码
+const (
+	exitNoUser   = 67
+	exitTempFail = 75
+)
+
+func main() {
+	rcpt := flag.String("rcpt", os.Getenv("ORIGINAL_RECIPIENT"), "收件地址，默认读取环境变量 ORIGINAL_RECIPIENT")
+	flag.Parse()
+
+	if *rcpt == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// 加载配置
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Printf("Failed to load config: %v", err)
+		os.Exit(exitTempFail)
+	}
+
+	// 初始化数据库连接
+	db, err := database.NewClient(cfg.Database)
+	if err != nil {
+		log.Printf("Failed to connect to database: %v", err)
+		os.Exit(exitTempFail)
+	}
+	defer db.Close()
+
+	// 进程在投递后立即退出，摘要和标签在下面同步生成，不使用异步补全
+	userRepo := repository.NewUserRepository(db)
+	articleRepo := repository.NewArticleRepository(db)
+	db.Article.Use(service.NewRevisionService(articleRepo, repository.NewArticleRevisionRepository(db), nil).Hook())
+	articleService := service.NewArticleService(articleRepo, nil)
+	mailService := service.NewMailService(userRepo, articleService, cfg.Mail.Domain)
+
+	ctx := context.Background()
+	articles, err := mailService.Deliver(ctx, *rcpt, os.Stdin)
+	switch {
+	case errors.Is(err, service.ErrUnknownRecipient):
+		log.Printf("Unknown recipient %s", *rcpt)
+		os.Exit(exitNoUser)
+	case errors.Is(err, service.ErrEmptyMail):
+		// 没有可保存的内容时丢弃邮件，不再重试
+		log.Printf("Dropped mail to %s: %v", *rcpt, err)
+		return
+	case err != nil:
+		log.Printf("Failed to deliver mail to %s: %v", *rcpt, err)
+		os.Exit(exitTempFail)
+	}
+
+	kimiClient, err := kimi.NewClient()
+	if err != nil {
+		log.Printf("Kimi client disabled: %v", err)
+	}
+	embedder, err := embedding.NewProvider(cfg.Embedding)
+	if err != nil {
+		log.Printf("Embedding disabled: %v", err)
+	}
+	// 无法访问存储时只是不归档图片，邮件已经保存
+	var imageService *service.ImageService
+	if store, err := storage.New(cfg.Storage, nil); err != nil {
+		log.Printf("Image archiving disabled: %v", err)
+	} else {
+		imageService = service.NewImageService(repository.NewImageRepository(db), articleRepo, store, cfg.Server.BaseURL, cfg.Images.MaxSize, cfg.Images.Quota)
+	}
+	// 提示词模板无效时只是不生成摘要和标签，邮件已经保存
+	var promptService *service.PromptService
+	if systemPrompts, err := prompt.System(cfg.Prompts); err != nil {
+		log.Printf("Prompt templates disabled: %v", err)
+	} else {
+		promptService = service.NewPromptService(repository.NewPromptTemplateRepository(db), repository.NewArticleEnrichmentRepository(db), articleRepo, kimiClient, systemPrompts)
+	}
+	enrichService := service.NewEnrichService(articleRepo, repository.NewChunkRepository(db), promptService, embedder, imageService)
+	for _, article := range articles {
+		// 只有链接的文章由服务端抓取正文后补全
+		if article.FetchStatus == domain.FetchStatusPending {
+			continue
+		}
+		if err := enrichService.Enrich(ctx, article.ID); err != nil {
+			log.Printf("Failed to enrich article %d: %v", article.ID, err)
+		}
+	}
+	log.Printf("Saved %d articles for %s", len(articles), *rcpt)
+}
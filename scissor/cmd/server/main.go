@@ -12,9 +12,21 @@ import (
 	"github.com/emicklei/go-restful/v3"
 	"github.com/gorexlv/cabinet/scissor/internal/config"
 	"github.com/gorexlv/cabinet/scissor/internal/handler"
+	"github.com/gorexlv/cabinet/scissor/internal/job"
+	"github.com/gorexlv/cabinet/scissor/internal/middleware"
 	"github.com/gorexlv/cabinet/scissor/internal/repository"
 	"github.com/gorexlv/cabinet/scissor/internal/service"
 	"github.com/gorexlv/cabinet/scissor/pkg/database"
+	"github.com/gorexlv/cabinet/scissor/pkg/embedding"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/hook"
+	"github.com/gorexlv/cabinet/scissor/pkg/extract"
+	"github.com/gorexlv/cabinet/scissor/pkg/feed"
+	"github.com/gorexlv/cabinet/scissor/pkg/kimi"
+	"github.com/gorexlv/cabinet/scissor/pkg/linkcheck"
+	"github.com/gorexlv/cabinet/scissor/pkg/mailin"
+	"github.com/gorexlv/cabinet/scissor/pkg/prompt"
+	"github.com/gorexlv/cabinet/scissor/pkg/storage"
 	"github.com/gorexlv/cabinet/scissor/pkg/wechat"
 )
 
@@ -32,20 +44,136 @@ func main() {
 	}
 	defer db.Close()
 
+	// 初始化存储，本地和内存存储的签名下载地址由 /api/blobs 提供。
+	// 未配置签名密钥时从JWT密钥派生，不直接复用
+	signingKey := cfg.Storage.SigningKey
+	if signingKey == "" {
+		signingKey = storage.DeriveSigningKey(cfg.JWT.Secret)
+	}
+	signer := storage.NewURLSigner(cfg.Server.BaseURL, signingKey)
+	store, err := storage.New(cfg.Storage, signer)
+	if err != nil {
+		log.Fatalf("Failed to create storage: %v", err)
+	}
+
 	// 初始化微信客户端
-	wxClient := wechat.NewClient(cfg.Wechat.AppID, cfg.Wechat.AppSecret)
+	wxClient := wechat.NewClient(cfg.Wechat.AppID, cfg.Wechat.AppSecret, store)
+
+	// 初始化Kimi客户端，未配置时跳过AI摘要和标签生成
+	kimiClient, err := kimi.NewClient()
+	if err != nil {
+		log.Printf("Kimi client disabled: %v", err)
+	}
+
+	// 初始化向量化服务
+	embedder, err := embedding.NewProvider(cfg.Embedding)
+	if err != nil {
+		log.Fatalf("Failed to create embedding provider: %v", err)
+	}
+
+	// 系统提示词模板：内置模板和配置文件中的模板
+	systemPrompts, err := prompt.System(cfg.Prompts)
+	if err != nil {
+		log.Fatalf("Failed to load prompt templates: %v", err)
+	}
 
 	// 初始化仓库
 	userRepo := repository.NewUserRepository(db)
 	articleRepo := repository.NewArticleRepository(db)
+	chunkRepo := repository.NewChunkRepository(db)
+	clusterRepo := repository.NewClusterRepository(db)
+	exportRepo := repository.NewExportRepository(db)
+	highlightRepo := repository.NewHighlightRepository(db)
+	feedRepo := repository.NewFeedRepository(db)
+	accessTokenRepo := repository.NewAccessTokenRepository(db)
+	imageRepo := repository.NewImageRepository(db)
+	snapshotRepo := repository.NewSnapshotRepository(db)
+	revisionRepo := repository.NewArticleRevisionRepository(db)
+	notificationRepo := repository.NewNotificationRepository(db)
+	translationRepo := repository.NewArticleTranslationRepository(db)
+	promptRepo := repository.NewPromptTemplateRepository(db)
+	enrichmentRepo := repository.NewArticleEnrichmentRepository(db)
 
 	// 初始化服务
 	userService := service.NewUserService(userRepo, cfg.JWT.Secret, wxClient)
-	articleService := service.NewArticleService(articleRepo)
+	imageService := service.NewImageService(imageRepo, articleRepo, store, cfg.Server.BaseURL, cfg.Images.MaxSize, cfg.Images.Quota)
+	promptService := service.NewPromptService(promptRepo, enrichmentRepo, articleRepo, kimiClient, systemPrompts)
+	enrichService := service.NewEnrichService(articleRepo, chunkRepo, promptService, embedder, imageService)
+	articleService := service.NewArticleService(articleRepo, enrichService)
+	searchService := service.NewSearchService(articleRepo, chunkRepo, embedder)
+	askService := service.NewAskService(searchService, kimiClient)
+	relatedService := service.NewRelatedService(articleRepo, chunkRepo, embedder)
+	clusterService := service.NewClusterService(clusterRepo, chunkRepo, kimiClient, embedder, cfg.Cluster.Threshold)
+	fetchService := service.NewFetchService(articleRepo, enrichService, extract.NewClient())
+	importService := service.NewImportService(articleService, articleRepo, highlightRepo)
+	exportService := service.NewExportService(articleRepo, exportRepo, store)
+	epubService := service.NewEpubService(articleRepo, imageService)
+	highlightService := service.NewHighlightService(articleRepo, highlightRepo)
+	vaultService := service.NewVaultService(articleRepo, highlightRepo, cfg.Vault.Dir, cfg.Vault.Format)
+	feedService := service.NewFeedService(feedRepo, articleService, feed.NewClient(), cfg.Feeds.Interval)
+	libraryFeedService := service.NewLibraryFeedService(userRepo, articleRepo, clusterRepo)
+	mailService := service.NewMailService(userRepo, articleService, cfg.Mail.Domain)
+	accessTokenService := service.NewAccessTokenService(accessTokenRepo)
+	captureService := service.NewCaptureService(articleRepo, articleService, enrichService)
+	snapshotService := service.NewSnapshotService(articleRepo, snapshotRepo, store)
+	revisionService := service.NewRevisionService(articleRepo, revisionRepo, enrichService)
+	notificationService := service.NewNotificationService(notificationRepo)
+	translationService := service.NewTranslationService(articleRepo, translationRepo, kimiClient)
+	linkCheckService := service.NewLinkCheckService(articleRepo, notificationService, linkcheck.NewChecker(cfg.LinkCheck.Timeout, cfg.LinkCheck.HostInterval), cfg.LinkCheck.Recheck)
+	wechatMPService := service.NewWechatMPService(userRepo, articleRepo, articleService, extract.NewClient(), cfg.Wechat.MPToken)
+
+	// 文章或检索向量变化时使相关推荐缓存失效
+	db.Article.Use(relatedService.InvalidateHook())
+	db.ArticleChunk.Use(relatedService.InvalidateHook())
+	// 正文、摘要或标签变化时记录历史版本
+	db.Article.Use(revisionService.Hook())
+
+	// 初始化后台任务
+	scheduler := job.NewScheduler()
+	scheduler.Every("topic-clustering", cfg.Cluster.Interval, clusterService.RefreshAll)
+	// 新文章向量化完成后立即增量聚类
+	db.ArticleChunk.Use(scheduler.TriggerHook("topic-clustering"))
+	scheduler.Every("content-fetch", cfg.Fetch.Interval, fetchService.ProcessPending)
+	// 导入书签后立即开始抓取正文
+	db.Article.Use(hook.On(scheduler.TriggerHook("content-fetch"), ent.OpCreate))
+	scheduler.Every("library-export", cfg.Export.Interval, exportService.ProcessPending)
+	db.Export.Use(hook.On(scheduler.TriggerHook("library-export"), ent.OpCreate))
+	scheduler.Every("feed-poll", cfg.Feeds.Interval, feedService.PollAll)
+	scheduler.Every("link-check", cfg.LinkCheck.Interval, linkCheckService.CheckDue)
+	scheduler.Every("article-translate", cfg.Translate.Interval, translationService.ProcessPending)
+	// 请求翻译或重新翻译后立即开始
+	db.ArticleTranslation.Use(hook.On(scheduler.TriggerHook("article-translate"), ent.OpCreate|ent.OpUpdateOne))
+	// 清洗旧文章的正文并补全阅读元数据，启动时触发一次，之后每天检查
+	scheduler.Every("content-normalize", 24*time.Hour, articleService.NormalizeContent)
 
 	// 初始化处理器
 	userHandler := handler.NewUserHandler(userService)
-	articleHandler := handler.NewArticleHandler(articleService)
+	auth := middleware.AuthMiddleware(cfg.JWT.Secret)
+	articleHandler := handler.NewArticleHandler(articleService, auth)
+	searchHandler := handler.NewSearchHandler(searchService, auth)
+	askHandler := handler.NewAskHandler(askService, auth)
+	relatedHandler := handler.NewRelatedHandler(relatedService, auth)
+	clusterHandler := handler.NewClusterHandler(clusterService, auth)
+	importHandler := handler.NewImportHandler(importService, auth)
+	exportHandler := handler.NewExportHandler(exportService, epubService, auth)
+	highlightHandler := handler.NewHighlightHandler(highlightService, auth)
+	vaultHandler := handler.NewVaultHandler(vaultService, auth)
+	feedHandler := handler.NewFeedHandler(feedService, auth)
+	libraryFeedHandler := handler.NewLibraryFeedHandler(libraryFeedService, auth)
+	wechatMPHandler := handler.NewWechatMPHandler(wechatMPService, auth)
+	mailHandler := handler.NewMailHandler(mailService, auth)
+	accessTokenHandler := handler.NewAccessTokenHandler(accessTokenService, auth)
+	imageHandler := handler.NewImageHandler(imageService, auth)
+	blobHandler := handler.NewBlobHandler(store, signer)
+	snapshotHandler := handler.NewSnapshotHandler(snapshotService, auth)
+	revisionHandler := handler.NewRevisionHandler(revisionService, auth)
+	linkCheckHandler := handler.NewLinkCheckHandler(linkCheckService, auth)
+	notificationHandler := handler.NewNotificationHandler(notificationService, auth)
+	translationHandler := handler.NewTranslationHandler(translationService, auth)
+	promptHandler := handler.NewPromptHandler(promptService, auth)
+	// 剪藏接口同时接受个人访问令牌，并允许书签小工具和浏览器扩展跨域调用
+	tokenAuth := middleware.TokenAuthMiddleware(cfg.JWT.Secret, accessTokenService.Authenticate)
+	captureHandler := handler.NewCaptureHandler(captureService, tokenAuth, middleware.CORS(cfg.Capture.AllowedOrigins))
 
 	// 创建 WebService
 	ws := new(restful.WebService)
@@ -56,6 +184,28 @@ func main() {
 	// 注册路由
 	userHandler.Register(ws)
 	articleHandler.Register(ws)
+	searchHandler.Register(ws)
+	askHandler.Register(ws)
+	relatedHandler.Register(ws)
+	clusterHandler.Register(ws)
+	importHandler.Register(ws)
+	exportHandler.Register(ws)
+	highlightHandler.Register(ws)
+	vaultHandler.Register(ws)
+	feedHandler.Register(ws)
+	libraryFeedHandler.Register(ws)
+	wechatMPHandler.Register(ws)
+	mailHandler.Register(ws)
+	accessTokenHandler.Register(ws)
+	captureHandler.Register(ws)
+	imageHandler.Register(ws)
+	blobHandler.Register(ws)
+	snapshotHandler.Register(ws)
+	revisionHandler.Register(ws)
+	linkCheckHandler.Register(ws)
+	notificationHandler.Register(ws)
+	translationHandler.Register(ws)
+	promptHandler.Register(ws)
 
 	// 创建 WebService 容器
 	container := restful.NewContainer()
@@ -67,6 +217,30 @@ func main() {
 		Handler: container,
 	}
 
+	// 启动后台任务
+	scheduler.Start(context.Background())
+	scheduler.Trigger("content-normalize")
+
+	// 启动收件SMTP服务器
+	smtpCtx, stopSMTP := context.WithCancel(context.Background())
+	defer stopSMTP()
+	if cfg.Mail.Listen != "" && cfg.Mail.Domain != "" {
+		smtpServer := &mailin.Server{
+			Addr:     cfg.Mail.Listen,
+			Domain:   cfg.Mail.Domain,
+			MaxSize:  cfg.Mail.MaxSize,
+			MaxConns: cfg.Mail.MaxConns,
+			Accept:   mailService.Accept,
+			Deliver:  mailService.DeliverSMTP,
+		}
+		go func() {
+			log.Printf("SMTP server starting on %s", cfg.Mail.Listen)
+			if err := smtpServer.ListenAndServe(smtpCtx); err != nil {
+				log.Printf("SMTP server stopped: %v", err)
+			}
+		}()
+	}
+
 	// 启动服务器
 	go func() {
 		log.Printf("Server starting on %s", server.Addr)
@@ -87,6 +261,8 @@ func main() {
 	if err := server.Shutdown(ctx); err != nil {
 		log.Fatalf("Server forced to shutdown: %v", err)
 	}
+	scheduler.Stop()
+	stopSMTP()
 
 	log.Println("Server exiting")
 }
@@ -0,0 +1,51 @@
+// vault-sync 命令将用户的文章和划线同步到本地的 Obsidian 或 Logseq 笔记库
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+
+	"github.com/gorexlv/cabinet/scissor/internal/config"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+	"github.com/gorexlv/cabinet/scissor/pkg/database"
+)
+
+func main() {
+	userID := flag.Uint("user", 0, "用户ID")
+	dir := flag.String("vault", "", "笔记库根目录")
+	format := flag.String("format", "obsidian", "笔记库格式：obsidian 或 logseq")
+	flag.Parse()
+
+	if *userID == 0 || *dir == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	// 加载配置
+	cfg, err := config.LoadConfig()
+	if err != nil {
+		log.Fatalf("Failed to load config: %v", err)
+	}
+
+	// 初始化数据库连接
+	db, err := database.NewClient(cfg.Database)
+	if err != nil {
+		log.Fatalf("Failed to connect to database: %v", err)
+	}
+	defer db.Close()
+
+	vaultService := service.NewVaultService(repository.NewArticleRepository(db), repository.NewHighlightRepository(db), "", "")
+	result, err := vaultService.SyncTo(context.Background(), *userID, *dir, *format)
+	if err != nil {
+		log.Fatalf("Sync failed: %v", err)
+	}
+
+	for _, path := range result.Conflicts {
+		fmt.Printf("skipped %s: 标记行已被删除\n", path)
+	}
+	fmt.Printf("written: %d, unchanged: %d, conflicts: %d\n", result.Written, result.Unchanged, len(result.Conflicts))
+}
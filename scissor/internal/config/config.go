@@ -2,21 +2,37 @@ package config
 
 import (
 	"fmt"
+	"time"
 
 	"github.com/spf13/viper"
 )
 
 type Config struct {
-	Server   ServerConfig   `mapstructure:"server"`
-	Database DatabaseConfig `mapstructure:"database"`
-	Kimi     KimiConfig     `mapstructure:"kimi"`
-	Wechat   WechatConfig   `mapstructure:"wechat"`
-	JWT      JWTConfig      `mapstructure:"jwt"`
-	WeChat   WeChatConfig   `mapstructure:"wechat"`
+	Server    ServerConfig    `mapstructure:"server"`
+	Database  DatabaseConfig  `mapstructure:"database"`
+	Kimi      KimiConfig      `mapstructure:"kimi"`
+	Wechat    WechatConfig    `mapstructure:"wechat"`
+	JWT       JWTConfig       `mapstructure:"jwt"`
+	WeChat    WeChatConfig    `mapstructure:"wechat"`
+	Embedding EmbeddingConfig `mapstructure:"embedding"`
+	Cluster   ClusterConfig   `mapstructure:"cluster"`
+	Fetch     FetchConfig     `mapstructure:"fetch"`
+	Export    ExportConfig    `mapstructure:"export"`
+	Vault     VaultConfig     `mapstructure:"vault"`
+	Feeds     FeedsConfig     `mapstructure:"feeds"`
+	Mail      MailConfig      `mapstructure:"mail"`
+	Capture   CaptureConfig   `mapstructure:"capture"`
+	Images    ImagesConfig    `mapstructure:"images"`
+	Storage   StorageConfig   `mapstructure:"storage"`
+	LinkCheck LinkCheckConfig `mapstructure:"link_check"`
+	Translate TranslateConfig `mapstructure:"translate"`
+	Prompts   PromptsConfig   `mapstructure:"prompts"`
 }
 
 type ServerConfig struct {
 	Port int `mapstructure:"port"`
+	// BaseURL 服务的外部地址，如 https://scissor.example.com，用于生成归档图片和下载链接的绝对地址，为空时使用相对地址
+	BaseURL string `mapstructure:"base_url"`
 }
 
 type DatabaseConfig struct {
@@ -31,9 +47,126 @@ type KimiConfig struct {
 	APIKey string `mapstructure:"api_key"`
 }
 
+type EmbeddingConfig struct {
+	// Provider 可选 local 或 openai（任意OpenAI兼容接口）
+	Provider   string `mapstructure:"provider"`
+	BaseURL    string `mapstructure:"base_url"`
+	APIKey     string `mapstructure:"api_key"`
+	Model      string `mapstructure:"model"`
+	Dimensions int    `mapstructure:"dimensions"`
+}
+
+type ClusterConfig struct {
+	// Interval 增量聚类的执行间隔，新文章向量化后也会立即触发
+	Interval time.Duration `mapstructure:"interval"`
+	// Threshold 文章归入主题所需的最小余弦相似度
+	Threshold float32 `mapstructure:"threshold"`
+}
+
+type FetchConfig struct {
+	// Interval 检查待抓取正文文章的间隔，新建待抓取文章时也会立即触发
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+type ExportConfig struct {
+	// Interval 检查待执行导出任务的间隔，创建任务时也会立即触发
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+type VaultConfig struct {
+	// Dir 笔记库根目录，每个用户同步到以用户ID命名的子目录，为空时不提供同步接口
+	Dir string `mapstructure:"dir"`
+	// Format 可选 obsidian 或 logseq
+	Format string `mapstructure:"format"`
+}
+
+type FeedsConfig struct {
+	// Interval 拉取订阅源的间隔，连续失败的订阅在此基础上指数退避
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+type MailConfig struct {
+	// Domain 收件地址的域名，为空时不提供邮件收件
+	Domain string `mapstructure:"domain"`
+	// Listen 内置SMTP服务器的监听地址，为空时只能通过 cmd/mail-in 投递
+	Listen string `mapstructure:"listen"`
+	// MaxSize 单封邮件的大小上限（字节）
+	MaxSize int64 `mapstructure:"max_size"`
+	// MaxConns 内置SMTP服务器的并发会话上限
+	MaxConns int `mapstructure:"max_conns"`
+}
+
+type CaptureConfig struct {
+	// AllowedOrigins 允许跨域调用剪藏接口的来源，如浏览器扩展的 chrome-extension://<id>；
+	// 书签小工具运行在任意网页上，需要包含 *
+	AllowedOrigins []string `mapstructure:"allowed_origins"`
+}
+
+type ImagesConfig struct {
+	// MaxSize 单张图片的大小上限（字节），超出的图片保留原地址
+	MaxSize int64 `mapstructure:"max_size"`
+	// Quota 每个用户归档图片的总大小上限（字节），0表示不限制
+	Quota int64 `mapstructure:"quota"`
+}
+
+type LinkCheckConfig struct {
+	// Interval 检查到期文章的间隔
+	Interval time.Duration `mapstructure:"interval"`
+	// Recheck 同一篇文章两次检查之间的间隔
+	Recheck time.Duration `mapstructure:"recheck"`
+	// HostInterval 对同一主机两次请求之间的最小间隔
+	HostInterval time.Duration `mapstructure:"host_interval"`
+	// Timeout 单次请求的超时时间
+	Timeout time.Duration `mapstructure:"timeout"`
+}
+
+type TranslateConfig struct {
+	// Interval 检查待执行翻译任务的间隔，请求翻译时也会立即触发
+	Interval time.Duration `mapstructure:"interval"`
+}
+
+type PromptsConfig struct {
+	// Templates 系统级提示词模板，对所有用户生效，与内置模板同名时覆盖内置模板
+	Templates []PromptTemplateConfig `mapstructure:"templates"`
+}
+
+type PromptTemplateConfig struct {
+	// Name 任务名称，summary 和 tags 为摘要和标签，其他名称为附加补全任务
+	Name     string `mapstructure:"name"`
+	Title    string `mapstructure:"title"`
+	Template string `mapstructure:"template"`
+	// Format 输出格式：text（默认）、list 或 json
+	Format string `mapstructure:"format"`
+	// Auto 文章保存后自动执行
+	Auto bool `mapstructure:"auto"`
+}
+
+type StorageConfig struct {
+	// Backend 可选 local、s3 或 memory（仅用于测试，重启后数据丢失）
+	Backend string `mapstructure:"backend"`
+	// Dir 本地存储的根目录，图片、导出文件和二维码分别保存在 images/、exports/ 和 qrcodes/ 下
+	Dir string `mapstructure:"dir"`
+	// SigningKey 本地和内存存储签名下载地址的密钥，为空时从 jwt.secret 派生
+	SigningKey string   `mapstructure:"signing_key"`
+	S3         S3Config `mapstructure:"s3"`
+}
+
+type S3Config struct {
+	// Endpoint S3兼容服务的地址，如 http://localhost:9000，为空时使用 AWS S3
+	Endpoint  string `mapstructure:"endpoint"`
+	Region    string `mapstructure:"region"`
+	Bucket    string `mapstructure:"bucket"`
+	AccessKey string `mapstructure:"access_key"`
+	SecretKey string `mapstructure:"secret_key"`
+	// PathStyle 使用 endpoint/bucket/key 形式的地址，MinIO 需要开启
+	PathStyle bool `mapstructure:"path_style"`
+}
+
 type WechatConfig struct {
 	AppID     string `mapstructure:"app_id"`
 	AppSecret string `mapstructure:"app_secret"`
+	// MPToken 公众号后台「服务器配置」中的令牌，为空时不提供公众号消息接口
+	MPToken string `mapstructure:"mp_token"`
 }
 
 type JWTConfig struct {
@@ -50,6 +183,16 @@ func (c *DatabaseConfig) DSN() string {
 		c.User, c.Password, c.Host, c.Port, c.DBName)
 }
 
+// defaultIntervals 后台任务的默认执行间隔
+var defaultIntervals = map[string]time.Duration{
+	"cluster.interval":    10 * time.Minute,
+	"fetch.interval":      5 * time.Minute,
+	"export.interval":     time.Minute,
+	"feeds.interval":      30 * time.Minute,
+	"link_check.interval": time.Hour,
+	"translate.interval":  time.Minute,
+}
+
 func LoadConfig() (*Config, error) {
 	viper.SetConfigName("config")
 	viper.SetConfigType("yaml")
@@ -63,13 +206,34 @@ func LoadConfig() (*Config, error) {
 	viper.SetDefault("database.port", 3306)
 	viper.SetDefault("database.user", "root")
 	viper.SetDefault("database.dbname", "scissor")
+	viper.SetDefault("embedding.provider", "local")
+	viper.SetDefault("cluster.threshold", 0.75)
+	for key, d := range defaultIntervals {
+		viper.SetDefault(key, d)
+	}
+	viper.SetDefault("vault.format", "obsidian")
+	viper.SetDefault("mail.max_size", 25<<20)
+	viper.SetDefault("mail.max_conns", 100)
+	viper.SetDefault("capture.allowed_origins", []string{"*"})
+	viper.SetDefault("images.max_size", 10<<20)
+	viper.SetDefault("images.quota", 1<<30)
+	viper.SetDefault("storage.backend", "local")
+	viper.SetDefault("storage.dir", "data")
+	viper.SetDefault("link_check.recheck", "168h")
+	viper.SetDefault("link_check.host_interval", "3s")
+	viper.SetDefault("link_check.timeout", "30s")
 
 	// 从环境变量读取敏感信息
 	viper.BindEnv("database.password", "MYSQL_PASSWORD")
 	viper.BindEnv("kimi.api_key", "KIMI_API_KEY")
 	viper.BindEnv("wechat.app_id", "WECHAT_APP_ID")
 	viper.BindEnv("wechat.app_secret", "WECHAT_APP_SECRET")
+	viper.BindEnv("wechat.mp_token", "WECHAT_MP_TOKEN")
 	viper.BindEnv("jwt.secret", "JWT_SECRET")
+	viper.BindEnv("embedding.api_key", "EMBEDDING_API_KEY")
+	viper.BindEnv("storage.signing_key", "STORAGE_SIGNING_KEY")
+	viper.BindEnv("storage.s3.access_key", "S3_ACCESS_KEY")
+	viper.BindEnv("storage.s3.secret_key", "S3_SECRET_KEY")
 
 	viper.AutomaticEnv()
 
@@ -84,5 +248,22 @@ func LoadConfig() (*Config, error) {
 		return nil, fmt.Errorf("failed to unmarshal config: %w", err)
 	}
 
+	// 后台任务间隔配置为空或非正数时使用默认值
+	for _, iv := range []struct {
+		key   string
+		value *time.Duration
+	}{
+		{"cluster.interval", &config.Cluster.Interval},
+		{"fetch.interval", &config.Fetch.Interval},
+		{"export.interval", &config.Export.Interval},
+		{"feeds.interval", &config.Feeds.Interval},
+		{"link_check.interval", &config.LinkCheck.Interval},
+		{"translate.interval", &config.Translate.Interval},
+	} {
+		if *iv.value <= 0 {
+			*iv.value = defaultIntervals[iv.key]
+		}
+	}
+
 	return &config, nil
 }
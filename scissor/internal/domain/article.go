@@ -8,6 +8,7 @@ type Article struct {
 	ID          uint      `json:"id"`
 	Title       string    `json:"title"`
 	Content     string    `json:"content"`
+	ContentText string    `json:"content_text,omitempty"`
 	URL         string    `json:"url"`
 	Author      string    `json:"author"`
 	Source      string    `json:"source"`
@@ -17,8 +18,56 @@ type Article struct {
 	UserID      uint      `json:"user_id"`
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
+	// CanonicalURL 规范化后的链接，用于识别同一文章的不同链接形式
+	CanonicalURL string `json:"canonical_url,omitempty"`
+	// FetchStatus 正文抓取状态：pending/done/failed，直接提交正文的文章为空
+	FetchStatus string `json:"fetch_status,omitempty"`
+	// ReadAt 标记为已读的时间，未读时为空
+	ReadAt *time.Time `json:"read_at,omitempty"`
+	// SourceStatus 原文链接状态：alive/deleted/moved/changed，尚未检查时为空
+	SourceStatus string `json:"source_status,omitempty"`
+	// SourceMovedTo 原文迁移后的新地址
+	SourceMovedTo   string     `json:"source_moved_to,omitempty"`
+	SourceCheckedAt *time.Time `json:"source_checked_at,omitempty"`
+	// WordCount 字数，中日韩文字按字计数，其他文字按词计数
+	WordCount      int `json:"word_count"`
+	ReadingMinutes int `json:"reading_minutes"`
+	// Language 正文的主要语言（ISO 639-1），无法判断时为空
+	Language   string `json:"language,omitempty"`
+	ImageCount int    `json:"image_count"`
+	// LeadImage 题图，正文中第一张非图标的图片
+	LeadImage string `json:"lead_image,omitempty"`
+	// PossibleDuplicates 创建时发现的内容近似文章
+	PossibleDuplicates []*DuplicateCandidate `json:"possible_duplicates,omitempty"`
 }
 
+// DuplicateCandidate 可能重复的已有文章
+type DuplicateCandidate struct {
+	ID         uint    `json:"id"`
+	Title      string  `json:"title"`
+	Similarity float64 `json:"similarity"`
+}
+
+// 正文抓取状态
+const (
+	FetchStatusPending = "pending"
+	FetchStatusDone    = "done"
+	FetchStatusFailed  = "failed"
+)
+
+// 原文链接状态
+const (
+	SourceStatusAlive   = "alive"
+	SourceStatusDeleted = "deleted"
+	SourceStatusMoved   = "moved"
+	SourceStatusChanged = "changed"
+)
+
+const (
+	// OnDuplicateMerge 发现重复时合并到已有文章而不是新建
+	OnDuplicateMerge = "merge"
+)
+
 type CreateArticleRequest struct {
 	Title       string    `json:"title"`
 	Content     string    `json:"content"`
@@ -29,6 +78,30 @@ type CreateArticleRequest struct {
 	Tags        []string  `json:"tags"`
 	PublishedAt time.Time `json:"published_at"`
 	UserID      uint      `json:"user_id"`
+	// OnDuplicate 为 merge 时，重复文章会合并到已有文章
+	OnDuplicate string `json:"on_duplicate,omitempty"`
+}
+
+// 文章列表的排序方式，前缀"-"表示降序
+const (
+	ArticleSortPublishedAt    = "published_at"
+	ArticleSortReadingMinutes = "reading_minutes"
+	ArticleSortWordCount      = "word_count"
+)
+
+// ArticleListQuery 文章列表的筛选和排序条件
+type ArticleListQuery struct {
+	Language string
+	// MinMinutes、MaxMinutes 预计阅读时长范围（分钟），0表示不限
+	MinMinutes int
+	MaxMinutes int
+	// Sort 排序字段，前缀"-"表示降序，为空时按发布时间倒序
+	Sort string
+}
+
+type MergeArticleRequest struct {
+	// Into 合并目标文章ID
+	Into uint `json:"into"`
 }
 
 type ArticleResponse struct {
@@ -45,3 +118,35 @@ type ArticleResponse struct {
 	CreatedAt   time.Time `json:"created_at"`
 	UpdatedAt   time.Time `json:"updated_at"`
 }
+
+type SearchResult struct {
+	Article *Article `json:"article"`
+	Score   float32  `json:"score"`
+	// Passage 命中的正文片段
+	Passage string `json:"passage,omitempty"`
+}
+
+// Passage 检索得到的文章片段
+type Passage struct {
+	ArticleID uint    `json:"article_id"`
+	Title     string  `json:"title"`
+	URL       string  `json:"url"`
+	Content   string  `json:"content"`
+	Score     float32 `json:"score"`
+}
+
+// RelatedArticle 与当前文章相关的同库文章
+type RelatedArticle struct {
+	Article    *Article `json:"article"`
+	Score      float64  `json:"score"`
+	SharedTags []string `json:"shared_tags,omitempty"`
+}
+
+// ImageUsage 用户归档图片占用的空间
+type ImageUsage struct {
+	// Used 已占用的字节数
+	Used int64 `json:"used"`
+	// Quota 配额（字节），0表示不限制
+	Quota int64 `json:"quota"`
+	Count int   `json:"count"`
+}
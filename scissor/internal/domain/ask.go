@@ -0,0 +1,21 @@
+package domain
+
+type AskRequest struct {
+	Question string `json:"question"`
+}
+
+// Citation 回答中引用的来源
+type Citation struct {
+	// Index 回答正文中的引用编号，如 [1]
+	Index     int    `json:"index"`
+	ArticleID uint   `json:"article_id"`
+	Title     string `json:"title"`
+	URL       string `json:"url"`
+	// Quote 来源文章中支撑该论断的原文
+	Quote string `json:"quote"`
+}
+
+type AskResponse struct {
+	Answer    string      `json:"answer"`
+	Citations []*Citation `json:"citations"`
+}
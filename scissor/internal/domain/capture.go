@@ -0,0 +1,23 @@
+package domain
+
+// 剪藏模式
+const (
+	// CaptureModePage 从整个页面中提取正文
+	CaptureModePage = "page"
+	// CaptureModeSelection 只保存用户选中的部分
+	CaptureModeSelection = "selection"
+)
+
+// CaptureRequest 书签小工具或浏览器扩展提交的页面
+type CaptureRequest struct {
+	URL string `json:"url"`
+	// HTML 浏览器渲染后的完整页面，即 document.documentElement.outerHTML
+	HTML string `json:"html"`
+	// Selection 选中部分的HTML，mode为selection时作为正文
+	Selection string `json:"selection,omitempty"`
+	// Mode 为 page（默认）或 selection
+	Mode string `json:"mode,omitempty"`
+	// Title 页面标题，为空时从HTML中提取
+	Title string   `json:"title,omitempty"`
+	Tags  []string `json:"tags,omitempty"`
+}
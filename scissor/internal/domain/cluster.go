@@ -0,0 +1,15 @@
+package domain
+
+import (
+	"time"
+)
+
+// TopicCluster 自动聚合的文章主题
+type TopicCluster struct {
+	ID        uint      `json:"id"`
+	Name      string    `json:"name"`
+	Keywords  []string  `json:"keywords"`
+	Size      int       `json:"size"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
@@ -0,0 +1,28 @@
+package domain
+
+import "time"
+
+// Export 后台导出任务
+type Export struct {
+	ID int `json:"id"`
+	// Status 任务状态：pending/running/done/failed
+	Status       string     `json:"status"`
+	Size         int64      `json:"size"`
+	ArticleCount int        `json:"article_count"`
+	Error        string     `json:"error,omitempty"`
+	CreatedAt    time.Time  `json:"created_at"`
+	FinishedAt   *time.Time `json:"finished_at,omitempty"`
+	// DownloadURL 导出完成后的下载地址
+	DownloadURL string `json:"download_url,omitempty"`
+}
+
+// EpubRequest 选择要生成电子书的文章，IDs、Tag和Query至少指定一项
+type EpubRequest struct {
+	IDs []uint `json:"ids"`
+	// Tag 包含该标签的文章
+	Tag string `json:"tag"`
+	// Query 标题、正文或作者包含该关键词的文章
+	Query string `json:"query"`
+	// Title 电子书标题，为空时自动生成
+	Title string `json:"title"`
+}
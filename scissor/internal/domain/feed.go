@@ -0,0 +1,66 @@
+package domain
+
+import "time"
+
+// Feed 订阅的 RSS/Atom 源
+type Feed struct {
+	ID      int       `json:"id"`
+	URL     string    `json:"url"`
+	Title   string    `json:"title"`
+	SiteURL string    `json:"site_url,omitempty"`
+	Rules   FeedRules `json:"rules"`
+	// Tags 自动保存的文章附加的标签
+	Tags    []string `json:"tags"`
+	Enabled bool     `json:"enabled"`
+	// LastFetchedAt 最近一次拉取的时间，无论成功与否
+	LastFetchedAt *time.Time `json:"last_fetched_at,omitempty"`
+	LastSuccessAt *time.Time `json:"last_success_at,omitempty"`
+	// ErrorCount 连续失败的次数，成功拉取后清零
+	ErrorCount int       `json:"error_count"`
+	LastError  string    `json:"last_error,omitempty"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// FeedRules 自动保存规则，各类规则均为空时保存全部条目。
+// 同一类规则满足任意一项即可，不同类规则需同时满足。
+type FeedRules struct {
+	// Keywords 标题或正文包含任一关键词（不区分大小写）
+	Keywords []string `json:"keywords,omitempty"`
+	// Authors 作者为其中之一
+	Authors []string `json:"authors,omitempty"`
+	// Tags 条目分类包含任一标签
+	Tags []string `json:"tags,omitempty"`
+}
+
+type CreateFeedRequest struct {
+	URL   string    `json:"url"`
+	Title string    `json:"title"`
+	Rules FeedRules `json:"rules"`
+	Tags  []string  `json:"tags"`
+}
+
+type UpdateFeedRequest struct {
+	Title   string    `json:"title"`
+	Rules   FeedRules `json:"rules"`
+	Tags    []string  `json:"tags"`
+	Enabled bool      `json:"enabled"`
+}
+
+// FeedRefreshResult 一次拉取的结果
+type FeedRefreshResult struct {
+	Feed *Feed `json:"feed"`
+	// NotModified 源未更新（HTTP 304）
+	NotModified bool `json:"not_modified"`
+	// Saved 本次自动保存的文章ID
+	Saved []uint `json:"saved"`
+	// Skipped 不符合规则或已在库中的条目数
+	Skipped int `json:"skipped"`
+}
+
+// LibraryFeedToken 文章库订阅令牌及对应的订阅地址
+type LibraryFeedToken struct {
+	Token string `json:"token"`
+	RSS   string `json:"rss"`
+	Atom  string `json:"atom"`
+	JSON  string `json:"json"`
+}
@@ -0,0 +1,23 @@
+package domain
+
+import "time"
+
+// Highlight 文章中的划线及批注
+type Highlight struct {
+	ID        int       `json:"id"`
+	ArticleID uint      `json:"article_id"`
+	Text      string    `json:"text"`
+	Note      string    `json:"note,omitempty"`
+	Location  string    `json:"location,omitempty"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+type CreateHighlightRequest struct {
+	Text string `json:"text"`
+	Note string `json:"note"`
+}
+
+type UpdateHighlightRequest struct {
+	Note string `json:"note"`
+}
@@ -0,0 +1,32 @@
+package domain
+
+// 导入结果中单条书签的状态
+const (
+	ImportStatusCreated = "created"
+	ImportStatusSkipped = "skipped"
+	// ImportStatusUpdated 已有的文章添加了新划线
+	ImportStatusUpdated = "updated"
+	ImportStatusFailed  = "failed"
+)
+
+// ImportItem 单条书签的导入结果
+type ImportItem struct {
+	URL    string `json:"url"`
+	Title  string `json:"title"`
+	Status string `json:"status"`
+	// ArticleID 新建的文章ID，跳过时为已存在的文章ID
+	ArticleID uint `json:"article_id,omitempty"`
+	// Highlights 新增的划线数
+	Highlights int    `json:"highlights,omitempty"`
+	Error      string `json:"error,omitempty"`
+}
+
+// ImportReport 书签导入报告
+type ImportReport struct {
+	Total   int           `json:"total"`
+	Created int           `json:"created"`
+	Skipped int           `json:"skipped"`
+	Updated int           `json:"updated,omitempty"`
+	Failed  int           `json:"failed"`
+	Items   []*ImportItem `json:"items"`
+}
@@ -0,0 +1,21 @@
+package domain
+
+import "time"
+
+// Notification 站内通知
+type Notification struct {
+	ID int `json:"id"`
+	// Kind 通知类型，如 source_deleted、source_moved、source_changed
+	Kind      string     `json:"kind"`
+	Title     string     `json:"title"`
+	Body      string     `json:"body,omitempty"`
+	ArticleID *uint      `json:"article_id,omitempty"`
+	ReadAt    *time.Time `json:"read_at,omitempty"`
+	CreatedAt time.Time  `json:"created_at"`
+}
+
+// NotificationList 通知列表及未读数
+type NotificationList struct {
+	Unread        int             `json:"unread"`
+	Notifications []*Notification `json:"notifications"`
+}
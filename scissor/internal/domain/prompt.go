@@ -0,0 +1,56 @@
+package domain
+
+import (
+	"encoding/json"
+	"time"
+)
+
+// 提示词模板的来源
+const (
+	// PromptSourceSystem 内置或配置文件中的系统模板
+	PromptSourceSystem = "system"
+	// PromptSourceUser 用户自定义的模板，与系统模板同名时覆盖系统模板
+	PromptSourceUser = "user"
+)
+
+// PromptTemplate 对当前用户生效的提示词模板
+type PromptTemplate struct {
+	Name     string `json:"name"`
+	Title    string `json:"title"`
+	Template string `json:"template"`
+	// Format 输出格式：text、list 或 json
+	Format string `json:"format"`
+	// Auto 文章保存后自动执行
+	Auto   bool   `json:"auto"`
+	Source string `json:"source"`
+	// Overrides 用户模板覆盖了同名的系统模板
+	Overrides bool       `json:"overrides,omitempty"`
+	UpdatedAt *time.Time `json:"updated_at,omitempty"`
+}
+
+// SavePromptTemplateRequest 创建或覆盖提示词模板，名称取自路径
+type SavePromptTemplateRequest struct {
+	Title    string `json:"title"`
+	Template string `json:"template"`
+	// Format 默认为 text，标签模板默认为 list
+	Format string `json:"format"`
+	Auto   bool   `json:"auto"`
+}
+
+// ArticleEnrichment 补全任务对文章的输出
+type ArticleEnrichment struct {
+	Name   string `json:"name"`
+	Title  string `json:"title,omitempty"`
+	Format string `json:"format"`
+	// Content 整理后的文本
+	Content string `json:"content"`
+	// Data 结构化输出，list 格式为字符串数组，json 格式为模型输出的JSON
+	Data      json.RawMessage `json:"data,omitempty"`
+	UpdatedAt time.Time       `json:"updated_at"`
+}
+
+// SummarizeResponse 流式生成摘要完成后返回的结果
+type SummarizeResponse struct {
+	ArticleID uint   `json:"article_id"`
+	Summary   string `json:"summary"`
+}
@@ -0,0 +1,31 @@
+package domain
+
+import "time"
+
+// ArticleRevision 文章的历史版本，列表中不包含正文
+type ArticleRevision struct {
+	ID        int       `json:"id"`
+	ArticleID uint      `json:"article_id"`
+	Title     string    `json:"title"`
+	Content   string    `json:"content,omitempty"`
+	Summary   string    `json:"summary,omitempty"`
+	Tags      []string  `json:"tags"`
+	CreatedAt time.Time `json:"created_at"`
+}
+
+// DiffLine 差异中的一行，Op 为 equal、insert 或 delete
+type DiffLine struct {
+	Op   string `json:"op"`
+	Text string `json:"text"`
+}
+
+// RevisionDiff 两个历史版本之间的差异，正文和摘要按纯文本逐行比较
+type RevisionDiff struct {
+	From        *ArticleRevision `json:"from"`
+	To          *ArticleRevision `json:"to"`
+	Title       []DiffLine       `json:"title"`
+	Content     []DiffLine       `json:"content"`
+	Summary     []DiffLine       `json:"summary"`
+	TagsAdded   []string         `json:"tags_added"`
+	TagsRemoved []string         `json:"tags_removed"`
+}
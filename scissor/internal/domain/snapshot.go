@@ -0,0 +1,29 @@
+package domain
+
+import "time"
+
+// 快照格式
+const (
+	// SnapshotFormatHTML 内联了样式和图片的单文件网页
+	SnapshotFormatHTML = "html"
+	// SnapshotFormatWARC 原始请求和响应的WARC记录
+	SnapshotFormatWARC = "warc"
+)
+
+// Snapshot 文章原网页的快照
+type Snapshot struct {
+	ID        int    `json:"id"`
+	ArticleID uint   `json:"article_id"`
+	Format    string `json:"format"`
+	Size      int64  `json:"size"`
+	SHA256    string `json:"sha256"`
+	// SourceURL 跟随重定向后实际保存的地址
+	SourceURL  string    `json:"source_url"`
+	StatusCode int       `json:"status_code"`
+	CreatedAt  time.Time `json:"created_at"`
+}
+
+// CreateSnapshotRequest 保存快照的请求，Format 默认为 html
+type CreateSnapshotRequest struct {
+	Format string `json:"format"`
+}
@@ -0,0 +1,42 @@
+package domain
+
+import "time"
+
+// 翻译任务状态
+const (
+	TranslationStatusPending = "pending"
+	TranslationStatusRunning = "running"
+	TranslationStatusDone    = "done"
+	TranslationStatusFailed  = "failed"
+)
+
+// 译文的显示方式
+const (
+	// TranslationViewTranslated 只显示译文
+	TranslationViewTranslated = "translated"
+	// TranslationViewBilingual 双语对照，每个段落内依次包含原文和译文
+	TranslationViewBilingual = "bilingual"
+)
+
+// ArticleTranslation 文章的另一种语言版本，列表中不包含正文
+type ArticleTranslation struct {
+	ID        int    `json:"id"`
+	ArticleID uint   `json:"article_id"`
+	Language  string `json:"language"`
+	Status    string `json:"status"`
+	Title     string `json:"title,omitempty"`
+	Content   string `json:"content,omitempty"`
+	// View 正文的显示方式：translated 或 bilingual
+	View  string `json:"view,omitempty"`
+	Error string `json:"error,omitempty"`
+	// Stale 翻译后原文已修改
+	Stale     bool      `json:"stale"`
+	CreatedAt time.Time `json:"created_at"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// CreateTranslationRequest 请求翻译文章
+type CreateTranslationRequest struct {
+	// Language 目标语言的 ISO 639-1 代码，默认为 zh
+	Language string `json:"language"`
+}
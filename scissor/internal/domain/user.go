@@ -45,3 +45,36 @@ type UserResponse struct {
 	CreatedAt time.Time `json:"created_at"`
 	UpdatedAt time.Time `json:"updated_at"`
 }
+
+// WechatBindCode 关联公众号身份的一次性绑定码
+type WechatBindCode struct {
+	Code string `json:"code"`
+	// ExpiresIn 有效期（秒）
+	ExpiresIn int `json:"expires_in"`
+	// Claimed 已在公众号中发送，等待确认
+	Claimed bool `json:"claimed"`
+}
+
+// ConfirmWechatBindRequest 确认关联发送绑定码的微信
+type ConfirmWechatBindRequest struct {
+	Code string `json:"code"`
+}
+
+// MailAddress 用户的收件地址，发送到该地址的邮件会保存为文章
+type MailAddress struct {
+	Address string `json:"address"`
+}
+
+// AccessToken 个人访问令牌，Token明文只在创建时返回
+type AccessToken struct {
+	ID         int        `json:"id"`
+	Name       string     `json:"name"`
+	Token      string     `json:"token,omitempty"`
+	Prefix     string     `json:"prefix"`
+	LastUsedAt *time.Time `json:"last_used_at,omitempty"`
+	CreatedAt  time.Time  `json:"created_at"`
+}
+
+type CreateAccessTokenRequest struct {
+	Name string `json:"name"`
+}
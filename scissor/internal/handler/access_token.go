@@ -0,0 +1,116 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+)
+
+// AccessTokenHandler 管理个人访问令牌
+type AccessTokenHandler struct {
+	accessTokenService *service.AccessTokenService
+	auth               restful.FilterFunction
+}
+
+// NewAccessTokenHandler 创建个人访问令牌处理器，auth应只接受登录JWT，令牌不能用来创建新令牌
+func NewAccessTokenHandler(accessTokenService *service.AccessTokenService, auth restful.FilterFunction) *AccessTokenHandler {
+	return &AccessTokenHandler{
+		accessTokenService: accessTokenService,
+		auth:               auth,
+	}
+}
+
+// Register 注册路由
+func (h *AccessTokenHandler) Register(ws *restful.WebService) {
+	ws.Route(ws.GET("/tokens").To(h.List).
+		Filter(h.auth).
+		Doc("获取个人访问令牌列表").
+		Returns(200, "OK", []domain.AccessToken{}))
+
+	ws.Route(ws.POST("/tokens").To(h.Create).
+		Filter(h.auth).
+		Doc("创建个人访问令牌，明文令牌只在响应中返回一次").
+		Reads(domain.CreateAccessTokenRequest{}).
+		Returns(201, "Created", domain.AccessToken{}).
+		Returns(400, "Bad Request", nil))
+
+	ws.Route(ws.DELETE("/tokens/{id}").To(h.Delete).
+		Filter(h.auth).
+		Doc("撤销个人访问令牌").
+		Param(ws.PathParameter("id", "令牌ID").DataType("integer")).
+		Returns(204, "No Content", nil).
+		Returns(404, "Not Found", nil))
+}
+
+// List 返回当前用户的令牌
+func (h *AccessTokenHandler) List(req *restful.Request, resp *restful.Response) {
+	tokens, err := h.accessTokenService.List(req.Request.Context(), currentUserID(req))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteEntity(tokens)
+}
+
+// Create 创建令牌
+func (h *AccessTokenHandler) Create(req *restful.Request, resp *restful.Response) {
+	var createReq domain.CreateAccessTokenRequest
+	if err := req.ReadEntity(&createReq); err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的请求数据",
+		})
+		return
+	}
+	name := strings.TrimSpace(createReq.Name)
+	if name == "" {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "令牌名称不能为空",
+		})
+		return
+	}
+
+	token, err := h.accessTokenService.Create(req.Request.Context(), currentUserID(req), name)
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteHeaderAndEntity(http.StatusCreated, token)
+}
+
+// Delete 撤销令牌
+func (h *AccessTokenHandler) Delete(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.Atoi(req.PathParameter("id"))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的令牌ID",
+		})
+		return
+	}
+
+	if err := h.accessTokenService.Delete(req.Request.Context(), currentUserID(req), id); err != nil {
+		if errors.Is(err, repository.ErrNotFound) || errors.Is(err, service.ErrForbidden) {
+			resp.WriteHeaderAndEntity(http.StatusNotFound, map[string]string{
+				"error": "令牌不存在",
+			})
+			return
+		}
+		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteHeader(http.StatusNoContent)
+}
@@ -1,21 +1,25 @@
 package handler
 
 import (
+	"errors"
 	"net/http"
 	"strconv"
 
 	"github.com/emicklei/go-restful/v3"
 	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
 	"github.com/gorexlv/cabinet/scissor/internal/service"
 )
 
 type ArticleHandler struct {
 	articleService *service.ArticleService
+	auth           restful.FilterFunction
 }
 
-func NewArticleHandler(articleService *service.ArticleService) *ArticleHandler {
+func NewArticleHandler(articleService *service.ArticleService, auth restful.FilterFunction) *ArticleHandler {
 	return &ArticleHandler{
 		articleService: articleService,
+		auth:           auth,
 	}
 }
 
@@ -24,7 +28,30 @@ func (h *ArticleHandler) Register(ws *restful.WebService) {
 		Doc("创建文章").
 		Reads(domain.CreateArticleRequest{}).
 		Returns(200, "OK", domain.Article{}).
-		Returns(400, "Bad Request", nil))
+		Returns(400, "Bad Request", nil).
+		Returns(409, "Conflict", nil))
+
+	ws.Route(ws.POST("/articles/{id}/merge").To(h.Merge).
+		Filter(h.auth).
+		Doc("将文章合并到另一篇文章并删除原文章").
+		Param(ws.PathParameter("id", "文章ID").DataType("integer")).
+		Reads(domain.MergeArticleRequest{}).
+		Returns(200, "OK", domain.Article{}).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.PUT("/articles/{id}/read").To(h.MarkRead).
+		Filter(h.auth).
+		Doc("标记文章为已读").
+		Param(ws.PathParameter("id", "文章ID").DataType("integer")).
+		Returns(200, "OK", domain.Article{}).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.DELETE("/articles/{id}/read").To(h.MarkUnread).
+		Filter(h.auth).
+		Doc("标记文章为未读").
+		Param(ws.PathParameter("id", "文章ID").DataType("integer")).
+		Returns(200, "OK", domain.Article{}).
+		Returns(404, "Not Found", nil))
 
 	ws.Route(ws.GET("/articles/{id}").To(h.GetByID).
 		Doc("获取文章").
@@ -36,7 +63,12 @@ func (h *ArticleHandler) Register(ws *restful.WebService) {
 		Doc("获取文章列表").
 		Param(ws.QueryParameter("offset", "偏移量").DataType("integer").DefaultValue("0")).
 		Param(ws.QueryParameter("limit", "限制数量").DataType("integer").DefaultValue("10")).
-		Returns(200, "OK", []domain.Article{}))
+		Param(ws.QueryParameter("language", "正文语言（ISO 639-1），如 zh、en")).
+		Param(ws.QueryParameter("min_minutes", "最短预计阅读分钟数").DataType("integer")).
+		Param(ws.QueryParameter("max_minutes", "最长预计阅读分钟数").DataType("integer")).
+		Param(ws.QueryParameter("sort", "排序：published_at、reading_minutes、word_count，前缀 - 表示降序").DefaultValue("-published_at")).
+		Returns(200, "OK", []domain.Article{}).
+		Returns(400, "Bad Request", nil))
 
 	ws.Route(ws.GET("/articles/search").To(h.Search).
 		Doc("搜索文章").
@@ -46,7 +78,12 @@ func (h *ArticleHandler) Register(ws *restful.WebService) {
 	ws.Route(ws.GET("/users/{userId}/articles").To(h.GetByUserID).
 		Doc("获取用户文章").
 		Param(ws.PathParameter("userId", "用户ID").DataType("integer")).
-		Returns(200, "OK", []domain.Article{}))
+		Param(ws.QueryParameter("language", "正文语言（ISO 639-1），如 zh、en")).
+		Param(ws.QueryParameter("min_minutes", "最短预计阅读分钟数").DataType("integer")).
+		Param(ws.QueryParameter("max_minutes", "最长预计阅读分钟数").DataType("integer")).
+		Param(ws.QueryParameter("sort", "排序：published_at、reading_minutes、word_count，前缀 - 表示降序").DefaultValue("-published_at")).
+		Returns(200, "OK", []domain.Article{}).
+		Returns(400, "Bad Request", nil))
 }
 
 func (h *ArticleHandler) Create(req *restful.Request, resp *restful.Response) {
@@ -70,8 +107,21 @@ func (h *ArticleHandler) Create(req *restful.Request, resp *restful.Response) {
 		UserID:      createReq.UserID,
 	}
 
-	createdArticle, err := h.articleService.Create(req.Request.Context(), article)
+	create := h.articleService.Create
+	if createReq.OnDuplicate == domain.OnDuplicateMerge {
+		create = h.articleService.CreateOrMerge
+	}
+
+	createdArticle, err := create(req.Request.Context(), article)
 	if err != nil {
+		var dupErr *service.DuplicateError
+		if errors.As(err, &dupErr) {
+			resp.WriteHeaderAndEntity(http.StatusConflict, map[string]interface{}{
+				"error":        err.Error(),
+				"duplicate_of": dupErr.ArticleID,
+			})
+			return
+		}
 		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
 			"error": err.Error(),
 		})
@@ -81,6 +131,74 @@ func (h *ArticleHandler) Create(req *restful.Request, resp *restful.Response) {
 	resp.WriteHeaderAndEntity(http.StatusCreated, createdArticle)
 }
 
+func (h *ArticleHandler) Merge(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.ParseUint(req.PathParameter("id"), 10, 32)
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的文章ID",
+		})
+		return
+	}
+
+	var mergeReq domain.MergeArticleRequest
+	if err := req.ReadEntity(&mergeReq); err != nil || mergeReq.Into == 0 {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的请求数据",
+		})
+		return
+	}
+
+	merged, err := h.articleService.Merge(req.Request.Context(), currentUserID(req), uint(id), mergeReq.Into)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) || errors.Is(err, service.ErrForbidden) {
+			resp.WriteHeaderAndEntity(http.StatusNotFound, map[string]string{
+				"error": "文章不存在",
+			})
+			return
+		}
+		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteEntity(merged)
+}
+
+func (h *ArticleHandler) MarkRead(req *restful.Request, resp *restful.Response) {
+	h.setRead(req, resp, true)
+}
+
+func (h *ArticleHandler) MarkUnread(req *restful.Request, resp *restful.Response) {
+	h.setRead(req, resp, false)
+}
+
+func (h *ArticleHandler) setRead(req *restful.Request, resp *restful.Response, read bool) {
+	id, err := strconv.ParseUint(req.PathParameter("id"), 10, 32)
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的文章ID",
+		})
+		return
+	}
+
+	article, err := h.articleService.SetRead(req.Request.Context(), currentUserID(req), uint(id), read)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) || errors.Is(err, service.ErrForbidden) {
+			resp.WriteHeaderAndEntity(http.StatusNotFound, map[string]string{
+				"error": "文章不存在",
+			})
+			return
+		}
+		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteEntity(article)
+}
+
 func (h *ArticleHandler) GetByID(req *restful.Request, resp *restful.Response) {
 	id, err := strconv.ParseUint(req.PathParameter("id"), 10, 32)
 	if err != nil {
@@ -104,8 +222,21 @@ func (h *ArticleHandler) GetByID(req *restful.Request, resp *restful.Response) {
 func (h *ArticleHandler) List(req *restful.Request, resp *restful.Response) {
 	offset, _ := strconv.Atoi(req.QueryParameter("offset"))
 	limit, _ := strconv.Atoi(req.QueryParameter("limit"))
+	query, err := articleListQuery(req)
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
 
-	articles, err := h.articleService.List(req.Request.Context(), offset, limit)
+	articles, err := h.articleService.List(req.Request.Context(), offset, limit, query)
+	if errors.Is(err, service.ErrInvalidArticleQuery) {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
 	if err != nil {
 		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
 			"error": err.Error(),
@@ -145,7 +276,21 @@ func (h *ArticleHandler) GetByUserID(req *restful.Request, resp *restful.Respons
 		return
 	}
 
-	articles, err := h.articleService.GetByUserID(req.Request.Context(), uint(userID))
+	query, err := articleListQuery(req)
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	articles, err := h.articleService.GetByUserID(req.Request.Context(), uint(userID), query)
+	if errors.Is(err, service.ErrInvalidArticleQuery) {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
 	if err != nil {
 		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
 			"error": err.Error(),
@@ -156,6 +301,27 @@ func (h *ArticleHandler) GetByUserID(req *restful.Request, resp *restful.Respons
 	resp.WriteEntity(articles)
 }
 
+// articleListQuery 读取文章列表的筛选和排序参数
+func articleListQuery(req *restful.Request) (*domain.ArticleListQuery, error) {
+	query := &domain.ArticleListQuery{
+		Language: req.QueryParameter("language"),
+		Sort:     req.QueryParameter("sort"),
+	}
+	for name, dst := range map[string]*int{
+		"min_minutes": &query.MinMinutes,
+		"max_minutes": &query.MaxMinutes,
+	} {
+		if v := req.QueryParameter(name); v != "" {
+			n, err := strconv.Atoi(v)
+			if err != nil {
+				return nil, service.ErrInvalidArticleQuery
+			}
+			*dst = n
+		}
+	}
+	return query, nil
+}
+
 func (h *ArticleHandler) GetByURL(req *restful.Request, resp *restful.Response) {
 	url := req.QueryParameter("url")
 	if url == "" {
@@ -165,7 +331,15 @@ func (h *ArticleHandler) GetByURL(req *restful.Request, resp *restful.Response)
 		return
 	}
 
-	article, err := h.articleService.GetByURL(req.Request.Context(), url)
+	userID, err := strconv.ParseUint(req.QueryParameter("user_id"), 10, 32)
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的用户ID",
+		})
+		return
+	}
+
+	article, err := h.articleService.GetByURL(req.Request.Context(), uint(userID), url)
 	if err != nil {
 		resp.WriteHeaderAndEntity(http.StatusNotFound, map[string]string{
 			"error": "文章不存在",
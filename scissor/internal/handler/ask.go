@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strings"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+)
+
+// AskHandler 处理基于文章库的问答请求
+type AskHandler struct {
+	askService *service.AskService
+	auth       restful.FilterFunction
+}
+
+// NewAskHandler 创建问答处理器
+func NewAskHandler(askService *service.AskService, auth restful.FilterFunction) *AskHandler {
+	return &AskHandler{
+		askService: askService,
+		auth:       auth,
+	}
+}
+
+// Register 注册路由
+func (h *AskHandler) Register(ws *restful.WebService) {
+	ws.Route(ws.POST("/articles/ask").To(h.Ask).
+		Filter(h.auth).
+		Doc("基于文章库回答问题，以SSE流式返回").
+		Produces(mimeEventStream, restful.MIME_JSON).
+		Reads(domain.AskRequest{}).
+		Returns(200, "OK", domain.AskResponse{}).
+		Returns(400, "Bad Request", nil))
+}
+
+// Ask 流式返回回答。事件依次为若干 delta（增量文本）、一个 done（完整回答及引用），出错时为 error。
+func (h *AskHandler) Ask(req *restful.Request, resp *restful.Response) {
+	var askReq domain.AskRequest
+	if err := req.ReadEntity(&askReq); err != nil || strings.TrimSpace(askReq.Question) == "" {
+		resp.WriteHeaderAndJson(http.StatusBadRequest, map[string]string{
+			"error": "问题不能为空",
+		}, restful.MIME_JSON)
+		return
+	}
+
+	stream, err := newSSEWriter(resp)
+	if err != nil {
+		resp.WriteHeaderAndJson(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		}, restful.MIME_JSON)
+		return
+	}
+
+	ctx := req.Request.Context()
+	answer, err := h.askService.Ask(ctx, currentUserID(req), askReq.Question, func(delta string) error {
+		return stream.Send("delta", map[string]string{"content": delta})
+	})
+	if err != nil {
+		// 客户端已断开时无需再写入
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return
+		}
+		stream.Send("error", map[string]string{"error": err.Error()})
+		return
+	}
+
+	stream.Send("done", answer)
+}
@@ -0,0 +1,13 @@
+package handler
+
+import (
+	restful "github.com/emicklei/go-restful/v3"
+)
+
+// currentUserID 返回认证中间件写入请求上下文的用户ID，未认证时返回0
+func currentUserID(req *restful.Request) uint {
+	if id, ok := req.Attribute("user_id").(uint); ok {
+		return id
+	}
+	return 0
+}
@@ -0,0 +1,79 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"path"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/pkg/storage"
+)
+
+// BlobHandler 提供本地和内存存储的签名下载地址，S3存储的签名地址直接指向S3
+type BlobHandler struct {
+	store  storage.Storage
+	signer *storage.URLSigner
+}
+
+// NewBlobHandler 创建签名下载处理器
+func NewBlobHandler(store storage.Storage, signer *storage.URLSigner) *BlobHandler {
+	return &BlobHandler{
+		store:  store,
+		signer: signer,
+	}
+}
+
+// Register 注册路由
+func (h *BlobHandler) Register(ws *restful.WebService) {
+	// 地址中的签名即是认证，不需要登录
+	ws.Route(ws.GET("/blobs/{key:*}").To(h.Get).
+		Doc("通过签名地址下载存储中的对象").
+		Param(ws.PathParameter("key", "对象键")).
+		Param(ws.QueryParameter("expires", "过期时间（Unix秒）")).
+		Param(ws.QueryParameter("signature", "签名")).
+		Produces("*/*").
+		Returns(200, "OK", nil).
+		Returns(403, "Forbidden", nil).
+		Returns(404, "Not Found", nil))
+}
+
+// Get 校验签名后输出对象
+func (h *BlobHandler) Get(req *restful.Request, resp *restful.Response) {
+	key := req.PathParameter("key")
+	if err := h.signer.Verify(key, req.QueryParameter("expires"), req.QueryParameter("signature")); err != nil {
+		resp.WriteHeaderAndJson(http.StatusForbidden, map[string]string{
+			"error": err.Error(),
+		}, restful.MIME_JSON)
+		return
+	}
+
+	r, err := h.store.Get(req.Request.Context(), key)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) || errors.Is(err, storage.ErrInvalidKey) {
+			resp.WriteHeaderAndJson(http.StatusNotFound, map[string]string{
+				"error": "文件不存在",
+			}, restful.MIME_JSON)
+			return
+		}
+		resp.WriteHeaderAndJson(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		}, restful.MIME_JSON)
+		return
+	}
+	defer r.Close()
+
+	contentType := mime.TypeByExtension(path.Ext(key))
+	if contentType == "" {
+		contentType = "application/octet-stream"
+	}
+	resp.Header().Set("Content-Type", contentType)
+	resp.Header().Set("X-Content-Type-Options", "nosniff")
+	resp.Header().Set("Cache-Control", "private, max-age=300")
+	resp.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(resp, r); err != nil {
+		log.Printf("Failed to send blob %s: %v", key, err)
+	}
+}
@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+)
+
+// 剪藏请求体的大小上限，渲染后的页面可能内嵌 data URI 图片
+const maxCaptureSize = 16 << 20
+
+// CaptureHandler 接收书签小工具和浏览器扩展提交的页面
+type CaptureHandler struct {
+	captureService *service.CaptureService
+	auth           restful.FilterFunction
+	cors           restful.FilterFunction
+}
+
+// NewCaptureHandler 创建剪藏处理器，auth应同时接受个人访问令牌
+func NewCaptureHandler(captureService *service.CaptureService, auth, cors restful.FilterFunction) *CaptureHandler {
+	return &CaptureHandler{
+		captureService: captureService,
+		auth:           auth,
+		cors:           cors,
+	}
+}
+
+// Register 注册路由
+func (h *CaptureHandler) Register(ws *restful.WebService) {
+	// 跨域预检请求不带认证信息
+	ws.Route(ws.OPTIONS("/capture").To(func(*restful.Request, *restful.Response) {}).
+		Filter(h.cors).
+		Doc("剪藏接口的跨域预检").
+		Returns(204, "No Content", nil))
+
+	ws.Route(ws.POST("/capture").To(h.Capture).
+		Filter(h.cors).
+		Filter(h.auth).
+		Doc("保存浏览器中渲染后的页面，mode为selection时只保存选中部分").
+		Reads(domain.CaptureRequest{}).
+		Returns(200, "OK", domain.Article{}).
+		Returns(201, "Created", domain.Article{}).
+		Returns(400, "Bad Request", nil).
+		Returns(409, "Conflict", nil).
+		Returns(413, "Request Entity Too Large", nil).
+		Returns(422, "Unprocessable Entity", nil))
+}
+
+// Capture 保存页面，链接已在库中但尚未抓取到正文时以提交的页面补全并返回200
+func (h *CaptureHandler) Capture(req *restful.Request, resp *restful.Response) {
+	req.Request.Body = http.MaxBytesReader(resp.ResponseWriter, req.Request.Body, maxCaptureSize)
+
+	var captureReq domain.CaptureRequest
+	if err := req.ReadEntity(&captureReq); err != nil {
+		var maxErr *http.MaxBytesError
+		if errors.As(err, &maxErr) {
+			resp.WriteHeaderAndEntity(http.StatusRequestEntityTooLarge, map[string]string{
+				"error": "页面过大",
+			})
+			return
+		}
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的请求数据",
+		})
+		return
+	}
+
+	article, created, err := h.captureService.Capture(req.Request.Context(), currentUserID(req), &captureReq)
+	if err != nil {
+		var dupErr *service.DuplicateError
+		switch {
+		case errors.As(err, &dupErr):
+			resp.WriteHeaderAndEntity(http.StatusConflict, map[string]interface{}{
+				"error":        err.Error(),
+				"duplicate_of": dupErr.ArticleID,
+			})
+		case errors.Is(err, service.ErrInvalidCapture):
+			resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+				"error": err.Error(),
+			})
+		case errors.Is(err, service.ErrEmptyCapture):
+			resp.WriteHeaderAndEntity(http.StatusUnprocessableEntity, map[string]string{
+				"error": err.Error(),
+			})
+		default:
+			resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+				"error": err.Error(),
+			})
+		}
+		return
+	}
+
+	status := http.StatusOK
+	if created {
+		status = http.StatusCreated
+	}
+	resp.WriteHeaderAndEntity(status, article)
+}
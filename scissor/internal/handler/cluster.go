@@ -0,0 +1,113 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+)
+
+// ClusterHandler 处理文章主题浏览请求
+type ClusterHandler struct {
+	clusterService *service.ClusterService
+	auth           restful.FilterFunction
+}
+
+// NewClusterHandler 创建主题处理器
+func NewClusterHandler(clusterService *service.ClusterService, auth restful.FilterFunction) *ClusterHandler {
+	return &ClusterHandler{
+		clusterService: clusterService,
+		auth:           auth,
+	}
+}
+
+// Register 注册路由
+func (h *ClusterHandler) Register(ws *restful.WebService) {
+	ws.Route(ws.GET("/clusters").To(h.List).
+		Filter(h.auth).
+		Doc("获取文章主题列表").
+		Returns(200, "OK", []domain.TopicCluster{}))
+
+	ws.Route(ws.GET("/clusters/{id}/articles").To(h.Articles).
+		Filter(h.auth).
+		Doc("获取主题下的文章").
+		Param(ws.PathParameter("id", "主题ID").DataType("integer")).
+		Returns(200, "OK", []domain.Article{}).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.POST("/clusters/rebuild").To(h.Rebuild).
+		Filter(h.auth).
+		Doc("重新聚类全部文章").
+		Returns(200, "OK", []domain.TopicCluster{}))
+}
+
+// List 返回当前用户的主题
+func (h *ClusterHandler) List(req *restful.Request, resp *restful.Response) {
+	clusters, err := h.clusterService.List(req.Request.Context(), currentUserID(req))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteEntity(clusters)
+}
+
+// Articles 返回主题下的文章
+func (h *ClusterHandler) Articles(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.Atoi(req.PathParameter("id"))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的主题ID",
+		})
+		return
+	}
+
+	articles, err := h.clusterService.Articles(req.Request.Context(), currentUserID(req), id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) || errors.Is(err, service.ErrForbidden) {
+			resp.WriteHeaderAndEntity(http.StatusNotFound, map[string]string{
+				"error": "主题不存在",
+			})
+			return
+		}
+		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteEntity(articles)
+}
+
+// Rebuild 清除现有主题并重新聚类
+func (h *ClusterHandler) Rebuild(req *restful.Request, resp *restful.Response) {
+	ctx := req.Request.Context()
+	userID := currentUserID(req)
+
+	if err := h.clusterService.Rebuild(ctx, userID); err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, service.ErrEmbeddingDisabled) {
+			status = http.StatusServiceUnavailable
+		}
+		resp.WriteHeaderAndEntity(status, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	clusters, err := h.clusterService.List(ctx, userID)
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteEntity(clusters)
+}
@@ -0,0 +1,251 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+	"github.com/gorexlv/cabinet/scissor/pkg/epub"
+)
+
+const (
+	mimeZip  = "application/zip"
+	mimeEpub = "application/epub+zip"
+	// 未读文章合集默认包含最近7天的文章
+	defaultDigestDays = 7
+)
+
+// ExportHandler 处理文章库导出请求
+type ExportHandler struct {
+	exportService *service.ExportService
+	epubService   *service.EpubService
+	auth          restful.FilterFunction
+}
+
+// NewExportHandler 创建导出处理器
+func NewExportHandler(exportService *service.ExportService, epubService *service.EpubService, auth restful.FilterFunction) *ExportHandler {
+	return &ExportHandler{
+		exportService: exportService,
+		epubService:   epubService,
+		auth:          auth,
+	}
+}
+
+// Register 注册路由
+func (h *ExportHandler) Register(ws *restful.WebService) {
+	ws.Route(ws.GET("/export").To(h.Download).
+		Filter(h.auth).
+		Doc("以zip格式直接下载文章库（Markdown、JSON和HTML索引）").
+		Produces(mimeZip).
+		Returns(200, "OK", nil))
+
+	ws.Route(ws.GET("/export/epub").To(h.Epub).
+		Filter(h.auth).
+		Doc("将选中的文章生成 EPUB 电子书，ids、tag、q 至少指定一项").
+		Param(ws.QueryParameter("ids", "文章ID，以逗号分隔")).
+		Param(ws.QueryParameter("tag", "标签")).
+		Param(ws.QueryParameter("q", "关键词")).
+		Param(ws.QueryParameter("title", "电子书标题")).
+		Produces(mimeEpub, restful.MIME_JSON).
+		Returns(200, "OK", nil).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.GET("/export/epub/digest").To(h.EpubDigest).
+		Filter(h.auth).
+		Doc("将最近保存的未读文章生成 EPUB 电子书").
+		Param(ws.QueryParameter("days", "包含最近几天保存的文章").DataType("integer").DefaultValue("7")).
+		Produces(mimeEpub, restful.MIME_JSON).
+		Returns(200, "OK", nil).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.POST("/exports").To(h.Request).
+		Filter(h.auth).
+		Doc("创建后台导出任务，适用于较大的文章库").
+		Returns(202, "Accepted", domain.Export{}))
+
+	ws.Route(ws.GET("/exports").To(h.List).
+		Filter(h.auth).
+		Doc("获取导出任务列表").
+		Returns(200, "OK", []domain.Export{}))
+
+	ws.Route(ws.GET("/exports/{id}").To(h.Get).
+		Filter(h.auth).
+		Doc("获取导出任务状态").
+		Param(ws.PathParameter("id", "导出任务ID").DataType("integer")).
+		Returns(200, "OK", domain.Export{}).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.GET("/exports/{id}/download").To(h.DownloadExport).
+		Filter(h.auth).
+		Doc("下载已完成的导出文件").
+		Param(ws.PathParameter("id", "导出任务ID").DataType("integer")).
+		Produces(mimeZip, restful.MIME_JSON).
+		Returns(200, "OK", nil).
+		Returns(404, "Not Found", nil))
+}
+
+// Download 边生成边输出zip
+func (h *ExportHandler) Download(req *restful.Request, resp *restful.Response) {
+	filename := fmt.Sprintf("scissor-export-%s.zip", time.Now().Format("20060102"))
+	resp.Header().Set("Content-Type", mimeZip)
+	resp.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	resp.WriteHeader(http.StatusOK)
+
+	// 响应头已发送，出错时只能中断输出
+	if _, err := h.exportService.WriteArchive(req.Request.Context(), currentUserID(req), resp); err != nil {
+		log.Printf("Failed to stream export for user %d: %v", currentUserID(req), err)
+	}
+}
+
+// Request 创建后台导出任务
+func (h *ExportHandler) Request(req *restful.Request, resp *restful.Response) {
+	e, err := h.exportService.Request(req.Request.Context(), currentUserID(req))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteHeaderAndEntity(http.StatusAccepted, e)
+}
+
+// List 返回当前用户的导出任务
+func (h *ExportHandler) List(req *restful.Request, resp *restful.Response) {
+	exports, err := h.exportService.List(req.Request.Context(), currentUserID(req))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteEntity(exports)
+}
+
+// Get 返回导出任务状态
+func (h *ExportHandler) Get(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.Atoi(req.PathParameter("id"))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的导出任务ID",
+		})
+		return
+	}
+
+	e, err := h.exportService.Get(req.Request.Context(), currentUserID(req), id)
+	if err != nil {
+		writeExportError(resp, err)
+		return
+	}
+
+	resp.WriteEntity(e)
+}
+
+// DownloadExport 下载已完成的导出文件
+func (h *ExportHandler) DownloadExport(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.Atoi(req.PathParameter("id"))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的导出任务ID",
+		})
+		return
+	}
+
+	f, e, err := h.exportService.Open(req.Request.Context(), currentUserID(req), id)
+	if err != nil {
+		writeExportError(resp, err)
+		return
+	}
+	defer f.Close()
+
+	filename := fmt.Sprintf("scissor-export-%s.zip", e.CreatedAt.Format("20060102"))
+	resp.Header().Set("Content-Type", mimeZip)
+	resp.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	resp.Header().Set("Content-Length", strconv.FormatInt(e.Size, 10))
+	resp.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(resp, f); err != nil {
+		log.Printf("Failed to send export %d: %v", id, err)
+	}
+}
+
+// Epub 下载选中文章的电子书
+func (h *ExportHandler) Epub(req *restful.Request, resp *restful.Response) {
+	epubReq := &domain.EpubRequest{
+		Tag:   req.QueryParameter("tag"),
+		Query: req.QueryParameter("q"),
+		Title: req.QueryParameter("title"),
+	}
+	for _, s := range strings.Split(req.QueryParameter("ids"), ",") {
+		if s = strings.TrimSpace(s); s == "" {
+			continue
+		}
+		id, err := strconv.ParseUint(s, 10, 32)
+		if err != nil {
+			resp.WriteHeaderAndJson(http.StatusBadRequest, map[string]string{
+				"error": "无效的文章ID",
+			}, restful.MIME_JSON)
+			return
+		}
+		epubReq.IDs = append(epubReq.IDs, uint(id))
+	}
+
+	book, err := h.epubService.Book(req.Request.Context(), currentUserID(req), epubReq)
+	h.writeEpub(resp, book, err)
+}
+
+// EpubDigest 下载未读文章合集
+func (h *ExportHandler) EpubDigest(req *restful.Request, resp *restful.Response) {
+	days, err := strconv.Atoi(req.QueryParameter("days"))
+	if err != nil || days <= 0 {
+		days = defaultDigestDays
+	}
+
+	book, err := h.epubService.Digest(req.Request.Context(), currentUserID(req), days)
+	h.writeEpub(resp, book, err)
+}
+
+func (h *ExportHandler) writeEpub(resp *restful.Response, book *epub.Book, err error) {
+	if err != nil {
+		if errors.Is(err, service.ErrNoArticles) {
+			resp.WriteHeaderAndJson(http.StatusNotFound, map[string]string{
+				"error": err.Error(),
+			}, restful.MIME_JSON)
+			return
+		}
+		resp.WriteHeaderAndJson(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		}, restful.MIME_JSON)
+		return
+	}
+
+	filename := url.PathEscape(book.Title + ".epub")
+	resp.Header().Set("Content-Type", mimeEpub)
+	resp.Header().Set("Content-Disposition", "attachment; filename*=UTF-8''"+filename)
+	resp.WriteHeader(http.StatusOK)
+	if err := book.Write(resp); err != nil {
+		log.Printf("Failed to write epub: %v", err)
+	}
+}
+
+func writeExportError(resp *restful.Response, err error) {
+	if errors.Is(err, repository.ErrNotFound) || errors.Is(err, service.ErrForbidden) {
+		resp.WriteHeaderAndJson(http.StatusNotFound, map[string]string{
+			"error": "导出任务不存在",
+		}, restful.MIME_JSON)
+		return
+	}
+	resp.WriteHeaderAndJson(http.StatusInternalServerError, map[string]string{
+		"error": err.Error(),
+	}, restful.MIME_JSON)
+}
@@ -0,0 +1,189 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+)
+
+// FeedHandler 处理 RSS/Atom 订阅请求
+type FeedHandler struct {
+	feedService *service.FeedService
+	auth        restful.FilterFunction
+}
+
+// NewFeedHandler 创建订阅处理器
+func NewFeedHandler(feedService *service.FeedService, auth restful.FilterFunction) *FeedHandler {
+	return &FeedHandler{
+		feedService: feedService,
+		auth:        auth,
+	}
+}
+
+// Register 注册路由
+func (h *FeedHandler) Register(ws *restful.WebService) {
+	ws.Route(ws.GET("/feeds").To(h.List).
+		Filter(h.auth).
+		Doc("获取订阅列表及拉取状态").
+		Returns(200, "OK", []domain.Feed{}))
+
+	ws.Route(ws.POST("/feeds").To(h.Create).
+		Filter(h.auth).
+		Doc("订阅 RSS/Atom 源").
+		Reads(domain.CreateFeedRequest{}).
+		Returns(201, "Created", domain.FeedRefreshResult{}).
+		Returns(400, "Bad Request", nil).
+		Returns(409, "Conflict", nil))
+
+	ws.Route(ws.PUT("/feeds/{id}").To(h.Update).
+		Filter(h.auth).
+		Doc("修改订阅的规则、标签和启用状态").
+		Param(ws.PathParameter("id", "订阅ID").DataType("integer")).
+		Reads(domain.UpdateFeedRequest{}).
+		Returns(200, "OK", domain.Feed{}).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.DELETE("/feeds/{id}").To(h.Delete).
+		Filter(h.auth).
+		Doc("取消订阅").
+		Param(ws.PathParameter("id", "订阅ID").DataType("integer")).
+		Returns(204, "No Content", nil).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.POST("/feeds/{id}/refresh").To(h.Refresh).
+		Filter(h.auth).
+		Doc("立即拉取订阅源").
+		Param(ws.PathParameter("id", "订阅ID").DataType("integer")).
+		Returns(200, "OK", domain.FeedRefreshResult{}).
+		Returns(404, "Not Found", nil).
+		Returns(502, "Bad Gateway", nil))
+}
+
+// List 返回当前用户的订阅
+func (h *FeedHandler) List(req *restful.Request, resp *restful.Response) {
+	feeds, err := h.feedService.List(req.Request.Context(), currentUserID(req))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteEntity(feeds)
+}
+
+// Create 订阅新的源
+func (h *FeedHandler) Create(req *restful.Request, resp *restful.Response) {
+	var createReq domain.CreateFeedRequest
+	if err := req.ReadEntity(&createReq); err != nil || createReq.URL == "" {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的请求数据",
+		})
+		return
+	}
+
+	result, err := h.feedService.Subscribe(req.Request.Context(), currentUserID(req), &createReq)
+	if err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, service.ErrInvalidFeed):
+			status = http.StatusBadRequest
+		case errors.Is(err, service.ErrFeedExists):
+			status = http.StatusConflict
+		}
+		resp.WriteHeaderAndEntity(status, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteHeaderAndEntity(http.StatusCreated, result)
+}
+
+// Update 修改订阅设置
+func (h *FeedHandler) Update(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.Atoi(req.PathParameter("id"))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的订阅ID",
+		})
+		return
+	}
+
+	var updateReq domain.UpdateFeedRequest
+	if err := req.ReadEntity(&updateReq); err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的请求数据",
+		})
+		return
+	}
+
+	feed, err := h.feedService.Update(req.Request.Context(), currentUserID(req), id, &updateReq)
+	if err != nil {
+		writeFeedError(resp, err)
+		return
+	}
+
+	resp.WriteEntity(feed)
+}
+
+// Delete 取消订阅
+func (h *FeedHandler) Delete(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.Atoi(req.PathParameter("id"))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的订阅ID",
+		})
+		return
+	}
+
+	if err := h.feedService.Delete(req.Request.Context(), currentUserID(req), id); err != nil {
+		writeFeedError(resp, err)
+		return
+	}
+
+	resp.WriteHeader(http.StatusNoContent)
+}
+
+// Refresh 立即拉取订阅源
+func (h *FeedHandler) Refresh(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.Atoi(req.PathParameter("id"))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的订阅ID",
+		})
+		return
+	}
+
+	result, err := h.feedService.Refresh(req.Request.Context(), currentUserID(req), id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) || errors.Is(err, service.ErrForbidden) {
+			writeFeedError(resp, err)
+			return
+		}
+		// 拉取失败已记录到订阅的错误信息中
+		resp.WriteHeaderAndEntity(http.StatusBadGateway, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteEntity(result)
+}
+
+func writeFeedError(resp *restful.Response, err error) {
+	if errors.Is(err, repository.ErrNotFound) || errors.Is(err, service.ErrForbidden) {
+		resp.WriteHeaderAndEntity(http.StatusNotFound, map[string]string{
+			"error": "订阅不存在",
+		})
+		return
+	}
+	resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+		"error": err.Error(),
+	})
+}
@@ -0,0 +1,163 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+)
+
+// HighlightHandler 处理文章划线请求
+type HighlightHandler struct {
+	highlightService *service.HighlightService
+	auth             restful.FilterFunction
+}
+
+// NewHighlightHandler 创建划线处理器
+func NewHighlightHandler(highlightService *service.HighlightService, auth restful.FilterFunction) *HighlightHandler {
+	return &HighlightHandler{
+		highlightService: highlightService,
+		auth:             auth,
+	}
+}
+
+// Register 注册路由
+func (h *HighlightHandler) Register(ws *restful.WebService) {
+	ws.Route(ws.GET("/articles/{id}/highlights").To(h.List).
+		Filter(h.auth).
+		Doc("获取文章的划线").
+		Param(ws.PathParameter("id", "文章ID").DataType("integer")).
+		Returns(200, "OK", []domain.Highlight{}).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.POST("/articles/{id}/highlights").To(h.Create).
+		Filter(h.auth).
+		Doc("添加划线").
+		Param(ws.PathParameter("id", "文章ID").DataType("integer")).
+		Reads(domain.CreateHighlightRequest{}).
+		Returns(201, "Created", domain.Highlight{}).
+		Returns(400, "Bad Request", nil).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.PUT("/highlights/{id}").To(h.Update).
+		Filter(h.auth).
+		Doc("修改划线批注").
+		Param(ws.PathParameter("id", "划线ID").DataType("integer")).
+		Reads(domain.UpdateHighlightRequest{}).
+		Returns(200, "OK", domain.Highlight{}).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.DELETE("/highlights/{id}").To(h.Delete).
+		Filter(h.auth).
+		Doc("删除划线").
+		Param(ws.PathParameter("id", "划线ID").DataType("integer")).
+		Returns(204, "No Content", nil).
+		Returns(404, "Not Found", nil))
+}
+
+// List 返回文章的划线
+func (h *HighlightHandler) List(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.ParseUint(req.PathParameter("id"), 10, 32)
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的文章ID",
+		})
+		return
+	}
+
+	highlights, err := h.highlightService.List(req.Request.Context(), currentUserID(req), uint(id))
+	if err != nil {
+		writeHighlightError(resp, err, "文章不存在")
+		return
+	}
+
+	resp.WriteEntity(highlights)
+}
+
+// Create 添加划线
+func (h *HighlightHandler) Create(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.ParseUint(req.PathParameter("id"), 10, 32)
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的文章ID",
+		})
+		return
+	}
+
+	var createReq domain.CreateHighlightRequest
+	if err := req.ReadEntity(&createReq); err != nil || createReq.Text == "" {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的请求数据",
+		})
+		return
+	}
+
+	highlight, err := h.highlightService.Create(req.Request.Context(), currentUserID(req), uint(id), &createReq)
+	if err != nil {
+		writeHighlightError(resp, err, "文章不存在")
+		return
+	}
+
+	resp.WriteHeaderAndEntity(http.StatusCreated, highlight)
+}
+
+// Update 修改划线批注
+func (h *HighlightHandler) Update(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.Atoi(req.PathParameter("id"))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的划线ID",
+		})
+		return
+	}
+
+	var updateReq domain.UpdateHighlightRequest
+	if err := req.ReadEntity(&updateReq); err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的请求数据",
+		})
+		return
+	}
+
+	highlight, err := h.highlightService.UpdateNote(req.Request.Context(), currentUserID(req), id, updateReq.Note)
+	if err != nil {
+		writeHighlightError(resp, err, "划线不存在")
+		return
+	}
+
+	resp.WriteEntity(highlight)
+}
+
+// Delete 删除划线
+func (h *HighlightHandler) Delete(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.Atoi(req.PathParameter("id"))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的划线ID",
+		})
+		return
+	}
+
+	if err := h.highlightService.Delete(req.Request.Context(), currentUserID(req), id); err != nil {
+		writeHighlightError(resp, err, "划线不存在")
+		return
+	}
+
+	resp.WriteHeader(http.StatusNoContent)
+}
+
+func writeHighlightError(resp *restful.Response, err error, notFound string) {
+	if errors.Is(err, repository.ErrNotFound) || errors.Is(err, service.ErrForbidden) {
+		resp.WriteHeaderAndEntity(http.StatusNotFound, map[string]string{
+			"error": notFound,
+		})
+		return
+	}
+	resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+		"error": err.Error(),
+	})
+}
@@ -0,0 +1,97 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"regexp"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+)
+
+var imageHashPattern = regexp.MustCompile(`^[0-9a-f]{64}$`)
+
+// ImageHandler 提供归档的图片
+type ImageHandler struct {
+	imageService *service.ImageService
+	auth         restful.FilterFunction
+}
+
+// NewImageHandler 创建图片处理器
+func NewImageHandler(imageService *service.ImageService, auth restful.FilterFunction) *ImageHandler {
+	return &ImageHandler{
+		imageService: imageService,
+		auth:         auth,
+	}
+}
+
+// Register 注册路由
+func (h *ImageHandler) Register(ws *restful.WebService) {
+	ws.Route(ws.GET("/images/usage").To(h.Usage).
+		Filter(h.auth).
+		Doc("获取归档图片占用的空间及配额").
+		Returns(200, "OK", domain.ImageUsage{}))
+
+	// 图片地址以内容哈希命名且内嵌在正文中，<img> 无法携带认证信息，因此不需要登录
+	ws.Route(ws.GET("/images/{hash}").To(h.Get).
+		Doc("获取归档的图片").
+		Param(ws.PathParameter("hash", "图片内容的SHA-256")).
+		Produces("*/*").
+		Returns(200, "OK", nil).
+		Returns(404, "Not Found", nil))
+}
+
+// Usage 返回当前用户的图片空间用量
+func (h *ImageHandler) Usage(req *restful.Request, resp *restful.Response) {
+	usage, err := h.imageService.Usage(req.Request.Context(), currentUserID(req))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteEntity(usage)
+}
+
+// Get 输出图片，内容不会变化，允许长期缓存
+func (h *ImageHandler) Get(req *restful.Request, resp *restful.Response) {
+	hash := req.PathParameter("hash")
+	if !imageHashPattern.MatchString(hash) {
+		resp.WriteHeaderAndJson(http.StatusNotFound, map[string]string{
+			"error": "图片不存在",
+		}, restful.MIME_JSON)
+		return
+	}
+
+	etag := `"` + hash + `"`
+	if req.HeaderParameter("If-None-Match") == etag {
+		resp.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	r, contentType, err := h.imageService.Open(req.Request.Context(), hash)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			resp.WriteHeaderAndJson(http.StatusNotFound, map[string]string{
+				"error": "图片不存在",
+			}, restful.MIME_JSON)
+			return
+		}
+		resp.WriteHeaderAndJson(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		}, restful.MIME_JSON)
+		return
+	}
+	defer r.Close()
+
+	resp.Header().Set("Content-Type", contentType)
+	resp.Header().Set("Cache-Control", "public, max-age=31536000, immutable")
+	resp.Header().Set("ETag", etag)
+	resp.Header().Set("X-Content-Type-Options", "nosniff")
+	resp.WriteHeader(http.StatusOK)
+	io.Copy(resp, r)
+}
@@ -0,0 +1,74 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strings"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+)
+
+// 导入文件的大小上限
+const maxImportSize = 32 << 20
+
+// ImportHandler 处理书签导入请求
+type ImportHandler struct {
+	importService *service.ImportService
+	auth          restful.FilterFunction
+}
+
+// NewImportHandler 创建书签导入处理器
+func NewImportHandler(importService *service.ImportService, auth restful.FilterFunction) *ImportHandler {
+	return &ImportHandler{
+		importService: importService,
+		auth:          auth,
+	}
+}
+
+// Register 注册路由
+func (h *ImportHandler) Register(ws *restful.WebService) {
+	ws.Route(ws.POST("/import").To(h.Import).
+		Filter(h.auth).
+		Doc("导入书签或划线，支持 Netscape 书签HTML、Pocket、Instapaper CSV、Pinboard JSON 和 Kindle My Clippings.txt").
+		Consumes("multipart/form-data", "text/html", "text/csv", "text/plain", restful.MIME_JSON, restful.MIME_OCTET).
+		Param(ws.QueryParameter("format", "导出格式：netscape、pocket、instapaper、pinboard 或 kindle，为空时自动识别")).
+		Param(ws.FormParameter("file", "导出文件，也可直接作为请求体上传").DataType("file")).
+		Returns(200, "OK", domain.ImportReport{}).
+		Returns(400, "Bad Request", nil))
+}
+
+// Import 导入上传的书签文件，正文在后台抓取
+func (h *ImportHandler) Import(req *restful.Request, resp *restful.Response) {
+	body := http.MaxBytesReader(resp.ResponseWriter, req.Request.Body, maxImportSize)
+
+	var file io.Reader = body
+	if strings.HasPrefix(req.HeaderParameter("Content-Type"), "multipart/form-data") {
+		req.Request.Body = body
+		f, _, err := req.Request.FormFile("file")
+		if err != nil {
+			resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+				"error": "缺少导入文件",
+			})
+			return
+		}
+		defer f.Close()
+		file = f
+	}
+
+	report, err := h.importService.Import(req.Request.Context(), currentUserID(req), req.QueryParameter("format"), file)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, service.ErrInvalidImport) {
+			status = http.StatusBadRequest
+		}
+		resp.WriteHeaderAndEntity(status, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteEntity(report)
+}
@@ -0,0 +1,194 @@
+package handler
+
+import (
+	"bytes"
+	"errors"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"time"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+	"github.com/gorexlv/cabinet/scissor/pkg/feed"
+)
+
+// 订阅源的输出格式
+var libraryFeedFormats = map[string]struct {
+	mime  string
+	write func(io.Writer, *feed.Feed) error
+}{
+	"rss":  {feed.MimeRSS, feed.WriteRSS},
+	"atom": {feed.MimeAtom, feed.WriteAtom},
+	"json": {feed.MimeJSON, feed.WriteJSON},
+}
+
+// LibraryFeedHandler 以订阅令牌发布用户的文章库
+type LibraryFeedHandler struct {
+	libraryFeedService *service.LibraryFeedService
+	auth               restful.FilterFunction
+}
+
+// NewLibraryFeedHandler 创建文章库订阅处理器
+func NewLibraryFeedHandler(libraryFeedService *service.LibraryFeedService, auth restful.FilterFunction) *LibraryFeedHandler {
+	return &LibraryFeedHandler{
+		libraryFeedService: libraryFeedService,
+		auth:               auth,
+	}
+}
+
+// Register 注册路由
+func (h *LibraryFeedHandler) Register(ws *restful.WebService) {
+	ws.Route(ws.GET("/library/feed-token").To(h.GetToken).
+		Filter(h.auth).
+		Doc("获取文章库订阅令牌及订阅地址").
+		Returns(200, "OK", domain.LibraryFeedToken{}))
+
+	ws.Route(ws.POST("/library/feed-token").To(h.ResetToken).
+		Filter(h.auth).
+		Doc("重新生成订阅令牌，旧的订阅地址随即失效").
+		Returns(200, "OK", domain.LibraryFeedToken{}))
+
+	// 订阅地址通过令牌认证，阅读器发送的Accept头各不相同，不做内容协商
+	ws.Route(ws.GET("/library/{token}/{format}").To(h.Feed).
+		Doc("订阅用户最近保存的文章").
+		Param(ws.PathParameter("token", "订阅令牌")).
+		Param(ws.PathParameter("format", "rss、atom 或 json")).
+		Produces("*/*").
+		Returns(200, "OK", nil).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.GET("/library/{token}/tags/{tag}/{format}").To(h.Feed).
+		Doc("订阅带有指定标签的文章").
+		Param(ws.PathParameter("token", "订阅令牌")).
+		Param(ws.PathParameter("tag", "标签")).
+		Param(ws.PathParameter("format", "rss、atom 或 json")).
+		Produces("*/*").
+		Returns(200, "OK", nil).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.GET("/library/{token}/clusters/{id}/{format}").To(h.Feed).
+		Doc("订阅主题下的文章").
+		Param(ws.PathParameter("token", "订阅令牌")).
+		Param(ws.PathParameter("id", "主题ID").DataType("integer")).
+		Param(ws.PathParameter("format", "rss、atom 或 json")).
+		Produces("*/*").
+		Returns(200, "OK", nil).
+		Returns(404, "Not Found", nil))
+}
+
+// GetToken 返回订阅令牌，尚未生成时自动生成
+func (h *LibraryFeedHandler) GetToken(req *restful.Request, resp *restful.Response) {
+	token, err := h.libraryFeedService.Token(req.Request.Context(), currentUserID(req))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteEntity(libraryFeedToken(req, token))
+}
+
+// ResetToken 重新生成订阅令牌
+func (h *LibraryFeedHandler) ResetToken(req *restful.Request, resp *restful.Response) {
+	token, err := h.libraryFeedService.ResetToken(req.Request.Context(), currentUserID(req))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteEntity(libraryFeedToken(req, token))
+}
+
+// Feed 输出订阅源，支持 If-Modified-Since 条件请求
+func (h *LibraryFeedHandler) Feed(req *restful.Request, resp *restful.Response) {
+	format, ok := libraryFeedFormats[req.PathParameter("format")]
+	if !ok {
+		resp.WriteHeaderAndJson(http.StatusNotFound, map[string]string{
+			"error": "不支持的订阅格式",
+		}, restful.MIME_JSON)
+		return
+	}
+
+	ctx := req.Request.Context()
+	token := req.PathParameter("token")
+	var (
+		f   *feed.Feed
+		err error
+	)
+	if idParam := req.PathParameter("id"); idParam != "" {
+		id, convErr := strconv.Atoi(idParam)
+		if convErr != nil {
+			resp.WriteHeaderAndJson(http.StatusBadRequest, map[string]string{
+				"error": "无效的主题ID",
+			}, restful.MIME_JSON)
+			return
+		}
+		f, err = h.libraryFeedService.ClusterFeed(ctx, token, id)
+	} else {
+		f, err = h.libraryFeedService.UserFeed(ctx, token, req.PathParameter("tag"))
+	}
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, service.ErrInvalidFeedToken) || errors.Is(err, repository.ErrNotFound) || errors.Is(err, service.ErrForbidden) {
+			status = http.StatusNotFound
+		}
+		resp.WriteHeaderAndJson(status, map[string]string{
+			"error": err.Error(),
+		}, restful.MIME_JSON)
+		return
+	}
+
+	f.SiteURL = requestBaseURL(req) + "/"
+	f.FeedURL = requestBaseURL(req) + req.Request.URL.EscapedPath()
+
+	lastModified := f.Updated.UTC().Truncate(time.Second)
+	if since, err := http.ParseTime(req.HeaderParameter("If-Modified-Since")); err == nil && !lastModified.After(since) {
+		resp.WriteHeader(http.StatusNotModified)
+		return
+	}
+
+	var buf bytes.Buffer
+	if err := format.write(&buf, f); err != nil {
+		resp.WriteHeaderAndJson(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		}, restful.MIME_JSON)
+		return
+	}
+	resp.Header().Set("Content-Type", format.mime+"; charset=utf-8")
+	resp.Header().Set("Last-Modified", lastModified.Format(http.TimeFormat))
+	resp.WriteHeader(http.StatusOK)
+	resp.Write(buf.Bytes())
+}
+
+func libraryFeedToken(req *restful.Request, token string) *domain.LibraryFeedToken {
+	base := requestBaseURL(req) + "/api/library/" + url.PathEscape(token) + "/"
+	return &domain.LibraryFeedToken{
+		Token: token,
+		RSS:   base + "rss",
+		Atom:  base + "atom",
+		JSON:  base + "json",
+	}
+}
+
+// requestBaseURL 根据请求还原服务的外部地址，兼容反向代理设置的转发头
+func requestBaseURL(req *restful.Request) string {
+	scheme := "http"
+	if req.Request.TLS != nil {
+		scheme = "https"
+	}
+	if proto := req.HeaderParameter("X-Forwarded-Proto"); proto != "" {
+		scheme = proto
+	}
+	host := req.Request.Host
+	if forwarded := req.HeaderParameter("X-Forwarded-Host"); forwarded != "" {
+		host = forwarded
+	}
+	return scheme + "://" + host
+}
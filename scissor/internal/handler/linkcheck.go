@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+)
+
+// LinkCheckHandler 查看和检查文章的原文状态
+type LinkCheckHandler struct {
+	linkCheckService *service.LinkCheckService
+	auth             restful.FilterFunction
+}
+
+// NewLinkCheckHandler 创建原文检查处理器
+func NewLinkCheckHandler(linkCheckService *service.LinkCheckService, auth restful.FilterFunction) *LinkCheckHandler {
+	return &LinkCheckHandler{
+		linkCheckService: linkCheckService,
+		auth:             auth,
+	}
+}
+
+// Register 注册路由
+func (h *LinkCheckHandler) Register(ws *restful.WebService) {
+	ws.Route(ws.GET("/articles/source-status").To(h.List).
+		Filter(h.auth).
+		Doc("按原文状态筛选文章，默认返回原文已删除、已迁移或内容已变化的文章").
+		Param(ws.QueryParameter("status", "alive、deleted、moved、changed，以逗号分隔")).
+		Returns(200, "OK", []domain.Article{}).
+		Returns(400, "Bad Request", nil))
+
+	ws.Route(ws.POST("/articles/{id}/source-check").To(h.Check).
+		Filter(h.auth).
+		Doc("立即检查文章的原文状态").
+		Param(ws.PathParameter("id", "文章ID").DataType("integer")).
+		Returns(200, "OK", domain.Article{}).
+		Returns(404, "Not Found", nil).
+		Returns(422, "Unprocessable Entity", nil))
+}
+
+// List 返回处于指定原文状态的文章
+func (h *LinkCheckHandler) List(req *restful.Request, resp *restful.Response) {
+	var statuses []string
+	for _, s := range strings.Split(req.QueryParameter("status"), ",") {
+		if s = strings.TrimSpace(s); s != "" {
+			statuses = append(statuses, s)
+		}
+	}
+
+	articles, err := h.linkCheckService.ListBySourceStatus(req.Request.Context(), currentUserID(req), statuses)
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, service.ErrInvalidSourceStatus) {
+			status = http.StatusBadRequest
+		}
+		resp.WriteHeaderAndEntity(status, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteEntity(articles)
+}
+
+// Check 立即检查原文
+func (h *LinkCheckHandler) Check(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.ParseUint(req.PathParameter("id"), 10, 32)
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的文章ID",
+		})
+		return
+	}
+
+	article, err := h.linkCheckService.Check(req.Request.Context(), currentUserID(req), uint(id))
+	if err != nil {
+		switch {
+		case errors.Is(err, repository.ErrNotFound) || errors.Is(err, service.ErrForbidden):
+			resp.WriteHeaderAndEntity(http.StatusNotFound, map[string]string{
+				"error": "文章不存在",
+			})
+		case errors.Is(err, service.ErrNoSourceURL):
+			resp.WriteHeaderAndEntity(http.StatusUnprocessableEntity, map[string]string{
+				"error": err.Error(),
+			})
+		default:
+			resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+				"error": err.Error(),
+			})
+		}
+		return
+	}
+
+	resp.WriteEntity(article)
+}
@@ -0,0 +1,71 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+)
+
+// MailHandler 管理用户的收件地址
+type MailHandler struct {
+	mailService *service.MailService
+	auth        restful.FilterFunction
+}
+
+// NewMailHandler 创建收件地址处理器
+func NewMailHandler(mailService *service.MailService, auth restful.FilterFunction) *MailHandler {
+	return &MailHandler{
+		mailService: mailService,
+		auth:        auth,
+	}
+}
+
+// Register 注册路由
+func (h *MailHandler) Register(ws *restful.WebService) {
+	ws.Route(ws.GET("/mail/address").To(h.GetAddress).
+		Filter(h.auth).
+		Doc("获取收件地址，发送到该地址的邮件会保存为文章").
+		Returns(200, "OK", domain.MailAddress{}).
+		Returns(503, "Service Unavailable", nil))
+
+	ws.Route(ws.POST("/mail/address").To(h.ResetAddress).
+		Filter(h.auth).
+		Doc("重新生成收件地址，旧地址随即失效").
+		Returns(200, "OK", domain.MailAddress{}).
+		Returns(503, "Service Unavailable", nil))
+}
+
+// GetAddress 返回收件地址，尚未生成时自动生成
+func (h *MailHandler) GetAddress(req *restful.Request, resp *restful.Response) {
+	addr, err := h.mailService.Address(req.Request.Context(), currentUserID(req))
+	if err != nil {
+		writeMailError(resp, err)
+		return
+	}
+
+	resp.WriteEntity(addr)
+}
+
+// ResetAddress 重新生成收件地址
+func (h *MailHandler) ResetAddress(req *restful.Request, resp *restful.Response) {
+	addr, err := h.mailService.ResetAddress(req.Request.Context(), currentUserID(req))
+	if err != nil {
+		writeMailError(resp, err)
+		return
+	}
+
+	resp.WriteEntity(addr)
+}
+
+func writeMailError(resp *restful.Response, err error) {
+	status := http.StatusInternalServerError
+	if errors.Is(err, service.ErrMailDisabled) {
+		status = http.StatusServiceUnavailable
+	}
+	resp.WriteHeaderAndEntity(status, map[string]string{
+		"error": err.Error(),
+	})
+}
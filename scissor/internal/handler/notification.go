@@ -0,0 +1,101 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+)
+
+// NotificationHandler 查看站内通知
+type NotificationHandler struct {
+	notificationService *service.NotificationService
+	auth                restful.FilterFunction
+}
+
+// NewNotificationHandler 创建通知处理器
+func NewNotificationHandler(notificationService *service.NotificationService, auth restful.FilterFunction) *NotificationHandler {
+	return &NotificationHandler{
+		notificationService: notificationService,
+		auth:                auth,
+	}
+}
+
+// Register 注册路由
+func (h *NotificationHandler) Register(ws *restful.WebService) {
+	ws.Route(ws.GET("/notifications").To(h.List).
+		Filter(h.auth).
+		Doc("获取最近的通知及未读数").
+		Param(ws.QueryParameter("unread", "只返回未读通知").DataType("boolean").DefaultValue("false")).
+		Returns(200, "OK", domain.NotificationList{}))
+
+	ws.Route(ws.PUT("/notifications/{id}/read").To(h.MarkRead).
+		Filter(h.auth).
+		Doc("将通知标记为已读").
+		Param(ws.PathParameter("id", "通知ID").DataType("integer")).
+		Returns(200, "OK", domain.Notification{}).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.POST("/notifications/read-all").To(h.MarkAllRead).
+		Filter(h.auth).
+		Doc("将全部通知标记为已读").
+		Returns(204, "No Content", nil))
+}
+
+// List 返回当前用户的通知
+func (h *NotificationHandler) List(req *restful.Request, resp *restful.Response) {
+	unreadOnly, _ := strconv.ParseBool(req.QueryParameter("unread"))
+
+	list, err := h.notificationService.List(req.Request.Context(), currentUserID(req), unreadOnly)
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteEntity(list)
+}
+
+// MarkRead 将通知标记为已读
+func (h *NotificationHandler) MarkRead(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.Atoi(req.PathParameter("id"))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的通知ID",
+		})
+		return
+	}
+
+	n, err := h.notificationService.MarkRead(req.Request.Context(), currentUserID(req), id)
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) || errors.Is(err, service.ErrForbidden) {
+			resp.WriteHeaderAndEntity(http.StatusNotFound, map[string]string{
+				"error": "通知不存在",
+			})
+			return
+		}
+		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteEntity(n)
+}
+
+// MarkAllRead 将全部通知标记为已读
+func (h *NotificationHandler) MarkAllRead(req *restful.Request, resp *restful.Response) {
+	if err := h.notificationService.MarkAllRead(req.Request.Context(), currentUserID(req)); err != nil {
+		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteHeader(http.StatusNoContent)
+}
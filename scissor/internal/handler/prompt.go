@@ -0,0 +1,221 @@
+package handler
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"strconv"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+	"github.com/gorexlv/cabinet/scissor/pkg/prompt"
+)
+
+// PromptHandler 处理提示词模板和文章补全任务请求
+type PromptHandler struct {
+	promptService *service.PromptService
+	auth          restful.FilterFunction
+}
+
+// NewPromptHandler 创建提示词处理器
+func NewPromptHandler(promptService *service.PromptService, auth restful.FilterFunction) *PromptHandler {
+	return &PromptHandler{
+		promptService: promptService,
+		auth:          auth,
+	}
+}
+
+// Register 注册路由
+func (h *PromptHandler) Register(ws *restful.WebService) {
+	ws.Route(ws.GET("/prompts").To(h.List).
+		Filter(h.auth).
+		Doc("获取对当前用户生效的提示词模板，包括系统模板和自定义模板").
+		Returns(200, "OK", []domain.PromptTemplate{}))
+
+	ws.Route(ws.PUT("/prompts/{name}").To(h.Save).
+		Filter(h.auth).
+		Doc("创建或覆盖提示词模板，summary 和 tags 覆盖摘要和标签的模板，其他名称为附加补全任务").
+		Param(ws.PathParameter("name", "任务名称，小写字母开头，可包含数字、下划线和连字符")).
+		Reads(domain.SavePromptTemplateRequest{}).
+		Returns(200, "OK", domain.PromptTemplate{}).
+		Returns(400, "Bad Request", nil))
+
+	ws.Route(ws.DELETE("/prompts/{name}").To(h.Delete).
+		Filter(h.auth).
+		Doc("删除自定义模板，同名的系统模板重新生效").
+		Param(ws.PathParameter("name", "任务名称")).
+		Returns(204, "No Content", nil).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.POST("/articles/{id}/summarize").To(h.Summarize).
+		Filter(h.auth).
+		Doc("按摘要模板重新生成文章摘要，以SSE流式返回，完成后保存到文章").
+		Param(ws.PathParameter("id", "文章ID").DataType("integer")).
+		Produces(mimeEventStream, restful.MIME_JSON).
+		Returns(200, "OK", domain.SummarizeResponse{}).
+		Returns(404, "Not Found", nil).
+		Returns(503, "Service Unavailable", nil))
+
+	ws.Route(ws.GET("/articles/{id}/enrichments").To(h.ListEnrichments).
+		Filter(h.auth).
+		Doc("获取文章附加补全任务的输出").
+		Param(ws.PathParameter("id", "文章ID").DataType("integer")).
+		Returns(200, "OK", []domain.ArticleEnrichment{}).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.POST("/articles/{id}/enrichments/{name}").To(h.Regenerate).
+		Filter(h.auth).
+		Doc("按当前模板重新生成文章指定任务的输出，summary 和 tags 更新文章的摘要和标签").
+		Param(ws.PathParameter("id", "文章ID").DataType("integer")).
+		Param(ws.PathParameter("name", "任务名称")).
+		Returns(200, "OK", domain.ArticleEnrichment{}).
+		Returns(404, "Not Found", nil).
+		Returns(502, "Bad Gateway", nil).
+		Returns(503, "Service Unavailable", nil))
+}
+
+// List 返回提示词模板
+func (h *PromptHandler) List(req *restful.Request, resp *restful.Response) {
+	templates, err := h.promptService.List(req.Request.Context(), currentUserID(req))
+	if err != nil {
+		writePromptError(resp, err, "")
+		return
+	}
+
+	resp.WriteEntity(templates)
+}
+
+// Save 创建或覆盖提示词模板
+func (h *PromptHandler) Save(req *restful.Request, resp *restful.Response) {
+	var saveReq domain.SavePromptTemplateRequest
+	if err := req.ReadEntity(&saveReq); err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的请求数据",
+		})
+		return
+	}
+
+	template, err := h.promptService.Save(req.Request.Context(), currentUserID(req), req.PathParameter("name"), &saveReq)
+	if err != nil {
+		writePromptError(resp, err, "")
+		return
+	}
+
+	resp.WriteEntity(template)
+}
+
+// Delete 删除自定义模板
+func (h *PromptHandler) Delete(req *restful.Request, resp *restful.Response) {
+	if err := h.promptService.Delete(req.Request.Context(), currentUserID(req), req.PathParameter("name")); err != nil {
+		writePromptError(resp, err, "自定义模板不存在")
+		return
+	}
+
+	resp.WriteHeader(http.StatusNoContent)
+}
+
+// Summarize 流式生成文章摘要。事件依次为若干 delta（增量文本）、一个 done（完整摘要），
+// 开始输出后出错时为 error；开始输出前出错时按普通JSON响应返回状态码。
+func (h *PromptHandler) Summarize(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.ParseUint(req.PathParameter("id"), 10, 32)
+	if err != nil {
+		resp.WriteHeaderAndJson(http.StatusBadRequest, map[string]string{
+			"error": "无效的文章ID",
+		}, restful.MIME_JSON)
+		return
+	}
+
+	// 收到第一段输出时才切换为SSE响应，文章不存在等错误仍返回对应的状态码
+	var stream *sseWriter
+	ctx := req.Request.Context()
+	result, err := h.promptService.Summarize(ctx, currentUserID(req), uint(id), func(delta string) error {
+		if stream == nil {
+			var err error
+			if stream, err = newSSEWriter(resp); err != nil {
+				return err
+			}
+		}
+		return stream.Send("delta", map[string]string{"content": delta})
+	})
+	if err != nil {
+		// 客户端已断开时无需再写入
+		if errors.Is(ctx.Err(), context.Canceled) {
+			return
+		}
+		if stream == nil {
+			// 客户端请求的是事件流，错误仍以JSON返回
+			resp.SetRequestAccepts(restful.MIME_JSON)
+			writePromptError(resp, err, "文章不存在")
+			return
+		}
+		stream.Send("error", map[string]string{"error": err.Error()})
+		return
+	}
+
+	stream.Send("done", result)
+}
+
+// ListEnrichments 返回文章附加补全任务的输出
+func (h *PromptHandler) ListEnrichments(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.ParseUint(req.PathParameter("id"), 10, 32)
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的文章ID",
+		})
+		return
+	}
+
+	enrichments, err := h.promptService.ListEnrichments(req.Request.Context(), currentUserID(req), uint(id))
+	if err != nil {
+		writePromptError(resp, err, "文章不存在")
+		return
+	}
+
+	resp.WriteEntity(enrichments)
+}
+
+// Regenerate 重新生成文章指定任务的输出
+func (h *PromptHandler) Regenerate(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.ParseUint(req.PathParameter("id"), 10, 32)
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的文章ID",
+		})
+		return
+	}
+
+	enrichment, err := h.promptService.Regenerate(req.Request.Context(), currentUserID(req), uint(id), req.PathParameter("name"))
+	if err != nil {
+		writePromptError(resp, err, "文章或任务不存在")
+		return
+	}
+
+	resp.WriteEntity(enrichment)
+}
+
+func writePromptError(resp *restful.Response, err error, notFound string) {
+	switch {
+	case errors.Is(err, repository.ErrNotFound) || errors.Is(err, service.ErrForbidden):
+		resp.WriteHeaderAndEntity(http.StatusNotFound, map[string]string{
+			"error": notFound,
+		})
+	case errors.Is(err, service.ErrInvalidPrompt):
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	case errors.Is(err, prompt.ErrInvalidOutput):
+		resp.WriteHeaderAndEntity(http.StatusBadGateway, map[string]string{
+			"error": err.Error(),
+		})
+	case errors.Is(err, service.ErrLLMDisabled):
+		resp.WriteHeaderAndEntity(http.StatusServiceUnavailable, map[string]string{
+			"error": err.Error(),
+		})
+	default:
+		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+}
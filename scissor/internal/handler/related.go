@@ -0,0 +1,78 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+)
+
+const (
+	defaultRelatedLimit = 5
+	maxRelatedLimit     = 20
+)
+
+// RelatedHandler 处理相关文章推荐请求
+type RelatedHandler struct {
+	relatedService *service.RelatedService
+	auth           restful.FilterFunction
+}
+
+// NewRelatedHandler 创建相关文章处理器
+func NewRelatedHandler(relatedService *service.RelatedService, auth restful.FilterFunction) *RelatedHandler {
+	return &RelatedHandler{
+		relatedService: relatedService,
+		auth:           auth,
+	}
+}
+
+// Register 注册路由
+func (h *RelatedHandler) Register(ws *restful.WebService) {
+	ws.Route(ws.GET("/articles/{id}/related").To(h.Related).
+		Filter(h.auth).
+		Doc("获取同一文章库中的相关文章").
+		Param(ws.PathParameter("id", "文章ID").DataType("integer")).
+		Param(ws.QueryParameter("limit", "返回数量").DataType("integer").DefaultValue("5")).
+		Returns(200, "OK", []domain.RelatedArticle{}).
+		Returns(404, "Not Found", nil))
+}
+
+// Related 返回与指定文章相关的当前用户文章
+func (h *RelatedHandler) Related(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.ParseUint(req.PathParameter("id"), 10, 32)
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的文章ID",
+		})
+		return
+	}
+
+	limit, err := strconv.Atoi(req.QueryParameter("limit"))
+	if err != nil || limit <= 0 {
+		limit = defaultRelatedLimit
+	}
+	if limit > maxRelatedLimit {
+		limit = maxRelatedLimit
+	}
+
+	related, err := h.relatedService.Related(req.Request.Context(), currentUserID(req), uint(id), limit)
+	if err != nil {
+		// 不区分文章不存在和无权访问，避免泄露其他用户的文章ID
+		if errors.Is(err, repository.ErrNotFound) || errors.Is(err, service.ErrForbidden) {
+			resp.WriteHeaderAndEntity(http.StatusNotFound, map[string]string{
+				"error": "文章不存在",
+			})
+			return
+		}
+		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteEntity(related)
+}
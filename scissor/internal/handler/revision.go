@@ -0,0 +1,179 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+)
+
+// RevisionHandler 查看和恢复文章的历史版本
+type RevisionHandler struct {
+	revisionService *service.RevisionService
+	auth            restful.FilterFunction
+}
+
+// NewRevisionHandler 创建历史版本处理器
+func NewRevisionHandler(revisionService *service.RevisionService, auth restful.FilterFunction) *RevisionHandler {
+	return &RevisionHandler{
+		revisionService: revisionService,
+		auth:            auth,
+	}
+}
+
+// Register 注册路由
+func (h *RevisionHandler) Register(ws *restful.WebService) {
+	ws.Route(ws.GET("/articles/{id}/revisions").To(h.List).
+		Filter(h.auth).
+		Doc("获取文章的历史版本，不含正文").
+		Param(ws.PathParameter("id", "文章ID").DataType("integer")).
+		Returns(200, "OK", []domain.ArticleRevision{}).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.GET("/articles/{id}/revisions/diff").To(h.Diff).
+		Filter(h.auth).
+		Doc("比较两个历史版本，正文和摘要按纯文本逐行比较").
+		Param(ws.PathParameter("id", "文章ID").DataType("integer")).
+		Param(ws.QueryParameter("from", "旧版本ID").DataType("integer").Required(true)).
+		Param(ws.QueryParameter("to", "新版本ID，默认为最新版本").DataType("integer")).
+		Returns(200, "OK", domain.RevisionDiff{}).
+		Returns(400, "Bad Request", nil).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.GET("/articles/{id}/revisions/{revision}").To(h.Get).
+		Filter(h.auth).
+		Doc("获取历史版本的完整内容").
+		Param(ws.PathParameter("id", "文章ID").DataType("integer")).
+		Param(ws.PathParameter("revision", "版本ID").DataType("integer")).
+		Returns(200, "OK", domain.ArticleRevision{}).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.POST("/articles/{id}/revisions/{revision}/restore").To(h.Restore).
+		Filter(h.auth).
+		Doc("将文章恢复为历史版本，恢复后记录为新版本").
+		Param(ws.PathParameter("id", "文章ID").DataType("integer")).
+		Param(ws.PathParameter("revision", "版本ID").DataType("integer")).
+		Returns(200, "OK", domain.Article{}).
+		Returns(404, "Not Found", nil))
+}
+
+// List 返回文章的历史版本
+func (h *RevisionHandler) List(req *restful.Request, resp *restful.Response) {
+	articleID, ok := revisionArticleID(req, resp)
+	if !ok {
+		return
+	}
+
+	revisions, err := h.revisionService.List(req.Request.Context(), currentUserID(req), articleID)
+	if err != nil {
+		writeRevisionError(resp, err, "文章不存在")
+		return
+	}
+
+	resp.WriteEntity(revisions)
+}
+
+// Get 返回历史版本
+func (h *RevisionHandler) Get(req *restful.Request, resp *restful.Response) {
+	articleID, ok := revisionArticleID(req, resp)
+	if !ok {
+		return
+	}
+	id, err := strconv.Atoi(req.PathParameter("revision"))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的版本ID",
+		})
+		return
+	}
+
+	revision, err := h.revisionService.Get(req.Request.Context(), currentUserID(req), articleID, id)
+	if err != nil {
+		writeRevisionError(resp, err, "版本不存在")
+		return
+	}
+
+	resp.WriteEntity(revision)
+}
+
+// Diff 返回两个历史版本之间的差异
+func (h *RevisionHandler) Diff(req *restful.Request, resp *restful.Response) {
+	articleID, ok := revisionArticleID(req, resp)
+	if !ok {
+		return
+	}
+	from, err := strconv.Atoi(req.QueryParameter("from"))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的版本ID",
+		})
+		return
+	}
+	to := 0
+	if s := req.QueryParameter("to"); s != "" {
+		if to, err = strconv.Atoi(s); err != nil {
+			resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+				"error": "无效的版本ID",
+			})
+			return
+		}
+	}
+
+	diff, err := h.revisionService.Diff(req.Request.Context(), currentUserID(req), articleID, from, to)
+	if err != nil {
+		writeRevisionError(resp, err, "版本不存在")
+		return
+	}
+
+	resp.WriteEntity(diff)
+}
+
+// Restore 恢复历史版本
+func (h *RevisionHandler) Restore(req *restful.Request, resp *restful.Response) {
+	articleID, ok := revisionArticleID(req, resp)
+	if !ok {
+		return
+	}
+	id, err := strconv.Atoi(req.PathParameter("revision"))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的版本ID",
+		})
+		return
+	}
+
+	article, err := h.revisionService.Restore(req.Request.Context(), currentUserID(req), articleID, id)
+	if err != nil {
+		writeRevisionError(resp, err, "版本不存在")
+		return
+	}
+
+	resp.WriteEntity(article)
+}
+
+func revisionArticleID(req *restful.Request, resp *restful.Response) (uint, bool) {
+	id, err := strconv.ParseUint(req.PathParameter("id"), 10, 32)
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的文章ID",
+		})
+		return 0, false
+	}
+	return uint(id), true
+}
+
+func writeRevisionError(resp *restful.Response, err error, notFound string) {
+	if errors.Is(err, repository.ErrNotFound) || errors.Is(err, service.ErrForbidden) {
+		resp.WriteHeaderAndEntity(http.StatusNotFound, map[string]string{
+			"error": notFound,
+		})
+		return
+	}
+	resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+		"error": err.Error(),
+	})
+}
@@ -0,0 +1,86 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+	"strconv"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+)
+
+const (
+	defaultSearchLimit = 10
+	maxSearchLimit     = 50
+)
+
+// SearchHandler 处理语义检索请求
+type SearchHandler struct {
+	searchService *service.SearchService
+	auth          restful.FilterFunction
+}
+
+// NewSearchHandler 创建检索处理器
+func NewSearchHandler(searchService *service.SearchService, auth restful.FilterFunction) *SearchHandler {
+	return &SearchHandler{
+		searchService: searchService,
+		auth:          auth,
+	}
+}
+
+// Register 注册路由
+func (h *SearchHandler) Register(ws *restful.WebService) {
+	ws.Route(ws.GET("/articles/semantic-search").To(h.SemanticSearch).
+		Filter(h.auth).
+		Doc("语义检索文章").
+		Param(ws.QueryParameter("q", "查询语句").Required(true)).
+		Param(ws.QueryParameter("mode", "检索模式：semantic 或 hybrid").DefaultValue(service.SearchModeSemantic)).
+		Param(ws.QueryParameter("limit", "返回数量").DataType("integer").DefaultValue("10")).
+		Returns(200, "OK", []domain.SearchResult{}).
+		Returns(400, "Bad Request", nil))
+}
+
+// SemanticSearch 在当前用户的文章中进行语义检索
+func (h *SearchHandler) SemanticSearch(req *restful.Request, resp *restful.Response) {
+	query := req.QueryParameter("q")
+	if query == "" {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "查询语句不能为空",
+		})
+		return
+	}
+
+	limit, err := strconv.Atoi(req.QueryParameter("limit"))
+	if err != nil || limit <= 0 {
+		limit = defaultSearchLimit
+	}
+	if limit > maxSearchLimit {
+		limit = maxSearchLimit
+	}
+
+	var results []*domain.SearchResult
+	switch req.QueryParameter("mode") {
+	case "", service.SearchModeSemantic:
+		results, err = h.searchService.Semantic(req.Request.Context(), currentUserID(req), query, limit)
+	case service.SearchModeHybrid:
+		results, err = h.searchService.Hybrid(req.Request.Context(), currentUserID(req), query, limit)
+	default:
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的检索模式",
+		})
+		return
+	}
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, service.ErrEmbeddingDisabled) {
+			status = http.StatusServiceUnavailable
+		}
+		resp.WriteHeaderAndEntity(status, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteEntity(results)
+}
@@ -0,0 +1,217 @@
+package handler
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net/http"
+	"strconv"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+	"github.com/gorexlv/cabinet/scissor/pkg/snapshot"
+)
+
+// snapshotCSP 查看快照时的内容安全策略，在快照自带的策略之上以沙箱方式显示
+const snapshotCSP = "sandbox; " + snapshot.ContentSecurityPolicy
+
+// SnapshotHandler 管理文章原网页的快照
+type SnapshotHandler struct {
+	snapshotService *service.SnapshotService
+	auth            restful.FilterFunction
+}
+
+// NewSnapshotHandler 创建快照处理器
+func NewSnapshotHandler(snapshotService *service.SnapshotService, auth restful.FilterFunction) *SnapshotHandler {
+	return &SnapshotHandler{
+		snapshotService: snapshotService,
+		auth:            auth,
+	}
+}
+
+// Register 注册路由
+func (h *SnapshotHandler) Register(ws *restful.WebService) {
+	ws.Route(ws.GET("/articles/{id}/snapshots").To(h.List).
+		Filter(h.auth).
+		Doc("获取文章的网页快照").
+		Param(ws.PathParameter("id", "文章ID").DataType("integer")).
+		Returns(200, "OK", []domain.Snapshot{}).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.POST("/articles/{id}/snapshots").To(h.Create).
+		Filter(h.auth).
+		Doc("抓取文章原网页并保存快照，format 为 html（单文件网页）或 warc").
+		Param(ws.PathParameter("id", "文章ID").DataType("integer")).
+		Reads(domain.CreateSnapshotRequest{}).
+		Returns(201, "Created", domain.Snapshot{}).
+		Returns(400, "Bad Request", nil).
+		Returns(404, "Not Found", nil).
+		Returns(422, "Unprocessable Entity", nil).
+		Returns(502, "Bad Gateway", nil))
+
+	ws.Route(ws.GET("/snapshots/{id}/view").To(h.View).
+		Filter(h.auth).
+		Doc("以沙箱方式查看快照，WARC快照会生成单文件网页").
+		Param(ws.PathParameter("id", "快照ID").DataType("integer")).
+		Produces("*/*").
+		Returns(200, "OK", nil).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.GET("/snapshots/{id}/download").To(h.Download).
+		Filter(h.auth).
+		Doc("下载快照原始文件").
+		Param(ws.PathParameter("id", "快照ID").DataType("integer")).
+		Produces("*/*").
+		Returns(200, "OK", nil).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.DELETE("/snapshots/{id}").To(h.Delete).
+		Filter(h.auth).
+		Doc("删除快照").
+		Param(ws.PathParameter("id", "快照ID").DataType("integer")).
+		Returns(204, "No Content", nil).
+		Returns(404, "Not Found", nil))
+}
+
+// List 返回文章的快照
+func (h *SnapshotHandler) List(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.ParseUint(req.PathParameter("id"), 10, 32)
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的文章ID",
+		})
+		return
+	}
+
+	snapshots, err := h.snapshotService.List(req.Request.Context(), currentUserID(req), uint(id))
+	if err != nil {
+		writeSnapshotError(resp, err, "文章不存在")
+		return
+	}
+
+	resp.WriteEntity(snapshots)
+}
+
+// Create 保存快照，抓取网页和资源可能需要几十秒
+func (h *SnapshotHandler) Create(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.ParseUint(req.PathParameter("id"), 10, 32)
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的文章ID",
+		})
+		return
+	}
+
+	// 请求体可以省略，默认保存单文件网页
+	var createReq domain.CreateSnapshotRequest
+	if err := req.ReadEntity(&createReq); err != nil && !errors.Is(err, io.EOF) {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的请求数据",
+		})
+		return
+	}
+
+	snap, err := h.snapshotService.Create(req.Request.Context(), currentUserID(req), uint(id), &createReq)
+	if err != nil {
+		writeSnapshotError(resp, err, "文章不存在")
+		return
+	}
+
+	resp.WriteHeaderAndEntity(http.StatusCreated, snap)
+}
+
+// View 输出快照网页。快照来自第三方网站，以沙箱CSP禁止脚本执行和外部请求。
+func (h *SnapshotHandler) View(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.Atoi(req.PathParameter("id"))
+	if err != nil {
+		resp.WriteHeaderAndJson(http.StatusBadRequest, map[string]string{
+			"error": "无效的快照ID",
+		}, restful.MIME_JSON)
+		return
+	}
+
+	page, err := h.snapshotService.View(req.Request.Context(), currentUserID(req), id)
+	if err != nil {
+		writeSnapshotError(resp, err, "快照不存在")
+		return
+	}
+
+	resp.Header().Set("Content-Type", "text/html; charset=utf-8")
+	resp.Header().Set("Content-Security-Policy", snapshotCSP)
+	resp.Header().Set("X-Content-Type-Options", "nosniff")
+	resp.Header().Set("Referrer-Policy", "no-referrer")
+	resp.Header().Set("Cache-Control", "private, max-age=86400")
+	resp.WriteHeader(http.StatusOK)
+	resp.Write(page)
+}
+
+// Download 下载快照原始文件
+func (h *SnapshotHandler) Download(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.Atoi(req.PathParameter("id"))
+	if err != nil {
+		resp.WriteHeaderAndJson(http.StatusBadRequest, map[string]string{
+			"error": "无效的快照ID",
+		}, restful.MIME_JSON)
+		return
+	}
+
+	f, snap, err := h.snapshotService.Open(req.Request.Context(), currentUserID(req), id)
+	if err != nil {
+		writeSnapshotError(resp, err, "快照不存在")
+		return
+	}
+	defer f.Close()
+
+	contentType, ext := "text/html; charset=utf-8", "html"
+	if snap.Format == domain.SnapshotFormatWARC {
+		contentType, ext = snapshot.MimeWARC, "warc.gz"
+	}
+	filename := fmt.Sprintf("snapshot-%d-%s.%s", snap.ArticleID, snap.CreatedAt.Format("20060102150405"), ext)
+	resp.Header().Set("Content-Type", contentType)
+	resp.Header().Set("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	resp.Header().Set("Content-Length", strconv.FormatInt(snap.Size, 10))
+	resp.Header().Set("X-Content-Type-Options", "nosniff")
+	resp.WriteHeader(http.StatusOK)
+	if _, err := io.Copy(resp, f); err != nil {
+		log.Printf("Failed to send snapshot %d: %v", id, err)
+	}
+}
+
+// Delete 删除快照
+func (h *SnapshotHandler) Delete(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.Atoi(req.PathParameter("id"))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的快照ID",
+		})
+		return
+	}
+
+	if err := h.snapshotService.Delete(req.Request.Context(), currentUserID(req), id); err != nil {
+		writeSnapshotError(resp, err, "快照不存在")
+		return
+	}
+
+	resp.WriteHeader(http.StatusNoContent)
+}
+
+func writeSnapshotError(resp *restful.Response, err error, notFound string) {
+	status := http.StatusInternalServerError
+	message := err.Error()
+	switch {
+	case errors.Is(err, repository.ErrNotFound) || errors.Is(err, service.ErrForbidden):
+		status, message = http.StatusNotFound, notFound
+	case errors.Is(err, service.ErrInvalidSnapshotFormat):
+		status = http.StatusBadRequest
+	case errors.Is(err, service.ErrSnapshotUnavailable):
+		status = http.StatusUnprocessableEntity
+	case errors.Is(err, service.ErrSnapshotFetch):
+		status = http.StatusBadGateway
+	}
+	resp.WriteHeaderAndJson(status, map[string]string{
+		"error": message,
+	}, restful.MIME_JSON)
+}
@@ -0,0 +1,49 @@
+package handler
+
+import (
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	restful "github.com/emicklei/go-restful/v3"
+)
+
+const mimeEventStream = "text/event-stream"
+
+// sseWriter 以Server-Sent Events格式向客户端推送事件
+type sseWriter struct {
+	w       http.ResponseWriter
+	flusher http.Flusher
+}
+
+// newSSEWriter 写入SSE响应头，底层连接不支持刷新时返回错误
+func newSSEWriter(resp *restful.Response) (*sseWriter, error) {
+	flusher, ok := resp.ResponseWriter.(http.Flusher)
+	if !ok {
+		return nil, fmt.Errorf("streaming is not supported")
+	}
+
+	header := resp.Header()
+	header.Set("Content-Type", mimeEventStream)
+	header.Set("Cache-Control", "no-cache")
+	header.Set("Connection", "keep-alive")
+	// 禁止反向代理缓冲
+	header.Set("X-Accel-Buffering", "no")
+	resp.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	return &sseWriter{w: resp.ResponseWriter, flusher: flusher}, nil
+}
+
+// Send 发送一个事件，data序列化为JSON
+func (s *sseWriter) Send(event string, data interface{}) error {
+	payload, err := json.Marshal(data)
+	if err != nil {
+		return err
+	}
+	if _, err := fmt.Fprintf(s.w, "event: %s\ndata: %s\n\n", event, payload); err != nil {
+		return err
+	}
+	s.flusher.Flush()
+	return nil
+}
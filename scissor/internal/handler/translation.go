@@ -0,0 +1,171 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"net/http"
+	"strconv"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+)
+
+// TranslationHandler 处理文章翻译请求
+type TranslationHandler struct {
+	translationService *service.TranslationService
+	auth               restful.FilterFunction
+}
+
+// NewTranslationHandler 创建翻译处理器
+func NewTranslationHandler(translationService *service.TranslationService, auth restful.FilterFunction) *TranslationHandler {
+	return &TranslationHandler{
+		translationService: translationService,
+		auth:               auth,
+	}
+}
+
+// Register 注册路由
+func (h *TranslationHandler) Register(ws *restful.WebService) {
+	ws.Route(ws.GET("/articles/{id}/translations").To(h.List).
+		Filter(h.auth).
+		Doc("获取文章的语言版本，不含正文").
+		Param(ws.PathParameter("id", "文章ID").DataType("integer")).
+		Returns(200, "OK", []domain.ArticleTranslation{}).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.POST("/articles/{id}/translations").To(h.Create).
+		Filter(h.auth).
+		Doc("请求将文章翻译为指定语言，已有该语言版本时重新翻译").
+		Param(ws.PathParameter("id", "文章ID").DataType("integer")).
+		Reads(domain.CreateTranslationRequest{}).
+		Returns(202, "Accepted", domain.ArticleTranslation{}).
+		Returns(400, "Bad Request", nil).
+		Returns(404, "Not Found", nil).
+		Returns(503, "Service Unavailable", nil))
+
+	ws.Route(ws.GET("/articles/{id}/translations/{language}").To(h.Get).
+		Filter(h.auth).
+		Doc("获取文章指定语言的版本").
+		Param(ws.PathParameter("id", "文章ID").DataType("integer")).
+		Param(ws.PathParameter("language", "目标语言，如 zh")).
+		Param(ws.QueryParameter("view", "translated（只显示译文）或 bilingual（双语对照）").DefaultValue("translated")).
+		Returns(200, "OK", domain.ArticleTranslation{}).
+		Returns(400, "Bad Request", nil).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.DELETE("/articles/{id}/translations/{language}").To(h.Delete).
+		Filter(h.auth).
+		Doc("删除文章指定语言的版本").
+		Param(ws.PathParameter("id", "文章ID").DataType("integer")).
+		Param(ws.PathParameter("language", "目标语言，如 zh")).
+		Returns(204, "No Content", nil).
+		Returns(404, "Not Found", nil))
+}
+
+// List 返回文章的语言版本
+func (h *TranslationHandler) List(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.ParseUint(req.PathParameter("id"), 10, 32)
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的文章ID",
+		})
+		return
+	}
+
+	translations, err := h.translationService.List(req.Request.Context(), currentUserID(req), uint(id))
+	if err != nil {
+		writeTranslationError(resp, err, "文章不存在")
+		return
+	}
+
+	resp.WriteEntity(translations)
+}
+
+// Create 请求翻译文章
+func (h *TranslationHandler) Create(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.ParseUint(req.PathParameter("id"), 10, 32)
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的文章ID",
+		})
+		return
+	}
+
+	// 请求体可以省略，默认翻译为中文
+	var createReq domain.CreateTranslationRequest
+	if err := req.ReadEntity(&createReq); err != nil && !errors.Is(err, io.EOF) {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的请求数据",
+		})
+		return
+	}
+
+	translation, err := h.translationService.Request(req.Request.Context(), currentUserID(req), uint(id), createReq.Language)
+	if err != nil {
+		writeTranslationError(resp, err, "文章不存在")
+		return
+	}
+
+	resp.WriteHeaderAndEntity(http.StatusAccepted, translation)
+}
+
+// Get 返回文章指定语言的版本
+func (h *TranslationHandler) Get(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.ParseUint(req.PathParameter("id"), 10, 32)
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的文章ID",
+		})
+		return
+	}
+
+	translation, err := h.translationService.Get(req.Request.Context(), currentUserID(req), uint(id),
+		req.PathParameter("language"), req.QueryParameter("view"))
+	if err != nil {
+		writeTranslationError(resp, err, "译文不存在")
+		return
+	}
+
+	resp.WriteEntity(translation)
+}
+
+// Delete 删除文章指定语言的版本
+func (h *TranslationHandler) Delete(req *restful.Request, resp *restful.Response) {
+	id, err := strconv.ParseUint(req.PathParameter("id"), 10, 32)
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的文章ID",
+		})
+		return
+	}
+
+	if err := h.translationService.Delete(req.Request.Context(), currentUserID(req), uint(id), req.PathParameter("language")); err != nil {
+		writeTranslationError(resp, err, "译文不存在")
+		return
+	}
+
+	resp.WriteHeader(http.StatusNoContent)
+}
+
+func writeTranslationError(resp *restful.Response, err error, notFound string) {
+	switch {
+	case errors.Is(err, repository.ErrNotFound) || errors.Is(err, service.ErrForbidden):
+		resp.WriteHeaderAndEntity(http.StatusNotFound, map[string]string{
+			"error": notFound,
+		})
+	case errors.Is(err, service.ErrUnsupportedLanguage) || errors.Is(err, service.ErrInvalidTranslationView):
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": err.Error(),
+		})
+	case errors.Is(err, service.ErrLLMDisabled):
+		resp.WriteHeaderAndEntity(http.StatusServiceUnavailable, map[string]string{
+			"error": err.Error(),
+		})
+	default:
+		resp.WriteHeaderAndEntity(http.StatusInternalServerError, map[string]string{
+			"error": err.Error(),
+		})
+	}
+}
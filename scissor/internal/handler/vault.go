@@ -0,0 +1,50 @@
+package handler
+
+import (
+	"errors"
+	"net/http"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+	"github.com/gorexlv/cabinet/scissor/pkg/vault"
+)
+
+// VaultHandler 处理笔记库同步请求
+type VaultHandler struct {
+	vaultService *service.VaultService
+	auth         restful.FilterFunction
+}
+
+// NewVaultHandler 创建笔记库同步处理器
+func NewVaultHandler(vaultService *service.VaultService, auth restful.FilterFunction) *VaultHandler {
+	return &VaultHandler{
+		vaultService: vaultService,
+		auth:         auth,
+	}
+}
+
+// Register 注册路由
+func (h *VaultHandler) Register(ws *restful.WebService) {
+	ws.Route(ws.POST("/sync/vault").To(h.Sync).
+		Filter(h.auth).
+		Doc("将文章、摘要、标签和划线同步到 Obsidian/Logseq 笔记库").
+		Returns(200, "OK", vault.Result{}).
+		Returns(503, "Service Unavailable", nil))
+}
+
+// Sync 同步当前用户的文章到笔记库
+func (h *VaultHandler) Sync(req *restful.Request, resp *restful.Response) {
+	result, err := h.vaultService.Sync(req.Request.Context(), currentUserID(req))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, service.ErrVaultDisabled) {
+			status = http.StatusServiceUnavailable
+		}
+		resp.WriteHeaderAndEntity(status, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteEntity(result)
+}
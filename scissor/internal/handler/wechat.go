@@ -43,7 +43,7 @@ func (h *WechatHandler) Register(ws *restful.WebService) {
 
 // GetQRCode 获取微信登录二维码
 func (h *WechatHandler) GetQRCode(req *restful.Request, resp *restful.Response) {
-	qrcodeURL, err := h.wxClient.GetQRCode()
+	qrcodeURL, err := h.wxClient.GetQRCode(req.Request.Context())
 	if err != nil {
 		resp.WriteHeaderAndJson(http.StatusInternalServerError, map[string]string{
 			"error": fmt.Sprintf("生成二维码失败: %v", err),
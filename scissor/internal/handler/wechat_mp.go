@@ -0,0 +1,188 @@
+package handler
+
+import (
+	"errors"
+	"io"
+	"log"
+	"net/http"
+
+	restful "github.com/emicklei/go-restful/v3"
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/service"
+	"github.com/gorexlv/cabinet/scissor/pkg/wechat"
+)
+
+// 公众号消息的大小上限
+const maxWechatMessageSize = 1 << 20
+
+// WechatMPHandler 处理公众号服务器的消息推送
+type WechatMPHandler struct {
+	wechatMPService *service.WechatMPService
+	auth            restful.FilterFunction
+}
+
+// NewWechatMPHandler 创建公众号消息处理器
+func NewWechatMPHandler(wechatMPService *service.WechatMPService, auth restful.FilterFunction) *WechatMPHandler {
+	return &WechatMPHandler{
+		wechatMPService: wechatMPService,
+		auth:            auth,
+	}
+}
+
+// Register 注册路由
+func (h *WechatMPHandler) Register(ws *restful.WebService) {
+	ws.Route(ws.GET("/wechat/mp").To(h.Verify).
+		Doc("公众号服务器地址校验").
+		Param(ws.QueryParameter("signature", "微信签名")).
+		Param(ws.QueryParameter("timestamp", "时间戳")).
+		Param(ws.QueryParameter("nonce", "随机数")).
+		Param(ws.QueryParameter("echostr", "校验通过后原样返回的字符串")).
+		Produces(restful.MIME_JSON, "text/plain").
+		Returns(200, "OK", nil).
+		Returns(403, "Forbidden", nil))
+
+	ws.Route(ws.POST("/wechat/mp").To(h.Receive).
+		Doc("接收公众号消息，保存链接并被动回复摘要").
+		Consumes("text/xml", restful.MIME_XML).
+		Produces(restful.MIME_JSON, restful.MIME_XML, "text/xml").
+		Returns(200, "OK", nil).
+		Returns(403, "Forbidden", nil))
+
+	ws.Route(ws.POST("/wechat/mp/bind-code").To(h.BindCode).
+		Filter(h.auth).
+		Doc("获取关联公众号的绑定码").
+		Returns(200, "OK", domain.WechatBindCode{}).
+		Returns(503, "Service Unavailable", nil))
+
+	ws.Route(ws.GET("/wechat/mp/bind-code").To(h.BindStatus).
+		Filter(h.auth).
+		Doc("获取当前的绑定码及是否已在公众号中发送").
+		Returns(200, "OK", domain.WechatBindCode{}).
+		Returns(404, "Not Found", nil))
+
+	ws.Route(ws.POST("/wechat/mp/bind").To(h.ConfirmBind).
+		Filter(h.auth).
+		Doc("确认关联发送绑定码的微信，覆盖原有的微信身份").
+		Reads(domain.ConfirmWechatBindRequest{}).
+		Returns(204, "No Content", nil).
+		Returns(400, "Bad Request", nil).
+		Returns(409, "Conflict", nil))
+}
+
+// Verify 校验签名后原样返回echostr
+func (h *WechatMPHandler) Verify(req *restful.Request, resp *restful.Response) {
+	if !h.verify(req, resp) {
+		return
+	}
+	resp.Header().Set("Content-Type", "text/plain; charset=utf-8")
+	io.WriteString(resp, req.QueryParameter("echostr"))
+}
+
+// Receive 处理消息推送，无需回复时按微信要求返回 success
+func (h *WechatMPHandler) Receive(req *restful.Request, resp *restful.Response) {
+	if !h.verify(req, resp) {
+		return
+	}
+
+	msg, err := wechat.ParseMessage(io.LimitReader(req.Request.Body, maxWechatMessageSize))
+	if err != nil {
+		resp.WriteHeaderAndJson(http.StatusBadRequest, map[string]string{
+			"error": "无效的消息",
+		}, restful.MIME_JSON)
+		return
+	}
+
+	content := h.wechatMPService.Handle(req.Request.Context(), msg)
+	if content == "" {
+		resp.Header().Set("Content-Type", "text/plain; charset=utf-8")
+		io.WriteString(resp, "success")
+		return
+	}
+
+	body, err := wechat.TextReply(msg, content)
+	if err != nil {
+		log.Printf("Failed to build wechat reply: %v", err)
+		io.WriteString(resp, "success")
+		return
+	}
+	resp.Header().Set("Content-Type", "application/xml; charset=utf-8")
+	resp.Write(body)
+}
+
+// BindCode 生成绑定码
+func (h *WechatMPHandler) BindCode(req *restful.Request, resp *restful.Response) {
+	code, err := h.wechatMPService.BindCode(currentUserID(req))
+	if err != nil {
+		status := http.StatusInternalServerError
+		if errors.Is(err, service.ErrWechatMPDisabled) {
+			status = http.StatusServiceUnavailable
+		}
+		resp.WriteHeaderAndEntity(status, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteEntity(code)
+}
+
+// BindStatus 返回当前的绑定码状态
+func (h *WechatMPHandler) BindStatus(req *restful.Request, resp *restful.Response) {
+	code, err := h.wechatMPService.BindStatus(currentUserID(req))
+	if err != nil {
+		resp.WriteHeaderAndEntity(http.StatusNotFound, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteEntity(code)
+}
+
+// ConfirmBind 确认关联微信
+func (h *WechatMPHandler) ConfirmBind(req *restful.Request, resp *restful.Response) {
+	var confirmReq domain.ConfirmWechatBindRequest
+	if err := req.ReadEntity(&confirmReq); err != nil {
+		resp.WriteHeaderAndEntity(http.StatusBadRequest, map[string]string{
+			"error": "无效的请求数据",
+		})
+		return
+	}
+
+	if err := h.wechatMPService.ConfirmBind(req.Request.Context(), currentUserID(req), confirmReq.Code); err != nil {
+		status := http.StatusInternalServerError
+		switch {
+		case errors.Is(err, service.ErrInvalidBindCode) || errors.Is(err, service.ErrBindNotClaimed):
+			status = http.StatusBadRequest
+		case errors.Is(err, service.ErrWechatAlreadyBound):
+			status = http.StatusConflict
+		}
+		resp.WriteHeaderAndEntity(status, map[string]string{
+			"error": err.Error(),
+		})
+		return
+	}
+
+	resp.WriteHeader(http.StatusNoContent)
+}
+
+func (h *WechatMPHandler) verify(req *restful.Request, resp *restful.Response) bool {
+	ok, err := h.wechatMPService.Verify(
+		req.QueryParameter("signature"),
+		req.QueryParameter("timestamp"),
+		req.QueryParameter("nonce"),
+	)
+	if err != nil {
+		resp.WriteHeaderAndJson(http.StatusServiceUnavailable, map[string]string{
+			"error": err.Error(),
+		}, restful.MIME_JSON)
+		return false
+	}
+	if !ok {
+		resp.WriteHeaderAndJson(http.StatusForbidden, map[string]string{
+			"error": "签名校验失败",
+		}, restful.MIME_JSON)
+		return false
+	}
+	return true
+}
@@ -0,0 +1,124 @@
+// Package job 提供进程内的后台任务调度
+package job
+
+import (
+	"context"
+	"log"
+	"sync"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+)
+
+// Func 后台任务，ctx在调度器停止时取消
+type Func func(ctx context.Context) error
+
+type task struct {
+	name     string
+	interval time.Duration
+	fn       Func
+	trigger  chan struct{}
+}
+
+// Scheduler 按固定间隔执行任务，也支持立即触发。同一任务不会并发执行。
+type Scheduler struct {
+	mu     sync.Mutex
+	tasks  map[string]*task
+	cancel context.CancelFunc
+	wg     sync.WaitGroup
+}
+
+// NewScheduler 创建调度器
+func NewScheduler() *Scheduler {
+	return &Scheduler{
+		tasks: make(map[string]*task),
+	}
+}
+
+// Every 注册周期任务，需在Start之前调用。间隔不为正数时任务只在触发时执行。
+func (s *Scheduler) Every(name string, interval time.Duration, fn Func) {
+	if interval <= 0 {
+		log.Printf("Job %s has non-positive interval %s, it will only run when triggered", name, interval)
+		interval = 0
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.tasks[name] = &task{
+		name:     name,
+		interval: interval,
+		fn:       fn,
+		trigger:  make(chan struct{}, 1),
+	}
+}
+
+// Trigger 请求尽快执行一次任务，不等待执行完成；任务执行前的多次触发会合并为一次
+func (s *Scheduler) Trigger(name string) {
+	s.mu.Lock()
+	t, ok := s.tasks[name]
+	s.mu.Unlock()
+	if !ok {
+		return
+	}
+	select {
+	case t.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// TriggerHook 返回在变更成功后触发指定任务的ent钩子
+func (s *Scheduler) TriggerHook(name string) ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			if err == nil {
+				s.Trigger(name)
+			}
+			return v, err
+		})
+	}
+}
+
+// Start 启动所有已注册任务
+func (s *Scheduler) Start(ctx context.Context) {
+	ctx, s.cancel = context.WithCancel(ctx)
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, t := range s.tasks {
+		s.wg.Add(1)
+		go s.loop(ctx, t)
+	}
+}
+
+// Stop 停止调度并等待正在执行的任务退出
+func (s *Scheduler) Stop() {
+	if s.cancel != nil {
+		s.cancel()
+	}
+	s.wg.Wait()
+}
+
+func (s *Scheduler) loop(ctx context.Context, t *task) {
+	defer s.wg.Done()
+
+	var tick <-chan time.Time
+	if t.interval > 0 {
+		ticker := time.NewTicker(t.interval)
+		defer ticker.Stop()
+		tick = ticker.C
+	}
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-tick:
+		case <-t.trigger:
+		}
+
+		start := time.Now()
+		if err := t.fn(ctx); err != nil && ctx.Err() == nil {
+			log.Printf("Job %s failed after %s: %v", t.name, time.Since(start).Round(time.Millisecond), err)
+		}
+	}
+}
@@ -1,6 +1,7 @@
 package middleware
 
 import (
+	"context"
 	"net/http"
 	"strings"
 
@@ -9,6 +10,12 @@ import (
 )
 
 func AuthMiddleware(jwtSecret string) restful.FilterFunction {
+	return TokenAuthMiddleware(jwtSecret, nil)
+}
+
+// TokenAuthMiddleware 与AuthMiddleware相同，但JWT校验失败时再以个人访问令牌校验，
+// authenticate返回令牌所属的用户ID
+func TokenAuthMiddleware(jwtSecret string, authenticate func(ctx context.Context, token string) (uint, error)) restful.FilterFunction {
 	return func(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
 		authHeader := req.HeaderParameter("Authorization")
 		if authHeader == "" {
@@ -22,14 +29,20 @@ func AuthMiddleware(jwtSecret string) restful.FilterFunction {
 			return
 		}
 
+		var userID uint
 		claims, err := jwt.ValidateToken(parts[1], jwtSecret)
+		if err == nil {
+			userID = claims.UserID
+		} else if authenticate != nil {
+			userID, err = authenticate(req.Request.Context(), parts[1])
+		}
 		if err != nil {
 			resp.WriteError(http.StatusUnauthorized, restful.NewError(http.StatusUnauthorized, "invalid token"))
 			return
 		}
 
 		// 将用户ID添加到请求上下文中
-		req.SetAttribute("user_id", claims.UserID)
+		req.SetAttribute("user_id", userID)
 		chain.ProcessFilter(req, resp)
 	}
 }
@@ -0,0 +1,39 @@
+package middleware
+
+import (
+	"net/http"
+	"strings"
+
+	"github.com/emicklei/go-restful/v3"
+)
+
+// CORS 允许来自allowedOrigins的跨域POST请求，供书签小工具和浏览器扩展调用，列表包含 * 时允许任意来源。
+// 只放行 Authorization 请求头而不放行 Cookie，预检请求（OPTIONS）直接返回。
+func CORS(allowedOrigins []string) restful.FilterFunction {
+	allowAll := false
+	allowed := make(map[string]bool, len(allowedOrigins))
+	for _, o := range allowedOrigins {
+		o = strings.TrimRight(strings.TrimSpace(o), "/")
+		if o == "*" {
+			allowAll = true
+		}
+		allowed[strings.ToLower(o)] = true
+	}
+
+	return func(req *restful.Request, resp *restful.Response, chain *restful.FilterChain) {
+		origin := req.HeaderParameter("Origin")
+		resp.AddHeader("Vary", "Origin")
+		if origin != "" && (allowAll || allowed[strings.ToLower(origin)]) {
+			resp.AddHeader("Access-Control-Allow-Origin", origin)
+			resp.AddHeader("Access-Control-Allow-Methods", "POST, OPTIONS")
+			resp.AddHeader("Access-Control-Allow-Headers", "Authorization, Content-Type")
+			resp.AddHeader("Access-Control-Max-Age", "86400")
+		}
+
+		if req.Request.Method == http.MethodOptions {
+			resp.WriteHeader(http.StatusNoContent)
+			return
+		}
+		chain.ProcessFilter(req, resp)
+	}
+}
@@ -0,0 +1,69 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/accesstoken"
+)
+
+type AccessTokenRepository struct {
+	client *ent.Client
+}
+
+func NewAccessTokenRepository(client *ent.Client) *AccessTokenRepository {
+	return &AccessTokenRepository{client: client}
+}
+
+func (r *AccessTokenRepository) Create(ctx context.Context, t *ent.AccessToken) (*ent.AccessToken, error) {
+	return r.client.AccessToken.Create().
+		SetUserID(t.UserID).
+		SetName(t.Name).
+		SetTokenHash(t.TokenHash).
+		SetPrefix(t.Prefix).
+		Save(ctx)
+}
+
+func (r *AccessTokenRepository) FindByID(ctx context.Context, id int) (*ent.AccessToken, error) {
+	t, err := r.client.AccessToken.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+// FindByHash 根据令牌的哈希查找令牌
+func (r *AccessTokenRepository) FindByHash(ctx context.Context, hash string) (*ent.AccessToken, error) {
+	t, err := r.client.AccessToken.Query().
+		Where(accesstoken.TokenHash(hash)).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+func (r *AccessTokenRepository) FindByUserID(ctx context.Context, userID int) ([]*ent.AccessToken, error) {
+	return r.client.AccessToken.Query().
+		Where(accesstoken.UserID(userID)).
+		Order(ent.Desc(accesstoken.FieldCreatedAt)).
+		All(ctx)
+}
+
+// Touch 记录令牌的最近使用时间
+func (r *AccessTokenRepository) Touch(ctx context.Context, id int, at time.Time) error {
+	return r.client.AccessToken.UpdateOneID(id).
+		SetLastUsedAt(at).
+		Exec(ctx)
+}
+
+func (r *AccessTokenRepository) Delete(ctx context.Context, id int) error {
+	return r.client.AccessToken.DeleteOneID(id).Exec(ctx)
+}
@@ -3,10 +3,14 @@ package repository
 import (
 	"context"
 	"errors"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqljson"
 
 	"github.com/gorexlv/cabinet/scissor/pkg/ent"
 	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
-	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
 )
 
 var ErrNotFound = errors.New("记录未找到")
@@ -20,16 +24,26 @@ func NewArticleRepository(client *ent.Client) *ArticleRepository {
 }
 
 func (r *ArticleRepository) Create(ctx context.Context, article *ent.Article) (*ent.Article, error) {
-	return r.client.Article.Create().
+	create := r.client.Article.Create()
+	// 导入时保留原始收藏时间
+	if !article.CreatedAt.IsZero() {
+		create.SetCreatedAt(article.CreatedAt)
+	}
+	if article.FetchStatus != "" {
+		create.SetFetchStatus(article.FetchStatus)
+	}
+	return create.
 		SetTitle(article.Title).
 		SetContent(article.Content).
 		SetURL(article.URL).
+		SetCanonicalURL(article.CanonicalURL).
+		SetFingerprint(article.Fingerprint).
 		SetAuthor(article.Author).
 		SetSource(article.Source).
 		SetSummary(article.Summary).
 		SetTags(article.Tags).
 		SetPublishedAt(article.PublishedAt).
-		SetUserID(article.Edges.User.ID).
+		SetUserID(article.UserID).
 		Save(ctx)
 }
 
@@ -44,9 +58,16 @@ func (r *ArticleRepository) FindByID(ctx context.Context, id int) (*ent.Article,
 	return article, nil
 }
 
-func (r *ArticleRepository) FindByURL(ctx context.Context, url string) (*ent.Article, error) {
+func (r *ArticleRepository) FindByIDs(ctx context.Context, ids []uint) ([]*ent.Article, error) {
+	return r.client.Article.Query().
+		Where(article.IDIn(ids...)).
+		All(ctx)
+}
+
+// FindByURL 查找用户库中链接完全相同的文章
+func (r *ArticleRepository) FindByURL(ctx context.Context, userID int, url string) (*ent.Article, error) {
 	article, err := r.client.Article.Query().
-		Where(article.URL(url)).
+		Where(article.UserID(userID), article.URL(url)).
 		Only(ctx)
 	if err != nil {
 		if ent.IsNotFound(err) {
@@ -57,18 +78,154 @@ func (r *ArticleRepository) FindByURL(ctx context.Context, url string) (*ent.Art
 	return article, nil
 }
 
+// FindByCanonicalURL 查找用户库中规范化URL相同的文章
+func (r *ArticleRepository) FindByCanonicalURL(ctx context.Context, userID int, canonicalURL string) (*ent.Article, error) {
+	article, err := r.client.Article.Query().
+		Where(
+			article.UserID(userID),
+			article.CanonicalURL(canonicalURL),
+		).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return article, nil
+}
+
+// FindFingerprintsByUserID 返回用户所有已计算内容指纹的文章，只包含ID、标题和指纹
+func (r *ArticleRepository) FindFingerprintsByUserID(ctx context.Context, userID int) ([]*ent.Article, error) {
+	return r.client.Article.Query().
+		Where(
+			article.UserID(userID),
+			article.FingerprintNotNil(),
+			article.FingerprintNEQ(0),
+		).
+		Select(article.FieldID, article.FieldTitle, article.FieldFingerprint).
+		All(ctx)
+}
+
 func (r *ArticleRepository) FindByUserID(ctx context.Context, userID int) ([]*ent.Article, error) {
 	return r.client.Article.Query().
-		Where(article.HasUserWith(user.ID(userID))).
+		Where(article.UserID(userID)).
 		All(ctx)
 }
 
-func (r *ArticleRepository) List(ctx context.Context, page, pageSize int) ([]*ent.Article, error) {
-	offset := (page - 1) * pageSize
+// FindByUserIDAfter 按ID升序分页返回用户的文章，afterID为上一页最后一篇文章的ID
+func (r *ArticleRepository) FindByUserIDAfter(ctx context.Context, userID int, afterID uint, limit int) ([]*ent.Article, error) {
+	return r.client.Article.Query().
+		Where(
+			article.UserID(userID),
+			article.IDGT(afterID),
+		).
+		Order(ent.Asc(article.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
+
+// FindByUserIDAndTag 返回用户带有指定标签的文章
+func (r *ArticleRepository) FindByUserIDAndTag(ctx context.Context, userID int, tag string) ([]*ent.Article, error) {
 	return r.client.Article.Query().
+		Where(
+			article.UserID(userID),
+			func(s *sql.Selector) {
+				s.Where(sqljson.ValueContains(article.FieldTags, tag))
+			},
+		).
+		Order(ent.Desc(article.FieldPublishedAt)).
+		All(ctx)
+}
+
+// FindRecentByUserID 返回用户最近保存的文章，tag不为空时只返回带有该标签的文章
+func (r *ArticleRepository) FindRecentByUserID(ctx context.Context, userID int, tag string, limit int) ([]*ent.Article, error) {
+	query := r.client.Article.Query().
+		Where(article.UserID(userID))
+	if tag != "" {
+		query.Where(func(s *sql.Selector) {
+			s.Where(sqljson.ValueContains(article.FieldTags, tag))
+		})
+	}
+	return query.
+		Order(ent.Desc(article.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+// FindUnread 返回用户在since之后保存且尚未阅读的文章，按保存时间升序
+func (r *ArticleRepository) FindUnread(ctx context.Context, userID int, since time.Time, limit int) ([]*ent.Article, error) {
+	return r.client.Article.Query().
+		Where(
+			article.UserID(userID),
+			article.ReadAtIsNil(),
+			article.CreatedAtGTE(since),
+		).
+		Order(ent.Asc(article.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+// SetReadAt 设置文章的阅读时间，readAt为nil时标记为未读
+func (r *ArticleRepository) SetReadAt(ctx context.Context, id int, readAt *time.Time) (*ent.Article, error) {
+	update := r.client.Article.UpdateOneID(uint(id))
+	if readAt == nil {
+		update.ClearReadAt()
+	} else {
+		update.SetReadAt(*readAt)
+	}
+	return update.Save(ctx)
+}
+
+// ArticleFilter 文章列表的筛选和排序条件，零值表示不筛选、按发布时间倒序
+type ArticleFilter struct {
+	Language   string
+	MinMinutes int
+	MaxMinutes int
+	// OrderBy 排序字段，为空时按发布时间排序
+	OrderBy string
+	Asc     bool
+}
+
+// apply 将筛选和排序条件应用到查询上
+func (f *ArticleFilter) apply(q *ent.ArticleQuery) *ent.ArticleQuery {
+	if f.Language != "" {
+		q.Where(article.Language(f.Language))
+	}
+	if f.MinMinutes > 0 {
+		q.Where(article.ReadingMinutesGTE(f.MinMinutes))
+	}
+	if f.MaxMinutes > 0 {
+		q.Where(article.ReadingMinutesLTE(f.MaxMinutes))
+	}
+
+	field := f.OrderBy
+	if field == "" {
+		field = article.FieldPublishedAt
+	}
+	order := ent.Desc
+	if f.Asc {
+		order = ent.Asc
+	}
+	q.Order(order(field))
+	if field != article.FieldPublishedAt {
+		// 阅读时长或字数相同的文章按发布时间倒序
+		q.Order(ent.Desc(article.FieldPublishedAt))
+	}
+	return q.Order(ent.Desc(article.FieldID))
+}
+
+func (r *ArticleRepository) List(ctx context.Context, page, pageSize int, filter *ArticleFilter) ([]*ent.Article, error) {
+	offset := (page - 1) * pageSize
+	return filter.apply(r.client.Article.Query()).
 		Offset(offset).
 		Limit(pageSize).
-		Order(ent.Desc(article.FieldPublishedAt)).
+		All(ctx)
+}
+
+// ListByUserID 按筛选条件返回用户的全部文章
+func (r *ArticleRepository) ListByUserID(ctx context.Context, userID int, filter *ArticleFilter) ([]*ent.Article, error) {
+	return filter.apply(r.client.Article.Query().Where(article.UserID(userID))).
 		All(ctx)
 }
 
@@ -77,7 +234,21 @@ func (r *ArticleRepository) Search(ctx context.Context, keyword string) ([]*ent.
 		Where(
 			article.Or(
 				article.TitleContains(keyword),
-				article.ContentContains(keyword),
+				contentContains(keyword),
+				article.AuthorContains(keyword),
+			),
+		).
+		Order(ent.Desc(article.FieldPublishedAt)).
+		All(ctx)
+}
+
+func (r *ArticleRepository) SearchByUserID(ctx context.Context, userID int, keyword string) ([]*ent.Article, error) {
+	return r.client.Article.Query().
+		Where(
+			article.UserID(userID),
+			article.Or(
+				article.TitleContains(keyword),
+				contentContains(keyword),
 				article.AuthorContains(keyword),
 			),
 		).
@@ -85,20 +256,185 @@ func (r *ArticleRepository) Search(ctx context.Context, keyword string) ([]*ent.
 		All(ctx)
 }
 
+// contentContains 在正文纯文本中匹配关键词，尚未生成纯文本的旧文章回退到匹配HTML
+func contentContains(keyword string) predicate.Article {
+	return article.Or(
+		article.ContentTextContains(keyword),
+		article.And(article.ContentTextIsNil(), article.ContentContains(keyword)),
+	)
+}
+
 func (r *ArticleRepository) Update(ctx context.Context, id int, article *ent.Article) (*ent.Article, error) {
-	return r.client.Article.UpdateOneID(uint(id)).
-		SetTitle(article.Title).
+	return setArticle(r.client.Article.UpdateOneID(uint(id)), article).Save(ctx)
+}
+
+// Merge 在同一事务中以合并结果更新目标文章并删除源文章
+func (r *ArticleRepository) Merge(ctx context.Context, targetID int, merged *ent.Article, sourceID int) (*ent.Article, error) {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return nil, err
+	}
+	updated, err := setArticle(tx.Article.UpdateOneID(uint(targetID)), merged).Save(ctx)
+	if err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Article.DeleteOneID(uint(sourceID)).Exec(ctx); err != nil {
+		return nil, rollback(tx, err)
+	}
+	if err := tx.Commit(); err != nil {
+		return nil, err
+	}
+	return updated.Unwrap(), nil
+}
+
+// setArticle 设置Update使用的全部可编辑字段
+func setArticle(u *ent.ArticleUpdateOne, article *ent.Article) *ent.ArticleUpdateOne {
+	return u.SetTitle(article.Title).
 		SetContent(article.Content).
 		SetURL(article.URL).
+		SetCanonicalURL(article.CanonicalURL).
+		SetFingerprint(article.Fingerprint).
 		SetAuthor(article.Author).
 		SetSource(article.Source).
 		SetSummary(article.Summary).
 		SetTags(article.Tags).
 		SetPublishedAt(article.PublishedAt).
-		SetUserID(article.Edges.User.ID).
+		SetUserID(article.UserID)
+}
+
+// UpdateSummary 仅更新摘要，不影响同时修改的标签
+func (r *ArticleRepository) UpdateSummary(ctx context.Context, id int, summary string) (*ent.Article, error) {
+	return r.client.Article.UpdateOneID(uint(id)).
+		SetSummary(summary).
+		Save(ctx)
+}
+
+// UpdateTags 仅更新标签，不影响同时修改的摘要
+func (r *ArticleRepository) UpdateTags(ctx context.Context, id int, tags []string) (*ent.Article, error) {
+	return r.client.Article.UpdateOneID(uint(id)).
+		SetTags(tags).
 		Save(ctx)
 }
 
+// FindPendingFetch 返回等待抓取正文的文章，按创建时间升序
+func (r *ArticleRepository) FindPendingFetch(ctx context.Context, limit int) ([]*ent.Article, error) {
+	return r.client.Article.Query().
+		Where(article.FetchStatusEQ(article.FetchStatusPending)).
+		Order(ent.Asc(article.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+// UpdateFetched 写入抓取到的正文及元数据，并标记抓取完成
+func (r *ArticleRepository) UpdateFetched(ctx context.Context, id int, fetched *ent.Article) (*ent.Article, error) {
+	return r.client.Article.UpdateOneID(uint(id)).
+		SetTitle(fetched.Title).
+		SetContent(fetched.Content).
+		SetFingerprint(fetched.Fingerprint).
+		SetAuthor(fetched.Author).
+		SetSource(fetched.Source).
+		SetPublishedAt(fetched.PublishedAt).
+		SetFetchStatus(article.FetchStatusDone).
+		ClearFetchError().
+		Save(ctx)
+}
+
+// UpdateContent 仅更新正文及内容指纹
+func (r *ArticleRepository) UpdateContent(ctx context.Context, id int, content string, fingerprint uint64) (*ent.Article, error) {
+	return r.client.Article.UpdateOneID(uint(id)).
+		SetContent(content).
+		SetFingerprint(fingerprint).
+		Save(ctx)
+}
+
+// ReplaceContent 仅在正文仍为old时更新正文及内容指纹，正文已被修改或文章已删除时返回ErrNotFound
+func (r *ArticleRepository) ReplaceContent(ctx context.Context, id int, old, content string, fingerprint uint64) (*ent.Article, error) {
+	a, err := r.client.Article.UpdateOneID(uint(id)).
+		Where(article.Content(old)).
+		SetContent(content).
+		SetFingerprint(fingerprint).
+		Save(ctx)
+	if ent.IsNotFound(err) {
+		return nil, ErrNotFound
+	}
+	return a, err
+}
+
+// UpdateText 更新标题、正文、摘要和标签，用于恢复历史版本
+func (r *ArticleRepository) UpdateText(ctx context.Context, id int, text *ent.Article) (*ent.Article, error) {
+	return r.client.Article.UpdateOneID(uint(id)).
+		SetTitle(text.Title).
+		SetContent(text.Content).
+		SetFingerprint(text.Fingerprint).
+		SetSummary(text.Summary).
+		SetTags(text.Tags).
+		Save(ctx)
+}
+
+// FindDueSourceCheck 返回原文链接从未检查或上次检查早于before的网页文章，最久未检查的在前。
+// 尚未抓取正文的文章不检查。
+func (r *ArticleRepository) FindDueSourceCheck(ctx context.Context, before time.Time, limit int) ([]*ent.Article, error) {
+	return r.client.Article.Query().
+		Where(
+			article.Or(article.URLHasPrefix("http://"), article.URLHasPrefix("https://")),
+			article.Or(article.FetchStatusIsNil(), article.FetchStatusNEQ(article.FetchStatusPending)),
+			article.Or(article.SourceCheckedAtIsNil(), article.SourceCheckedAtLT(before)),
+		).
+		Order(ent.Asc(article.FieldSourceCheckedAt), ent.Asc(article.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
+
+// FindByUserIDAndSourceStatus 返回原文处于指定状态的文章，最近检查的在前
+func (r *ArticleRepository) FindByUserIDAndSourceStatus(ctx context.Context, userID int, statuses []article.SourceStatus) ([]*ent.Article, error) {
+	return r.client.Article.Query().
+		Where(
+			article.UserID(userID),
+			article.SourceStatusIn(statuses...),
+		).
+		Order(ent.Desc(article.FieldSourceCheckedAt)).
+		All(ctx)
+}
+
+// UpdateSourceStatus 记录原文链接的检查结果，status为空时只更新检查时间，baseline不为0时记录原文指纹基线
+func (r *ArticleRepository) UpdateSourceStatus(ctx context.Context, id uint, status article.SourceStatus, movedTo string, baseline uint64, checkedAt time.Time) (*ent.Article, error) {
+	update := r.client.Article.UpdateOneID(id).
+		SetSourceCheckedAt(checkedAt)
+	if status != "" {
+		update.SetSourceStatus(status).SetSourceMovedTo(movedTo)
+	}
+	if baseline != 0 {
+		update.SetSourceFingerprint(baseline)
+	}
+	return update.Save(ctx)
+}
+
+// FindUnnormalized 返回尚未经过清洗、没有纯文本正文或阅读元数据的文章
+func (r *ArticleRepository) FindUnnormalized(ctx context.Context, limit int) ([]*ent.Article, error) {
+	return r.client.Article.Query().
+		Where(article.Or(article.ContentTextIsNil(), article.WordCountIsNil())).
+		Order(ent.Asc(article.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
+
+// Normalize 重新写入正文，由schema钩子清洗HTML并生成纯文本和阅读元数据。
+// 使用批量更新以绕过只监听单条更新的历史版本钩子，清洗不算作内容修改。
+func (r *ArticleRepository) Normalize(ctx context.Context, id uint, content string) error {
+	return r.client.Article.Update().
+		Where(article.ID(id)).
+		SetContent(content).
+		Exec(ctx)
+}
+
+// MarkFetchFailed 标记正文抓取失败并记录原因
+func (r *ArticleRepository) MarkFetchFailed(ctx context.Context, id int, reason string) error {
+	return r.client.Article.UpdateOneID(uint(id)).
+		SetFetchStatus(article.FetchStatusFailed).
+		SetFetchError(reason).
+		Exec(ctx)
+}
+
 func (r *ArticleRepository) Delete(ctx context.Context, id int) error {
 	return r.client.Article.DeleteOneID(uint(id)).Exec(ctx)
 }
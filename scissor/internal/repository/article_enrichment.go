@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articleenrichment"
+)
+
+type ArticleEnrichmentRepository struct {
+	client *ent.Client
+}
+
+func NewArticleEnrichmentRepository(client *ent.Client) *ArticleEnrichmentRepository {
+	return &ArticleEnrichmentRepository{client: client}
+}
+
+// FindByArticleID 返回文章的全部补全任务输出，按名称排序
+func (r *ArticleEnrichmentRepository) FindByArticleID(ctx context.Context, articleID uint) ([]*ent.ArticleEnrichment, error) {
+	return r.client.ArticleEnrichment.Query().
+		Where(articleenrichment.ArticleID(articleID)).
+		Order(ent.Asc(articleenrichment.FieldName)).
+		All(ctx)
+}
+
+// Save 保存文章指定任务的输出，覆盖原有输出
+func (r *ArticleEnrichmentRepository) Save(ctx context.Context, e *ent.ArticleEnrichment) (*ent.ArticleEnrichment, error) {
+	existing, err := r.client.ArticleEnrichment.Query().
+		Where(
+			articleenrichment.ArticleID(e.ArticleID),
+			articleenrichment.Name(e.Name),
+		).
+		Only(ctx)
+	switch {
+	case ent.IsNotFound(err):
+		return r.client.ArticleEnrichment.Create().
+			SetArticleID(e.ArticleID).
+			SetName(e.Name).
+			SetContent(e.Content).
+			SetData(e.Data).
+			SetFormat(e.Format).
+			Save(ctx)
+	case err != nil:
+		return nil, err
+	}
+	update := r.client.ArticleEnrichment.UpdateOne(existing).
+		SetContent(e.Content).
+		SetFormat(e.Format)
+	if e.Data != nil {
+		update.SetData(e.Data)
+	} else {
+		update.ClearData()
+	}
+	return update.Save(ctx)
+}
@@ -0,0 +1,81 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articlerevision"
+)
+
+type ArticleRevisionRepository struct {
+	client *ent.Client
+}
+
+func NewArticleRevisionRepository(client *ent.Client) *ArticleRevisionRepository {
+	return &ArticleRevisionRepository{client: client}
+}
+
+// Create 以文章当前的标题、正文、摘要和标签创建历史版本
+func (r *ArticleRevisionRepository) Create(ctx context.Context, a *ent.Article) (*ent.ArticleRevision, error) {
+	return r.client.ArticleRevision.Create().
+		SetArticleID(a.ID).
+		SetTitle(a.Title).
+		SetContent(a.Content).
+		SetSummary(a.Summary).
+		SetTags(a.Tags).
+		Save(ctx)
+}
+
+// FindByID 返回历史版本及其所属文章
+func (r *ArticleRevisionRepository) FindByID(ctx context.Context, id int) (*ent.ArticleRevision, error) {
+	rev, err := r.client.ArticleRevision.Query().
+		Where(articlerevision.ID(id)).
+		WithArticle().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return rev, nil
+}
+
+// FindByArticleID 返回文章的历史版本，最新的在前
+func (r *ArticleRevisionRepository) FindByArticleID(ctx context.Context, articleID uint) ([]*ent.ArticleRevision, error) {
+	return r.client.ArticleRevision.Query().
+		Where(articlerevision.ArticleID(articleID)).
+		Order(ent.Desc(articlerevision.FieldID)).
+		All(ctx)
+}
+
+// FindLatest 返回文章最新的历史版本
+func (r *ArticleRevisionRepository) FindLatest(ctx context.Context, articleID uint) (*ent.ArticleRevision, error) {
+	rev, err := r.client.ArticleRevision.Query().
+		Where(articlerevision.ArticleID(articleID)).
+		Order(ent.Desc(articlerevision.FieldID)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return rev, nil
+}
+
+// Prune 只保留文章最新的keep个历史版本
+func (r *ArticleRevisionRepository) Prune(ctx context.Context, articleID uint, keep int) error {
+	ids, err := r.client.ArticleRevision.Query().
+		Where(articlerevision.ArticleID(articleID)).
+		Order(ent.Desc(articlerevision.FieldID)).
+		Offset(keep).
+		IDs(ctx)
+	if err != nil || len(ids) == 0 {
+		return err
+	}
+	_, err = r.client.ArticleRevision.Delete().
+		Where(articlerevision.IDIn(ids...)).
+		Exec(ctx)
+	return err
+}
@@ -0,0 +1,136 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articletranslation"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+)
+
+type ArticleTranslationRepository struct {
+	client *ent.Client
+}
+
+func NewArticleTranslationRepository(client *ent.Client) *ArticleTranslationRepository {
+	return &ArticleTranslationRepository{client: client}
+}
+
+// Create 创建等待翻译的语言版本
+func (r *ArticleTranslationRepository) Create(ctx context.Context, articleID uint, language string) (*ent.ArticleTranslation, error) {
+	return r.client.ArticleTranslation.Create().
+		SetArticleID(articleID).
+		SetLanguage(language).
+		Save(ctx)
+}
+
+// Find 返回文章指定语言的版本
+func (r *ArticleTranslationRepository) Find(ctx context.Context, articleID uint, language string) (*ent.ArticleTranslation, error) {
+	t, err := r.client.ArticleTranslation.Query().
+		Where(
+			articletranslation.ArticleID(articleID),
+			articletranslation.Language(language),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+// FindByArticleID 返回文章的全部语言版本，不加载译文
+func (r *ArticleTranslationRepository) FindByArticleID(ctx context.Context, articleID uint) ([]*ent.ArticleTranslation, error) {
+	return r.client.ArticleTranslation.Query().
+		Where(articletranslation.ArticleID(articleID)).
+		Select(
+			articletranslation.FieldArticleID,
+			articletranslation.FieldLanguage,
+			articletranslation.FieldStatus,
+			articletranslation.FieldTitle,
+			articletranslation.FieldSourceFingerprint,
+			articletranslation.FieldError,
+			articletranslation.FieldCreatedAt,
+			articletranslation.FieldUpdatedAt,
+		).
+		Order(ent.Asc(articletranslation.FieldLanguage)).
+		All(ctx)
+}
+
+// claimable 等待中的任务，以及在staleBefore之前开始、进程已中断而遗留的翻译中任务
+func claimable(staleBefore time.Time) predicate.ArticleTranslation {
+	return articletranslation.Or(
+		articletranslation.StatusEQ(articletranslation.StatusPending),
+		articletranslation.And(
+			articletranslation.StatusEQ(articletranslation.StatusRunning),
+			articletranslation.UpdatedAtLT(staleBefore),
+		),
+	)
+}
+
+// FindPending 返回可以领取的任务，包括超过staleBefore仍未结束的翻译中任务，按更新时间升序
+func (r *ArticleTranslationRepository) FindPending(ctx context.Context, limit int, staleBefore time.Time) ([]*ent.ArticleTranslation, error) {
+	return r.client.ArticleTranslation.Query().
+		Where(claimable(staleBefore)).
+		Order(ent.Asc(articletranslation.FieldUpdatedAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+// Requeue 将已完成、失败或遗留的版本重新加入翻译队列，翻译完成前保留原有译文
+func (r *ArticleTranslationRepository) Requeue(ctx context.Context, id int) (*ent.ArticleTranslation, error) {
+	return r.client.ArticleTranslation.UpdateOneID(id).
+		SetStatus(articletranslation.StatusPending).
+		ClearError().
+		Save(ctx)
+}
+
+// MarkRunning 领取任务并标记为翻译中，同时刷新更新时间，任务已被其他进程领取时返回false
+func (r *ArticleTranslationRepository) MarkRunning(ctx context.Context, id int, staleBefore time.Time) (bool, error) {
+	n, err := r.client.ArticleTranslation.Update().
+		Where(
+			articletranslation.ID(id),
+			claimable(staleBefore),
+		).
+		SetStatus(articletranslation.StatusRunning).
+		SetUpdatedAt(time.Now()).
+		Save(ctx)
+	return n > 0, err
+}
+
+// Release 将翻译中的任务放回等待队列，用于进程退出时中断的任务
+func (r *ArticleTranslationRepository) Release(ctx context.Context, id int) error {
+	return r.client.ArticleTranslation.Update().
+		Where(
+			articletranslation.ID(id),
+			articletranslation.StatusEQ(articletranslation.StatusRunning),
+		).
+		SetStatus(articletranslation.StatusPending).
+		Exec(ctx)
+}
+
+// MarkDone 保存译文
+func (r *ArticleTranslationRepository) MarkDone(ctx context.Context, id int, title, content, bilingual string, fingerprint uint64) error {
+	return r.client.ArticleTranslation.UpdateOneID(id).
+		SetStatus(articletranslation.StatusDone).
+		SetTitle(title).
+		SetContent(content).
+		SetBilingual(bilingual).
+		SetSourceFingerprint(fingerprint).
+		ClearError().
+		Exec(ctx)
+}
+
+func (r *ArticleTranslationRepository) MarkFailed(ctx context.Context, id int, reason string) error {
+	return r.client.ArticleTranslation.UpdateOneID(id).
+		SetStatus(articletranslation.StatusFailed).
+		SetError(reason).
+		Exec(ctx)
+}
+
+func (r *ArticleTranslationRepository) Delete(ctx context.Context, id int) error {
+	return r.client.ArticleTranslation.DeleteOneID(id).Exec(ctx)
+}
@@ -0,0 +1,92 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articlechunk"
+)
+
+type ChunkRepository struct {
+	client *ent.Client
+}
+
+func NewChunkRepository(client *ent.Client) *ChunkRepository {
+	return &ChunkRepository{client: client}
+}
+
+func (r *ChunkRepository) FindByArticleID(ctx context.Context, articleID uint) ([]*ent.ArticleChunk, error) {
+	return r.client.ArticleChunk.Query().
+		Where(articlechunk.ArticleID(articleID)).
+		Order(ent.Asc(articlechunk.FieldSeq)).
+		All(ctx)
+}
+
+// FindByUserID 返回用户全部文章中指定模型生成的分块
+func (r *ChunkRepository) FindByUserID(ctx context.Context, userID int, model string) ([]*ent.ArticleChunk, error) {
+	return r.client.ArticleChunk.Query().
+		Where(
+			articlechunk.Model(model),
+			articlechunk.HasArticleWith(article.UserID(userID)),
+		).
+		All(ctx)
+}
+
+// FindByArticleIDs 返回一批文章中指定模型生成的分块
+func (r *ChunkRepository) FindByArticleIDs(ctx context.Context, articleIDs []uint, model string) ([]*ent.ArticleChunk, error) {
+	return r.client.ArticleChunk.Query().
+		Where(
+			articlechunk.Model(model),
+			articlechunk.ArticleIDIn(articleIDs...),
+		).
+		All(ctx)
+}
+
+// ReplaceForArticle 在同一事务中替换文章的全部分块
+func (r *ChunkRepository) ReplaceForArticle(ctx context.Context, articleID uint, chunks []*ent.ArticleChunk) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+
+	if _, err := tx.ArticleChunk.Delete().
+		Where(articlechunk.ArticleID(articleID)).
+		Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	builders := make([]*ent.ArticleChunkCreate, len(chunks))
+	for i, c := range chunks {
+		builders[i] = tx.ArticleChunk.Create().
+			SetArticleID(articleID).
+			SetSeq(c.Seq).
+			SetContent(c.Content).
+			SetContentHash(c.ContentHash).
+			SetModel(c.Model).
+			SetEmbedding(c.Embedding)
+	}
+	if len(builders) > 0 {
+		if _, err := tx.ArticleChunk.CreateBulk(builders...).Save(ctx); err != nil {
+			return rollback(tx, err)
+		}
+	}
+
+	// 向量变化后文章需要重新参与聚类
+	if err := tx.Article.Update().
+		Where(article.ID(articleID)).
+		ClearClusterCheckedAt().
+		Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+
+	return tx.Commit()
+}
+
+func rollback(tx *ent.Tx, err error) error {
+	if rerr := tx.Rollback(); rerr != nil {
+		return fmt.Errorf("%w: rolling back transaction: %v", err, rerr)
+	}
+	return err
+}
@@ -0,0 +1,132 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/topiccluster"
+)
+
+type ClusterRepository struct {
+	client *ent.Client
+}
+
+func NewClusterRepository(client *ent.Client) *ClusterRepository {
+	return &ClusterRepository{client: client}
+}
+
+func (r *ClusterRepository) FindByID(ctx context.Context, id int) (*ent.TopicCluster, error) {
+	cluster, err := r.client.TopicCluster.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return cluster, nil
+}
+
+func (r *ClusterRepository) FindByUserID(ctx context.Context, userID int) ([]*ent.TopicCluster, error) {
+	return r.client.TopicCluster.Query().
+		Where(topiccluster.UserID(userID)).
+		Order(ent.Desc(topiccluster.FieldSize)).
+		All(ctx)
+}
+
+func (r *ClusterRepository) FindArticles(ctx context.Context, clusterID int) ([]*ent.Article, error) {
+	return r.client.Article.Query().
+		Where(article.ClusterID(clusterID)).
+		Order(ent.Desc(article.FieldPublishedAt)).
+		All(ctx)
+}
+
+// FindClusteringInputs 返回用户全部文章的ID、标题、标签和所属主题
+func (r *ClusterRepository) FindClusteringInputs(ctx context.Context, userID int) ([]*ent.Article, error) {
+	return r.client.Article.Query().
+		Where(article.UserID(userID)).
+		Select(article.FieldID, article.FieldTitle, article.FieldTags, article.FieldClusterID).
+		All(ctx)
+}
+
+// FindUsersWithUnclustered 返回存在已向量化、尚未归入主题且尚未参与过聚类的文章的用户
+func (r *ClusterRepository) FindUsersWithUnclustered(ctx context.Context) ([]int, error) {
+	return r.client.Article.Query().
+		Where(
+			article.ClusterIDIsNil(),
+			article.ClusterCheckedAtIsNil(),
+			article.HasChunks(),
+		).
+		GroupBy(article.FieldUserID).
+		Ints(ctx)
+}
+
+func (r *ClusterRepository) Create(ctx context.Context, cluster *ent.TopicCluster) (*ent.TopicCluster, error) {
+	return r.client.TopicCluster.Create().
+		SetUserID(cluster.UserID).
+		SetName(cluster.Name).
+		SetKeywords(cluster.Keywords).
+		SetCentroid(cluster.Centroid).
+		SetModel(cluster.Model).
+		SetSize(cluster.Size).
+		SetNamedSize(cluster.NamedSize).
+		Save(ctx)
+}
+
+func (r *ClusterRepository) Update(ctx context.Context, cluster *ent.TopicCluster) (*ent.TopicCluster, error) {
+	return r.client.TopicCluster.UpdateOneID(cluster.ID).
+		SetName(cluster.Name).
+		SetKeywords(cluster.Keywords).
+		SetCentroid(cluster.Centroid).
+		SetModel(cluster.Model).
+		SetSize(cluster.Size).
+		SetNamedSize(cluster.NamedSize).
+		Save(ctx)
+}
+
+// AssignArticles 将文章归入指定主题
+func (r *ClusterRepository) AssignArticles(ctx context.Context, clusterID int, articleIDs []uint) error {
+	if len(articleIDs) == 0 {
+		return nil
+	}
+	return r.client.Article.Update().
+		Where(article.IDIn(articleIDs...)).
+		SetClusterID(clusterID).
+		Exec(ctx)
+}
+
+// MarkClusterChecked 记录未归类文章已参与聚类，跳过since之后有更新的文章
+func (r *ClusterRepository) MarkClusterChecked(ctx context.Context, articleIDs []uint, since time.Time) error {
+	if len(articleIDs) == 0 {
+		return nil
+	}
+	return r.client.Article.Update().
+		Where(
+			article.IDIn(articleIDs...),
+			article.ClusterIDIsNil(),
+			article.UpdatedAtLT(since),
+		).
+		SetClusterCheckedAt(time.Now()).
+		Exec(ctx)
+}
+
+// DeleteByUserID 删除用户全部主题并清除文章的主题归属
+func (r *ClusterRepository) DeleteByUserID(ctx context.Context, userID int) error {
+	tx, err := r.client.Tx(ctx)
+	if err != nil {
+		return err
+	}
+	if err := tx.Article.Update().
+		Where(article.UserID(userID), article.ClusterIDNotNil()).
+		ClearClusterID().
+		Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+	if _, err := tx.TopicCluster.Delete().
+		Where(topiccluster.UserID(userID)).
+		Exec(ctx); err != nil {
+		return rollback(tx, err)
+	}
+	return tx.Commit()
+}
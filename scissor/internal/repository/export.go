@@ -0,0 +1,105 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/export"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+)
+
+type ExportRepository struct {
+	client *ent.Client
+}
+
+func NewExportRepository(client *ent.Client) *ExportRepository {
+	return &ExportRepository{client: client}
+}
+
+func (r *ExportRepository) Create(ctx context.Context, userID int) (*ent.Export, error) {
+	return r.client.Export.Create().
+		SetUserID(userID).
+		Save(ctx)
+}
+
+func (r *ExportRepository) FindByID(ctx context.Context, id int) (*ent.Export, error) {
+	e, err := r.client.Export.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return e, nil
+}
+
+func (r *ExportRepository) FindByUserID(ctx context.Context, userID int) ([]*ent.Export, error) {
+	return r.client.Export.Query().
+		Where(export.UserID(userID)).
+		Order(ent.Desc(export.FieldCreatedAt)).
+		All(ctx)
+}
+
+// exportClaimable 等待中的任务，以及在staleBefore之前开始、进程已中断而遗留的执行中任务
+func exportClaimable(staleBefore time.Time) predicate.Export {
+	return export.Or(
+		export.StatusEQ(export.StatusPending),
+		export.And(
+			export.StatusEQ(export.StatusRunning),
+			export.Or(export.StartedAtIsNil(), export.StartedAtLT(staleBefore)),
+		),
+	)
+}
+
+// FindPending 返回可以领取的导出任务，包括超过staleBefore仍未结束的执行中任务，按创建时间升序
+func (r *ExportRepository) FindPending(ctx context.Context, limit int, staleBefore time.Time) ([]*ent.Export, error) {
+	return r.client.Export.Query().
+		Where(exportClaimable(staleBefore)).
+		Order(ent.Asc(export.FieldCreatedAt)).
+		Limit(limit).
+		All(ctx)
+}
+
+// MarkRunning 领取任务并标记为执行中，同时记录开始时间，任务已被其他进程领取时返回false
+func (r *ExportRepository) MarkRunning(ctx context.Context, id int, staleBefore time.Time) (bool, error) {
+	n, err := r.client.Export.Update().
+		Where(
+			export.ID(id),
+			exportClaimable(staleBefore),
+		).
+		SetStatus(export.StatusRunning).
+		SetStartedAt(time.Now()).
+		Save(ctx)
+	return n > 0, err
+}
+
+// Release 将执行中的任务放回等待队列，用于进程退出时中断的任务
+func (r *ExportRepository) Release(ctx context.Context, id int) error {
+	return r.client.Export.Update().
+		Where(
+			export.ID(id),
+			export.StatusEQ(export.StatusRunning),
+		).
+		SetStatus(export.StatusPending).
+		ClearStartedAt().
+		Exec(ctx)
+}
+
+func (r *ExportRepository) MarkDone(ctx context.Context, id int, path string, size int64, articleCount int) error {
+	return r.client.Export.UpdateOneID(id).
+		SetStatus(export.StatusDone).
+		SetPath(path).
+		SetSize(size).
+		SetArticleCount(articleCount).
+		SetFinishedAt(time.Now()).
+		Exec(ctx)
+}
+
+func (r *ExportRepository) MarkFailed(ctx context.Context, id int, reason string) error {
+	return r.client.Export.UpdateOneID(id).
+		SetStatus(export.StatusFailed).
+		SetError(reason).
+		SetFinishedAt(time.Now()).
+		Exec(ctx)
+}
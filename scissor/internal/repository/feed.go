@@ -0,0 +1,116 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/feed"
+)
+
+type FeedRepository struct {
+	client *ent.Client
+}
+
+func NewFeedRepository(client *ent.Client) *FeedRepository {
+	return &FeedRepository{client: client}
+}
+
+func (r *FeedRepository) Create(ctx context.Context, f *ent.Feed) (*ent.Feed, error) {
+	return r.client.Feed.Create().
+		SetUserID(f.UserID).
+		SetURL(f.URL).
+		SetTitle(f.Title).
+		SetSiteURL(f.SiteURL).
+		SetRules(f.Rules).
+		SetTags(f.Tags).
+		SetEnabled(f.Enabled).
+		Save(ctx)
+}
+
+func (r *FeedRepository) FindByID(ctx context.Context, id int) (*ent.Feed, error) {
+	f, err := r.client.Feed.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return f, nil
+}
+
+func (r *FeedRepository) FindByUserID(ctx context.Context, userID int) ([]*ent.Feed, error) {
+	return r.client.Feed.Query().
+		Where(feed.UserID(userID)).
+		Order(ent.Asc(feed.FieldID)).
+		All(ctx)
+}
+
+// FindByUserIDAndURL 查找用户是否已订阅该地址
+func (r *FeedRepository) FindByUserIDAndURL(ctx context.Context, userID int, url string) (*ent.Feed, error) {
+	f, err := r.client.Feed.Query().
+		Where(
+			feed.UserID(userID),
+			feed.URL(url),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return f, nil
+}
+
+// FindEnabled 返回所有启用的订阅
+func (r *FeedRepository) FindEnabled(ctx context.Context) ([]*ent.Feed, error) {
+	return r.client.Feed.Query().
+		Where(feed.Enabled(true)).
+		Order(ent.Asc(feed.FieldID)).
+		All(ctx)
+}
+
+// UpdateSettings 更新用户可修改的订阅设置
+func (r *FeedRepository) UpdateSettings(ctx context.Context, id int, f *ent.Feed) (*ent.Feed, error) {
+	return r.client.Feed.UpdateOneID(id).
+		SetTitle(f.Title).
+		SetRules(f.Rules).
+		SetTags(f.Tags).
+		SetEnabled(f.Enabled).
+		Save(ctx)
+}
+
+// MarkPolled 记录一次成功的拉取，并清除错误计数
+func (r *FeedRepository) MarkPolled(ctx context.Context, id int, f *ent.Feed) (*ent.Feed, error) {
+	now := time.Now()
+	update := r.client.Feed.UpdateOneID(id).
+		SetEtag(f.Etag).
+		SetLastModified(f.LastModified).
+		SetSeen(f.Seen).
+		SetLastFetchedAt(now).
+		SetLastSuccessAt(now).
+		SetErrorCount(0).
+		ClearLastError()
+	// 标题和站点地址为空时保持原值
+	if f.Title != "" {
+		update.SetTitle(f.Title)
+	}
+	if f.SiteURL != "" {
+		update.SetSiteURL(f.SiteURL)
+	}
+	return update.Save(ctx)
+}
+
+// MarkPollFailed 记录一次失败的拉取并累加错误计数
+func (r *FeedRepository) MarkPollFailed(ctx context.Context, id int, reason string) (*ent.Feed, error) {
+	return r.client.Feed.UpdateOneID(id).
+		SetLastFetchedAt(time.Now()).
+		AddErrorCount(1).
+		SetLastError(reason).
+		Save(ctx)
+}
+
+func (r *FeedRepository) Delete(ctx context.Context, id int) error {
+	return r.client.Feed.DeleteOneID(id).Exec(ctx)
+}
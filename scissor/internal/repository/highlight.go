@@ -0,0 +1,66 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/highlight"
+)
+
+type HighlightRepository struct {
+	client *ent.Client
+}
+
+func NewHighlightRepository(client *ent.Client) *HighlightRepository {
+	return &HighlightRepository{client: client}
+}
+
+func (r *HighlightRepository) Create(ctx context.Context, h *ent.Highlight) (*ent.Highlight, error) {
+	return r.client.Highlight.Create().
+		SetArticleID(h.ArticleID).
+		SetText(h.Text).
+		SetNote(h.Note).
+		SetLocation(h.Location).
+		Save(ctx)
+}
+
+// FindByID 返回划线及其所属文章
+func (r *HighlightRepository) FindByID(ctx context.Context, id int) (*ent.Highlight, error) {
+	h, err := r.client.Highlight.Query().
+		Where(highlight.ID(id)).
+		WithArticle().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return h, nil
+}
+
+func (r *HighlightRepository) FindByArticleID(ctx context.Context, articleID uint) ([]*ent.Highlight, error) {
+	return r.client.Highlight.Query().
+		Where(highlight.ArticleID(articleID)).
+		Order(ent.Asc(highlight.FieldID)).
+		All(ctx)
+}
+
+// FindByUserID 返回用户全部文章中的划线
+func (r *HighlightRepository) FindByUserID(ctx context.Context, userID int) ([]*ent.Highlight, error) {
+	return r.client.Highlight.Query().
+		Where(highlight.HasArticleWith(article.UserID(userID))).
+		Order(ent.Asc(highlight.FieldID)).
+		All(ctx)
+}
+
+func (r *HighlightRepository) UpdateNote(ctx context.Context, id int, note string) (*ent.Highlight, error) {
+	return r.client.Highlight.UpdateOneID(id).
+		SetNote(note).
+		Save(ctx)
+}
+
+func (r *HighlightRepository) Delete(ctx context.Context, id int) error {
+	return r.client.Highlight.DeleteOneID(id).Exec(ctx)
+}
@@ -0,0 +1,74 @@
+package repository
+
+import (
+	"context"
+	"database/sql"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/image"
+)
+
+type ImageRepository struct {
+	client *ent.Client
+}
+
+func NewImageRepository(client *ent.Client) *ImageRepository {
+	return &ImageRepository{client: client}
+}
+
+func (r *ImageRepository) Create(ctx context.Context, img *ent.Image) (*ent.Image, error) {
+	return r.client.Image.Create().
+		SetUserID(img.UserID).
+		SetHash(img.Hash).
+		SetContentType(img.ContentType).
+		SetSize(img.Size).
+		SetSourceURL(img.SourceURL).
+		Save(ctx)
+}
+
+// FindByUserIDAndHash 查找用户已归档的相同图片
+func (r *ImageRepository) FindByUserIDAndHash(ctx context.Context, userID int, hash string) (*ent.Image, error) {
+	img, err := r.client.Image.Query().
+		Where(
+			image.UserID(userID),
+			image.Hash(hash),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return img, nil
+}
+
+// FindByHash 查找任一用户归档的该图片，用于读取图片的类型
+func (r *ImageRepository) FindByHash(ctx context.Context, hash string) (*ent.Image, error) {
+	img, err := r.client.Image.Query().
+		Where(image.Hash(hash)).
+		First(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return img, nil
+}
+
+// UsageByUserID 返回用户归档图片的总大小和数量
+func (r *ImageRepository) UsageByUserID(ctx context.Context, userID int) (int64, int, error) {
+	var v []struct {
+		Sum   sql.NullInt64 `json:"sum"`
+		Count int           `json:"count"`
+	}
+	err := r.client.Image.Query().
+		Where(image.UserID(userID)).
+		Aggregate(ent.Sum(image.FieldSize), ent.Count()).
+		Scan(ctx, &v)
+	if err != nil || len(v) == 0 {
+		return 0, 0, err
+	}
+	return v[0].Sum.Int64, v[0].Count, nil
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/notification"
+)
+
+type NotificationRepository struct {
+	client *ent.Client
+}
+
+func NewNotificationRepository(client *ent.Client) *NotificationRepository {
+	return &NotificationRepository{client: client}
+}
+
+func (r *NotificationRepository) Create(ctx context.Context, n *ent.Notification) (*ent.Notification, error) {
+	return r.client.Notification.Create().
+		SetUserID(n.UserID).
+		SetKind(n.Kind).
+		SetTitle(n.Title).
+		SetBody(n.Body).
+		SetNillableArticleID(n.ArticleID).
+		Save(ctx)
+}
+
+func (r *NotificationRepository) FindByID(ctx context.Context, id int) (*ent.Notification, error) {
+	n, err := r.client.Notification.Get(ctx, id)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return n, nil
+}
+
+// FindByUserID 返回用户的通知，最新的在前，unreadOnly为true时只返回未读通知
+func (r *NotificationRepository) FindByUserID(ctx context.Context, userID int, unreadOnly bool, limit int) ([]*ent.Notification, error) {
+	query := r.client.Notification.Query().
+		Where(notification.UserID(userID))
+	if unreadOnly {
+		query.Where(notification.ReadAtIsNil())
+	}
+	return query.
+		Order(ent.Desc(notification.FieldID)).
+		Limit(limit).
+		All(ctx)
+}
+
+// CountUnread 返回用户的未读通知数
+func (r *NotificationRepository) CountUnread(ctx context.Context, userID int) (int, error) {
+	return r.client.Notification.Query().
+		Where(
+			notification.UserID(userID),
+			notification.ReadAtIsNil(),
+		).
+		Count(ctx)
+}
+
+func (r *NotificationRepository) MarkRead(ctx context.Context, id int, readAt time.Time) (*ent.Notification, error) {
+	return r.client.Notification.UpdateOneID(id).
+		SetReadAt(readAt).
+		Save(ctx)
+}
+
+// MarkAllRead 将用户的未读通知全部标记为已读，返回标记的数量
+func (r *NotificationRepository) MarkAllRead(ctx context.Context, userID int, readAt time.Time) (int, error) {
+	return r.client.Notification.Update().
+		Where(
+			notification.UserID(userID),
+			notification.ReadAtIsNil(),
+		).
+		SetReadAt(readAt).
+		Save(ctx)
+}
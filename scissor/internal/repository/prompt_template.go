@@ -0,0 +1,82 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/prompttemplate"
+)
+
+type PromptTemplateRepository struct {
+	client *ent.Client
+}
+
+func NewPromptTemplateRepository(client *ent.Client) *PromptTemplateRepository {
+	return &PromptTemplateRepository{client: client}
+}
+
+// FindByUserID 返回用户自定义的全部模板，按名称排序
+func (r *PromptTemplateRepository) FindByUserID(ctx context.Context, userID int) ([]*ent.PromptTemplate, error) {
+	return r.client.PromptTemplate.Query().
+		Where(prompttemplate.UserID(userID)).
+		Order(ent.Asc(prompttemplate.FieldName)).
+		All(ctx)
+}
+
+// Find 返回用户指定名称的模板
+func (r *PromptTemplateRepository) Find(ctx context.Context, userID int, name string) (*ent.PromptTemplate, error) {
+	t, err := r.client.PromptTemplate.Query().
+		Where(
+			prompttemplate.UserID(userID),
+			prompttemplate.Name(name),
+		).
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return t, nil
+}
+
+// Save 创建或更新用户指定名称的模板
+func (r *PromptTemplateRepository) Save(ctx context.Context, t *ent.PromptTemplate) (*ent.PromptTemplate, error) {
+	existing, err := r.Find(ctx, t.UserID, t.Name)
+	switch {
+	case err == ErrNotFound:
+		return r.client.PromptTemplate.Create().
+			SetUserID(t.UserID).
+			SetName(t.Name).
+			SetTitle(t.Title).
+			SetTemplate(t.Template).
+			SetFormat(t.Format).
+			SetAuto(t.Auto).
+			Save(ctx)
+	case err != nil:
+		return nil, err
+	}
+	return r.client.PromptTemplate.UpdateOne(existing).
+		SetTitle(t.Title).
+		SetTemplate(t.Template).
+		SetFormat(t.Format).
+		SetAuto(t.Auto).
+		Save(ctx)
+}
+
+// Delete 删除用户指定名称的模板
+func (r *PromptTemplateRepository) Delete(ctx context.Context, userID int, name string) error {
+	n, err := r.client.PromptTemplate.Delete().
+		Where(
+			prompttemplate.UserID(userID),
+			prompttemplate.Name(name),
+		).
+		Exec(ctx)
+	if err != nil {
+		return err
+	}
+	if n == 0 {
+		return ErrNotFound
+	}
+	return nil
+}
@@ -0,0 +1,55 @@
+package repository
+
+import (
+	"context"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/snapshot"
+)
+
+type SnapshotRepository struct {
+	client *ent.Client
+}
+
+func NewSnapshotRepository(client *ent.Client) *SnapshotRepository {
+	return &SnapshotRepository{client: client}
+}
+
+func (r *SnapshotRepository) Create(ctx context.Context, s *ent.Snapshot) (*ent.Snapshot, error) {
+	return r.client.Snapshot.Create().
+		SetArticleID(s.ArticleID).
+		SetFormat(s.Format).
+		SetStorageKey(s.StorageKey).
+		SetSize(s.Size).
+		SetSha256(s.Sha256).
+		SetSourceURL(s.SourceURL).
+		SetStatusCode(s.StatusCode).
+		Save(ctx)
+}
+
+// FindByID 返回快照及其所属文章
+func (r *SnapshotRepository) FindByID(ctx context.Context, id int) (*ent.Snapshot, error) {
+	s, err := r.client.Snapshot.Query().
+		Where(snapshot.ID(id)).
+		WithArticle().
+		Only(ctx)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrNotFound
+		}
+		return nil, err
+	}
+	return s, nil
+}
+
+// FindByArticleID 返回文章的快照，最新的在前
+func (r *SnapshotRepository) FindByArticleID(ctx context.Context, articleID uint) ([]*ent.Snapshot, error) {
+	return r.client.Snapshot.Query().
+		Where(snapshot.ArticleID(articleID)).
+		Order(ent.Desc(snapshot.FieldCreatedAt), ent.Desc(snapshot.FieldID)).
+		All(ctx)
+}
+
+func (r *SnapshotRepository) Delete(ctx context.Context, id int) error {
+	return r.client.Snapshot.DeleteOneID(id).Exec(ctx)
+}
@@ -49,6 +49,27 @@ func (r *UserRepository) FindByWxOpenID(ctx context.Context, openID string) (*en
 		Only(ctx)
 }
 
+// FindByFeedToken 根据订阅令牌查找用户
+func (r *UserRepository) FindByFeedToken(ctx context.Context, token string) (*ent.User, error) {
+	return r.client.User.Query().
+		Where(user.FeedToken(token)).
+		Only(ctx)
+}
+
+// SetFeedToken 设置用户的订阅令牌，旧令牌随之失效
+func (r *UserRepository) SetFeedToken(ctx context.Context, id int, token string) (*ent.User, error) {
+	return r.client.User.UpdateOneID(id).
+		SetFeedToken(token).
+		Save(ctx)
+}
+
+// SetMailToken 设置用户收件地址中的令牌，旧地址随之失效
+func (r *UserRepository) SetMailToken(ctx context.Context, id int, token string) (*ent.User, error) {
+	return r.client.User.UpdateOneID(id).
+		SetMailToken(token).
+		Save(ctx)
+}
+
 func (r *UserRepository) ExistsByUsername(ctx context.Context, username string) (bool, error) {
 	return r.client.User.Query().
 		Where(user.UsernameEQ(username)).
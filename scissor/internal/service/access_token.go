@@ -0,0 +1,121 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+)
+
+// ErrInvalidAccessToken 访问令牌不存在或已被撤销
+var ErrInvalidAccessToken = errors.New("无效的访问令牌")
+
+const (
+	// 访问令牌的前缀，便于识别和密钥扫描
+	accessTokenPrefix = "scs_"
+	// 列表中展示的令牌开头长度
+	accessTokenDisplayLength = 12
+	// 最近使用时间的更新间隔，避免每个请求都写数据库
+	accessTokenTouchInterval = time.Minute
+)
+
+// AccessTokenService 管理个人访问令牌，令牌只保存哈希
+type AccessTokenService struct {
+	repo *repository.AccessTokenRepository
+}
+
+// NewAccessTokenService 创建个人访问令牌服务
+func NewAccessTokenService(repo *repository.AccessTokenRepository) *AccessTokenService {
+	return &AccessTokenService{repo: repo}
+}
+
+// Create 生成新令牌，返回值中的明文令牌只在此时可见
+func (s *AccessTokenService) Create(ctx context.Context, userID uint, name string) (*domain.AccessToken, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return nil, err
+	}
+	token := accessTokenPrefix + base64.RawURLEncoding.EncodeToString(b)
+
+	t, err := s.repo.Create(ctx, &ent.AccessToken{
+		UserID:    int(userID),
+		Name:      name,
+		TokenHash: hashAccessToken(token),
+		Prefix:    token[:accessTokenDisplayLength],
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	result := toDomainAccessToken(t)
+	result.Token = token
+	return result, nil
+}
+
+// List 返回用户的全部令牌，不含明文
+func (s *AccessTokenService) List(ctx context.Context, userID uint) ([]*domain.AccessToken, error) {
+	tokens, err := s.repo.FindByUserID(ctx, int(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.AccessToken, len(tokens))
+	for i, t := range tokens {
+		result[i] = toDomainAccessToken(t)
+	}
+	return result, nil
+}
+
+// Delete 撤销用户的令牌
+func (s *AccessTokenService) Delete(ctx context.Context, userID uint, id int) error {
+	t, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return err
+	}
+	if uint(t.UserID) != userID {
+		return ErrForbidden
+	}
+	return s.repo.Delete(ctx, id)
+}
+
+// Authenticate 校验令牌并返回其所属用户ID
+func (s *AccessTokenService) Authenticate(ctx context.Context, token string) (uint, error) {
+	t, err := s.repo.FindByHash(ctx, hashAccessToken(token))
+	if err != nil {
+		if errors.Is(err, repository.ErrNotFound) {
+			return 0, ErrInvalidAccessToken
+		}
+		return 0, err
+	}
+
+	now := time.Now()
+	if t.LastUsedAt == nil || now.Sub(*t.LastUsedAt) > accessTokenTouchInterval {
+		if err := s.repo.Touch(ctx, t.ID, now); err != nil {
+			log.Printf("Failed to update access token %d: %v", t.ID, err)
+		}
+	}
+	return uint(t.UserID), nil
+}
+
+func hashAccessToken(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return hex.EncodeToString(sum[:])
+}
+
+func toDomainAccessToken(t *ent.AccessToken) *domain.AccessToken {
+	return &domain.AccessToken{
+		ID:         t.ID,
+		Name:       t.Name,
+		Prefix:     t.Prefix,
+		LastUsedAt: t.LastUsedAt,
+		CreatedAt:  t.CreatedAt,
+	}
+}
@@ -3,64 +3,105 @@ package service
 import (
 	"context"
 	"errors"
+	"strings"
+	"time"
 
 	"github.com/gorexlv/cabinet/scissor/internal/domain"
 	"github.com/gorexlv/cabinet/scissor/internal/repository"
 	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	entarticle "github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/textutil"
+	"github.com/gorexlv/cabinet/scissor/pkg/urlcanon"
 )
 
+// ErrURLExists 链接已被用户的另一篇文章使用，文章URL在同一用户的文章中唯一
+var ErrURLExists = errors.New("文章URL已存在")
+
+// ErrInvalidArticleQuery 文章列表的筛选或排序条件无效
+var ErrInvalidArticleQuery = errors.New("无效的筛选或排序条件")
+
 type ArticleService struct {
-	repo *repository.ArticleRepository
+	repo     *repository.ArticleRepository
+	enricher *EnrichService
 }
 
-func NewArticleService(repo *repository.ArticleRepository) *ArticleService {
-	return &ArticleService{repo: repo}
+func NewArticleService(repo *repository.ArticleRepository, enricher *EnrichService) *ArticleService {
+	return &ArticleService{
+		repo:     repo,
+		enricher: enricher,
+	}
 }
 
 func (s *ArticleService) Create(ctx context.Context, article *domain.Article) (*domain.Article, error) {
-	// 检查URL是否已存在
-	existing, err := s.repo.FindByURL(ctx, article.URL)
+	return s.create(ctx, article, false)
+}
+
+// CreateOrMerge 与Create相同，但发现重复或内容近似的文章时合并到已有文章而不是新建
+func (s *ArticleService) CreateOrMerge(ctx context.Context, article *domain.Article) (*domain.Article, error) {
+	return s.create(ctx, article, true)
+}
+
+func (s *ArticleService) create(ctx context.Context, article *domain.Article, merge bool) (*domain.Article, error) {
+	// 检查用户是否已保存过该URL
+	existing, err := s.repo.FindByURL(ctx, int(article.UserID), article.URL)
 	if err != nil && !errors.Is(err, repository.ErrNotFound) {
 		return nil, err
 	}
+
+	// 检查规范化后的URL是否已存在
+	canonicalURL := urlcanon.Canonicalize(article.URL)
+	if existing == nil {
+		existing, err = s.repo.FindByCanonicalURL(ctx, int(article.UserID), canonicalURL)
+		if err != nil && !errors.Is(err, repository.ErrNotFound) {
+			return nil, err
+		}
+	}
 	if existing != nil {
-		return nil, errors.New("文章URL已存在")
+		if merge {
+			return s.mergeInto(ctx, existing, article)
+		}
+		return nil, &DuplicateError{ArticleID: existing.ID}
+	}
+
+	// 查找内容近似的文章
+	fingerprint := contentFingerprint(article.Content)
+	candidates, err := s.findNearDuplicates(ctx, article.UserID, fingerprint, 0)
+	if err != nil {
+		return nil, err
+	}
+	if merge && len(candidates) > 0 {
+		target, err := s.repo.FindByID(ctx, int(candidates[0].ID))
+		if err != nil {
+			return nil, err
+		}
+		return s.mergeInto(ctx, target, article)
 	}
 
 	// 创建文章
 	entArticle, err := s.repo.Create(ctx, &ent.Article{
-		Title:       article.Title,
-		Content:     article.Content,
-		URL:         article.URL,
-		Author:      article.Author,
-		Source:      article.Source,
-		Summary:     article.Summary,
-		Tags:        article.Tags,
-		PublishedAt: article.PublishedAt,
-		Edges: ent.ArticleEdges{
-			User: &ent.User{
-				ID: int(article.UserID),
-			},
-		},
+		Title:        article.Title,
+		Content:      article.Content,
+		URL:          article.URL,
+		CanonicalURL: canonicalURL,
+		Fingerprint:  fingerprint,
+		Author:       article.Author,
+		Source:       article.Source,
+		Summary:      article.Summary,
+		Tags:         article.Tags,
+		PublishedAt:  article.PublishedAt,
+		UserID:       int(article.UserID),
+		CreatedAt:    article.CreatedAt,
+		FetchStatus:  entarticle.FetchStatus(article.FetchStatus),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &domain.Article{
-		ID:          uint(entArticle.ID),
-		Title:       entArticle.Title,
-		Content:     entArticle.Content,
-		URL:         entArticle.URL,
-		Author:      entArticle.Author,
-		Source:      entArticle.Source,
-		Summary:     entArticle.Summary,
-		Tags:        entArticle.Tags,
-		PublishedAt: entArticle.PublishedAt,
-		UserID:      uint(entArticle.Edges.User.ID),
-		CreatedAt:   entArticle.CreatedAt,
-		UpdatedAt:   entArticle.UpdatedAt,
-	}, nil
+	s.enricher.EnrichAsync(entArticle.ID)
+
+	result := toDomainArticle(entArticle)
+	result.PossibleDuplicates = candidates
+	return result, nil
 }
 
 func (s *ArticleService) GetByID(ctx context.Context, id uint) (*domain.Article, error) {
@@ -69,66 +110,31 @@ func (s *ArticleService) GetByID(ctx context.Context, id uint) (*domain.Article,
 		return nil, err
 	}
 
-	return &domain.Article{
-		ID:          uint(article.ID),
-		Title:       article.Title,
-		Content:     article.Content,
-		URL:         article.URL,
-		Author:      article.Author,
-		Source:      article.Source,
-		Summary:     article.Summary,
-		Tags:        article.Tags,
-		PublishedAt: article.PublishedAt,
-		UserID:      uint(article.Edges.User.ID),
-		CreatedAt:   article.CreatedAt,
-		UpdatedAt:   article.UpdatedAt,
-	}, nil
-}
-
-func (s *ArticleService) GetByURL(ctx context.Context, url string) (*domain.Article, error) {
-	article, err := s.repo.FindByURL(ctx, url)
+	return toDomainArticle(article), nil
+}
+
+func (s *ArticleService) GetByURL(ctx context.Context, userID uint, url string) (*domain.Article, error) {
+	article, err := s.repo.FindByURL(ctx, int(userID), url)
 	if err != nil {
 		return nil, err
 	}
 
-	return &domain.Article{
-		ID:          uint(article.ID),
-		Title:       article.Title,
-		Content:     article.Content,
-		URL:         article.URL,
-		Author:      article.Author,
-		Source:      article.Source,
-		Summary:     article.Summary,
-		Tags:        article.Tags,
-		PublishedAt: article.PublishedAt,
-		UserID:      uint(article.Edges.User.ID),
-		CreatedAt:   article.CreatedAt,
-		UpdatedAt:   article.UpdatedAt,
-	}, nil
-}
-
-func (s *ArticleService) List(ctx context.Context, page, pageSize int) ([]*domain.Article, error) {
-	articles, err := s.repo.List(ctx, page, pageSize)
+	return toDomainArticle(article), nil
+}
+
+func (s *ArticleService) List(ctx context.Context, page, pageSize int, query *domain.ArticleListQuery) ([]*domain.Article, error) {
+	filter, err := articleFilter(query)
+	if err != nil {
+		return nil, err
+	}
+	articles, err := s.repo.List(ctx, page, pageSize, filter)
 	if err != nil {
 		return nil, err
 	}
 
 	result := make([]*domain.Article, len(articles))
 	for i, article := range articles {
-		result[i] = &domain.Article{
-			ID:          uint(article.ID),
-			Title:       article.Title,
-			Content:     article.Content,
-			URL:         article.URL,
-			Author:      article.Author,
-			Source:      article.Source,
-			Summary:     article.Summary,
-			Tags:        article.Tags,
-			PublishedAt: article.PublishedAt,
-			UserID:      uint(article.Edges.User.ID),
-			CreatedAt:   article.CreatedAt,
-			UpdatedAt:   article.UpdatedAt,
-		}
+		result[i] = toDomainArticle(article)
 	}
 
 	return result, nil
@@ -142,52 +148,59 @@ func (s *ArticleService) Search(ctx context.Context, keyword string) ([]*domain.
 
 	result := make([]*domain.Article, len(articles))
 	for i, article := range articles {
-		result[i] = &domain.Article{
-			ID:          uint(article.ID),
-			Title:       article.Title,
-			Content:     article.Content,
-			URL:         article.URL,
-			Author:      article.Author,
-			Source:      article.Source,
-			Summary:     article.Summary,
-			Tags:        article.Tags,
-			PublishedAt: article.PublishedAt,
-			UserID:      uint(article.Edges.User.ID),
-			CreatedAt:   article.CreatedAt,
-			UpdatedAt:   article.UpdatedAt,
-		}
+		result[i] = toDomainArticle(article)
 	}
 
 	return result, nil
 }
 
-func (s *ArticleService) GetByUserID(ctx context.Context, userID uint) ([]*domain.Article, error) {
-	articles, err := s.repo.FindByUserID(ctx, int(userID))
+func (s *ArticleService) GetByUserID(ctx context.Context, userID uint, query *domain.ArticleListQuery) ([]*domain.Article, error) {
+	filter, err := articleFilter(query)
+	if err != nil {
+		return nil, err
+	}
+	articles, err := s.repo.ListByUserID(ctx, int(userID), filter)
 	if err != nil {
 		return nil, err
 	}
 
 	result := make([]*domain.Article, len(articles))
 	for i, article := range articles {
-		result[i] = &domain.Article{
-			ID:          uint(article.ID),
-			Title:       article.Title,
-			Content:     article.Content,
-			URL:         article.URL,
-			Author:      article.Author,
-			Source:      article.Source,
-			Summary:     article.Summary,
-			Tags:        article.Tags,
-			PublishedAt: article.PublishedAt,
-			UserID:      uint(article.Edges.User.ID),
-			CreatedAt:   article.CreatedAt,
-			UpdatedAt:   article.UpdatedAt,
-		}
+		result[i] = toDomainArticle(article)
 	}
 
 	return result, nil
 }
 
+// articleFilter 校验列表查询条件并转换为仓储层的筛选条件
+func articleFilter(query *domain.ArticleListQuery) (*repository.ArticleFilter, error) {
+	filter := &repository.ArticleFilter{}
+	if query == nil {
+		return filter, nil
+	}
+	if query.MinMinutes < 0 || query.MaxMinutes < 0 ||
+		(query.MaxMinutes > 0 && query.MinMinutes > query.MaxMinutes) {
+		return nil, ErrInvalidArticleQuery
+	}
+	filter.Language = query.Language
+	filter.MinMinutes = query.MinMinutes
+	filter.MaxMinutes = query.MaxMinutes
+
+	sort := strings.TrimPrefix(query.Sort, "-")
+	filter.Asc = sort != "" && !strings.HasPrefix(query.Sort, "-")
+	switch sort {
+	case "", domain.ArticleSortPublishedAt:
+		filter.OrderBy = entarticle.FieldPublishedAt
+	case domain.ArticleSortReadingMinutes:
+		filter.OrderBy = entarticle.FieldReadingMinutes
+	case domain.ArticleSortWordCount:
+		filter.OrderBy = entarticle.FieldWordCount
+	default:
+		return nil, ErrInvalidArticleQuery
+	}
+	return filter, nil
+}
+
 func (s *ArticleService) Update(ctx context.Context, id uint, article *domain.Article) (*domain.Article, error) {
 	// 检查文章是否存在
 	existing, err := s.repo.FindByID(ctx, int(id))
@@ -195,53 +208,125 @@ func (s *ArticleService) Update(ctx context.Context, id uint, article *domain.Ar
 		return nil, err
 	}
 
-	// 如果URL发生变化，检查新URL是否已存在
+	// 如果URL发生变化，检查用户是否已有使用新URL的文章
 	if existing.URL != article.URL {
-		urlExists, err := s.repo.FindByURL(ctx, article.URL)
+		urlExists, err := s.repo.FindByURL(ctx, existing.UserID, article.URL)
 		if err != nil && !errors.Is(err, repository.ErrNotFound) {
 			return nil, err
 		}
 		if urlExists != nil {
-			return nil, errors.New("文章URL已存在")
+			return nil, ErrURLExists
 		}
 	}
 
 	// 更新文章
 	entArticle, err := s.repo.Update(ctx, int(id), &ent.Article{
-		Title:       article.Title,
-		Content:     article.Content,
-		URL:         article.URL,
-		Author:      article.Author,
-		Source:      article.Source,
-		Summary:     article.Summary,
-		Tags:        article.Tags,
-		PublishedAt: article.PublishedAt,
-		Edges: ent.ArticleEdges{
-			User: &ent.User{
-				ID: int(article.UserID),
-			},
-		},
+		Title:        article.Title,
+		Content:      article.Content,
+		URL:          article.URL,
+		CanonicalURL: urlcanon.Canonicalize(article.URL),
+		Fingerprint:  contentFingerprint(article.Content),
+		Author:       article.Author,
+		Source:       article.Source,
+		Summary:      article.Summary,
+		Tags:         article.Tags,
+		PublishedAt:  article.PublishedAt,
+		UserID:       int(article.UserID),
 	})
 	if err != nil {
 		return nil, err
 	}
 
-	return &domain.Article{
-		ID:          uint(entArticle.ID),
-		Title:       entArticle.Title,
-		Content:     entArticle.Content,
-		URL:         entArticle.URL,
-		Author:      entArticle.Author,
-		Source:      entArticle.Source,
-		Summary:     entArticle.Summary,
-		Tags:        entArticle.Tags,
-		PublishedAt: entArticle.PublishedAt,
-		UserID:      uint(entArticle.Edges.User.ID),
-		CreatedAt:   entArticle.CreatedAt,
-		UpdatedAt:   entArticle.UpdatedAt,
-	}, nil
+	s.enricher.EnrichAsync(entArticle.ID)
+
+	return toDomainArticle(entArticle), nil
+}
+
+// SetRead 将用户的文章标记为已读或未读
+func (s *ArticleService) SetRead(ctx context.Context, userID, id uint, read bool) (*domain.Article, error) {
+	existing, err := s.repo.FindByID(ctx, int(id))
+	if err != nil {
+		return nil, err
+	}
+	if uint(existing.UserID) != userID {
+		return nil, ErrForbidden
+	}
+
+	var readAt *time.Time
+	if read {
+		now := time.Now()
+		readAt = &now
+	}
+	article, err := s.repo.SetReadAt(ctx, int(id), readAt)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainArticle(article), nil
 }
 
 func (s *ArticleService) Delete(ctx context.Context, id uint) error {
 	return s.repo.Delete(ctx, int(id))
 }
+
+// normalizeBatchSize 每批清洗的旧文章数
+const normalizeBatchSize = 100
+
+// NormalizeContent 清洗引入白名单清理之前保存的文章正文并生成纯文本
+func (s *ArticleService) NormalizeContent(ctx context.Context) error {
+	for {
+		articles, err := s.repo.FindUnnormalized(ctx, normalizeBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(articles) == 0 {
+			return nil
+		}
+		for _, a := range articles {
+			if err := s.repo.Normalize(ctx, a.ID, a.Content); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// articleText 返回文章正文的纯文本，供检索、摘要和向量化使用。尚未生成纯文本的旧文章从HTML中提取。
+func articleText(a *ent.Article) string {
+	if a.ContentText != nil {
+		return *a.ContentText
+	}
+	return textutil.PlainText(a.Content)
+}
+
+func toDomainArticle(article *ent.Article) *domain.Article {
+	a := &domain.Article{
+		ID:           uint(article.ID),
+		Title:        article.Title,
+		Content:      article.Content,
+		ContentText:  articleText(article),
+		URL:          article.URL,
+		Author:       article.Author,
+		Source:       article.Source,
+		Summary:      article.Summary,
+		Tags:         article.Tags,
+		PublishedAt:  article.PublishedAt,
+		UserID:       uint(article.UserID),
+		CreatedAt:    article.CreatedAt,
+		UpdatedAt:    article.UpdatedAt,
+		CanonicalURL: article.CanonicalURL,
+		FetchStatus:  string(article.FetchStatus),
+		ReadAt:       article.ReadAt,
+
+		SourceStatus:    string(article.SourceStatus),
+		SourceMovedTo:   article.SourceMovedTo,
+		SourceCheckedAt: article.SourceCheckedAt,
+
+		ReadingMinutes: article.ReadingMinutes,
+		Language:       article.Language,
+		ImageCount:     article.ImageCount,
+		LeadImage:      article.LeadImage,
+	}
+	if article.WordCount != nil {
+		a.WordCount = *article.WordCount
+	}
+	return a
+}
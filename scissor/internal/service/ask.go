@@ -0,0 +1,143 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/pkg/kimi"
+	"github.com/gorexlv/cabinet/scissor/pkg/textutil"
+)
+
+const (
+	// 检索的片段数量及每篇文章最多贡献的片段数
+	askPassageLimit       = 8
+	askPassagesPerArticle = 2
+	// 引用原文的最大长度
+	maxQuoteLength = 200
+)
+
+const askSystemPrompt = `你是用户个人文章库的问答助手。只能依据用户提供的资料回答问题，不要使用资料以外的知识。
+每个论断之后用方括号标注所依据的资料编号，例如 [1] 或 [2][3]。
+如果资料不足以回答问题，直接说明资料库中没有相关内容。`
+
+const noSourceAnswer = "资料库中没有找到与问题相关的文章。"
+
+var (
+	ErrLLMDisabled   = errors.New("未配置大模型服务")
+	citationPattern  = regexp.MustCompile(`\[(\d+)\]`)
+	sentenceBoundary = regexp.MustCompile(`[^。！？!?\n]+[。！？!?]?`)
+)
+
+// AskService 基于用户文章库的问答（检索增强生成）
+type AskService struct {
+	searchService *SearchService
+	kimiClient    *kimi.Client
+}
+
+func NewAskService(searchService *SearchService, kimiClient *kimi.Client) *AskService {
+	return &AskService{
+		searchService: searchService,
+		kimiClient:    kimiClient,
+	}
+}
+
+// Ask 检索用户自己的文章并生成带引用的回答，生成过程中的增量文本通过onDelta回调。
+// 回答只允许引用本次检索到的、属于该用户的片段，无效的引用编号会被忽略。
+func (s *AskService) Ask(ctx context.Context, userID uint, question string, onDelta func(string) error) (*domain.AskResponse, error) {
+	if s.kimiClient == nil {
+		return nil, ErrLLMDisabled
+	}
+
+	passages, err := s.searchService.Passages(ctx, userID, question, askPassageLimit, askPassagesPerArticle)
+	if err != nil {
+		return nil, err
+	}
+	if len(passages) == 0 {
+		if err := onDelta(noSourceAnswer); err != nil {
+			return nil, err
+		}
+		return &domain.AskResponse{Answer: noSourceAnswer, Citations: []*domain.Citation{}}, nil
+	}
+
+	answer, err := s.kimiClient.ChatStream(ctx, []kimi.Message{
+		{Role: "system", Content: askSystemPrompt},
+		{Role: "user", Content: buildAskPrompt(question, passages)},
+	}, onDelta)
+	if err != nil {
+		return nil, err
+	}
+
+	return &domain.AskResponse{
+		Answer:    answer,
+		Citations: extractCitations(answer, passages),
+	}, nil
+}
+
+func buildAskPrompt(question string, passages []*domain.Passage) string {
+	var b strings.Builder
+	b.WriteString("资料：\n\n")
+	for i, p := range passages {
+		fmt.Fprintf(&b, "[%d] 《%s》\n%s\n\n", i+1, p.Title, p.Content)
+	}
+	b.WriteString("问题：")
+	b.WriteString(question)
+	return b.String()
+}
+
+// extractCitations 解析回答中的引用编号，并为每个引用找出来源片段中最贴近论断的原文
+func extractCitations(answer string, passages []*domain.Passage) []*domain.Citation {
+	citations := []*domain.Citation{}
+	seen := make(map[int]bool)
+
+	for _, sentence := range sentenceBoundary.FindAllString(answer, -1) {
+		claim := citationPattern.ReplaceAllString(sentence, "")
+		for _, m := range citationPattern.FindAllStringSubmatch(sentence, -1) {
+			index, err := strconv.Atoi(m[1])
+			if err != nil || index < 1 || index > len(passages) || seen[index] {
+				continue
+			}
+			seen[index] = true
+
+			p := passages[index-1]
+			citations = append(citations, &domain.Citation{
+				Index:     index,
+				ArticleID: p.ArticleID,
+				Title:     p.Title,
+				URL:       p.URL,
+				Quote:     bestQuote(p.Content, claim),
+			})
+		}
+	}
+	return citations
+}
+
+// bestQuote 从片段中选出与论断词元重合最多的句子
+func bestQuote(passage, claim string) string {
+	claimTokens := make(map[string]bool)
+	for _, t := range textutil.Tokens(claim) {
+		claimTokens[t] = true
+	}
+
+	best, bestScore := "", -1
+	for _, sentence := range sentenceBoundary.FindAllString(passage, -1) {
+		sentence = strings.TrimSpace(sentence)
+		if sentence == "" {
+			continue
+		}
+		score := 0
+		for _, t := range textutil.Tokens(sentence) {
+			if claimTokens[t] {
+				score++
+			}
+		}
+		if score > bestScore {
+			best, bestScore = sentence, score
+		}
+	}
+	return textutil.Truncate(best, maxQuoteLength)
+}
@@ -0,0 +1,123 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	entarticle "github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/extract"
+	"github.com/gorexlv/cabinet/scissor/pkg/textutil"
+)
+
+var (
+	// ErrInvalidCapture 缺少链接或页面内容
+	ErrInvalidCapture = errors.New("缺少页面链接或内容")
+	// ErrEmptyCapture 页面或选中部分中没有可保存的正文
+	ErrEmptyCapture = errors.New("未能提取到正文")
+)
+
+// CaptureService 保存浏览器端提交的页面HTML，适用于需要登录或由脚本渲染、服务端无法抓取的页面
+type CaptureService struct {
+	articleRepo    *repository.ArticleRepository
+	articleService *ArticleService
+	enricher       *EnrichService
+}
+
+// NewCaptureService 创建剪藏服务
+func NewCaptureService(articleRepo *repository.ArticleRepository, articleService *ArticleService, enricher *EnrichService) *CaptureService {
+	return &CaptureService{
+		articleRepo:    articleRepo,
+		articleService: articleService,
+		enricher:       enricher,
+	}
+}
+
+// Capture 使用与正文抓取相同的提取和清理流程保存页面，selection模式下只保存选中部分。
+// 链接已在库中但正文尚未抓取成功时（如导入的书签）以提交的页面补全，此时created为false。
+func (s *CaptureService) Capture(ctx context.Context, userID uint, req *domain.CaptureRequest) (article *domain.Article, created bool, err error) {
+	selection := req.Mode == domain.CaptureModeSelection
+	u, err := url.Parse(req.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, false, ErrInvalidCapture
+	}
+	if (selection && strings.TrimSpace(req.Selection) == "") || (!selection && strings.TrimSpace(req.HTML) == "") {
+		return nil, false, ErrInvalidCapture
+	}
+
+	// 选中模式下完整页面只用于提取标题、作者等元数据，可以不提交
+	doc := &extract.Document{}
+	if req.HTML != "" {
+		doc, err = extract.Parse(req.URL, strings.NewReader(req.HTML))
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	if doc.Source == "" {
+		doc.Source = u.Hostname()
+	}
+
+	content := doc.Content
+	if selection {
+		content, err = extract.Clean(req.URL, req.Selection)
+		if err != nil {
+			return nil, false, err
+		}
+	}
+	if strings.TrimSpace(textutil.PlainText(content)) == "" {
+		return nil, false, ErrEmptyCapture
+	}
+
+	captured := &domain.Article{
+		Title:       firstNonEmpty(strings.TrimSpace(req.Title), doc.Title, req.URL),
+		Content:     content,
+		URL:         req.URL,
+		Author:      doc.Author,
+		Source:      doc.Source,
+		Tags:        normalizeTags(req.Tags),
+		PublishedAt: doc.PublishedAt,
+		UserID:      userID,
+	}
+	if captured.PublishedAt.IsZero() {
+		captured.PublishedAt = time.Now()
+	}
+	result, err := s.articleService.Create(ctx, captured)
+	if err == nil {
+		return result, true, nil
+	}
+
+	var dupErr *DuplicateError
+	if selection || !errors.As(err, &dupErr) {
+		return nil, false, err
+	}
+	existing, findErr := s.articleRepo.FindByID(ctx, int(dupErr.ArticleID))
+	if findErr != nil {
+		return nil, false, findErr
+	}
+	if existing.FetchStatus != entarticle.FetchStatusPending && existing.FetchStatus != entarticle.FetchStatusFailed {
+		return nil, false, err
+	}
+
+	fetched := &ent.Article{
+		Title:       firstNonEmpty(existing.Title, strings.TrimSpace(req.Title), doc.Title, req.URL),
+		Content:     content,
+		Fingerprint: contentFingerprint(content),
+		Author:      firstNonEmpty(existing.Author, doc.Author),
+		Source:      firstNonEmpty(existing.Source, doc.Source),
+		PublishedAt: existing.PublishedAt,
+	}
+	if !doc.PublishedAt.IsZero() {
+		fetched.PublishedAt = doc.PublishedAt
+	}
+	updated, err := s.articleRepo.UpdateFetched(ctx, int(existing.ID), fetched)
+	if err != nil {
+		return nil, false, err
+	}
+	s.enricher.EnrichAsync(updated.ID)
+	return toDomainArticle(updated), false, nil
+}
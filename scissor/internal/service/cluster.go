@@ -0,0 +1,319 @@
+package service
+
+import (
+	"context"
+	"log"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/embedding"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/kimi"
+)
+
+const (
+	// 新主题至少包含的文章数，孤立的文章等待后续文章到来
+	minClusterSize = 2
+	// 命名时提供给模型的文章标题数
+	clusterNamingTitles = 10
+	// 主题关键词数量
+	clusterKeywords = 5
+)
+
+// ClusterService 按内容相似度将用户文章聚合为主题
+type ClusterService struct {
+	clusterRepo *repository.ClusterRepository
+	chunkRepo   *repository.ChunkRepository
+	kimiClient  *kimi.Client
+	embedder    embedding.Provider
+	threshold   float32
+}
+
+// NewClusterService 创建主题聚类服务，threshold为文章归入主题所需的最小余弦相似度
+func NewClusterService(clusterRepo *repository.ClusterRepository, chunkRepo *repository.ChunkRepository, kimiClient *kimi.Client, embedder embedding.Provider, threshold float32) *ClusterService {
+	return &ClusterService{
+		clusterRepo: clusterRepo,
+		chunkRepo:   chunkRepo,
+		kimiClient:  kimiClient,
+		embedder:    embedder,
+		threshold:   threshold,
+	}
+}
+
+// clusterState 聚类过程中主题的成员及质心
+type clusterState struct {
+	cluster  *ent.TopicCluster
+	members  []uint
+	added    []uint
+	centroid []float32
+}
+
+// RefreshAll 为所有存在未归类文章的用户增量刷新主题，供后台任务调用
+func (s *ClusterService) RefreshAll(ctx context.Context) error {
+	userIDs, err := s.clusterRepo.FindUsersWithUnclustered(ctx)
+	if err != nil {
+		return err
+	}
+	for _, userID := range userIDs {
+		if err := s.Refresh(ctx, uint(userID)); err != nil {
+			log.Printf("Failed to refresh clusters for user %d: %v", userID, err)
+		}
+	}
+	return nil
+}
+
+// Rebuild 清除用户现有主题并重新聚类
+func (s *ClusterService) Rebuild(ctx context.Context, userID uint) error {
+	if err := s.clusterRepo.DeleteByUserID(ctx, int(userID)); err != nil {
+		return err
+	}
+	return s.Refresh(ctx, userID)
+}
+
+// Refresh 增量聚类：未归类的文章并入最相近的已有主题，其余文章之间按领头者算法形成新主题。
+// 已归类的文章保持不变，成员变化的主题重新计算质心，成员数翻倍时重新命名。
+func (s *ClusterService) Refresh(ctx context.Context, userID uint) error {
+	if s.embedder == nil {
+		return ErrEmbeddingDisabled
+	}
+
+	start := time.Now()
+	articles, err := s.clusterRepo.FindClusteringInputs(ctx, int(userID))
+	if err != nil {
+		return err
+	}
+	ids := make([]uint, len(articles))
+	byID := make(map[uint]*ent.Article, len(articles))
+	for i, a := range articles {
+		ids[i] = a.ID
+		byID[a.ID] = a
+	}
+	vectors, err := articleVectors(ctx, s.chunkRepo, s.embedder, ids)
+	if err != nil {
+		return err
+	}
+
+	existing, err := s.clusterRepo.FindByUserID(ctx, int(userID))
+	if err != nil {
+		return err
+	}
+	model := s.embedder.Model()
+	states := make(map[int]*clusterState, len(existing))
+	var current []*clusterState
+	for _, c := range existing {
+		st := &clusterState{cluster: c, centroid: c.Centroid}
+		states[c.ID] = st
+		// 向量模型变化后旧质心不可比较，只保留成员关系等待重建
+		if c.Model == model {
+			current = append(current, st)
+		}
+	}
+
+	var pending, unclustered []uint
+	for _, a := range articles {
+		if a.ClusterID != nil {
+			if st, ok := states[*a.ClusterID]; ok {
+				st.members = append(st.members, a.ID)
+			}
+			continue
+		}
+		unclustered = append(unclustered, a.ID)
+		if _, ok := vectors[a.ID]; ok {
+			pending = append(pending, a.ID)
+		}
+	}
+
+	// 并入已有主题
+	var leftover []uint
+	for _, id := range pending {
+		if st := s.nearest(current, vectors[id]); st != nil {
+			st.added = append(st.added, id)
+			continue
+		}
+		leftover = append(leftover, id)
+	}
+
+	// 剩余文章之间形成新主题
+	var groups []*clusterState
+	for _, id := range leftover {
+		if st := s.nearest(groups, vectors[id]); st != nil {
+			st.added = append(st.added, id)
+			st.centroid = meanOf(st.added, vectors)
+			continue
+		}
+		groups = append(groups, &clusterState{added: []uint{id}, centroid: vectors[id]})
+	}
+
+	for _, st := range current {
+		if len(st.added) == 0 {
+			continue
+		}
+		st.members = append(st.members, st.added...)
+		if err := s.save(ctx, userID, st, byID, vectors); err != nil {
+			return err
+		}
+	}
+	for _, st := range groups {
+		if len(st.added) < minClusterSize {
+			continue
+		}
+		st.members = st.added
+		if err := s.save(ctx, userID, st, byID, vectors); err != nil {
+			return err
+		}
+	}
+	// 仍未归类的文章不再触发后台刷新，直到重新向量化或有新文章加入
+	return s.clusterRepo.MarkClusterChecked(ctx, unclustered, start)
+}
+
+// nearest 返回质心与向量最相近且超过阈值的主题
+func (s *ClusterService) nearest(states []*clusterState, v []float32) *clusterState {
+	var best *clusterState
+	bestScore := s.threshold
+	for _, st := range states {
+		if score := embedding.Cosine(st.centroid, v); score >= bestScore {
+			best, bestScore = st, score
+		}
+	}
+	return best
+}
+
+// save 重新计算主题质心、关键词和名称并写入新成员
+func (s *ClusterService) save(ctx context.Context, userID uint, st *clusterState, byID map[uint]*ent.Article, vectors map[uint][]float32) error {
+	members := make([]*ent.Article, 0, len(st.members))
+	for _, id := range st.members {
+		if a, ok := byID[id]; ok {
+			members = append(members, a)
+		}
+	}
+
+	cluster := st.cluster
+	if cluster == nil {
+		cluster = &ent.TopicCluster{UserID: int(userID)}
+	}
+	cluster.Centroid = meanOf(st.members, vectors)
+	cluster.Model = s.embedder.Model()
+	cluster.Size = len(st.members)
+	cluster.Keywords = topTags(members, clusterKeywords)
+	if cluster.Name == "" || cluster.Size >= 2*cluster.NamedSize {
+		cluster.Name = s.name(members, cluster.Keywords)
+		cluster.NamedSize = cluster.Size
+	}
+
+	var err error
+	if cluster.ID == 0 {
+		cluster, err = s.clusterRepo.Create(ctx, cluster)
+	} else {
+		cluster, err = s.clusterRepo.Update(ctx, cluster)
+	}
+	if err != nil {
+		return err
+	}
+	return s.clusterRepo.AssignArticles(ctx, cluster.ID, st.added)
+}
+
+// name 通过大模型为主题命名，不可用时以高频标签代替
+func (s *ClusterService) name(members []*ent.Article, keywords []string) string {
+	if s.kimiClient != nil {
+		titles := make([]string, 0, clusterNamingTitles)
+		for _, a := range members {
+			if len(titles) == clusterNamingTitles {
+				break
+			}
+			titles = append(titles, a.Title)
+		}
+		name, err := s.kimiClient.GenerateTopicName(titles)
+		if err == nil && name != "" {
+			return name
+		}
+		if err != nil {
+			log.Printf("Failed to name topic cluster: %v", err)
+		}
+	}
+	if len(keywords) > 0 {
+		return strings.Join(keywords[:min(3, len(keywords))], " / ")
+	}
+	return members[0].Title
+}
+
+// List 返回用户的全部主题
+func (s *ClusterService) List(ctx context.Context, userID uint) ([]*domain.TopicCluster, error) {
+	clusters, err := s.clusterRepo.FindByUserID(ctx, int(userID))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.TopicCluster, len(clusters))
+	for i, c := range clusters {
+		result[i] = toDomainCluster(c)
+	}
+	return result, nil
+}
+
+// Articles 返回主题下的文章
+func (s *ClusterService) Articles(ctx context.Context, userID uint, clusterID int) ([]*domain.Article, error) {
+	cluster, err := s.clusterRepo.FindByID(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if uint(cluster.UserID) != userID {
+		return nil, ErrForbidden
+	}
+
+	articles, err := s.clusterRepo.FindArticles(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.Article, len(articles))
+	for i, a := range articles {
+		result[i] = toDomainArticle(a)
+	}
+	return result, nil
+}
+
+func meanOf(ids []uint, vectors map[uint][]float32) []float32 {
+	vs := make([][]float32, 0, len(ids))
+	for _, id := range ids {
+		if v, ok := vectors[id]; ok {
+			vs = append(vs, v)
+		}
+	}
+	return embedding.Mean(vs)
+}
+
+// topTags 返回成员文章中出现次数最多的标签
+func topTags(articles []*ent.Article, n int) []string {
+	counts := make(map[string]int)
+	for _, a := range articles {
+		for _, t := range a.Tags {
+			counts[t]++
+		}
+	}
+	tags := make([]string, 0, len(counts))
+	for t := range counts {
+		tags = append(tags, t)
+	}
+	sort.Slice(tags, func(i, j int) bool {
+		if counts[tags[i]] != counts[tags[j]] {
+			return counts[tags[i]] > counts[tags[j]]
+		}
+		return tags[i] < tags[j]
+	})
+	if len(tags) > n {
+		tags = tags[:n]
+	}
+	return tags
+}
+
+func toDomainCluster(c *ent.TopicCluster) *domain.TopicCluster {
+	return &domain.TopicCluster{
+		ID:        uint(c.ID),
+		Name:      c.Name,
+		Keywords:  c.Keywords,
+		Size:      c.Size,
+		CreatedAt: c.CreatedAt,
+		UpdatedAt: c.UpdatedAt,
+	}
+}
@@ -0,0 +1,132 @@
+package service
+
+import (
+	"context"
+	"fmt"
+	"sort"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/simhash"
+	"github.com/gorexlv/cabinet/scissor/pkg/textutil"
+)
+
+// 指纹汉明距离不超过该值时视为内容近似
+const maxDuplicateDistance = 6
+
+// DuplicateError 用户库中已存在同一篇文章
+type DuplicateError struct {
+	ArticleID uint
+}
+
+func (e *DuplicateError) Error() string {
+	return fmt.Sprintf("文章已存在: #%d", e.ArticleID)
+}
+
+// contentFingerprint 计算正文纯文本的SimHash指纹，正文为空时返回0
+func contentFingerprint(content string) uint64 {
+	return simhash.Fingerprint(textutil.PlainText(content))
+}
+
+// findNearDuplicates 返回用户库中指纹相近的文章，按相似度降序排列，excludeID对应的文章除外
+func (s *ArticleService) findNearDuplicates(ctx context.Context, userID uint, fingerprint uint64, excludeID uint) ([]*domain.DuplicateCandidate, error) {
+	if fingerprint == 0 {
+		return nil, nil
+	}
+
+	articles, err := s.repo.FindFingerprintsByUserID(ctx, int(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	var candidates []*domain.DuplicateCandidate
+	for _, a := range articles {
+		if a.ID == excludeID || simhash.Distance(a.Fingerprint, fingerprint) > maxDuplicateDistance {
+			continue
+		}
+		candidates = append(candidates, &domain.DuplicateCandidate{
+			ID:         a.ID,
+			Title:      a.Title,
+			Similarity: simhash.Similarity(a.Fingerprint, fingerprint),
+		})
+	}
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Similarity > candidates[j].Similarity
+	})
+	return candidates, nil
+}
+
+// Merge 将用户的一篇文章合并到另一篇，合并后删除源文章
+func (s *ArticleService) Merge(ctx context.Context, userID, sourceID, targetID uint) (*domain.Article, error) {
+	if sourceID == targetID {
+		return nil, fmt.Errorf("不能将文章合并到自身")
+	}
+
+	source, err := s.repo.FindByID(ctx, int(sourceID))
+	if err != nil {
+		return nil, err
+	}
+	target, err := s.repo.FindByID(ctx, int(targetID))
+	if err != nil {
+		return nil, err
+	}
+	if uint(source.UserID) != userID || uint(target.UserID) != userID {
+		return nil, ErrForbidden
+	}
+
+	updated, err := s.repo.Merge(ctx, int(targetID), mergeArticle(target, toDomainArticle(source)), int(sourceID))
+	if err != nil {
+		return nil, err
+	}
+
+	s.enricher.EnrichAsync(updated.ID)
+
+	return toDomainArticle(updated), nil
+}
+
+// mergeInto 将incoming合并到已有文章并保存
+func (s *ArticleService) mergeInto(ctx context.Context, target *ent.Article, incoming *domain.Article) (*domain.Article, error) {
+	updated, err := s.repo.Update(ctx, int(target.ID), mergeArticle(target, incoming))
+	if err != nil {
+		return nil, err
+	}
+
+	s.enricher.EnrichAsync(updated.ID)
+
+	return toDomainArticle(updated), nil
+}
+
+// mergeArticle 返回incoming合并到已有文章的结果：标签取并集，空字段用新值补齐；
+// 新文章发布时间更晚时视为后续修订，以其标题和正文为准。链接保持不变。
+func mergeArticle(target *ent.Article, incoming *domain.Article) *ent.Article {
+	merged := *target
+	merged.Tags = unionTags(target.Tags, incoming.Tags)
+	if merged.Summary == "" {
+		merged.Summary = incoming.Summary
+	}
+	if merged.Author == "" {
+		merged.Author = incoming.Author
+	}
+	if merged.Source == "" {
+		merged.Source = incoming.Source
+	}
+	if incoming.Content != "" && (merged.Content == "" || incoming.PublishedAt.After(target.PublishedAt)) {
+		merged.Title = incoming.Title
+		merged.Content = incoming.Content
+		merged.PublishedAt = incoming.PublishedAt
+		merged.Fingerprint = contentFingerprint(incoming.Content)
+	}
+	return &merged
+}
+
+func unionTags(a, b []string) []string {
+	seen := make(map[string]bool, len(a)+len(b))
+	tags := make([]string, 0, len(a)+len(b))
+	for _, t := range append(append([]string{}, a...), b...) {
+		if !seen[t] {
+			seen[t] = true
+			tags = append(tags, t)
+		}
+	}
+	return tags
+}
@@ -0,0 +1,159 @@
+package service
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/embedding"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	entarticle "github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/textutil"
+)
+
+const (
+	// 每个分块的最大字符数及相邻分块的重叠字符数
+	chunkSize    = 500
+	chunkOverlap = 50
+	// 后台补全单篇文章的超时时间，包括归档图片
+	enrichTimeout = 5 * time.Minute
+)
+
+// EnrichService 在文章保存后归档图片，并补全AI摘要、标签、自动补全任务和检索向量
+type EnrichService struct {
+	articleRepo *repository.ArticleRepository
+	chunkRepo   *repository.ChunkRepository
+	prompts     *PromptService
+	embedder    embedding.Provider
+	images      *ImageService
+}
+
+// NewEnrichService 创建文章补全服务，prompts为nil时跳过摘要、标签和附加任务，images为nil时不归档图片
+func NewEnrichService(articleRepo *repository.ArticleRepository, chunkRepo *repository.ChunkRepository, prompts *PromptService, embedder embedding.Provider, images *ImageService) *EnrichService {
+	return &EnrichService{
+		articleRepo: articleRepo,
+		chunkRepo:   chunkRepo,
+		prompts:     prompts,
+		embedder:    embedder,
+		images:      images,
+	}
+}
+
+// EnrichAsync 在后台补全文章，失败时只记录日志
+func (s *EnrichService) EnrichAsync(id uint) {
+	if s == nil {
+		return
+	}
+	go func() {
+		ctx, cancel := context.WithTimeout(context.Background(), enrichTimeout)
+		defer cancel()
+		if err := s.Enrich(ctx, id); err != nil {
+			log.Printf("Failed to enrich article %d: %v", id, err)
+		}
+	}()
+}
+
+// Enrich 为缺少摘要或标签的文章生成内容，执行自动补全任务，并刷新检索向量
+func (s *EnrichService) Enrich(ctx context.Context, id uint) error {
+	article, err := s.articleRepo.FindByID(ctx, int(id))
+	if err != nil {
+		return err
+	}
+	// 正文尚未抓取时跳过，抓取完成后会再次补全
+	if article.FetchStatus == entarticle.FetchStatusPending {
+		return nil
+	}
+
+	// 图片归档失败不影响摘要和向量
+	if s.images != nil {
+		archived, err := s.images.Archive(ctx, article)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Printf("Failed to archive images of article %d: %v", id, err)
+		} else {
+			article = archived
+		}
+	}
+
+	if article, err = s.prompts.EnrichArticle(ctx, article); err != nil {
+		return err
+	}
+
+	return s.RefreshEmbeddings(ctx, article)
+}
+
+// RefreshEmbeddings 重新切分文章并计算向量，内容和模型均未变化时跳过
+func (s *EnrichService) RefreshEmbeddings(ctx context.Context, article *ent.Article) error {
+	if s.embedder == nil {
+		return nil
+	}
+
+	text := article.Title + "\n" + articleText(article)
+	pieces := textutil.Chunk(text, chunkSize, chunkOverlap)
+	model := s.embedder.Model()
+
+	existing, err := s.chunkRepo.FindByArticleID(ctx, article.ID)
+	if err != nil {
+		return err
+	}
+	if chunksUpToDate(existing, pieces, model) {
+		return nil
+	}
+
+	vectors, err := s.embedder.Embed(ctx, pieces)
+	if err != nil {
+		return err
+	}
+
+	chunks := make([]*ent.ArticleChunk, len(pieces))
+	for i, piece := range pieces {
+		chunks[i] = &ent.ArticleChunk{
+			Seq:         i,
+			Content:     piece,
+			ContentHash: hashText(piece),
+			Model:       model,
+			Embedding:   vectors[i],
+		}
+	}
+	return s.chunkRepo.ReplaceForArticle(ctx, article.ID, chunks)
+}
+
+func chunksUpToDate(existing []*ent.ArticleChunk, pieces []string, model string) bool {
+	if len(existing) != len(pieces) {
+		return false
+	}
+	for i, c := range existing {
+		if c.Model != model || c.ContentHash != hashText(pieces[i]) {
+			return false
+		}
+	}
+	return true
+}
+
+func hashText(s string) string {
+	sum := sha1.Sum([]byte(s))
+	return hex.EncodeToString(sum[:])
+}
+
+// splitTags 解析模型返回的以逗号或顿号分隔的标签
+func splitTags(raw string) []string {
+	fields := strings.FieldsFunc(raw, func(r rune) bool {
+		return r == ',' || r == '，' || r == '、' || r == '\n'
+	})
+	tags := make([]string, 0, len(fields))
+	seen := make(map[string]bool)
+	for _, f := range fields {
+		tag := strings.Trim(strings.TrimSpace(f), "#\"'“”")
+		if tag != "" && !seen[tag] {
+			seen[tag] = true
+			tags = append(tags, tag)
+		}
+	}
+	return tags
+}
@@ -0,0 +1,176 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/epub"
+	"github.com/gorexlv/cabinet/scissor/pkg/safehttp"
+)
+
+const (
+	// 单本电子书最多包含的文章数
+	maxEpubArticles = 200
+	// 单张图片和整本书内嵌图片的大小上限
+	maxEpubImageSize  = 5 << 20
+	maxEpubImageTotal = 100 << 20
+)
+
+// ErrNoArticles 没有符合条件的文章
+var ErrNoArticles = errors.New("没有符合条件的文章")
+
+// EpubService 将选中的文章生成 EPUB 电子书
+type EpubService struct {
+	articleRepo *repository.ArticleRepository
+	fetcher     epub.ImageFetcher
+}
+
+// NewEpubService 创建电子书服务，images不为nil时已归档的图片直接从存储读取
+func NewEpubService(articleRepo *repository.ArticleRepository, images *ImageService) *EpubService {
+	fetcher := epub.HTTPFetcher(safehttp.NewClient(30*time.Second), maxEpubImageSize)
+	if images != nil {
+		fetcher = images.Fetcher(fetcher)
+	}
+	return &EpubService{
+		articleRepo: articleRepo,
+		fetcher:     fetcher,
+	}
+}
+
+// Book 按文章ID、标签或关键词选择用户的文章生成电子书，每篇文章一章
+func (s *EpubService) Book(ctx context.Context, userID uint, req *domain.EpubRequest) (*epub.Book, error) {
+	articles, err := s.selectArticles(ctx, userID, req)
+	if err != nil {
+		return nil, err
+	}
+
+	title := req.Title
+	if title == "" {
+		switch {
+		case req.Tag != "":
+			title = "标签：" + req.Tag
+		case req.Query != "":
+			title = "搜索：" + req.Query
+		default:
+			title = fmt.Sprintf("文章合集 %s", time.Now().Format("2006-01-02"))
+		}
+	}
+	return s.build(ctx, title, articles)
+}
+
+// Digest 生成最近一段时间内保存但尚未阅读的文章合集
+func (s *EpubService) Digest(ctx context.Context, userID uint, days int) (*epub.Book, error) {
+	now := time.Now()
+	since := now.AddDate(0, 0, -days)
+	articles, err := s.articleRepo.FindUnread(ctx, int(userID), since, maxEpubArticles)
+	if err != nil {
+		return nil, err
+	}
+
+	title := fmt.Sprintf("未读文章 %s - %s", since.Format("01.02"), now.Format("01.02"))
+	return s.build(ctx, title, articles)
+}
+
+func (s *EpubService) selectArticles(ctx context.Context, userID uint, req *domain.EpubRequest) ([]*ent.Article, error) {
+	var (
+		articles []*ent.Article
+		err      error
+	)
+	switch {
+	case len(req.IDs) > 0:
+		articles, err = s.articleRepo.FindByIDs(ctx, req.IDs)
+		// 按请求中的顺序排列章节
+		if err == nil {
+			articles = orderByIDs(articles, req.IDs)
+		}
+	case req.Tag != "":
+		articles, err = s.articleRepo.FindByUserIDAndTag(ctx, int(userID), req.Tag)
+	case req.Query != "":
+		articles, err = s.articleRepo.SearchByUserID(ctx, int(userID), req.Query)
+	default:
+		return nil, ErrNoArticles
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	owned := articles[:0]
+	for _, a := range articles {
+		if uint(a.UserID) == userID {
+			owned = append(owned, a)
+		}
+	}
+	if len(owned) > maxEpubArticles {
+		owned = owned[:maxEpubArticles]
+	}
+	return owned, nil
+}
+
+func orderByIDs(articles []*ent.Article, ids []uint) []*ent.Article {
+	byID := make(map[uint]*ent.Article, len(articles))
+	for _, a := range articles {
+		byID[a.ID] = a
+	}
+	ordered := make([]*ent.Article, 0, len(articles))
+	for _, id := range ids {
+		if a, ok := byID[id]; ok {
+			ordered = append(ordered, a)
+			delete(byID, id)
+		}
+	}
+	return ordered
+}
+
+func (s *EpubService) build(ctx context.Context, title string, articles []*ent.Article) (*epub.Book, error) {
+	if len(articles) == 0 {
+		return nil, ErrNoArticles
+	}
+
+	book := epub.New(title)
+	book.Author = "Scissor"
+	book.Description = fmt.Sprintf("共 %d 篇文章", len(articles))
+	book.Fetcher = s.fetcher
+	book.MaxImageBytes = maxEpubImageTotal
+
+	for _, a := range articles {
+		if err := book.AddChapter(ctx, a.Title, chapterHTML(a)); err != nil {
+			return nil, err
+		}
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+	}
+	return book, nil
+}
+
+// chapterHTML 生成章节内容：标题、来源信息、摘要和正文
+func chapterHTML(a *ent.Article) string {
+	var meta []string
+	for _, v := range []string{a.Author, a.Source} {
+		if v != "" {
+			meta = append(meta, html.EscapeString(v))
+		}
+	}
+	if !a.PublishedAt.IsZero() {
+		meta = append(meta, a.PublishedAt.Format("2006-01-02"))
+	}
+
+	var b strings.Builder
+	b.WriteString("<h1>" + html.EscapeString(a.Title) + "</h1>")
+	if len(meta) > 0 {
+		b.WriteString(`<p class="meta">` + strings.Join(meta, " · ") + "</p>")
+	}
+	if a.Summary != "" {
+		b.WriteString("<blockquote>" + html.EscapeString(a.Summary) + "</blockquote>")
+	}
+	b.WriteString(a.Content)
+	b.WriteString(`<p class="meta"><a href="` + html.EscapeString(a.URL) + `">原文链接</a></p>`)
+	return b.String()
+}
@@ -0,0 +1,200 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"os"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/export"
+	"github.com/gorexlv/cabinet/scissor/pkg/storage"
+)
+
+const (
+	// 导出文件在存储中的目录
+	exportPrefix = "exports/"
+	// 一次导出的时限
+	exportTimeout = 30 * time.Minute
+	// 执行中的任务超过该时间未结束，说明执行的进程已中断，可以重新领取
+	exportStaleAfter = exportTimeout + time.Minute
+)
+
+// ExportService 将用户的文章库导出为zip归档，支持直接下载和后台任务两种方式
+type ExportService struct {
+	articleRepo *repository.ArticleRepository
+	exportRepo  *repository.ExportRepository
+	// storage 保存后台导出文件
+	storage storage.Storage
+}
+
+// NewExportService 创建导出服务
+func NewExportService(articleRepo *repository.ArticleRepository, exportRepo *repository.ExportRepository, store storage.Storage) *ExportService {
+	return &ExportService{
+		articleRepo: articleRepo,
+		exportRepo:  exportRepo,
+		storage:     store,
+	}
+}
+
+// WriteArchive 将用户的文章库以zip格式写入w，返回导出的文章数
+func (s *ExportService) WriteArchive(ctx context.Context, userID uint, w io.Writer) (int, error) {
+	return writeArchive(ctx, s.articleRepo, userID, w)
+}
+
+// Request 创建后台导出任务
+func (s *ExportService) Request(ctx context.Context, userID uint) (*domain.Export, error) {
+	e, err := s.exportRepo.Create(ctx, int(userID))
+	if err != nil {
+		return nil, err
+	}
+	return toDomainExport(e), nil
+}
+
+// List 返回用户的导出任务，最新的在前
+func (s *ExportService) List(ctx context.Context, userID uint) ([]*domain.Export, error) {
+	exports, err := s.exportRepo.FindByUserID(ctx, int(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.Export, len(exports))
+	for i, e := range exports {
+		result[i] = toDomainExport(e)
+	}
+	return result, nil
+}
+
+// Get 返回用户的导出任务
+func (s *ExportService) Get(ctx context.Context, userID uint, id int) (*domain.Export, error) {
+	e, err := s.find(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainExport(e), nil
+}
+
+// Open 打开已完成的导出文件
+func (s *ExportService) Open(ctx context.Context, userID uint, id int) (io.ReadCloser, *domain.Export, error) {
+	e, err := s.find(ctx, userID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	if e.Status != export.StatusDone {
+		return nil, nil, repository.ErrNotFound
+	}
+
+	f, err := s.storage.Get(ctx, exportPrefix+e.Path)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil, repository.ErrNotFound
+		}
+		return nil, nil, err
+	}
+	return f, toDomainExport(e), nil
+}
+
+func (s *ExportService) find(ctx context.Context, userID uint, id int) (*ent.Export, error) {
+	e, err := s.exportRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if uint(e.UserID) != userID {
+		return nil, ErrForbidden
+	}
+	return e, nil
+}
+
+// ProcessPending 依次执行等待中的导出任务
+func (s *ExportService) ProcessPending(ctx context.Context) error {
+	for {
+		pending, err := s.exportRepo.FindPending(ctx, 10, time.Now().Add(-exportStaleAfter))
+		if err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+
+		for _, e := range pending {
+			ok, err := s.exportRepo.MarkRunning(ctx, e.ID, time.Now().Add(-exportStaleAfter))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+
+			path, size, count, err := s.run(ctx, e)
+			if err != nil {
+				if ctx.Err() != nil {
+					// 进程退出时放回队列，下次启动后重新导出
+					if err := s.exportRepo.Release(context.WithoutCancel(ctx), e.ID); err != nil {
+						log.Printf("Failed to release export %d: %v", e.ID, err)
+					}
+					return ctx.Err()
+				}
+				log.Printf("Export %d failed: %v", e.ID, err)
+				if err := s.exportRepo.MarkFailed(ctx, e.ID, err.Error()); err != nil {
+					return err
+				}
+				continue
+			}
+			if err := s.exportRepo.MarkDone(ctx, e.ID, path, size, count); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// run 将导出写入临时文件，完成后再上传到存储，避免下载到不完整的文件
+func (s *ExportService) run(ctx context.Context, e *ent.Export) (string, int64, int, error) {
+	ctx, cancel := context.WithTimeout(ctx, exportTimeout)
+	defer cancel()
+
+	path := fmt.Sprintf("%d/%d.zip", e.UserID, e.ID)
+
+	tmp, err := os.CreateTemp("", "scissor-export-*.zip")
+	if err != nil {
+		return "", 0, 0, err
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	count, err := writeArchive(ctx, s.articleRepo, uint(e.UserID), tmp)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	size, err := tmp.Seek(0, io.SeekCurrent)
+	if err != nil {
+		return "", 0, 0, err
+	}
+	if _, err := tmp.Seek(0, io.SeekStart); err != nil {
+		return "", 0, 0, err
+	}
+	if err := s.storage.Put(ctx, exportPrefix+path, tmp, "application/zip"); err != nil {
+		return "", 0, 0, err
+	}
+	return path, size, count, nil
+}
+
+func toDomainExport(e *ent.Export) *domain.Export {
+	result := &domain.Export{
+		ID:           e.ID,
+		Status:       string(e.Status),
+		Size:         e.Size,
+		ArticleCount: e.ArticleCount,
+		Error:        e.Error,
+		CreatedAt:    e.CreatedAt,
+		FinishedAt:   e.FinishedAt,
+	}
+	if e.Status == export.StatusDone {
+		result.DownloadURL = fmt.Sprintf("/api/exports/%d/download", e.ID)
+	}
+	return result
+}
@@ -0,0 +1,271 @@
+package service
+
+import (
+	"archive/zip"
+	"context"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"io"
+	"strings"
+	"time"
+	"unicode"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/markdown"
+)
+
+// 导出时每次从数据库读取的文章数
+const exportPageSize = 100
+
+// writeArchive 将用户的全部文章写入zip：每篇文章一个带YAML头信息的Markdown文件和一个HTML页面，
+// 以及完整的JSON数据和可浏览的HTML索引。返回导出的文章数。
+func writeArchive(ctx context.Context, repo *repository.ArticleRepository, userID uint, w io.Writer) (int, error) {
+	zw := zip.NewWriter(w)
+	var index []*archiveEntry
+
+	// 第一遍：逐篇写入Markdown和HTML页面，只保留索引所需的信息
+	err := eachArticle(ctx, repo, userID, func(a *domain.Article) error {
+		entry := &archiveEntry{
+			ID:          a.ID,
+			Title:       a.Title,
+			URL:         a.URL,
+			Source:      a.Source,
+			Tags:        a.Tags,
+			PublishedAt: a.PublishedAt,
+			Name:        fmt.Sprintf("%d-%s", a.ID, slugify(a.Title)),
+		}
+		index = append(index, entry)
+
+		if err := writeZipFile(zw, "markdown/"+entry.Name+".md", a.UpdatedAt, func(w io.Writer) error {
+			_, err := io.WriteString(w, articleMarkdown(a))
+			return err
+		}); err != nil {
+			return err
+		}
+		return writeZipFile(zw, "html/"+entry.Name+".html", a.UpdatedAt, func(w io.Writer) error {
+			return articlePageTemplate.Execute(w, struct {
+				*domain.Article
+				Body template.HTML
+			}{a, template.HTML(a.Content)})
+		})
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	// 第二遍：流式写入完整JSON，避免一次性加载全部正文
+	if err := writeZipFile(zw, "articles.json", time.Now(), func(w io.Writer) error {
+		if _, err := io.WriteString(w, "["); err != nil {
+			return err
+		}
+		first := true
+		err := eachArticle(ctx, repo, userID, func(a *domain.Article) error {
+			if !first {
+				if _, err := io.WriteString(w, ","); err != nil {
+					return err
+				}
+			}
+			first = false
+			data, err := json.MarshalIndent(a, "  ", "  ")
+			if err != nil {
+				return err
+			}
+			_, err = fmt.Fprintf(w, "\n  %s", data)
+			return err
+		})
+		if err != nil {
+			return err
+		}
+		_, err = io.WriteString(w, "\n]\n")
+		return err
+	}); err != nil {
+		return 0, err
+	}
+
+	if err := writeZipFile(zw, "index.html", time.Now(), func(w io.Writer) error {
+		return indexTemplate.Execute(w, struct {
+			ExportedAt time.Time
+			Articles   []*archiveEntry
+		}{time.Now(), index})
+	}); err != nil {
+		return 0, err
+	}
+
+	if err := zw.Close(); err != nil {
+		return 0, err
+	}
+	return len(index), nil
+}
+
+// archiveEntry 索引页中的一篇文章
+type archiveEntry struct {
+	ID          uint
+	Title       string
+	URL         string
+	Source      string
+	Tags        []string
+	PublishedAt time.Time
+	// Name 不含扩展名的文件名
+	Name string
+}
+
+// eachArticle 按ID顺序分页遍历用户的全部文章
+func eachArticle(ctx context.Context, repo *repository.ArticleRepository, userID uint, fn func(*domain.Article) error) error {
+	var after uint
+	for {
+		articles, err := repo.FindByUserIDAfter(ctx, int(userID), after, exportPageSize)
+		if err != nil {
+			return err
+		}
+		for _, a := range articles {
+			if err := fn(toDomainArticle(a)); err != nil {
+				return err
+			}
+		}
+		if len(articles) < exportPageSize {
+			return nil
+		}
+		after = articles[len(articles)-1].ID
+	}
+}
+
+func writeZipFile(zw *zip.Writer, name string, modified time.Time, write func(io.Writer) error) error {
+	w, err := zw.CreateHeader(&zip.FileHeader{
+		Name:     name,
+		Method:   zip.Deflate,
+		Modified: modified,
+	})
+	if err != nil {
+		return err
+	}
+	return write(w)
+}
+
+// articleMarkdown 生成带YAML头信息的Markdown，字符串以JSON格式写出，它同时是合法的YAML
+func articleMarkdown(a *domain.Article) string {
+	var b strings.Builder
+	b.WriteString("---\n")
+	writeYAML(&b, "title", a.Title)
+	writeYAML(&b, "url", a.URL)
+	writeYAML(&b, "author", a.Author)
+	writeYAML(&b, "source", a.Source)
+	tags := a.Tags
+	if tags == nil {
+		tags = []string{}
+	}
+	writeYAML(&b, "tags", tags)
+	writeYAML(&b, "summary", a.Summary)
+	writeYAML(&b, "published_at", a.PublishedAt.Format(time.RFC3339))
+	writeYAML(&b, "created_at", a.CreatedAt.Format(time.RFC3339))
+	writeYAML(&b, "updated_at", a.UpdatedAt.Format(time.RFC3339))
+	b.WriteString("---\n\n")
+
+	b.WriteString("# " + a.Title + "\n\n")
+	b.WriteString(markdown.FromHTML(a.Content))
+	return b.String()
+}
+
+func writeYAML(b *strings.Builder, key string, value interface{}) {
+	data, _ := json.Marshal(value)
+	b.WriteString(key + ": ")
+	b.Write(data)
+	b.WriteString("\n")
+}
+
+// slugify 生成文件名中使用的标题片段，保留字母、数字和汉字
+func slugify(title string) string {
+	var b strings.Builder
+	dash := false
+	n := 0
+	for _, r := range title {
+		if n >= 50 {
+			break
+		}
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(unicode.ToLower(r))
+			dash = false
+			n++
+			continue
+		}
+		if !dash && b.Len() > 0 {
+			b.WriteByte('-')
+			dash = true
+			n++
+		}
+	}
+	slug := strings.Trim(b.String(), "-")
+	if slug == "" {
+		return "untitled"
+	}
+	return slug
+}
+
+var templateFuncs = template.FuncMap{
+	"date": func(t time.Time) string {
+		if t.IsZero() {
+			return ""
+		}
+		return t.Format("2006-01-02")
+	},
+}
+
+var indexTemplate = template.Must(template.New("index").Funcs(templateFuncs).Parse(`<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+<meta charset="utf-8">
+<title>Scissor 文章库</title>
+<style>
+body { max-width: 960px; margin: 2em auto; padding: 0 1em; font-family: -apple-system, "PingFang SC", "Microsoft YaHei", sans-serif; color: #333; }
+input { width: 100%; padding: .5em; margin-bottom: 1em; font-size: 1em; box-sizing: border-box; }
+li { margin: .6em 0; list-style: none; }
+.meta { color: #888; font-size: .85em; }
+.tag { display: inline-block; background: #f0f0f0; border-radius: 3px; padding: 0 .4em; margin-right: .3em; }
+</style>
+</head>
+<body>
+<h1>Scissor 文章库</h1>
+<p class="meta">共 {{len .Articles}} 篇，导出于 {{.ExportedAt.Format "2006-01-02 15:04"}}</p>
+<input id="filter" placeholder="按标题、来源或标签筛选">
+<ul id="articles">
+{{range .Articles}}<li data-search="{{.Title}} {{.Source}} {{range .Tags}}{{.}} {{end}}">
+<a href="html/{{.Name}}.html">{{.Title}}</a>
+<div class="meta">{{.Source}} {{date .PublishedAt}} · <a href="markdown/{{.Name}}.md">Markdown</a> · <a href="{{.URL}}">原文</a>
+{{range .Tags}}<span class="tag">{{.}}</span>{{end}}</div>
+</li>
+{{end}}</ul>
+<script>
+document.getElementById('filter').addEventListener('input', function (e) {
+  var q = e.target.value.toLowerCase();
+  document.querySelectorAll('#articles li').forEach(function (li) {
+    li.style.display = li.dataset.search.toLowerCase().indexOf(q) >= 0 ? '' : 'none';
+  });
+});
+</script>
+</body>
+</html>
+`))
+
+var articlePageTemplate = template.Must(template.New("article").Funcs(templateFuncs).Parse(`<!DOCTYPE html>
+<html lang="zh-CN">
+<head>
+<meta charset="utf-8">
+<meta name="referrer" content="no-referrer">
+<title>{{.Title}}</title>
+<style>
+body { max-width: 760px; margin: 2em auto; padding: 0 1em; font-family: -apple-system, "PingFang SC", "Microsoft YaHei", sans-serif; line-height: 1.7; color: #333; }
+img { max-width: 100%; height: auto; }
+.meta { color: #888; font-size: .9em; }
+.summary { background: #f7f7f7; padding: .8em 1em; border-radius: 4px; }
+</style>
+</head>
+<body>
+<p><a href="../index.html">← 返回目录</a></p>
+<h1>{{.Title}}</h1>
+<p class="meta">{{.Author}} {{.Source}} {{date .PublishedAt}} · <a href="{{.URL}}">原文链接</a></p>
+{{if .Summary}}<div class="summary">{{.Summary}}</div>{{end}}
+<article>{{.Body}}</article>
+</body>
+</html>
+`))
@@ -0,0 +1,427 @@
+package service
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"log"
+	"net/url"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/schema"
+	"github.com/gorexlv/cabinet/scissor/pkg/feed"
+	"github.com/gorexlv/cabinet/scissor/pkg/textutil"
+)
+
+var (
+	// ErrInvalidFeed 订阅地址无效或无法解析为 RSS/Atom
+	ErrInvalidFeed = errors.New("无效的订阅源")
+	// ErrFeedExists 用户已订阅该地址
+	ErrFeedExists = errors.New("已订阅该地址")
+)
+
+const (
+	// 单个订阅源的拉取超时时间
+	feedTimeout = time.Minute
+	// 每个订阅源记住的已处理条目数
+	feedSeenLimit = 1000
+	// 连续失败后的最长退避时间
+	feedMaxBackoff = 24 * time.Hour
+	// 条目正文不足该字数时视为摘要，由后台任务抓取全文
+	feedMinContentLength = 200
+)
+
+// FeedService 管理 RSS/Atom 订阅，并按规则将新条目自动保存为文章
+type FeedService struct {
+	feedRepo       *repository.FeedRepository
+	articleService *ArticleService
+	client         *feed.Client
+	// interval 定时拉取的间隔，用于计算失败后的退避时间
+	interval time.Duration
+}
+
+// NewFeedService 创建订阅服务
+func NewFeedService(feedRepo *repository.FeedRepository, articleService *ArticleService, client *feed.Client, interval time.Duration) *FeedService {
+	return &FeedService{
+		feedRepo:       feedRepo,
+		articleService: articleService,
+		client:         client,
+		interval:       interval,
+	}
+}
+
+// Subscribe 订阅新的源，订阅前会先拉取一次以校验地址，并立即保存符合规则的条目
+func (s *FeedService) Subscribe(ctx context.Context, userID uint, req *domain.CreateFeedRequest) (*domain.FeedRefreshResult, error) {
+	feedURL := strings.TrimSpace(req.URL)
+	u, err := url.Parse(feedURL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, fmt.Errorf("%w: 仅支持 http/https 地址", ErrInvalidFeed)
+	}
+
+	if _, err := s.feedRepo.FindByUserIDAndURL(ctx, int(userID), feedURL); err == nil {
+		return nil, ErrFeedExists
+	} else if !errors.Is(err, repository.ErrNotFound) {
+		return nil, err
+	}
+
+	fetchCtx, cancel := context.WithTimeout(ctx, feedTimeout)
+	defer cancel()
+	fetched, err := s.client.Fetch(fetchCtx, feedURL, "", "")
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidFeed, err)
+	}
+
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		title = fetched.Feed.Title
+	}
+	f, err := s.feedRepo.Create(ctx, &ent.Feed{
+		UserID:  int(userID),
+		URL:     feedURL,
+		Title:   title,
+		SiteURL: fetched.Feed.SiteURL,
+		Rules:   toSchemaFeedRules(req.Rules),
+		Tags:    normalizeTags(req.Tags),
+		Enabled: true,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return s.apply(ctx, f, fetched)
+}
+
+// List 返回用户的全部订阅及其健康状态
+func (s *FeedService) List(ctx context.Context, userID uint) ([]*domain.Feed, error) {
+	feeds, err := s.feedRepo.FindByUserID(ctx, int(userID))
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.Feed, len(feeds))
+	for i, f := range feeds {
+		result[i] = toDomainFeed(f)
+	}
+	return result, nil
+}
+
+// Update 修改订阅的标题、规则、标签和启用状态
+func (s *FeedService) Update(ctx context.Context, userID uint, id int, req *domain.UpdateFeedRequest) (*domain.Feed, error) {
+	existing, err := s.find(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+
+	title := strings.TrimSpace(req.Title)
+	if title == "" {
+		title = existing.Title
+	}
+	f, err := s.feedRepo.UpdateSettings(ctx, id, &ent.Feed{
+		Title:   title,
+		Rules:   toSchemaFeedRules(req.Rules),
+		Tags:    normalizeTags(req.Tags),
+		Enabled: req.Enabled,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainFeed(f), nil
+}
+
+// Delete 取消订阅，已保存的文章保留
+func (s *FeedService) Delete(ctx context.Context, userID uint, id int) error {
+	if _, err := s.find(ctx, userID, id); err != nil {
+		return err
+	}
+	return s.feedRepo.Delete(ctx, id)
+}
+
+// Refresh 立即拉取一次订阅源，忽略失败退避
+func (s *FeedService) Refresh(ctx context.Context, userID uint, id int) (*domain.FeedRefreshResult, error) {
+	f, err := s.find(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	return s.poll(ctx, f)
+}
+
+// PollAll 拉取所有到期的启用订阅，连续失败的订阅按失败次数指数退避
+func (s *FeedService) PollAll(ctx context.Context) error {
+	feeds, err := s.feedRepo.FindEnabled(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for _, f := range feeds {
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+		if f.ErrorCount > 0 && f.LastFetchedAt != nil && now.Sub(*f.LastFetchedAt) < s.backoff(f.ErrorCount) {
+			continue
+		}
+
+		result, err := s.poll(ctx, f)
+		if err != nil {
+			if ctx.Err() != nil {
+				return ctx.Err()
+			}
+			log.Printf("Failed to poll feed %d (%s): %v", f.ID, f.URL, err)
+			continue
+		}
+		if len(result.Saved) > 0 {
+			log.Printf("Feed %d saved %d articles", f.ID, len(result.Saved))
+		}
+	}
+	return nil
+}
+
+// backoff 返回连续失败n次后距下一次拉取的最短间隔
+func (s *FeedService) backoff(n int) time.Duration {
+	d := s.interval
+	for i := 0; i < n && d < feedMaxBackoff; i++ {
+		d *= 2
+	}
+	if d > feedMaxBackoff {
+		d = feedMaxBackoff
+	}
+	return d
+}
+
+// poll 带条件请求地拉取订阅源，失败时记录到订阅上
+func (s *FeedService) poll(ctx context.Context, f *ent.Feed) (*domain.FeedRefreshResult, error) {
+	fetchCtx, cancel := context.WithTimeout(ctx, feedTimeout)
+	defer cancel()
+
+	fetched, err := s.client.Fetch(fetchCtx, f.URL, f.Etag, f.LastModified)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		if _, markErr := s.feedRepo.MarkPollFailed(ctx, f.ID, err.Error()); markErr != nil {
+			return nil, markErr
+		}
+		return nil, err
+	}
+	return s.apply(ctx, f, fetched)
+}
+
+// apply 保存新条目中符合规则的部分，并记录拉取成功
+func (s *FeedService) apply(ctx context.Context, f *ent.Feed, fetched *feed.FetchResult) (*domain.FeedRefreshResult, error) {
+	result := &domain.FeedRefreshResult{NotModified: fetched.NotModified}
+	update := &ent.Feed{
+		Etag:         fetched.ETag,
+		LastModified: fetched.LastModified,
+		Seen:         f.Seen,
+	}
+
+	if !fetched.NotModified {
+		seen := make(map[string]bool, len(f.Seen))
+		for _, key := range f.Seen {
+			seen[key] = true
+		}
+
+		// 当前条目排在前面，截断时优先丢弃最早处理的条目
+		var current []string
+		for _, item := range fetched.Feed.Items {
+			key := itemKey(item)
+			if seen[key] {
+				current = append(current, key)
+				continue
+			}
+
+			id, err := s.save(ctx, f, item)
+			if err != nil {
+				if ctx.Err() != nil {
+					return nil, ctx.Err()
+				}
+				// 保存失败的条目不记为已处理，下次拉取时重试
+				log.Printf("Failed to save feed %d entry %s: %v", f.ID, item.Link, err)
+				continue
+			}
+			seen[key] = true
+			current = append(current, key)
+			if id == 0 {
+				result.Skipped++
+			} else {
+				result.Saved = append(result.Saved, id)
+			}
+		}
+		update.Seen = mergeSeen(current, f.Seen)
+
+		if f.Title == "" {
+			update.Title = fetched.Feed.Title
+		}
+		if f.SiteURL == "" {
+			update.SiteURL = fetched.Feed.SiteURL
+		}
+	}
+
+	polled, err := s.feedRepo.MarkPolled(ctx, f.ID, update)
+	if err != nil {
+		return nil, err
+	}
+	result.Feed = toDomainFeed(polled)
+	return result, nil
+}
+
+// save 将符合规则的条目保存为文章，返回文章ID；条目不符合规则或已在库中时返回0
+func (s *FeedService) save(ctx context.Context, f *ent.Feed, item *feed.Item) (uint, error) {
+	if !matchFeedRules(f.Rules, item) {
+		return 0, nil
+	}
+	u, err := url.Parse(item.Link)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return 0, nil
+	}
+
+	article := &domain.Article{
+		Title:       firstNonEmpty(item.Title, item.Link),
+		Content:     item.Content,
+		URL:         item.Link,
+		Author:      item.Author,
+		Source:      f.Title,
+		Tags:        f.Tags,
+		PublishedAt: item.Published,
+		UserID:      uint(f.UserID),
+	}
+	if article.PublishedAt.IsZero() {
+		article.PublishedAt = time.Now()
+	}
+	// 多数源只输出摘要，交给后台任务抓取全文后再生成摘要和标签
+	if utf8.RuneCountInString(textutil.PlainText(item.Content)) < feedMinContentLength {
+		article.Content = ""
+		article.FetchStatus = domain.FetchStatusPending
+	}
+
+	created, err := s.articleService.Create(ctx, article)
+	if err != nil {
+		var dupErr *DuplicateError
+		if errors.As(err, &dupErr) {
+			return 0, nil
+		}
+		return 0, err
+	}
+	return created.ID, nil
+}
+
+func (s *FeedService) find(ctx context.Context, userID uint, id int) (*ent.Feed, error) {
+	f, err := s.feedRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if uint(f.UserID) != userID {
+		return nil, ErrForbidden
+	}
+	return f, nil
+}
+
+// matchFeedRules 判断条目是否满足订阅规则：同类规则满足任意一项，不同类规则同时满足
+func matchFeedRules(rules schema.FeedRules, item *feed.Item) bool {
+	if len(rules.Keywords) > 0 {
+		text := strings.ToLower(item.Title + "\n" + textutil.PlainText(item.Content))
+		if !containsAny(rules.Keywords, func(k string) bool {
+			return strings.Contains(text, strings.ToLower(k))
+		}) {
+			return false
+		}
+	}
+	if len(rules.Authors) > 0 {
+		if !containsAny(rules.Authors, func(a string) bool {
+			return strings.EqualFold(a, item.Author)
+		}) {
+			return false
+		}
+	}
+	if len(rules.Tags) > 0 {
+		if !containsAny(rules.Tags, func(t string) bool {
+			for _, c := range item.Categories {
+				if strings.EqualFold(t, c) {
+					return true
+				}
+			}
+			return false
+		}) {
+			return false
+		}
+	}
+	return true
+}
+
+func containsAny(values []string, match func(string) bool) bool {
+	for _, v := range values {
+		if match(v) {
+			return true
+		}
+	}
+	return false
+}
+
+// itemKey 返回条目标识的摘要，避免超长GUID撑大已处理列表
+func itemKey(item *feed.Item) string {
+	sum := sha1.Sum([]byte(item.GUID))
+	return hex.EncodeToString(sum[:8])
+}
+
+// mergeSeen 合并本次与此前处理过的条目标识，去重并截断到上限
+func mergeSeen(current, previous []string) []string {
+	merged := make([]string, 0, len(current)+len(previous))
+	added := make(map[string]bool, len(current)+len(previous))
+	for _, list := range [][]string{current, previous} {
+		for _, key := range list {
+			if !added[key] && len(merged) < feedSeenLimit {
+				added[key] = true
+				merged = append(merged, key)
+			}
+		}
+	}
+	return merged
+}
+
+// normalizeTags 去掉空白和重复的标签
+func normalizeTags(tags []string) []string {
+	result := make([]string, 0, len(tags))
+	seen := make(map[string]bool, len(tags))
+	for _, t := range tags {
+		t = strings.TrimSpace(t)
+		if t != "" && !seen[t] {
+			seen[t] = true
+			result = append(result, t)
+		}
+	}
+	return result
+}
+
+func toSchemaFeedRules(rules domain.FeedRules) schema.FeedRules {
+	return schema.FeedRules{
+		Keywords: normalizeTags(rules.Keywords),
+		Authors:  normalizeTags(rules.Authors),
+		Tags:     normalizeTags(rules.Tags),
+	}
+}
+
+func toDomainFeed(f *ent.Feed) *domain.Feed {
+	return &domain.Feed{
+		ID:      f.ID,
+		URL:     f.URL,
+		Title:   f.Title,
+		SiteURL: f.SiteURL,
+		Rules: domain.FeedRules{
+			Keywords: f.Rules.Keywords,
+			Authors:  f.Rules.Authors,
+			Tags:     f.Rules.Tags,
+		},
+		Tags:          f.Tags,
+		Enabled:       f.Enabled,
+		LastFetchedAt: f.LastFetchedAt,
+		LastSuccessAt: f.LastSuccessAt,
+		ErrorCount:    f.ErrorCount,
+		LastError:     f.LastError,
+		CreatedAt:     f.CreatedAt,
+	}
+}
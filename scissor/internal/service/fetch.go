@@ -0,0 +1,114 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/extract"
+)
+
+const (
+	// 每批抓取的文章数
+	fetchBatchSize = 20
+	// 相邻两次抓取的间隔，避免短时间内大量请求同一站点
+	fetchDelay = 500 * time.Millisecond
+	// 单篇文章的抓取超时时间
+	fetchTimeout = time.Minute
+)
+
+var errEmptyContent = errors.New("未能提取到正文")
+
+// FetchService 为只有链接的文章（如导入的书签）抓取正文
+type FetchService struct {
+	articleRepo *repository.ArticleRepository
+	enricher    *EnrichService
+	extractor   *extract.Client
+}
+
+// NewFetchService 创建正文抓取服务
+func NewFetchService(articleRepo *repository.ArticleRepository, enricher *EnrichService, extractor *extract.Client) *FetchService {
+	return &FetchService{
+		articleRepo: articleRepo,
+		enricher:    enricher,
+		extractor:   extractor,
+	}
+}
+
+// ProcessPending 逐批抓取所有待抓取的文章，抓取完成后补全摘要、标签和检索向量
+func (s *FetchService) ProcessPending(ctx context.Context) error {
+	for {
+		articles, err := s.articleRepo.FindPendingFetch(ctx, fetchBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(articles) == 0 {
+			return nil
+		}
+
+		for _, article := range articles {
+			if err := s.fetch(ctx, article); err != nil {
+				return err
+			}
+
+			select {
+			case <-ctx.Done():
+				return ctx.Err()
+			case <-time.After(fetchDelay):
+			}
+		}
+	}
+}
+
+// fetch 抓取单篇文章，网页抓取失败只记录到文章上，返回的错误均来自数据库
+func (s *FetchService) fetch(ctx context.Context, article *ent.Article) error {
+	fetchCtx, cancel := context.WithTimeout(ctx, fetchTimeout)
+	defer cancel()
+
+	doc, err := s.extractor.Fetch(fetchCtx, article.URL)
+	if err == nil && doc.Content == "" {
+		err = errEmptyContent
+	}
+	if err != nil {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		log.Printf("Failed to fetch article %d (%s): %v", article.ID, article.URL, err)
+		return s.articleRepo.MarkFetchFailed(ctx, int(article.ID), err.Error())
+	}
+
+	// 保留导入时已有的标题等信息，只补全缺失的部分
+	fetched := &ent.Article{
+		Title:       firstNonEmpty(article.Title, doc.Title, article.URL),
+		Content:     doc.Content,
+		Fingerprint: contentFingerprint(doc.Content),
+		Author:      firstNonEmpty(article.Author, doc.Author),
+		Source:      firstNonEmpty(article.Source, doc.Source),
+		PublishedAt: article.PublishedAt,
+	}
+	if !doc.PublishedAt.IsZero() {
+		fetched.PublishedAt = doc.PublishedAt
+	}
+	if _, err := s.articleRepo.UpdateFetched(ctx, int(article.ID), fetched); err != nil {
+		return err
+	}
+
+	if s.enricher != nil {
+		if err := s.enricher.Enrich(ctx, article.ID); err != nil {
+			log.Printf("Failed to enrich article %d: %v", article.ID, err)
+		}
+	}
+	return nil
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
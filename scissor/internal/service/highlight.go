@@ -0,0 +1,117 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"strings"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+)
+
+// HighlightService 管理文章划线
+type HighlightService struct {
+	articleRepo   *repository.ArticleRepository
+	highlightRepo *repository.HighlightRepository
+}
+
+// NewHighlightService 创建划线服务
+func NewHighlightService(articleRepo *repository.ArticleRepository, highlightRepo *repository.HighlightRepository) *HighlightService {
+	return &HighlightService{
+		articleRepo:   articleRepo,
+		highlightRepo: highlightRepo,
+	}
+}
+
+// Create 为用户的文章添加划线
+func (s *HighlightService) Create(ctx context.Context, userID, articleID uint, req *domain.CreateHighlightRequest) (*domain.Highlight, error) {
+	text := strings.TrimSpace(req.Text)
+	if text == "" {
+		return nil, errors.New("划线内容不能为空")
+	}
+	if err := s.checkArticle(ctx, userID, articleID); err != nil {
+		return nil, err
+	}
+
+	h, err := s.highlightRepo.Create(ctx, &ent.Highlight{
+		ArticleID: articleID,
+		Text:      text,
+		Note:      strings.TrimSpace(req.Note),
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainHighlight(h), nil
+}
+
+// List 返回用户文章中的全部划线
+func (s *HighlightService) List(ctx context.Context, userID, articleID uint) ([]*domain.Highlight, error) {
+	if err := s.checkArticle(ctx, userID, articleID); err != nil {
+		return nil, err
+	}
+
+	highlights, err := s.highlightRepo.FindByArticleID(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.Highlight, len(highlights))
+	for i, h := range highlights {
+		result[i] = toDomainHighlight(h)
+	}
+	return result, nil
+}
+
+// UpdateNote 修改划线批注
+func (s *HighlightService) UpdateNote(ctx context.Context, userID uint, id int, note string) (*domain.Highlight, error) {
+	if _, err := s.find(ctx, userID, id); err != nil {
+		return nil, err
+	}
+	h, err := s.highlightRepo.UpdateNote(ctx, id, strings.TrimSpace(note))
+	if err != nil {
+		return nil, err
+	}
+	return toDomainHighlight(h), nil
+}
+
+// Delete 删除划线
+func (s *HighlightService) Delete(ctx context.Context, userID uint, id int) error {
+	if _, err := s.find(ctx, userID, id); err != nil {
+		return err
+	}
+	return s.highlightRepo.Delete(ctx, id)
+}
+
+func (s *HighlightService) checkArticle(ctx context.Context, userID, articleID uint) error {
+	article, err := s.articleRepo.FindByID(ctx, int(articleID))
+	if err != nil {
+		return err
+	}
+	if uint(article.UserID) != userID {
+		return ErrForbidden
+	}
+	return nil
+}
+
+func (s *HighlightService) find(ctx context.Context, userID uint, id int) (*ent.Highlight, error) {
+	h, err := s.highlightRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if h.Edges.Article == nil || uint(h.Edges.Article.UserID) != userID {
+		return nil, ErrForbidden
+	}
+	return h, nil
+}
+
+func toDomainHighlight(h *ent.Highlight) *domain.Highlight {
+	return &domain.Highlight{
+		ID:        h.ID,
+		ArticleID: h.ArticleID,
+		Text:      h.Text,
+		Note:      h.Note,
+		Location:  h.Location,
+		CreatedAt: h.CreatedAt,
+		UpdatedAt: h.UpdatedAt,
+	}
+}
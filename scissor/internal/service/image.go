@@ -0,0 +1,316 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"mime"
+	"net/http"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/epub"
+	"github.com/gorexlv/cabinet/scissor/pkg/safehttp"
+	"github.com/gorexlv/cabinet/scissor/pkg/storage"
+	"github.com/gorexlv/cabinet/scissor/pkg/textutil"
+)
+
+var (
+	// ErrImageQuota 归档图片超出用户配额
+	ErrImageQuota = errors.New("图片空间已用完")
+	// errUnsupportedImage 不是可归档的图片，SVG可能包含脚本，不从本站提供
+	errUnsupportedImage = errors.New("不支持的图片类型")
+)
+
+const (
+	// 下载单张图片的超时时间
+	imageFetchTimeout = 30 * time.Second
+	// 归档图片地址的路径前缀
+	imagePathPrefix = "/api/images/"
+	// 正文在下载期间被修改时最多尝试写入的次数
+	imageArchiveAttempts = 3
+)
+
+// imageURLPattern 匹配已归档的图片地址，更换 base_url 后旧地址也能识别
+var imageURLPattern = regexp.MustCompile(`/api/images/([0-9a-f]{64})$`)
+
+// ImageService 下载文章中的图片并改写为本站地址，避免防盗链和原图失效导致图片无法显示
+type ImageService struct {
+	repo        *repository.ImageRepository
+	articleRepo *repository.ArticleRepository
+	storage     storage.Storage
+	fetch       epub.ImageFetcher
+	baseURL     string
+	maxSize     int64
+	quota       int64
+}
+
+// NewImageService 创建图片归档服务。baseURL为图片地址的前缀，为空时使用相对地址；
+// maxSize为单张图片的大小上限，quota为每个用户的图片空间配额，0表示不限制
+func NewImageService(repo *repository.ImageRepository, articleRepo *repository.ArticleRepository, store storage.Storage, baseURL string, maxSize, quota int64) *ImageService {
+	return &ImageService{
+		repo:        repo,
+		articleRepo: articleRepo,
+		storage:     store,
+		fetch:       epub.HTTPFetcher(safehttp.NewClient(imageFetchTimeout), maxSize),
+		baseURL:     strings.TrimRight(baseURL, "/"),
+		maxSize:     maxSize,
+		quota:       quota,
+	}
+}
+
+// Archive 归档文章中尚未归档的图片（包括懒加载的 data-src 和内嵌的 data URI），并更新正文。
+// 单张图片下载失败或超出配额时保留原地址，不影响其余图片。
+// 下载期间正文被修改时基于最新正文重试，已下载的图片不会重复下载。
+func (s *ImageService) Archive(ctx context.Context, article *ent.Article) (*ent.Article, error) {
+	// 原地址 -> 归档地址，同一篇文章中重复的图片只下载一次，失败的记为空
+	archived := make(map[string]string)
+	for attempt := 1; ; attempt++ {
+		updated, err := s.archiveContent(ctx, article, archived)
+		if !errors.Is(err, repository.ErrNotFound) || attempt == imageArchiveAttempts {
+			return updated, err
+		}
+		if article, err = s.articleRepo.FindByID(ctx, int(article.ID)); err != nil {
+			return nil, err
+		}
+	}
+}
+
+// archiveContent 替换正文中的图片地址，仅在正文未被修改时写入
+func (s *ImageService) archiveContent(ctx context.Context, article *ent.Article, archived map[string]string) (*ent.Article, error) {
+	if !strings.Contains(strings.ToLower(article.Content), "<img") {
+		return article, nil
+	}
+
+	body := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := html.ParseFragment(strings.NewReader(article.Content), body)
+	if err != nil {
+		return nil, err
+	}
+	used, _, err := s.repo.UsageByUserID(ctx, article.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	changed := false
+	for _, n := range nodes {
+		walkImages(n, func(img *html.Node) {
+			src := firstNonEmpty(strings.TrimSpace(htmlAttr(img, "data-src")), strings.TrimSpace(htmlAttr(img, "src")))
+			if src == "" || imageURLPattern.MatchString(src) {
+				return
+			}
+			target, ok := archived[src]
+			if !ok {
+				target, used, err = s.archive(ctx, article.UserID, src, used)
+				if err != nil && ctx.Err() == nil {
+					log.Printf("Failed to archive image %s of article %d: %v", textutil.Truncate(src, 100), article.ID, err)
+				}
+				archived[src] = target
+			}
+			if target == "" {
+				return
+			}
+
+			attrs := img.Attr[:0]
+			for _, a := range img.Attr {
+				switch strings.ToLower(a.Key) {
+				case "src", "data-src", "srcset", "data-srcset", "data-original-src":
+					continue
+				}
+				attrs = append(attrs, a)
+			}
+			img.Attr = append(attrs, html.Attribute{Key: "src", Val: target})
+			if !strings.HasPrefix(src, "data:") {
+				img.Attr = append(img.Attr, html.Attribute{Key: "data-original-src", Val: src})
+			}
+			changed = true
+		})
+	}
+	if ctx.Err() != nil {
+		return nil, ctx.Err()
+	}
+	if !changed {
+		return article, nil
+	}
+
+	var buf bytes.Buffer
+	for _, n := range nodes {
+		if err := html.Render(&buf, n); err != nil {
+			return nil, err
+		}
+	}
+	content := buf.String()
+	return s.articleRepo.ReplaceContent(ctx, int(article.ID), article.Content, content, contentFingerprint(content))
+}
+
+// archive 下载并保存单张图片，返回归档地址和更新后的已用空间
+func (s *ImageService) archive(ctx context.Context, userID int, src string, used int64) (string, int64, error) {
+	data, contentType, err := s.download(ctx, src)
+	if err != nil {
+		return "", used, err
+	}
+	sum := sha256.Sum256(data)
+	hash := hex.EncodeToString(sum[:])
+
+	_, err = s.repo.FindByUserIDAndHash(ctx, userID, hash)
+	if err == nil {
+		return s.url(hash), used, nil
+	}
+	if !errors.Is(err, repository.ErrNotFound) {
+		return "", used, err
+	}
+	if s.quota > 0 && used+int64(len(data)) > s.quota {
+		return "", used, ErrImageQuota
+	}
+
+	if err := s.storage.Put(ctx, imageKey(hash), bytes.NewReader(data), contentType); err != nil {
+		return "", used, err
+	}
+	sourceURL := src
+	if strings.HasPrefix(src, "data:") {
+		sourceURL = ""
+	}
+	_, err = s.repo.Create(ctx, &ent.Image{
+		UserID:      userID,
+		Hash:        hash,
+		ContentType: contentType,
+		Size:        int64(len(data)),
+		SourceURL:   sourceURL,
+	})
+	// 并发归档同一张图片时唯一索引冲突，图片已由另一方保存
+	if err != nil && !ent.IsConstraintError(err) {
+		return "", used, err
+	}
+	return s.url(hash), used + int64(len(data)), nil
+}
+
+// download 下载图片或解码 data URI，返回图片数据和类型
+func (s *ImageService) download(ctx context.Context, src string) ([]byte, string, error) {
+	var (
+		data     []byte
+		declared string
+		err      error
+	)
+	switch {
+	case strings.HasPrefix(src, "data:"):
+		data, declared, err = decodeDataURI(src)
+		if err == nil && int64(len(data)) > s.maxSize {
+			err = fmt.Errorf("图片超过 %d 字节", s.maxSize)
+		}
+	case strings.HasPrefix(src, "http://"), strings.HasPrefix(src, "https://"):
+		fetchCtx, cancel := context.WithTimeout(ctx, imageFetchTimeout)
+		defer cancel()
+		data, declared, err = s.fetch(fetchCtx, src)
+	default:
+		err = errUnsupportedImage
+	}
+	if err != nil {
+		return nil, "", err
+	}
+
+	// 以内容识别出的类型为准，识别不出时再使用声明的类型
+	contentType := http.DetectContentType(data)
+	if !strings.HasPrefix(contentType, "image/") {
+		contentType = declared
+	}
+	contentType, _, _ = mime.ParseMediaType(contentType)
+	if !strings.HasPrefix(contentType, "image/") || strings.HasPrefix(contentType, "image/svg") {
+		return nil, "", errUnsupportedImage
+	}
+	return data, contentType, nil
+}
+
+// Open 读取已归档的图片
+func (s *ImageService) Open(ctx context.Context, hash string) (io.ReadCloser, string, error) {
+	img, err := s.repo.FindByHash(ctx, hash)
+	if err != nil {
+		return nil, "", err
+	}
+	r, err := s.storage.Get(ctx, imageKey(hash))
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, "", repository.ErrNotFound
+		}
+		return nil, "", err
+	}
+	return r, img.ContentType, nil
+}
+
+// Usage 返回用户归档图片占用的空间
+func (s *ImageService) Usage(ctx context.Context, userID uint) (*domain.ImageUsage, error) {
+	used, count, err := s.repo.UsageByUserID(ctx, int(userID))
+	if err != nil {
+		return nil, err
+	}
+	return &domain.ImageUsage{Used: used, Quota: s.quota, Count: count}, nil
+}
+
+// Fetcher 返回直接从存储读取已归档图片的ImageFetcher，其余图片交给fallback下载
+func (s *ImageService) Fetcher(fallback epub.ImageFetcher) epub.ImageFetcher {
+	return func(ctx context.Context, url string) ([]byte, string, error) {
+		m := imageURLPattern.FindStringSubmatch(url)
+		if m == nil {
+			return fallback(ctx, url)
+		}
+		r, contentType, err := s.Open(ctx, m[1])
+		if err != nil {
+			return nil, "", err
+		}
+		defer r.Close()
+		data, err := io.ReadAll(r)
+		return data, contentType, err
+	}
+}
+
+func (s *ImageService) url(hash string) string {
+	return s.baseURL + imagePathPrefix + hash
+}
+
+// imageKey 图片在存储中的对象键，按哈希前两位分目录
+func imageKey(hash string) string {
+	return "images/" + hash[:2] + "/" + hash
+}
+
+// decodeDataURI 解码 base64 编码的 data URI
+func decodeDataURI(uri string) ([]byte, string, error) {
+	meta, payload, ok := strings.Cut(strings.TrimPrefix(uri, "data:"), ",")
+	if !ok || !strings.HasSuffix(meta, ";base64") {
+		return nil, "", errUnsupportedImage
+	}
+	data, err := base64.StdEncoding.DecodeString(payload)
+	if err != nil {
+		return nil, "", err
+	}
+	return data, strings.TrimSuffix(meta, ";base64"), nil
+}
+
+func walkImages(n *html.Node, fn func(*html.Node)) {
+	if n.Type == html.ElementNode && n.DataAtom == atom.Img {
+		fn(n)
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		walkImages(c, fn)
+	}
+}
+
+func htmlAttr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if strings.EqualFold(a.Key, key) {
+			return a.Val
+		}
+	}
+	return ""
+}
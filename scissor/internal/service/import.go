@@ -0,0 +1,114 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/importer"
+)
+
+// ErrInvalidImport 导出文件无法解析
+var ErrInvalidImport = errors.New("无法解析导入文件")
+
+// ImportService 从书签导出文件和 Kindle 标注文件批量导入文章
+type ImportService struct {
+	articleService *ArticleService
+	articleRepo    *repository.ArticleRepository
+	highlightRepo  *repository.HighlightRepository
+}
+
+// NewImportService 创建导入服务
+func NewImportService(articleService *ArticleService, articleRepo *repository.ArticleRepository, highlightRepo *repository.HighlightRepository) *ImportService {
+	return &ImportService{
+		articleService: articleService,
+		articleRepo:    articleRepo,
+		highlightRepo:  highlightRepo,
+	}
+}
+
+// Import 解析导出文件并为每条书签创建待抓取正文的文章。
+// 已在用户库中的链接计为跳过，单条失败不影响其余书签。
+func (s *ImportService) Import(ctx context.Context, userID uint, format string, r io.Reader) (*domain.ImportReport, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+	}
+	if format == "" {
+		format = importer.Detect(data)
+	}
+	if format == importer.FormatKindle {
+		return s.importKindle(ctx, userID, data)
+	}
+
+	bookmarks, err := importer.Parse(format, bytes.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+	}
+
+	report := &domain.ImportReport{
+		Total: len(bookmarks),
+		Items: make([]*domain.ImportItem, 0, len(bookmarks)),
+	}
+	for _, b := range bookmarks {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		item := s.importBookmark(ctx, userID, b)
+		switch item.Status {
+		case domain.ImportStatusCreated:
+			report.Created++
+		case domain.ImportStatusSkipped:
+			report.Skipped++
+		default:
+			report.Failed++
+		}
+		report.Items = append(report.Items, item)
+	}
+	return report, nil
+}
+
+func (s *ImportService) importBookmark(ctx context.Context, userID uint, b *importer.Bookmark) *domain.ImportItem {
+	item := &domain.ImportItem{URL: b.URL, Title: b.Title}
+
+	if u, err := url.Parse(b.URL); err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		item.Status = domain.ImportStatusFailed
+		item.Error = "不支持的链接"
+		return item
+	}
+
+	savedAt := b.SavedAt
+	if savedAt.IsZero() {
+		savedAt = time.Now()
+	}
+
+	article, err := s.articleService.Create(ctx, &domain.Article{
+		Title:       b.Title,
+		URL:         b.URL,
+		Tags:        b.Tags,
+		PublishedAt: savedAt,
+		CreatedAt:   savedAt,
+		UserID:      userID,
+		FetchStatus: domain.FetchStatusPending,
+	})
+	var dupErr *DuplicateError
+	switch {
+	case errors.As(err, &dupErr):
+		item.Status = domain.ImportStatusSkipped
+		item.ArticleID = dupErr.ArticleID
+	case err != nil:
+		item.Status = domain.ImportStatusFailed
+		item.Error = err.Error()
+	default:
+		item.Status = domain.ImportStatusCreated
+		item.ArticleID = article.ID
+	}
+	return item
+}
@@ -0,0 +1,183 @@
+package service
+
+import (
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html"
+	"strings"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/importer"
+)
+
+// importKindle 导入 Kindle 标注：每本书对应一篇文章，标注和笔记作为划线。
+// 重复导入时只添加新的划线，已有划线的笔记有变化时更新笔记。
+func (s *ImportService) importKindle(ctx context.Context, userID uint, data []byte) (*domain.ImportReport, error) {
+	books, err := importer.ParseKindle(data)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidImport, err)
+	}
+
+	report := &domain.ImportReport{
+		Total: len(books),
+		Items: make([]*domain.ImportItem, 0, len(books)),
+	}
+	for _, book := range books {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		item := &domain.ImportItem{
+			URL:   kindleBookURL(userID, book),
+			Title: book.Title,
+		}
+		if err := s.importBook(ctx, userID, book, item); err != nil {
+			item.Status = domain.ImportStatusFailed
+			item.Error = err.Error()
+		}
+		switch item.Status {
+		case domain.ImportStatusCreated:
+			report.Created++
+		case domain.ImportStatusUpdated:
+			report.Updated++
+		case domain.ImportStatusSkipped:
+			report.Skipped++
+		default:
+			report.Failed++
+		}
+		report.Items = append(report.Items, item)
+	}
+	return report, nil
+}
+
+func (s *ImportService) importBook(ctx context.Context, userID uint, book *importer.Book, item *domain.ImportItem) error {
+	existing, err := s.articleRepo.FindByURL(ctx, int(userID), item.URL)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return err
+	}
+
+	if existing == nil {
+		savedAt := time.Now()
+		for _, c := range book.Clippings {
+			if !c.AddedAt.IsZero() && c.AddedAt.Before(savedAt) {
+				savedAt = c.AddedAt
+			}
+		}
+		article, err := s.articleService.Create(ctx, &domain.Article{
+			Title:       book.Title,
+			Content:     kindleContent(book.Clippings),
+			URL:         item.URL,
+			Author:      book.Author,
+			Source:      "Kindle",
+			Tags:        []string{"kindle"},
+			PublishedAt: savedAt,
+			CreatedAt:   savedAt,
+			UserID:      userID,
+		})
+		if err != nil {
+			return err
+		}
+		item.ArticleID = article.ID
+		item.Status = domain.ImportStatusCreated
+		for _, c := range book.Clippings {
+			if _, err := s.highlightRepo.Create(ctx, kindleHighlight(article.ID, c)); err != nil {
+				return err
+			}
+			item.Highlights++
+		}
+		return nil
+	}
+
+	item.ArticleID = existing.ID
+	highlights, err := s.highlightRepo.FindByArticleID(ctx, existing.ID)
+	if err != nil {
+		return err
+	}
+	byText := make(map[string]*ent.Highlight, len(highlights))
+	for _, h := range highlights {
+		byText[h.Text] = h
+	}
+
+	changed := false
+	for _, c := range book.Clippings {
+		h := kindleHighlight(existing.ID, c)
+		if old, ok := byText[h.Text]; ok {
+			if old.Note != h.Note && h.Note != "" {
+				if _, err := s.highlightRepo.UpdateNote(ctx, old.ID, h.Note); err != nil {
+					return err
+				}
+				changed = true
+			}
+			continue
+		}
+		created, err := s.highlightRepo.Create(ctx, h)
+		if err != nil {
+			return err
+		}
+		byText[created.Text] = created
+		item.Highlights++
+		changed = true
+	}
+
+	if !changed {
+		item.Status = domain.ImportStatusSkipped
+		return nil
+	}
+	item.Status = domain.ImportStatusUpdated
+
+	// 正文由全部划线生成，以便检索和问答
+	all, err := s.highlightRepo.FindByArticleID(ctx, existing.ID)
+	if err != nil {
+		return err
+	}
+	clippings := make([]*importer.Clipping, len(all))
+	for i, h := range all {
+		clippings[i] = &importer.Clipping{Kind: importer.ClippingHighlight, Text: h.Text, Note: h.Note}
+	}
+	content := kindleContent(clippings)
+	if _, err := s.articleRepo.UpdateContent(ctx, int(existing.ID), content, contentFingerprint(content)); err != nil {
+		return err
+	}
+	s.articleService.enricher.EnrichAsync(existing.ID)
+	return nil
+}
+
+// kindleBookURL 为书籍生成稳定的虚拟链接。文章链接全局唯一，因此包含用户ID，
+// 以免不同用户导入同一本书时冲突。
+func kindleBookURL(userID uint, book *importer.Book) string {
+	sum := sha1.Sum([]byte(book.Title + "\x00" + book.Author))
+	return fmt.Sprintf("kindle://book/%d/%s", userID, hex.EncodeToString(sum[:8]))
+}
+
+// kindleHighlight 将标注转换为划线，单独的笔记以笔记内容作为划线内容
+func kindleHighlight(articleID uint, c *importer.Clipping) *ent.Highlight {
+	return &ent.Highlight{
+		ArticleID: articleID,
+		Text:      c.Text,
+		Note:      c.Note,
+		Location:  c.Location(),
+	}
+}
+
+// kindleContent 将标注渲染为文章正文
+func kindleContent(clippings []*importer.Clipping) string {
+	var b strings.Builder
+	for _, c := range clippings {
+		text := strings.ReplaceAll(html.EscapeString(c.Text), "\n", "<br>")
+		if c.Kind == importer.ClippingNote {
+			b.WriteString("<p>" + text + "</p>")
+		} else {
+			b.WriteString("<blockquote><p>" + text + "</p></blockquote>")
+		}
+		if c.Note != "" {
+			b.WriteString("<p>" + strings.ReplaceAll(html.EscapeString(c.Note), "\n", "<br>") + "</p>")
+		}
+	}
+	return b.String()
+}
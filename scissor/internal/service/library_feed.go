@@ -0,0 +1,169 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/feed"
+	"github.com/gorexlv/cabinet/scissor/pkg/textutil"
+)
+
+// ErrInvalidFeedToken 订阅令牌不存在或已重置
+var ErrInvalidFeedToken = errors.New("无效的订阅令牌")
+
+const (
+	// 订阅源中包含的最近文章数
+	libraryFeedLimit = 50
+	// 文章没有AI摘要时，以正文开头作为描述
+	libraryFeedExcerptLength = 200
+)
+
+// LibraryFeedService 将用户的文章库发布为 RSS/Atom/JSON Feed
+type LibraryFeedService struct {
+	userRepo    *repository.UserRepository
+	articleRepo *repository.ArticleRepository
+	clusterRepo *repository.ClusterRepository
+}
+
+// NewLibraryFeedService 创建文章库订阅服务
+func NewLibraryFeedService(userRepo *repository.UserRepository, articleRepo *repository.ArticleRepository, clusterRepo *repository.ClusterRepository) *LibraryFeedService {
+	return &LibraryFeedService{
+		userRepo:    userRepo,
+		articleRepo: articleRepo,
+		clusterRepo: clusterRepo,
+	}
+}
+
+// Token 返回用户的订阅令牌，尚未生成时自动生成
+func (s *LibraryFeedService) Token(ctx context.Context, userID uint) (string, error) {
+	u, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return "", err
+	}
+	if u.FeedToken != "" {
+		return u.FeedToken, nil
+	}
+	return s.ResetToken(ctx, userID)
+}
+
+// ResetToken 重新生成订阅令牌，旧的订阅地址随即失效
+func (s *LibraryFeedService) ResetToken(ctx context.Context, userID uint) (string, error) {
+	b := make([]byte, 24)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	token := base64.RawURLEncoding.EncodeToString(b)
+	if _, err := s.userRepo.SetFeedToken(ctx, int(userID), token); err != nil {
+		return "", err
+	}
+	return token, nil
+}
+
+// UserFeed 返回用户最近保存的文章，tag不为空时只包含带有该标签的文章
+func (s *LibraryFeedService) UserFeed(ctx context.Context, token, tag string) (*feed.Feed, error) {
+	u, err := s.findUser(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	articles, err := s.articleRepo.FindRecentByUserID(ctx, u.ID, tag, libraryFeedLimit)
+	if err != nil {
+		return nil, err
+	}
+
+	title := displayName(u) + " 的剪藏"
+	if tag != "" {
+		title += " · #" + tag
+	}
+	return buildLibraryFeed(title, articles), nil
+}
+
+// ClusterFeed 返回主题下最近保存的文章
+func (s *LibraryFeedService) ClusterFeed(ctx context.Context, token string, clusterID int) (*feed.Feed, error) {
+	u, err := s.findUser(ctx, token)
+	if err != nil {
+		return nil, err
+	}
+
+	cluster, err := s.clusterRepo.FindByID(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	if cluster.UserID != u.ID {
+		return nil, ErrForbidden
+	}
+
+	articles, err := s.clusterRepo.FindArticles(ctx, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	sort.SliceStable(articles, func(i, j int) bool {
+		return articles[i].CreatedAt.After(articles[j].CreatedAt)
+	})
+	if len(articles) > libraryFeedLimit {
+		articles = articles[:libraryFeedLimit]
+	}
+
+	return buildLibraryFeed(fmt.Sprintf("%s 的剪藏 · %s", displayName(u), cluster.Name), articles), nil
+}
+
+func (s *LibraryFeedService) findUser(ctx context.Context, token string) (*ent.User, error) {
+	if token == "" {
+		return nil, ErrInvalidFeedToken
+	}
+	u, err := s.userRepo.FindByFeedToken(ctx, token)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrInvalidFeedToken
+		}
+		return nil, err
+	}
+	return u, nil
+}
+
+// buildLibraryFeed 以AI摘要作为条目描述，按保存时间生成订阅源
+func buildLibraryFeed(title string, articles []*ent.Article) *feed.Feed {
+	f := &feed.Feed{
+		Title:       title,
+		Description: title,
+		Items:       make([]*feed.Item, 0, len(articles)),
+	}
+	for _, a := range articles {
+		summary := a.Summary
+		if summary == "" {
+			summary = textutil.Truncate(articleText(a), libraryFeedExcerptLength)
+		}
+		f.Items = append(f.Items, &feed.Item{
+			GUID:       fmt.Sprintf("urn:scissor:article:%d", a.ID),
+			Title:      a.Title,
+			Link:       a.URL,
+			Author:     a.Author,
+			Summary:    summary,
+			Content:    a.Content,
+			Categories: a.Tags,
+			Published:  a.CreatedAt,
+			Updated:    a.UpdatedAt,
+		})
+		if a.UpdatedAt.After(f.Updated) {
+			f.Updated = a.UpdatedAt
+		}
+	}
+	if f.Updated.IsZero() {
+		f.Updated = time.Now()
+	}
+	return f
+}
+
+func displayName(u *ent.User) string {
+	if u.Nickname != "" {
+		return u.Nickname
+	}
+	return u.Username
+}
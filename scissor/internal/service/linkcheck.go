@@ -0,0 +1,225 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/net/html/charset"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/extract"
+	"github.com/gorexlv/cabinet/scissor/pkg/linkcheck"
+	"github.com/gorexlv/cabinet/scissor/pkg/simhash"
+)
+
+var (
+	// ErrInvalidSourceStatus 不支持的原文状态
+	ErrInvalidSourceStatus = errors.New("原文状态只能是 alive、deleted、moved 或 changed")
+	// ErrNoSourceURL 文章没有原文网页地址，如邮件和手动录入的文章
+	ErrNoSourceURL = errors.New("文章没有原文网页地址")
+)
+
+const (
+	// 每批检查的文章数
+	linkCheckBatchSize = 100
+	// 同时检查的文章数，同一主机的请求另由限速器控制
+	linkCheckWorkers = 4
+	// 原文正文指纹与首次检查时的汉明距离超过该值时视为内容已变化
+	sourceChangedDistance = 12
+)
+
+// LinkCheckService 定期检查文章原文链接，原文被删除、迁移或修改时通知用户
+type LinkCheckService struct {
+	articleRepo   *repository.ArticleRepository
+	notifications *NotificationService
+	checker       *linkcheck.Checker
+	recheck       time.Duration
+}
+
+// NewLinkCheckService 创建原文检查服务，recheck为同一篇文章两次检查之间的间隔
+func NewLinkCheckService(articleRepo *repository.ArticleRepository, notifications *NotificationService, checker *linkcheck.Checker, recheck time.Duration) *LinkCheckService {
+	return &LinkCheckService{
+		articleRepo:   articleRepo,
+		notifications: notifications,
+		checker:       checker,
+		recheck:       recheck,
+	}
+}
+
+// CheckDue 检查所有到期的文章，供后台任务调用
+func (s *LinkCheckService) CheckDue(ctx context.Context) error {
+	for {
+		due, err := s.articleRepo.FindDueSourceCheck(ctx, time.Now().Add(-s.recheck), linkCheckBatchSize)
+		if err != nil {
+			return err
+		}
+		if len(due) == 0 {
+			return nil
+		}
+
+		var (
+			wg       sync.WaitGroup
+			mu       sync.Mutex
+			firstErr error
+			sem      = make(chan struct{}, linkCheckWorkers)
+		)
+		for _, a := range due {
+			sem <- struct{}{}
+			wg.Add(1)
+			go func(a *ent.Article) {
+				defer func() {
+					<-sem
+					wg.Done()
+				}()
+				if _, err := s.check(ctx, a); err != nil {
+					mu.Lock()
+					if firstErr == nil {
+						firstErr = err
+					}
+					mu.Unlock()
+				}
+			}(a)
+		}
+		wg.Wait()
+
+		// 写入检查结果失败时停止，避免反复检查同一批文章
+		if firstErr != nil {
+			return firstErr
+		}
+		if err := ctx.Err(); err != nil {
+			return err
+		}
+	}
+}
+
+// Check 立即检查用户一篇文章的原文
+func (s *LinkCheckService) Check(ctx context.Context, userID, articleID uint) (*domain.Article, error) {
+	a, err := s.articleRepo.FindByID(ctx, int(articleID))
+	if err != nil {
+		return nil, err
+	}
+	if uint(a.UserID) != userID {
+		return nil, ErrForbidden
+	}
+	if !strings.HasPrefix(a.URL, "http://") && !strings.HasPrefix(a.URL, "https://") {
+		return nil, ErrNoSourceURL
+	}
+
+	updated, err := s.check(ctx, a)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainArticle(updated), nil
+}
+
+// ListBySourceStatus 返回原文处于指定状态的文章，未指定时返回已删除、已迁移和内容已变化的文章
+func (s *LinkCheckService) ListBySourceStatus(ctx context.Context, userID uint, statuses []string) ([]*domain.Article, error) {
+	if len(statuses) == 0 {
+		statuses = []string{domain.SourceStatusDeleted, domain.SourceStatusMoved, domain.SourceStatusChanged}
+	}
+	values := make([]article.SourceStatus, len(statuses))
+	for i, status := range statuses {
+		values[i] = article.SourceStatus(status)
+		if article.SourceStatusValidator(values[i]) != nil {
+			return nil, ErrInvalidSourceStatus
+		}
+	}
+
+	articles, err := s.articleRepo.FindByUserIDAndSourceStatus(ctx, int(userID), values)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.Article, len(articles))
+	for i, a := range articles {
+		result[i] = toDomainArticle(a)
+	}
+	return result, nil
+}
+
+// check 检查原文并记录结果，状态变为删除、迁移或内容变化时通知用户。
+// 无法判断时只更新检查时间，保留原来的状态。
+func (s *LinkCheckService) check(ctx context.Context, a *ent.Article) (*ent.Article, error) {
+	res, err := s.checker.Check(ctx, a.URL)
+	if err != nil {
+		if ctx.Err() != nil {
+			return nil, ctx.Err()
+		}
+		log.Printf("Source check of article %d inconclusive: %v", a.ID, err)
+		return s.articleRepo.UpdateSourceStatus(ctx, a.ID, "", "", 0, time.Now())
+	}
+
+	status := article.SourceStatus(res.Status)
+	movedTo := ""
+	var baseline uint64
+	switch res.Status {
+	case linkcheck.StatusMoved:
+		movedTo = res.URL
+	case linkcheck.StatusAlive:
+		// 保存的正文可能来自划线剪藏、登录后的页面或手动录入，与服务端抓取的结果不可比较，
+		// 因此以首次检查时的原文为基线，之后的检查与基线比较
+		fingerprint := sourceFingerprint(res)
+		switch {
+		case fingerprint == 0:
+		case a.SourceFingerprint == 0:
+			baseline = fingerprint
+		case simhash.Distance(fingerprint, a.SourceFingerprint) > sourceChangedDistance:
+			status = article.SourceStatusChanged
+		}
+	}
+
+	updated, err := s.articleRepo.UpdateSourceStatus(ctx, a.ID, status, movedTo, baseline, time.Now())
+	if err != nil {
+		return nil, err
+	}
+	if status != a.SourceStatus && status != article.SourceStatusAlive {
+		if err := s.notify(ctx, updated, res); err != nil {
+			log.Printf("Failed to notify source status of article %d: %v", a.ID, err)
+		}
+	}
+	return updated, nil
+}
+
+func (s *LinkCheckService) notify(ctx context.Context, a *ent.Article, res *linkcheck.Result) error {
+	id := a.ID
+	n := &domain.Notification{
+		Kind:      "source_" + string(a.SourceStatus),
+		ArticleID: &id,
+	}
+	switch a.SourceStatus {
+	case article.SourceStatusDeleted:
+		n.Title = "原文已删除：" + a.Title
+		n.Body = fmt.Sprintf("《%s》的原文 %s 已无法访问（%s），文章库中保存的内容不受影响。", a.Title, a.URL, res.Reason)
+	case article.SourceStatusMoved:
+		n.Title = "原文已迁移：" + a.Title
+		n.Body = fmt.Sprintf("《%s》的原文 %s 已迁移到 %s。", a.Title, a.URL, a.SourceMovedTo)
+	case article.SourceStatusChanged:
+		n.Title = "原文内容已变化：" + a.Title
+		n.Body = fmt.Sprintf("《%s》的原文 %s 与保存时的内容不同，文章库中保存的仍是原来的内容。", a.Title, a.URL)
+	}
+	return s.notifications.Notify(ctx, uint(a.UserID), n)
+}
+
+// sourceFingerprint 提取原文正文并计算指纹，无法提取时返回0
+func sourceFingerprint(res *linkcheck.Result) uint64 {
+	if len(res.Body) == 0 {
+		return 0
+	}
+	r, err := charset.NewReader(bytes.NewReader(res.Body), "")
+	if err != nil {
+		return 0
+	}
+	doc, err := extract.Parse(res.URL, r)
+	if err != nil {
+		return 0
+	}
+	return contentFingerprint(doc.Content)
+}
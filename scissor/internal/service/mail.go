@@ -0,0 +1,348 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"html"
+	"io"
+	"log"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/extract"
+	"github.com/gorexlv/cabinet/scissor/pkg/mailin"
+	"github.com/gorexlv/cabinet/scissor/pkg/textutil"
+)
+
+var (
+	// ErrMailDisabled 未配置收件域名
+	ErrMailDisabled = errors.New("未配置收件域名")
+	// ErrUnknownRecipient 收件地址不存在或令牌已重置
+	ErrUnknownRecipient = errors.New("未知的收件地址")
+	// ErrEmptyMail 邮件中既没有正文也没有链接
+	ErrEmptyMail = errors.New("邮件中没有可保存的正文或链接")
+)
+
+const (
+	// 正文不足该字数时视为转发链接的邮件，保存其中的链接
+	mailMinContentLength = 200
+	// 一封邮件最多保存的链接数
+	mailMaxLinks = 10
+	// 以data URI内嵌到正文的图片大小上限
+	mailMaxInlineImage = 2 << 20
+	// 收件地址令牌的长度，只含小写字母和数字以兼容不区分大小写的邮件系统
+	mailTokenLength = 12
+)
+
+var (
+	mailRecipientPattern = regexp.MustCompile(`^u(\d+)\+([a-z0-9]+)$`)
+	mailLinkPattern      = regexp.MustCompile(`https?://[^\s<>"'\])]+`)
+	mailParagraphPattern = regexp.MustCompile(`\n\s*\n`)
+	mailHrefPattern      = regexp.MustCompile(`(?i)href\s*=\s*["']?(https?://[^"'\s>]+)`)
+	// 退订、偏好设置等邮件模板中的链接不保存
+	mailSkipLinkPattern = regexp.MustCompile(`(?i)unsubscribe|退订|preferences|manage.*subscription|list-manage|/track/|view.*browser`)
+)
+
+// MailService 将投递到用户收件地址的邮件保存为文章
+type MailService struct {
+	userRepo       *repository.UserRepository
+	articleService *ArticleService
+	domain         string
+}
+
+// NewMailService 创建邮件收件服务，domain为收件地址的域名
+func NewMailService(userRepo *repository.UserRepository, articleService *ArticleService, domain string) *MailService {
+	return &MailService{
+		userRepo:       userRepo,
+		articleService: articleService,
+		domain:         strings.ToLower(strings.TrimSpace(domain)),
+	}
+}
+
+// Address 返回用户的收件地址，尚未生成时自动生成
+func (s *MailService) Address(ctx context.Context, userID uint) (*domain.MailAddress, error) {
+	if s.domain == "" {
+		return nil, ErrMailDisabled
+	}
+	u, err := s.userRepo.FindByID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	if u.MailToken == "" {
+		return s.ResetAddress(ctx, userID)
+	}
+	return s.address(userID, u.MailToken), nil
+}
+
+// ResetAddress 重新生成收件地址，旧地址随即失效
+func (s *MailService) ResetAddress(ctx context.Context, userID uint) (*domain.MailAddress, error) {
+	if s.domain == "" {
+		return nil, ErrMailDisabled
+	}
+	token, err := randomMailToken()
+	if err != nil {
+		return nil, err
+	}
+	if _, err := s.userRepo.SetMailToken(ctx, int(userID), token); err != nil {
+		return nil, err
+	}
+	return s.address(userID, token), nil
+}
+
+func (s *MailService) address(userID uint, token string) *domain.MailAddress {
+	return &domain.MailAddress{
+		Address: fmt.Sprintf("u%d+%s@%s", userID, token, s.domain),
+	}
+}
+
+// Recipient 根据收件地址查找用户，地址格式为 u<用户ID>+<令牌>@<域名>
+func (s *MailService) Recipient(ctx context.Context, addr string) (*ent.User, error) {
+	local, host, ok := strings.Cut(strings.ToLower(strings.TrimSpace(addr)), "@")
+	if !ok || s.domain == "" || host != s.domain {
+		return nil, ErrUnknownRecipient
+	}
+	m := mailRecipientPattern.FindStringSubmatch(local)
+	if m == nil {
+		return nil, ErrUnknownRecipient
+	}
+	id, err := strconv.ParseUint(m[1], 10, 32)
+	if err != nil {
+		return nil, ErrUnknownRecipient
+	}
+
+	u, err := s.userRepo.FindByID(ctx, uint(id))
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return nil, ErrUnknownRecipient
+		}
+		return nil, err
+	}
+	if u.MailToken == "" || subtle.ConstantTimeCompare([]byte(u.MailToken), []byte(m[2])) != 1 {
+		return nil, ErrUnknownRecipient
+	}
+	return u, nil
+}
+
+// Accept 供SMTP服务器在RCPT阶段校验收件人
+func (s *MailService) Accept(ctx context.Context, rcpt string) bool {
+	_, err := s.Recipient(ctx, rcpt)
+	return err == nil
+}
+
+// DeliverSMTP 将SMTP服务器收到的邮件投递给每个收件人，没有可保存内容的邮件只记录日志
+func (s *MailService) DeliverSMTP(ctx context.Context, from string, rcpts []string, data []byte) error {
+	for _, rcpt := range rcpts {
+		articles, err := s.Deliver(ctx, rcpt, bytes.NewReader(data))
+		if errors.Is(err, ErrEmptyMail) {
+			log.Printf("Dropped mail from %s to %s: %v", from, rcpt, err)
+			continue
+		}
+		if err != nil {
+			return err
+		}
+		log.Printf("Saved %d articles from mail %s to %s", len(articles), from, rcpt)
+	}
+	return nil
+}
+
+// Deliver 将邮件保存到收件人的文章库：正文足够长时保存正文，否则保存邮件中的链接并由后台任务抓取
+func (s *MailService) Deliver(ctx context.Context, rcpt string, r io.Reader) ([]*domain.Article, error) {
+	u, err := s.Recipient(ctx, rcpt)
+	if err != nil {
+		return nil, err
+	}
+
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+	msg, err := mailin.Parse(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+
+	body := msg.HTML
+	if body == "" {
+		body = textToHTML(msg.Text)
+	}
+	if utf8.RuneCountInString(textutil.PlainText(body)) >= mailMinContentLength {
+		article, err := s.saveBody(ctx, uint(u.ID), msg, body, data)
+		if err != nil {
+			return nil, err
+		}
+		return []*domain.Article{article}, nil
+	}
+
+	links := mailLinks(msg)
+	if len(links) == 0 {
+		return nil, ErrEmptyMail
+	}
+	var saved []*domain.Article
+	for _, link := range links {
+		article, err := s.articleService.Create(ctx, &domain.Article{
+			Title:       link,
+			URL:         link,
+			Source:      mailSender(msg),
+			Tags:        []string{"email"},
+			PublishedAt: time.Now(),
+			UserID:      uint(u.ID),
+			FetchStatus: domain.FetchStatusPending,
+		})
+		if err != nil {
+			var dupErr *DuplicateError
+			if errors.As(err, &dupErr) {
+				continue
+			}
+			return saved, err
+		}
+		saved = append(saved, article)
+	}
+	return saved, nil
+}
+
+// saveBody 以邮件正文创建文章，内嵌图片转为data URI，附件在文末列出
+func (s *MailService) saveBody(ctx context.Context, userID uint, msg *mailin.Message, body string, raw []byte) (*domain.Article, error) {
+	body = inlineImages(msg, body)
+	if names := attachmentNames(msg); len(names) > 0 {
+		body += "<p>附件：" + html.EscapeString(strings.Join(names, "、")) + "</p>"
+	}
+
+	// 与网页相同的清理流程：去掉脚本、样式、事件属性等
+	content := body
+	if doc, err := extract.Parse("", strings.NewReader(body)); err == nil && doc.Content != "" {
+		content = doc.Content
+	}
+
+	publishedAt := msg.Date
+	if publishedAt.IsZero() {
+		publishedAt = time.Now()
+	}
+	sender := mailSender(msg)
+	article, err := s.articleService.Create(ctx, &domain.Article{
+		Title:       firstNonEmpty(msg.Subject, "无主题邮件"),
+		Content:     content,
+		URL:         mailArticleURL(userID, msg, raw),
+		Author:      sender,
+		Source:      sender,
+		Tags:        []string{"email"},
+		PublishedAt: publishedAt,
+		UserID:      userID,
+	})
+	if err != nil {
+		var dupErr *DuplicateError
+		if errors.As(err, &dupErr) {
+			log.Printf("Skipped duplicate mail %q for user %d", msg.Subject, userID)
+			return s.articleService.GetByID(ctx, dupErr.ArticleID)
+		}
+		return nil, err
+	}
+	return article, nil
+}
+
+// mailArticleURL 为邮件生成虚拟链接，重复投递的同一封邮件得到相同的链接
+func mailArticleURL(userID uint, msg *mailin.Message, raw []byte) string {
+	key := []byte(msg.MessageID)
+	if msg.MessageID == "" {
+		key = raw
+	}
+	sum := sha1.Sum(key)
+	return fmt.Sprintf("email://message/%d/%s", userID, hex.EncodeToString(sum[:8]))
+}
+
+func mailSender(msg *mailin.Message) string {
+	if msg.From == nil {
+		return ""
+	}
+	return firstNonEmpty(msg.From.Name, msg.From.Address)
+}
+
+// mailLinks 按出现顺序返回邮件中可保存的链接
+func mailLinks(msg *mailin.Message) []string {
+	var candidates []string
+	for _, m := range mailHrefPattern.FindAllStringSubmatch(msg.HTML, -1) {
+		candidates = append(candidates, html.UnescapeString(m[1]))
+	}
+	candidates = append(candidates, mailLinkPattern.FindAllString(msg.Text, -1)...)
+
+	var links []string
+	seen := make(map[string]bool)
+	for _, link := range candidates {
+		link = strings.TrimRight(link, ".,;:!?。，；")
+		u, err := url.Parse(link)
+		if err != nil || u.Host == "" || seen[link] || mailSkipLinkPattern.MatchString(link) {
+			continue
+		}
+		seen[link] = true
+		links = append(links, link)
+		if len(links) >= mailMaxLinks {
+			break
+		}
+	}
+	return links
+}
+
+// inlineImages 将正文中 cid: 引用的内嵌图片替换为data URI
+func inlineImages(msg *mailin.Message, body string) string {
+	for _, p := range msg.Parts {
+		if !p.Inline || p.ContentID == "" || !strings.HasPrefix(p.ContentType, "image/") {
+			continue
+		}
+		if p.Truncated || len(p.Data) > mailMaxInlineImage {
+			continue
+		}
+		uri := "data:" + p.ContentType + ";base64," + base64.StdEncoding.EncodeToString(p.Data)
+		body = strings.ReplaceAll(body, "cid:"+p.ContentID, uri)
+	}
+	return body
+}
+
+func attachmentNames(msg *mailin.Message) []string {
+	var names []string
+	for _, p := range msg.Attachments() {
+		if p.Filename != "" {
+			names = append(names, p.Filename)
+		}
+	}
+	return names
+}
+
+// textToHTML 将纯文本邮件转换为段落
+func textToHTML(text string) string {
+	var b strings.Builder
+	for _, para := range mailParagraphPattern.Split(strings.ReplaceAll(text, "\r\n", "\n"), -1) {
+		para = strings.TrimSpace(para)
+		if para == "" {
+			continue
+		}
+		b.WriteString("<p>")
+		b.WriteString(strings.ReplaceAll(html.EscapeString(para), "\n", "<br>"))
+		b.WriteString("</p>")
+	}
+	return b.String()
+}
+
+func randomMailToken() (string, error) {
+	const alphabet = "abcdefghijklmnopqrstuvwxyz0123456789"
+	b := make([]byte, mailTokenLength)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	for i := range b {
+		b[i] = alphabet[int(b[i])%len(alphabet)]
+	}
+	return string(b), nil
+}
@@ -0,0 +1,91 @@
+package service
+
+import (
+	"context"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+)
+
+// 通知列表返回的最大条数
+const maxNotifications = 100
+
+// NotificationService 管理站内通知
+type NotificationService struct {
+	repo *repository.NotificationRepository
+}
+
+// NewNotificationService 创建通知服务
+func NewNotificationService(repo *repository.NotificationRepository) *NotificationService {
+	return &NotificationService{repo: repo}
+}
+
+// Notify 向用户发送通知
+func (s *NotificationService) Notify(ctx context.Context, userID uint, n *domain.Notification) error {
+	_, err := s.repo.Create(ctx, &ent.Notification{
+		UserID:    int(userID),
+		Kind:      n.Kind,
+		Title:     n.Title,
+		Body:      n.Body,
+		ArticleID: n.ArticleID,
+	})
+	return err
+}
+
+// List 返回用户最近的通知及未读数
+func (s *NotificationService) List(ctx context.Context, userID uint, unreadOnly bool) (*domain.NotificationList, error) {
+	notifications, err := s.repo.FindByUserID(ctx, int(userID), unreadOnly, maxNotifications)
+	if err != nil {
+		return nil, err
+	}
+	unread, err := s.repo.CountUnread(ctx, int(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	list := &domain.NotificationList{
+		Unread:        unread,
+		Notifications: make([]*domain.Notification, len(notifications)),
+	}
+	for i, n := range notifications {
+		list.Notifications[i] = toDomainNotification(n)
+	}
+	return list, nil
+}
+
+// MarkRead 将通知标记为已读
+func (s *NotificationService) MarkRead(ctx context.Context, userID uint, id int) (*domain.Notification, error) {
+	n, err := s.repo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if uint(n.UserID) != userID {
+		return nil, ErrForbidden
+	}
+	if n.ReadAt == nil {
+		if n, err = s.repo.MarkRead(ctx, id, time.Now()); err != nil {
+			return nil, err
+		}
+	}
+	return toDomainNotification(n), nil
+}
+
+// MarkAllRead 将用户的通知全部标记为已读
+func (s *NotificationService) MarkAllRead(ctx context.Context, userID uint) error {
+	_, err := s.repo.MarkAllRead(ctx, int(userID), time.Now())
+	return err
+}
+
+func toDomainNotification(n *ent.Notification) *domain.Notification {
+	return &domain.Notification{
+		ID:        n.ID,
+		Kind:      n.Kind,
+		Title:     n.Title,
+		Body:      n.Body,
+		ArticleID: n.ArticleID,
+		ReadAt:    n.ReadAt,
+		CreatedAt: n.CreatedAt,
+	}
+}
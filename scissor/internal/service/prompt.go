@@ -0,0 +1,431 @@
+package service
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articleenrichment"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/prompttemplate"
+	"github.com/gorexlv/cabinet/scissor/pkg/kimi"
+	"github.com/gorexlv/cabinet/scissor/pkg/prompt"
+)
+
+// 按请求重新生成一个任务的超时时间
+const promptTimeout = 2 * time.Minute
+
+// ErrInvalidPrompt 提示词模板无效
+var ErrInvalidPrompt = errors.New("无效的提示词模板")
+
+// PromptService 管理提示词模板，并按模板为文章生成摘要、标签和附加补全任务的输出
+type PromptService struct {
+	promptRepo     *repository.PromptTemplateRepository
+	enrichmentRepo *repository.ArticleEnrichmentRepository
+	articleRepo    *repository.ArticleRepository
+	kimiClient     *kimi.Client
+	system         []*prompt.Template
+}
+
+// NewPromptService 创建提示词服务，system为系统模板，kimiClient为nil时只能管理模板
+func NewPromptService(promptRepo *repository.PromptTemplateRepository, enrichmentRepo *repository.ArticleEnrichmentRepository, articleRepo *repository.ArticleRepository, kimiClient *kimi.Client, system []*prompt.Template) *PromptService {
+	return &PromptService{
+		promptRepo:     promptRepo,
+		enrichmentRepo: enrichmentRepo,
+		articleRepo:    articleRepo,
+		kimiClient:     kimiClient,
+		system:         system,
+	}
+}
+
+// List 返回对用户生效的模板：系统模板，加上用户自定义的模板，同名时用户模板覆盖系统模板
+func (s *PromptService) List(ctx context.Context, userID uint) ([]*domain.PromptTemplate, error) {
+	custom, err := s.promptRepo.FindByUserID(ctx, int(userID))
+	if err != nil {
+		return nil, err
+	}
+	byName := make(map[string]*ent.PromptTemplate, len(custom))
+	for _, t := range custom {
+		byName[t.Name] = t
+	}
+
+	result := make([]*domain.PromptTemplate, 0, len(s.system)+len(custom))
+	for _, t := range s.system {
+		if c, ok := byName[t.Name]; ok {
+			result = append(result, toDomainPromptTemplate(c, true))
+			delete(byName, t.Name)
+			continue
+		}
+		result = append(result, &domain.PromptTemplate{
+			Name:     t.Name,
+			Title:    t.Title,
+			Template: t.Text,
+			Format:   t.Format,
+			Auto:     t.Auto,
+			Source:   domain.PromptSourceSystem,
+		})
+	}
+	for _, c := range custom {
+		if _, ok := byName[c.Name]; ok {
+			result = append(result, toDomainPromptTemplate(c, false))
+		}
+	}
+	return result, nil
+}
+
+// Save 创建或覆盖用户的模板，摘要和标签总是自动生成
+func (s *PromptService) Save(ctx context.Context, userID uint, name string, req *domain.SavePromptTemplateRequest) (*domain.PromptTemplate, error) {
+	t := &prompt.Template{
+		Name:   name,
+		Title:  strings.TrimSpace(req.Title),
+		Text:   req.Template,
+		Format: req.Format,
+		Auto:   req.Auto,
+	}
+	if t.Format == "" {
+		t.Format = prompt.FormatText
+		if name == prompt.Tags {
+			t.Format = prompt.FormatList
+		}
+	}
+	if name == prompt.Summary || name == prompt.Tags {
+		t.Auto = true
+	}
+	if err := t.Validate(); err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidPrompt, err)
+	}
+
+	saved, err := s.promptRepo.Save(ctx, &ent.PromptTemplate{
+		UserID:   int(userID),
+		Name:     t.Name,
+		Title:    t.Title,
+		Template: t.Text,
+		Format:   prompttemplate.Format(t.Format),
+		Auto:     t.Auto,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return toDomainPromptTemplate(saved, s.systemTemplate(name) != nil), nil
+}
+
+// Delete 删除用户的模板，同名的系统模板重新生效
+func (s *PromptService) Delete(ctx context.Context, userID uint, name string) error {
+	return s.promptRepo.Delete(ctx, int(userID), name)
+}
+
+// ListEnrichments 返回文章的附加补全任务输出
+func (s *PromptService) ListEnrichments(ctx context.Context, userID, articleID uint) ([]*domain.ArticleEnrichment, error) {
+	if _, err := s.checkArticle(ctx, userID, articleID); err != nil {
+		return nil, err
+	}
+	enrichments, err := s.enrichmentRepo.FindByArticleID(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+	templates, err := s.templates(ctx, int(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.ArticleEnrichment, len(enrichments))
+	for i, e := range enrichments {
+		result[i] = toDomainEnrichment(e, templates[e.Name])
+	}
+	return result, nil
+}
+
+// Regenerate 按用户当前的模板重新生成文章指定任务的输出。
+// 摘要和标签更新到文章上，其他任务的输出单独保存。
+func (s *PromptService) Regenerate(ctx context.Context, userID, articleID uint, name string) (*domain.ArticleEnrichment, error) {
+	if s.kimiClient == nil {
+		return nil, ErrLLMDisabled
+	}
+	a, err := s.checkArticle(ctx, userID, articleID)
+	if err != nil {
+		return nil, err
+	}
+	templates, err := s.templates(ctx, a.UserID)
+	if err != nil {
+		return nil, err
+	}
+	t, ok := templates[name]
+	if !ok {
+		return nil, repository.ErrNotFound
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, promptTimeout)
+	defer cancel()
+
+	// 只写入重新生成的字段，生成期间对另一字段的修改不受影响
+	switch name {
+	case prompt.Summary, prompt.Tags:
+		if name == prompt.Summary {
+			var summary string
+			if summary, err = s.summary(ctx, t, a); err == nil {
+				a, err = s.articleRepo.UpdateSummary(ctx, int(a.ID), summary)
+			}
+		} else {
+			var tags []string
+			if tags, err = s.tags(ctx, t, a); err == nil {
+				a, err = s.articleRepo.UpdateTags(ctx, int(a.ID), tags)
+			}
+		}
+		if err != nil {
+			return nil, err
+		}
+		result := &domain.ArticleEnrichment{
+			Name:      name,
+			Title:     t.Title,
+			Format:    t.Format,
+			Content:   a.Summary,
+			UpdatedAt: a.UpdatedAt,
+		}
+		if name == prompt.Tags {
+			result.Content = strings.Join(a.Tags, "\n")
+			result.Data, _ = json.Marshal(a.Tags)
+		}
+		return result, nil
+	}
+
+	e, err := s.run(ctx, t, a)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainEnrichment(e, t), nil
+}
+
+// Summarize 按用户的摘要模板流式生成文章摘要，增量文本通过onDelta回调，完成后保存为文章的摘要。
+// ctx取消（如客户端断开）时中断生成，不修改原有摘要。
+func (s *PromptService) Summarize(ctx context.Context, userID, articleID uint, onDelta func(string) error) (*domain.SummarizeResponse, error) {
+	if s.kimiClient == nil {
+		return nil, ErrLLMDisabled
+	}
+	a, err := s.checkArticle(ctx, userID, articleID)
+	if err != nil {
+		return nil, err
+	}
+	templates, err := s.templates(ctx, a.UserID)
+	if err != nil {
+		return nil, err
+	}
+	text, err := templates[prompt.Summary].Render(promptArticle(a))
+	if err != nil {
+		return nil, err
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, promptTimeout)
+	defer cancel()
+	output, err := s.kimiClient.ChatStream(ctx, []kimi.Message{{Role: "user", Content: text}}, onDelta)
+	if err != nil {
+		return nil, err
+	}
+	summary := strings.TrimSpace(output)
+	if summary == "" {
+		return nil, prompt.ErrInvalidOutput
+	}
+	// 只写入摘要，生成期间对标签的修改不受影响
+	if _, err := s.articleRepo.UpdateSummary(ctx, int(a.ID), summary); err != nil {
+		return nil, err
+	}
+	return &domain.SummarizeResponse{ArticleID: a.ID, Summary: summary}, nil
+}
+
+// EnrichArticle 为文章补全缺少的摘要和标签，并执行尚无输出的自动任务。
+// 使用文章所属用户的模板；附加任务失败只记录日志，不影响其他任务。
+func (s *PromptService) EnrichArticle(ctx context.Context, a *ent.Article) (*ent.Article, error) {
+	if s == nil || s.kimiClient == nil {
+		return a, nil
+	}
+	templates, err := s.templates(ctx, a.UserID)
+	if err != nil {
+		return nil, err
+	}
+
+	// 分别写入生成的字段，不覆盖生成期间用户填写的摘要或标签
+	if a.Summary == "" {
+		summary, err := s.summary(ctx, templates[prompt.Summary], a)
+		if err != nil {
+			return nil, err
+		}
+		if a, err = s.articleRepo.UpdateSummary(ctx, int(a.ID), summary); err != nil {
+			return nil, err
+		}
+	}
+	if len(a.Tags) == 0 {
+		tags, err := s.tags(ctx, templates[prompt.Tags], a)
+		if err != nil {
+			return nil, err
+		}
+		if a, err = s.articleRepo.UpdateTags(ctx, int(a.ID), tags); err != nil {
+			return nil, err
+		}
+	}
+
+	existing, err := s.enrichmentRepo.FindByArticleID(ctx, a.ID)
+	if err != nil {
+		return nil, err
+	}
+	done := make(map[string]bool, len(existing))
+	for _, e := range existing {
+		done[e.Name] = true
+	}
+	for name, t := range templates {
+		if !t.Auto || done[name] || name == prompt.Summary || name == prompt.Tags {
+			continue
+		}
+		if _, err := s.run(ctx, t, a); err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			log.Printf("Enrichment %q of article %d failed: %v", name, a.ID, err)
+		}
+	}
+	return a, nil
+}
+
+// summary 按模板生成摘要
+func (s *PromptService) summary(ctx context.Context, t *prompt.Template, a *ent.Article) (string, error) {
+	output, err := s.generate(ctx, t, a)
+	if err != nil {
+		return "", err
+	}
+	summary := strings.TrimSpace(output)
+	if summary == "" {
+		return "", prompt.ErrInvalidOutput
+	}
+	return summary, nil
+}
+
+// tags 按模板生成标签，兼容逗号分隔和每行一个的输出
+func (s *PromptService) tags(ctx context.Context, t *prompt.Template, a *ent.Article) ([]string, error) {
+	output, err := s.generate(ctx, t, a)
+	if err != nil {
+		return nil, err
+	}
+	tags := splitTags(strings.Join(prompt.ListItems(output), "\n"))
+	if len(tags) == 0 {
+		return nil, prompt.ErrInvalidOutput
+	}
+	return tags, nil
+}
+
+// run 执行附加任务并保存输出
+func (s *PromptService) run(ctx context.Context, t *prompt.Template, a *ent.Article) (*ent.ArticleEnrichment, error) {
+	output, err := s.generate(ctx, t, a)
+	if err != nil {
+		return nil, err
+	}
+	content, data, err := prompt.Parse(t.Format, output)
+	if err != nil {
+		return nil, err
+	}
+	return s.enrichmentRepo.Save(ctx, &ent.ArticleEnrichment{
+		ArticleID: a.ID,
+		Name:      t.Name,
+		Content:   content,
+		Data:      data,
+		Format:    articleenrichment.Format(t.Format),
+	})
+}
+
+// generate 渲染模板并调用大模型
+func (s *PromptService) generate(ctx context.Context, t *prompt.Template, a *ent.Article) (string, error) {
+	text, err := t.Render(promptArticle(a))
+	if err != nil {
+		return "", err
+	}
+	return s.kimiClient.Chat(ctx, []kimi.Message{{Role: "user", Content: text}})
+}
+
+// promptArticle 转换为模板使用的文章字段，正文为纯文本
+func promptArticle(a *ent.Article) *prompt.Article {
+	return &prompt.Article{
+		Title:       a.Title,
+		Author:      a.Author,
+		Source:      a.Source,
+		URL:         a.URL,
+		Content:     articleText(a),
+		Summary:     a.Summary,
+		Tags:        a.Tags,
+		Language:    a.Language,
+		PublishedAt: a.PublishedAt,
+	}
+}
+
+// templates 返回对用户生效的模板，按名称索引
+func (s *PromptService) templates(ctx context.Context, userID int) (map[string]*prompt.Template, error) {
+	result := make(map[string]*prompt.Template, len(s.system))
+	for _, t := range s.system {
+		result[t.Name] = t
+	}
+	custom, err := s.promptRepo.FindByUserID(ctx, userID)
+	if err != nil {
+		return nil, err
+	}
+	for _, c := range custom {
+		result[c.Name] = &prompt.Template{
+			Name:   c.Name,
+			Title:  c.Title,
+			Text:   c.Template,
+			Format: string(c.Format),
+			Auto:   c.Auto,
+		}
+	}
+	return result, nil
+}
+
+func (s *PromptService) systemTemplate(name string) *prompt.Template {
+	for _, t := range s.system {
+		if t.Name == name {
+			return t
+		}
+	}
+	return nil
+}
+
+func (s *PromptService) checkArticle(ctx context.Context, userID, articleID uint) (*ent.Article, error) {
+	a, err := s.articleRepo.FindByID(ctx, int(articleID))
+	if err != nil {
+		return nil, err
+	}
+	if uint(a.UserID) != userID {
+		return nil, ErrForbidden
+	}
+	return a, nil
+}
+
+func toDomainPromptTemplate(t *ent.PromptTemplate, overrides bool) *domain.PromptTemplate {
+	updatedAt := t.UpdatedAt
+	return &domain.PromptTemplate{
+		Name:      t.Name,
+		Title:     t.Title,
+		Template:  t.Template,
+		Format:    string(t.Format),
+		Auto:      t.Auto,
+		Source:    domain.PromptSourceUser,
+		Overrides: overrides,
+		UpdatedAt: &updatedAt,
+	}
+}
+
+// toDomainEnrichment 转换任务输出，模板已删除时t为nil
+func toDomainEnrichment(e *ent.ArticleEnrichment, t *prompt.Template) *domain.ArticleEnrichment {
+	result := &domain.ArticleEnrichment{
+		Name:      e.Name,
+		Format:    string(e.Format),
+		Content:   e.Content,
+		Data:      e.Data,
+		UpdatedAt: e.UpdatedAt,
+	}
+	if t != nil {
+		result.Title = t.Title
+	}
+	return result
+}
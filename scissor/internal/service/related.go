@@ -0,0 +1,302 @@
+package service
+
+import (
+	"container/list"
+	"context"
+	"errors"
+	"math"
+	"sort"
+	"sync"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/embedding"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/textutil"
+)
+
+// 各相关性信号的权重
+const (
+	relatedWeightText   = 0.5
+	relatedWeightTags   = 0.3
+	relatedWeightSource = 0.1
+	relatedWeightAuthor = 0.1
+
+	// 低于该得分的文章不视为相关
+	minRelatedScore = 0.05
+)
+
+var ErrForbidden = errors.New("无权访问该文章")
+
+// 缓存推荐结果的文章数上限，超出时淘汰最久未访问的
+const relatedCacheSize = 1000
+
+// 影响推荐结果或其中显示内容的文章字段，只修改其他字段（如阅读状态、原文检查结果、主题）时不清空缓存
+var relatedFields = map[string]bool{
+	article.FieldTitle:   true,
+	article.FieldContent: true,
+	article.FieldSummary: true,
+	article.FieldTags:    true,
+	article.FieldSource:  true,
+	article.FieldAuthor:  true,
+}
+
+// RelatedService 为文章推荐同一用户库中的相关文章
+type RelatedService struct {
+	articleRepo *repository.ArticleRepository
+	chunkRepo   *repository.ChunkRepository
+	embedder    embedding.Provider
+
+	mu sync.Mutex
+	// generation 每次清空缓存时递增，计算期间缓存被清空的结果不再写入
+	generation uint64
+	cache      map[uint]*list.Element
+	// lru 按访问时间排列的缓存项，最近访问的在前
+	lru *list.List
+}
+
+// relatedEntry 缓存的推荐结果，记录文章所属用户以便校验权限
+type relatedEntry struct {
+	articleID uint
+	userID    uint
+	related   []*domain.RelatedArticle
+}
+
+func NewRelatedService(articleRepo *repository.ArticleRepository, chunkRepo *repository.ChunkRepository, embedder embedding.Provider) *RelatedService {
+	return &RelatedService{
+		articleRepo: articleRepo,
+		chunkRepo:   chunkRepo,
+		embedder:    embedder,
+		cache:       make(map[uint]*list.Element),
+		lru:         list.New(),
+	}
+}
+
+// InvalidateHook 返回在文章增删、标题正文等内容修改或检索向量变化后清空推荐缓存的ent钩子
+func (s *RelatedService) InvalidateHook() ent.Hook {
+	return func(next ent.Mutator) ent.Mutator {
+		return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+			v, err := next.Mutate(ctx, m)
+			if err == nil && relatedAffected(m) {
+				s.mu.Lock()
+				s.generation++
+				s.cache = make(map[uint]*list.Element)
+				s.lru.Init()
+				s.mu.Unlock()
+			}
+			return v, err
+		})
+	}
+}
+
+// relatedAffected 判断变更是否可能改变推荐结果，分块的任何变更都意味着检索向量变化
+func relatedAffected(m ent.Mutation) bool {
+	if m.Type() != ent.TypeArticle || !m.Op().Is(ent.OpUpdate|ent.OpUpdateOne) {
+		return true
+	}
+	for _, f := range append(m.Fields(), m.ClearedFields()...) {
+		if relatedFields[f] {
+			return true
+		}
+	}
+	return false
+}
+
+// Related 按标签重合度、来源/作者及正文相似度对用户的其他文章排序
+func (s *RelatedService) Related(ctx context.Context, userID, articleID uint, limit int) ([]*domain.RelatedArticle, error) {
+	s.mu.Lock()
+	generation := s.generation
+	var entry *relatedEntry
+	if e, ok := s.cache[articleID]; ok {
+		s.lru.MoveToFront(e)
+		entry = e.Value.(*relatedEntry)
+	}
+	s.mu.Unlock()
+
+	if entry == nil {
+		related, err := s.rank(ctx, userID, articleID)
+		if err != nil {
+			return nil, err
+		}
+		entry = &relatedEntry{articleID: articleID, userID: userID, related: related}
+		s.store(generation, entry)
+	}
+	if entry.userID != userID {
+		return nil, ErrForbidden
+	}
+
+	related := entry.related
+	if len(related) > limit {
+		related = related[:limit]
+	}
+	return related, nil
+}
+
+// store 缓存推荐结果，计算期间缓存已被清空时丢弃
+func (s *RelatedService) store(generation uint64, entry *relatedEntry) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if generation != s.generation {
+		return
+	}
+	if e, ok := s.cache[entry.articleID]; ok {
+		e.Value = entry
+		s.lru.MoveToFront(e)
+		return
+	}
+	s.cache[entry.articleID] = s.lru.PushFront(entry)
+	for s.lru.Len() > relatedCacheSize {
+		oldest := s.lru.Back()
+		s.lru.Remove(oldest)
+		delete(s.cache, oldest.Value.(*relatedEntry).articleID)
+	}
+}
+
+func (s *RelatedService) rank(ctx context.Context, userID, articleID uint) ([]*domain.RelatedArticle, error) {
+	target, err := s.articleRepo.FindByID(ctx, int(articleID))
+	if err != nil {
+		return nil, err
+	}
+	if uint(target.UserID) != userID {
+		return nil, ErrForbidden
+	}
+
+	articles, err := s.articleRepo.FindByUserID(ctx, int(userID))
+	if err != nil {
+		return nil, err
+	}
+
+	textScores, err := s.textSimilarities(ctx, target, articles)
+	if err != nil {
+		return nil, err
+	}
+
+	related := []*domain.RelatedArticle{}
+	for _, a := range articles {
+		if a.ID == target.ID {
+			continue
+		}
+
+		shared := sharedTags(target.Tags, a.Tags)
+		score := relatedWeightText * textScores[a.ID]
+		score += relatedWeightTags * jaccard(len(shared), len(target.Tags), len(a.Tags))
+		if target.Source != "" && a.Source == target.Source {
+			score += relatedWeightSource
+		}
+		if target.Author != "" && a.Author == target.Author {
+			score += relatedWeightAuthor
+		}
+		if score < minRelatedScore {
+			continue
+		}
+
+		related = append(related, &domain.RelatedArticle{
+			Article:    toDomainArticle(a),
+			Score:      score,
+			SharedTags: shared,
+		})
+	}
+
+	sort.Slice(related, func(i, j int) bool {
+		return related[i].Score > related[j].Score
+	})
+	return related, nil
+}
+
+// textSimilarities 计算目标文章与其他文章的正文相似度。
+// 双方都有检索向量时使用分块均值向量的余弦相似度，否则退回TF-IDF。
+func (s *RelatedService) textSimilarities(ctx context.Context, target *ent.Article, articles []*ent.Article) (map[uint]float64, error) {
+	ids := make([]uint, len(articles))
+	for i, a := range articles {
+		ids[i] = a.ID
+	}
+	vectors, err := articleVectors(ctx, s.chunkRepo, s.embedder, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	var tfidf map[uint]map[string]float64
+	scores := make(map[uint]float64, len(articles))
+	for _, a := range articles {
+		if a.ID == target.ID {
+			continue
+		}
+		tv, av := vectors[target.ID], vectors[a.ID]
+		if tv != nil && av != nil {
+			scores[a.ID] = math.Max(0, float64(embedding.Cosine(tv, av)))
+			continue
+		}
+		if tfidf == nil {
+			tfidf = tfidfVectors(articles)
+		}
+		scores[a.ID] = sparseCosine(tfidf[target.ID], tfidf[a.ID])
+	}
+	return scores, nil
+}
+
+// tfidfVectors 以用户全部文章为语料计算每篇文章的TF-IDF向量
+func tfidfVectors(articles []*ent.Article) map[uint]map[string]float64 {
+	tf := make(map[uint]map[string]float64, len(articles))
+	df := make(map[string]int)
+	for _, a := range articles {
+		tokens := textutil.Tokens(a.Title + "\n" + articleText(a))
+		counts := make(map[string]float64)
+		for _, t := range tokens {
+			counts[t]++
+		}
+		for t := range counts {
+			df[t]++
+			counts[t] /= float64(len(tokens))
+		}
+		tf[a.ID] = counts
+	}
+
+	n := float64(len(articles))
+	for _, counts := range tf {
+		for t, v := range counts {
+			counts[t] = v * (math.Log(n/float64(df[t])) + 1)
+		}
+	}
+	return tf
+}
+
+func sparseCosine(a, b map[string]float64) float64 {
+	var dot, na, nb float64
+	for t, x := range a {
+		na += x * x
+		if y, ok := b[t]; ok {
+			dot += x * y
+		}
+	}
+	for _, y := range b {
+		nb += y * y
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+	return dot / (math.Sqrt(na) * math.Sqrt(nb))
+}
+
+func sharedTags(a, b []string) []string {
+	set := make(map[string]bool, len(a))
+	for _, t := range a {
+		set[t] = true
+	}
+	var shared []string
+	for _, t := range b {
+		if set[t] {
+			shared = append(shared, t)
+			delete(set, t)
+		}
+	}
+	return shared
+}
+
+func jaccard(shared, a, b int) float64 {
+	union := a + b - shared
+	if union <= 0 {
+		return 0
+	}
+	return float64(shared) / float64(union)
+}
@@ -0,0 +1,264 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"log"
+	"slices"
+	"strings"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/hook"
+	"github.com/gorexlv/cabinet/scissor/pkg/textutil"
+)
+
+// 每篇文章保留的历史版本数
+const maxRevisions = 50
+
+// 修改后需要记录历史版本的字段
+var revisionFields = []string{
+	article.FieldTitle,
+	article.FieldContent,
+	article.FieldSummary,
+	article.FieldTags,
+}
+
+// RevisionService 记录文章的历史版本，重新抓取、图片归档、AI补全或用户编辑都不会丢失之前的内容
+type RevisionService struct {
+	articleRepo  *repository.ArticleRepository
+	revisionRepo *repository.ArticleRevisionRepository
+	enricher     *EnrichService
+}
+
+// NewRevisionService 创建历史版本服务
+func NewRevisionService(articleRepo *repository.ArticleRepository, revisionRepo *repository.ArticleRevisionRepository, enricher *EnrichService) *RevisionService {
+	return &RevisionService{
+		articleRepo:  articleRepo,
+		revisionRepo: revisionRepo,
+		enricher:     enricher,
+	}
+}
+
+// Hook 返回在文章创建及标题、正文、摘要或标签修改后记录历史版本的ent钩子。
+// 只处理单篇的创建和 UpdateOneID，批量 Update() 不记录；记录失败只写日志，不影响文章的保存。
+func (s *RevisionService) Hook() ent.Hook {
+	return hook.On(func(next ent.Mutator) ent.Mutator {
+		return hook.ArticleFunc(func(ctx context.Context, m *ent.ArticleMutation) (ent.Value, error) {
+			if !revisionTracked(m) {
+				return next.Mutate(ctx, m)
+			}
+			// 事务中的修改通过同一事务记录，避免另开连接等待事务持有的行锁
+			rs := s
+			if _, err := m.Tx(); err == nil {
+				rs = s.withClient(m.Client())
+			}
+			if id, ok := m.ID(); ok && m.Op().Is(ent.OpUpdateOne) {
+				rs.recordBaseline(ctx, id)
+			}
+
+			v, err := next.Mutate(ctx, m)
+			if err != nil {
+				return v, err
+			}
+			if a, ok := v.(*ent.Article); ok {
+				if err := rs.record(ctx, a); err != nil {
+					log.Printf("Failed to record revision of article %d: %v", a.ID, err)
+				}
+			}
+			return v, nil
+		})
+	}, ent.OpCreate|ent.OpUpdateOne)
+}
+
+// withClient 返回使用指定客户端读写的副本
+func (s *RevisionService) withClient(client *ent.Client) *RevisionService {
+	return &RevisionService{
+		articleRepo:  repository.NewArticleRepository(client),
+		revisionRepo: repository.NewArticleRevisionRepository(client),
+		enricher:     s.enricher,
+	}
+}
+
+func revisionTracked(m *ent.ArticleMutation) bool {
+	for _, f := range revisionFields {
+		if _, ok := m.Field(f); ok || m.FieldCleared(f) {
+			return true
+		}
+	}
+	return false
+}
+
+// recordBaseline 文章还没有历史版本时记录修改前的内容
+func (s *RevisionService) recordBaseline(ctx context.Context, id uint) {
+	_, err := s.revisionRepo.FindLatest(ctx, id)
+	if !errors.Is(err, repository.ErrNotFound) {
+		return
+	}
+	old, err := s.articleRepo.FindByID(ctx, int(id))
+	if err != nil {
+		return
+	}
+	if err := s.record(ctx, old); err != nil {
+		log.Printf("Failed to record revision of article %d: %v", id, err)
+	}
+}
+
+// record 内容与最新的历史版本不同时记录新版本，并清理超出数量的旧版本。尚未抓取正文的文章不记录。
+func (s *RevisionService) record(ctx context.Context, a *ent.Article) error {
+	if a.Content == "" {
+		return nil
+	}
+	latest, err := s.revisionRepo.FindLatest(ctx, a.ID)
+	if err != nil && !errors.Is(err, repository.ErrNotFound) {
+		return err
+	}
+	if latest != nil && latest.Title == a.Title && latest.Content == a.Content &&
+		latest.Summary == a.Summary && slices.Equal(latest.Tags, a.Tags) {
+		return nil
+	}
+
+	if _, err := s.revisionRepo.Create(ctx, a); err != nil {
+		return err
+	}
+	return s.revisionRepo.Prune(ctx, a.ID, maxRevisions)
+}
+
+// List 返回文章的历史版本，最新的在前
+func (s *RevisionService) List(ctx context.Context, userID, articleID uint) ([]*domain.ArticleRevision, error) {
+	if _, err := s.checkArticle(ctx, userID, articleID); err != nil {
+		return nil, err
+	}
+	revisions, err := s.revisionRepo.FindByArticleID(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.ArticleRevision, len(revisions))
+	for i, rev := range revisions {
+		result[i] = toDomainRevision(rev)
+		result[i].Content = ""
+	}
+	return result, nil
+}
+
+// Get 返回历史版本的完整内容
+func (s *RevisionService) Get(ctx context.Context, userID, articleID uint, id int) (*domain.ArticleRevision, error) {
+	rev, err := s.find(ctx, userID, articleID, id)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainRevision(rev), nil
+}
+
+// Diff 比较同一文章的两个历史版本，to为0时与最新版本比较
+func (s *RevisionService) Diff(ctx context.Context, userID, articleID uint, from, to int) (*domain.RevisionDiff, error) {
+	fromRev, err := s.find(ctx, userID, articleID, from)
+	if err != nil {
+		return nil, err
+	}
+	var toRev *ent.ArticleRevision
+	if to == 0 {
+		toRev, err = s.revisionRepo.FindLatest(ctx, articleID)
+	} else {
+		toRev, err = s.find(ctx, userID, articleID, to)
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	diff := &domain.RevisionDiff{
+		From:    toDomainRevision(fromRev),
+		To:      toDomainRevision(toRev),
+		Title:   diffLines(fromRev.Title, toRev.Title),
+		Content: diffLines(textutil.PlainText(fromRev.Content), textutil.PlainText(toRev.Content)),
+		Summary: diffLines(fromRev.Summary, toRev.Summary),
+	}
+	diff.From.Content, diff.To.Content = "", ""
+	for _, tag := range toRev.Tags {
+		if !slices.Contains(fromRev.Tags, tag) {
+			diff.TagsAdded = append(diff.TagsAdded, tag)
+		}
+	}
+	for _, tag := range fromRev.Tags {
+		if !slices.Contains(toRev.Tags, tag) {
+			diff.TagsRemoved = append(diff.TagsRemoved, tag)
+		}
+	}
+	return diff, nil
+}
+
+// Restore 将文章恢复为历史版本的内容，恢复本身也会记录为新版本
+func (s *RevisionService) Restore(ctx context.Context, userID, articleID uint, id int) (*domain.Article, error) {
+	rev, err := s.find(ctx, userID, articleID, id)
+	if err != nil {
+		return nil, err
+	}
+	a, err := s.articleRepo.UpdateText(ctx, int(articleID), &ent.Article{
+		Title:       rev.Title,
+		Content:     rev.Content,
+		Fingerprint: contentFingerprint(rev.Content),
+		Summary:     rev.Summary,
+		Tags:        rev.Tags,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// 正文变化后重新生成检索向量，已有的摘要和标签保持不变
+	s.enricher.EnrichAsync(a.ID)
+
+	return toDomainArticle(a), nil
+}
+
+func (s *RevisionService) checkArticle(ctx context.Context, userID, articleID uint) (*ent.Article, error) {
+	a, err := s.articleRepo.FindByID(ctx, int(articleID))
+	if err != nil {
+		return nil, err
+	}
+	if uint(a.UserID) != userID {
+		return nil, ErrForbidden
+	}
+	return a, nil
+}
+
+// find 返回属于用户该文章的历史版本
+func (s *RevisionService) find(ctx context.Context, userID, articleID uint, id int) (*ent.ArticleRevision, error) {
+	rev, err := s.revisionRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if rev.ArticleID != articleID || rev.Edges.Article == nil || uint(rev.Edges.Article.UserID) != userID {
+		return nil, ErrForbidden
+	}
+	return rev, nil
+}
+
+func diffLines(from, to string) []domain.DiffLine {
+	lines := textutil.Diff(splitLines(from), splitLines(to))
+	result := make([]domain.DiffLine, len(lines))
+	for i, l := range lines {
+		result[i] = domain.DiffLine{Op: string(l.Op), Text: l.Text}
+	}
+	return result
+}
+
+func splitLines(s string) []string {
+	if s == "" {
+		return nil
+	}
+	return strings.Split(s, "\n")
+}
+
+func toDomainRevision(rev *ent.ArticleRevision) *domain.ArticleRevision {
+	return &domain.ArticleRevision{
+		ID:        rev.ID,
+		ArticleID: rev.ArticleID,
+		Title:     rev.Title,
+		Content:   rev.Content,
+		Summary:   rev.Summary,
+		Tags:      rev.Tags,
+		CreatedAt: rev.CreatedAt,
+	}
+}
@@ -0,0 +1,251 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"sort"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/embedding"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+)
+
+const (
+	SearchModeSemantic = "semantic"
+	SearchModeHybrid   = "hybrid"
+
+	// 倒数排名融合的平滑常数
+	rrfK = 60
+)
+
+var ErrEmbeddingDisabled = errors.New("未配置向量化服务")
+
+// SearchService 提供基于向量的语义检索及与关键词检索的混合检索
+type SearchService struct {
+	articleRepo *repository.ArticleRepository
+	chunkRepo   *repository.ChunkRepository
+	embedder    embedding.Provider
+}
+
+func NewSearchService(articleRepo *repository.ArticleRepository, chunkRepo *repository.ChunkRepository, embedder embedding.Provider) *SearchService {
+	return &SearchService{
+		articleRepo: articleRepo,
+		chunkRepo:   chunkRepo,
+		embedder:    embedder,
+	}
+}
+
+// scoredChunk 带相似度得分的分块
+type scoredChunk struct {
+	chunk *ent.ArticleChunk
+	score float32
+}
+
+// Semantic 按余弦相似度返回与查询最接近的用户文章
+func (s *SearchService) Semantic(ctx context.Context, userID uint, query string, limit int) ([]*domain.SearchResult, error) {
+	ranked, err := s.rankChunks(ctx, userID, query)
+	if err != nil {
+		return nil, err
+	}
+	if len(ranked) > limit {
+		ranked = ranked[:limit]
+	}
+
+	ids := make([]uint, len(ranked))
+	for i, r := range ranked {
+		ids[i] = r.chunk.ArticleID
+	}
+	articles, err := s.loadArticles(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	results := make([]*domain.SearchResult, 0, len(ranked))
+	for _, r := range ranked {
+		a, ok := articles[r.chunk.ArticleID]
+		if !ok {
+			continue
+		}
+		results = append(results, &domain.SearchResult{
+			Article: toDomainArticle(a),
+			Score:   r.score,
+			Passage: r.chunk.Content,
+		})
+	}
+	return results, nil
+}
+
+// Hybrid 使用倒数排名融合(RRF)合并语义检索和关键词检索的结果
+func (s *SearchService) Hybrid(ctx context.Context, userID uint, query string, limit int) ([]*domain.SearchResult, error) {
+	ranked, err := s.rankChunks(ctx, userID, query)
+	if err != nil {
+		return nil, err
+	}
+	keywordHits, err := s.articleRepo.SearchByUserID(ctx, int(userID), query)
+	if err != nil {
+		return nil, err
+	}
+
+	scores := make(map[uint]float32)
+	passages := make(map[uint]string)
+	for i, r := range ranked {
+		scores[r.chunk.ArticleID] += 1.0 / float32(rrfK+i+1)
+		passages[r.chunk.ArticleID] = r.chunk.Content
+	}
+	articles := make(map[uint]*ent.Article, len(keywordHits))
+	for i, a := range keywordHits {
+		scores[a.ID] += 1.0 / float32(rrfK+i+1)
+		articles[a.ID] = a
+	}
+
+	ids := make([]uint, 0, len(scores))
+	for id := range scores {
+		ids = append(ids, id)
+	}
+	sort.Slice(ids, func(i, j int) bool {
+		if scores[ids[i]] != scores[ids[j]] {
+			return scores[ids[i]] > scores[ids[j]]
+		}
+		return ids[i] > ids[j]
+	})
+	if len(ids) > limit {
+		ids = ids[:limit]
+	}
+
+	var missing []uint
+	for _, id := range ids {
+		if _, ok := articles[id]; !ok {
+			missing = append(missing, id)
+		}
+	}
+	loaded, err := s.loadArticles(ctx, missing)
+	if err != nil {
+		return nil, err
+	}
+	for id, a := range loaded {
+		articles[id] = a
+	}
+
+	results := make([]*domain.SearchResult, 0, len(ids))
+	for _, id := range ids {
+		a, ok := articles[id]
+		if !ok {
+			continue
+		}
+		results = append(results, &domain.SearchResult{
+			Article: toDomainArticle(a),
+			Score:   scores[id],
+			Passage: passages[id],
+		})
+	}
+	return results, nil
+}
+
+// Passages 返回与查询最相关的正文片段，每篇文章最多取perArticle个
+func (s *SearchService) Passages(ctx context.Context, userID uint, query string, limit, perArticle int) ([]*domain.Passage, error) {
+	scored, err := s.scoreChunks(ctx, userID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	var picked []scoredChunk
+	counts := make(map[uint]int)
+	for _, sc := range scored {
+		if len(picked) >= limit {
+			break
+		}
+		if counts[sc.chunk.ArticleID] >= perArticle {
+			continue
+		}
+		counts[sc.chunk.ArticleID]++
+		picked = append(picked, sc)
+	}
+
+	ids := make([]uint, 0, len(counts))
+	for id := range counts {
+		ids = append(ids, id)
+	}
+	articles, err := s.loadArticles(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+
+	passages := make([]*domain.Passage, 0, len(picked))
+	for _, sc := range picked {
+		a, ok := articles[sc.chunk.ArticleID]
+		if !ok {
+			continue
+		}
+		passages = append(passages, &domain.Passage{
+			ArticleID: a.ID,
+			Title:     a.Title,
+			URL:       a.URL,
+			Content:   sc.chunk.Content,
+			Score:     sc.score,
+		})
+	}
+	return passages, nil
+}
+
+// rankChunks 每篇文章只保留得分最高的分块，按得分降序返回
+func (s *SearchService) rankChunks(ctx context.Context, userID uint, query string) ([]scoredChunk, error) {
+	scored, err := s.scoreChunks(ctx, userID, query)
+	if err != nil {
+		return nil, err
+	}
+
+	seen := make(map[uint]bool)
+	ranked := make([]scoredChunk, 0, len(scored))
+	for _, sc := range scored {
+		if !seen[sc.chunk.ArticleID] {
+			seen[sc.chunk.ArticleID] = true
+			ranked = append(ranked, sc)
+		}
+	}
+	return ranked, nil
+}
+
+// scoreChunks 计算查询与用户全部分块的相似度，按得分降序返回正相关的分块
+func (s *SearchService) scoreChunks(ctx context.Context, userID uint, query string) ([]scoredChunk, error) {
+	if s.embedder == nil {
+		return nil, ErrEmbeddingDisabled
+	}
+
+	vectors, err := s.embedder.Embed(ctx, []string{query})
+	if err != nil {
+		return nil, err
+	}
+	queryVector := vectors[0]
+
+	chunks, err := s.chunkRepo.FindByUserID(ctx, int(userID), s.embedder.Model())
+	if err != nil {
+		return nil, err
+	}
+
+	scored := make([]scoredChunk, 0, len(chunks))
+	for _, c := range chunks {
+		if score := embedding.Cosine(queryVector, c.Embedding); score > 0 {
+			scored = append(scored, scoredChunk{chunk: c, score: score})
+		}
+	}
+	sort.Slice(scored, func(i, j int) bool {
+		return scored[i].score > scored[j].score
+	})
+	return scored, nil
+}
+
+func (s *SearchService) loadArticles(ctx context.Context, ids []uint) (map[uint]*ent.Article, error) {
+	result := make(map[uint]*ent.Article, len(ids))
+	if len(ids) == 0 {
+		return result, nil
+	}
+	articles, err := s.articleRepo.FindByIDs(ctx, ids)
+	if err != nil {
+		return nil, err
+	}
+	for _, a := range articles {
+		result[a.ID] = a
+	}
+	return result, nil
+}
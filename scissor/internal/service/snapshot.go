@@ -0,0 +1,234 @@
+package service
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	entsnapshot "github.com/gorexlv/cabinet/scissor/pkg/ent/snapshot"
+	"github.com/gorexlv/cabinet/scissor/pkg/safehttp"
+	"github.com/gorexlv/cabinet/scissor/pkg/snapshot"
+	"github.com/gorexlv/cabinet/scissor/pkg/storage"
+)
+
+var (
+	// ErrSnapshotUnavailable 文章没有可以保存快照的网页地址，如邮件和手动录入的文章
+	ErrSnapshotUnavailable = errors.New("文章没有可保存快照的网页地址")
+	// ErrInvalidSnapshotFormat 不支持的快照格式
+	ErrInvalidSnapshotFormat = errors.New("快照格式只能是 html 或 warc")
+	// ErrSnapshotFetch 原网页无法访问
+	ErrSnapshotFetch = errors.New("抓取网页失败")
+)
+
+const (
+	// 快照文件在存储中的目录
+	snapshotPrefix = "snapshots/"
+	// 保存一个快照（网页及全部资源）的超时时间
+	snapshotTimeout = 3 * time.Minute
+	// 抓取单个资源的超时时间
+	snapshotFetchTimeout = 30 * time.Second
+)
+
+// SnapshotService 保存文章原网页的不可变快照，原文修改或失效后仍可查看当时的页面
+type SnapshotService struct {
+	articleRepo  *repository.ArticleRepository
+	snapshotRepo *repository.SnapshotRepository
+	storage      storage.Storage
+	fetch        snapshot.Fetcher
+}
+
+// NewSnapshotService 创建快照服务
+func NewSnapshotService(articleRepo *repository.ArticleRepository, snapshotRepo *repository.SnapshotRepository, store storage.Storage) *SnapshotService {
+	return &SnapshotService{
+		articleRepo:  articleRepo,
+		snapshotRepo: snapshotRepo,
+		storage:      store,
+		fetch:        snapshot.HTTPFetcher(safehttp.NewClient(snapshotFetchTimeout)),
+	}
+}
+
+// Create 抓取文章原网页并保存快照。html 格式内联样式、图片和字体，移除脚本；
+// warc 格式保存网页及其资源的原始响应，查看时再生成单文件网页。
+func (s *SnapshotService) Create(ctx context.Context, userID, articleID uint, req *domain.CreateSnapshotRequest) (*domain.Snapshot, error) {
+	format := firstNonEmpty(req.Format, domain.SnapshotFormatHTML)
+	if format != domain.SnapshotFormatHTML && format != domain.SnapshotFormatWARC {
+		return nil, ErrInvalidSnapshotFormat
+	}
+	article, err := s.checkArticle(ctx, userID, articleID)
+	if err != nil {
+		return nil, err
+	}
+	u, err := url.Parse(article.URL)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+		return nil, ErrSnapshotUnavailable
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, snapshotTimeout)
+	defer cancel()
+
+	rec := snapshot.NewRecorder(s.fetch)
+	page, err := rec.Fetch(ctx, article.URL)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrSnapshotFetch, err)
+	}
+	// 生成单文件网页的同时记录用到的资源，WARC 格式保存的就是这些资源
+	data, err := snapshot.Inline(ctx, page, rec.Fetch)
+	if err != nil {
+		return nil, err
+	}
+	ext, contentType := ".html", "text/html; charset=utf-8"
+	if format == domain.SnapshotFormatWARC {
+		var buf bytes.Buffer
+		if err := snapshot.WriteWARC(&buf, rec.Resources()); err != nil {
+			return nil, err
+		}
+		data, ext, contentType = buf.Bytes(), ".warc.gz", snapshot.MimeWARC
+	}
+
+	key := fmt.Sprintf("%s%d/%d/%d%s", snapshotPrefix, userID, articleID, time.Now().UnixNano(), ext)
+	if err := s.storage.Put(ctx, key, bytes.NewReader(data), contentType); err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	snap, err := s.snapshotRepo.Create(ctx, &ent.Snapshot{
+		ArticleID:  articleID,
+		Format:     entsnapshot.Format(format),
+		StorageKey: key,
+		Size:       int64(len(data)),
+		Sha256:     hex.EncodeToString(sum[:]),
+		SourceURL:  page.URL,
+		StatusCode: page.StatusCode,
+	})
+	if err != nil {
+		s.storage.Delete(context.Background(), key)
+		return nil, err
+	}
+	return toDomainSnapshot(snap), nil
+}
+
+// List 返回文章的快照，最新的在前
+func (s *SnapshotService) List(ctx context.Context, userID, articleID uint) ([]*domain.Snapshot, error) {
+	if _, err := s.checkArticle(ctx, userID, articleID); err != nil {
+		return nil, err
+	}
+	snaps, err := s.snapshotRepo.FindByArticleID(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+	result := make([]*domain.Snapshot, len(snaps))
+	for i, snap := range snaps {
+		result[i] = toDomainSnapshot(snap)
+	}
+	return result, nil
+}
+
+// View 返回可直接显示的单文件网页，WARC快照从其中的记录重新生成
+func (s *SnapshotService) View(ctx context.Context, userID uint, id int) ([]byte, error) {
+	snap, err := s.find(ctx, userID, id)
+	if err != nil {
+		return nil, err
+	}
+	data, err := s.read(ctx, snap)
+	if err != nil {
+		return nil, err
+	}
+	if snap.Format == entsnapshot.FormatHTML {
+		return data, nil
+	}
+
+	resources, err := snapshot.ReadWARC(bytes.NewReader(data))
+	if err != nil {
+		return nil, err
+	}
+	if len(resources) == 0 {
+		return nil, errors.New("快照中没有网页")
+	}
+	return snapshot.Inline(ctx, resources[0], snapshot.Replay(resources))
+}
+
+// Open 打开快照原始文件用于下载
+func (s *SnapshotService) Open(ctx context.Context, userID uint, id int) (io.ReadCloser, *domain.Snapshot, error) {
+	snap, err := s.find(ctx, userID, id)
+	if err != nil {
+		return nil, nil, err
+	}
+	f, err := s.storage.Get(ctx, snap.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, nil, repository.ErrNotFound
+		}
+		return nil, nil, err
+	}
+	return f, toDomainSnapshot(snap), nil
+}
+
+// Delete 删除快照及其文件
+func (s *SnapshotService) Delete(ctx context.Context, userID uint, id int) error {
+	snap, err := s.find(ctx, userID, id)
+	if err != nil {
+		return err
+	}
+	if err := s.snapshotRepo.Delete(ctx, id); err != nil {
+		return err
+	}
+	if err := s.storage.Delete(ctx, snap.StorageKey); err != nil && !errors.Is(err, storage.ErrNotFound) {
+		return err
+	}
+	return nil
+}
+
+func (s *SnapshotService) read(ctx context.Context, snap *ent.Snapshot) ([]byte, error) {
+	f, err := s.storage.Get(ctx, snap.StorageKey)
+	if err != nil {
+		if errors.Is(err, storage.ErrNotFound) {
+			return nil, repository.ErrNotFound
+		}
+		return nil, err
+	}
+	defer f.Close()
+	return io.ReadAll(f)
+}
+
+func (s *SnapshotService) checkArticle(ctx context.Context, userID, articleID uint) (*ent.Article, error) {
+	article, err := s.articleRepo.FindByID(ctx, int(articleID))
+	if err != nil {
+		return nil, err
+	}
+	if uint(article.UserID) != userID {
+		return nil, ErrForbidden
+	}
+	return article, nil
+}
+
+func (s *SnapshotService) find(ctx context.Context, userID uint, id int) (*ent.Snapshot, error) {
+	snap, err := s.snapshotRepo.FindByID(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	if snap.Edges.Article == nil || uint(snap.Edges.Article.UserID) != userID {
+		return nil, ErrForbidden
+	}
+	return snap, nil
+}
+
+func toDomainSnapshot(s *ent.Snapshot) *domain.Snapshot {
+	return &domain.Snapshot{
+		ID:         s.ID,
+		ArticleID:  s.ArticleID,
+		Format:     string(s.Format),
+		Size:       s.Size,
+		SHA256:     s.Sha256,
+		SourceURL:  s.SourceURL,
+		StatusCode: s.StatusCode,
+		CreatedAt:  s.CreatedAt,
+	}
+}
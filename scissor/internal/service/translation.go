@@ -0,0 +1,249 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"html"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articletranslation"
+	"github.com/gorexlv/cabinet/scissor/pkg/kimi"
+	"github.com/gorexlv/cabinet/scissor/pkg/textutil"
+	"github.com/gorexlv/cabinet/scissor/pkg/translate"
+)
+
+const (
+	// 一篇文章的翻译时限
+	translateTimeout = 10 * time.Minute
+	// 翻译中的任务超过该时间未结束，说明执行的进程已中断，可以重新领取
+	translateStaleAfter = translateTimeout + time.Minute
+	// 同一篇文章并发翻译的段落数
+	translateWorkers = 4
+	// 未指定目标语言时翻译为中文
+	defaultTranslationLanguage = "zh"
+)
+
+// 支持的目标语言，值为提示词中使用的语言名称
+var translationLanguages = map[string]string{
+	"zh": "简体中文",
+	"en": "英语",
+	"ja": "日语",
+	"ko": "韩语",
+	"fr": "法语",
+	"de": "德语",
+	"es": "西班牙语",
+	"ru": "俄语",
+}
+
+var (
+	// ErrUnsupportedLanguage 不支持翻译为该语言
+	ErrUnsupportedLanguage = errors.New("不支持的目标语言")
+	// ErrInvalidTranslationView 无效的译文显示方式
+	ErrInvalidTranslationView = errors.New("无效的显示方式")
+)
+
+// TranslationService 按段落翻译文章，保存为文章的另一种语言版本
+type TranslationService struct {
+	articleRepo     *repository.ArticleRepository
+	translationRepo *repository.ArticleTranslationRepository
+	kimiClient      *kimi.Client
+}
+
+// NewTranslationService 创建翻译服务，kimiClient为nil时不能请求翻译
+func NewTranslationService(articleRepo *repository.ArticleRepository, translationRepo *repository.ArticleTranslationRepository, kimiClient *kimi.Client) *TranslationService {
+	return &TranslationService{
+		articleRepo:     articleRepo,
+		translationRepo: translationRepo,
+		kimiClient:      kimiClient,
+	}
+}
+
+// Request 请求将文章翻译为指定语言，由后台任务执行。已有该语言的版本时重新翻译，完成前保留原有译文。
+func (s *TranslationService) Request(ctx context.Context, userID, articleID uint, language string) (*domain.ArticleTranslation, error) {
+	if s.kimiClient == nil {
+		return nil, ErrLLMDisabled
+	}
+	language = strings.ToLower(strings.TrimSpace(language))
+	if language == "" {
+		language = defaultTranslationLanguage
+	}
+	if _, ok := translationLanguages[language]; !ok {
+		return nil, ErrUnsupportedLanguage
+	}
+	a, err := s.checkArticle(ctx, userID, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	t, err := s.translationRepo.Find(ctx, articleID, language)
+	switch {
+	case errors.Is(err, repository.ErrNotFound):
+		t, err = s.translationRepo.Create(ctx, articleID, language)
+	case err != nil:
+		return nil, err
+	case t.Status == articletranslation.StatusDone || t.Status == articletranslation.StatusFailed,
+		t.Status == articletranslation.StatusRunning && time.Since(t.UpdatedAt) > translateStaleAfter:
+		t, err = s.translationRepo.Requeue(ctx, t.ID)
+	}
+	if err != nil {
+		return nil, err
+	}
+	return toDomainTranslation(t, a, ""), nil
+}
+
+// List 返回文章的全部语言版本，不包含译文
+func (s *TranslationService) List(ctx context.Context, userID, articleID uint) ([]*domain.ArticleTranslation, error) {
+	a, err := s.checkArticle(ctx, userID, articleID)
+	if err != nil {
+		return nil, err
+	}
+	translations, err := s.translationRepo.FindByArticleID(ctx, articleID)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]*domain.ArticleTranslation, len(translations))
+	for i, t := range translations {
+		result[i] = toDomainTranslation(t, a, "")
+	}
+	return result, nil
+}
+
+// Get 返回文章指定语言的版本，view 为 bilingual 时正文为双语对照
+func (s *TranslationService) Get(ctx context.Context, userID, articleID uint, language, view string) (*domain.ArticleTranslation, error) {
+	if view == "" {
+		view = domain.TranslationViewTranslated
+	}
+	if view != domain.TranslationViewTranslated && view != domain.TranslationViewBilingual {
+		return nil, ErrInvalidTranslationView
+	}
+	a, err := s.checkArticle(ctx, userID, articleID)
+	if err != nil {
+		return nil, err
+	}
+	t, err := s.translationRepo.Find(ctx, articleID, language)
+	if err != nil {
+		return nil, err
+	}
+	return toDomainTranslation(t, a, view), nil
+}
+
+// Delete 删除文章指定语言的版本
+func (s *TranslationService) Delete(ctx context.Context, userID, articleID uint, language string) error {
+	if _, err := s.checkArticle(ctx, userID, articleID); err != nil {
+		return err
+	}
+	t, err := s.translationRepo.Find(ctx, articleID, language)
+	if err != nil {
+		return err
+	}
+	return s.translationRepo.Delete(ctx, t.ID)
+}
+
+// ProcessPending 依次执行等待中的翻译任务
+func (s *TranslationService) ProcessPending(ctx context.Context) error {
+	if s.kimiClient == nil {
+		return nil
+	}
+	for {
+		pending, err := s.translationRepo.FindPending(ctx, 10, time.Now().Add(-translateStaleAfter))
+		if err != nil {
+			return err
+		}
+		if len(pending) == 0 {
+			return nil
+		}
+
+		for _, t := range pending {
+			ok, err := s.translationRepo.MarkRunning(ctx, t.ID, time.Now().Add(-translateStaleAfter))
+			if err != nil {
+				return err
+			}
+			if !ok {
+				continue
+			}
+
+			if err := s.run(ctx, t); err != nil {
+				if ctx.Err() != nil {
+					// 进程退出时放回队列，下次启动后继续翻译
+					if err := s.translationRepo.Release(context.WithoutCancel(ctx), t.ID); err != nil {
+						log.Printf("Failed to release translation %d: %v", t.ID, err)
+					}
+					return ctx.Err()
+				}
+				log.Printf("Translation %d of article %d failed: %v", t.ID, t.ArticleID, err)
+				if err := s.translationRepo.MarkFailed(ctx, t.ID, err.Error()); err != nil {
+					return err
+				}
+			}
+		}
+	}
+}
+
+// run 翻译标题和正文并保存
+func (s *TranslationService) run(ctx context.Context, t *ent.ArticleTranslation) error {
+	ctx, cancel := context.WithTimeout(ctx, translateTimeout)
+	defer cancel()
+
+	a, err := s.articleRepo.FindByID(ctx, int(t.ArticleID))
+	if err != nil {
+		return err
+	}
+	language := translationLanguages[t.Language]
+	fn := func(ctx context.Context, fragment string) (string, error) {
+		return s.kimiClient.TranslateHTML(ctx, fragment, language)
+	}
+
+	title := a.Title
+	if textutil.Language(title) != t.Language {
+		translated, err := fn(ctx, html.EscapeString(title))
+		if err != nil {
+			return err
+		}
+		title = strings.TrimSpace(textutil.PlainText(translated))
+	}
+	result, err := translate.HTML(ctx, a.Content, t.Language, fn, translateWorkers)
+	if err != nil {
+		return err
+	}
+	return s.translationRepo.MarkDone(ctx, t.ID, title, result.Content, result.Bilingual, a.Fingerprint)
+}
+
+func (s *TranslationService) checkArticle(ctx context.Context, userID, articleID uint) (*ent.Article, error) {
+	a, err := s.articleRepo.FindByID(ctx, int(articleID))
+	if err != nil {
+		return nil, err
+	}
+	if uint(a.UserID) != userID {
+		return nil, ErrForbidden
+	}
+	return a, nil
+}
+
+// toDomainTranslation 转换语言版本，view为空时不包含正文
+func toDomainTranslation(t *ent.ArticleTranslation, a *ent.Article, view string) *domain.ArticleTranslation {
+	result := &domain.ArticleTranslation{
+		ID:        t.ID,
+		ArticleID: t.ArticleID,
+		Language:  t.Language,
+		Status:    string(t.Status),
+		Title:     t.Title,
+		Error:     t.Error,
+		// 首次翻译完成前没有译文，不算过期
+		Stale:     t.Title != "" && t.SourceFingerprint != a.Fingerprint,
+		CreatedAt: t.CreatedAt,
+		UpdatedAt: t.UpdatedAt,
+	}
+	switch view {
+	case domain.TranslationViewTranslated:
+		result.Content, result.View = t.Content, view
+	case domain.TranslationViewBilingual:
+		result.Content, result.View = t.Bilingual, view
+	}
+	return result
+}
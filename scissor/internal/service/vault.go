@@ -0,0 +1,81 @@
+package service
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"path/filepath"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/vault"
+)
+
+// ErrVaultDisabled 未配置笔记库目录
+var ErrVaultDisabled = errors.New("未配置笔记库目录")
+
+// VaultService 将文章、摘要、标签和划线同步到 Obsidian 或 Logseq 笔记库
+type VaultService struct {
+	articleRepo   *repository.ArticleRepository
+	highlightRepo *repository.HighlightRepository
+	// dir 笔记库根目录，每个用户使用以用户ID命名的子目录
+	dir    string
+	format string
+}
+
+// NewVaultService 创建笔记库同步服务，dir为空时只能通过SyncTo指定目录
+func NewVaultService(articleRepo *repository.ArticleRepository, highlightRepo *repository.HighlightRepository, dir, format string) *VaultService {
+	return &VaultService{
+		articleRepo:   articleRepo,
+		highlightRepo: highlightRepo,
+		dir:           dir,
+		format:        format,
+	}
+}
+
+// Sync 同步到配置的笔记库目录
+func (s *VaultService) Sync(ctx context.Context, userID uint) (*vault.Result, error) {
+	if s.dir == "" {
+		return nil, ErrVaultDisabled
+	}
+	return s.SyncTo(ctx, userID, filepath.Join(s.dir, fmt.Sprint(userID)), s.format)
+}
+
+// SyncTo 同步到指定的笔记库目录，只重写内容有变化的笔记
+func (s *VaultService) SyncTo(ctx context.Context, userID uint, dir, format string) (*vault.Result, error) {
+	syncer, err := vault.NewSyncer(dir, format)
+	if err != nil {
+		return nil, err
+	}
+
+	highlights, err := s.highlightRepo.FindByUserID(ctx, int(userID))
+	if err != nil {
+		return nil, err
+	}
+	byArticle := make(map[uint][]vault.Highlight)
+	for _, h := range highlights {
+		byArticle[h.ArticleID] = append(byArticle[h.ArticleID], vault.Highlight{Text: h.Text, Note: h.Note})
+	}
+
+	var notes []*vault.Note
+	err = eachArticle(ctx, s.articleRepo, userID, func(a *domain.Article) error {
+		notes = append(notes, &vault.Note{
+			ID:          a.ID,
+			Title:       a.Title,
+			URL:         a.URL,
+			Author:      a.Author,
+			Source:      a.Source,
+			Summary:     a.Summary,
+			Tags:        a.Tags,
+			PublishedAt: a.PublishedAt,
+			SavedAt:     a.CreatedAt,
+			Highlights:  byArticle[a.ID],
+		})
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return syncer.Sync(notes)
+}
@@ -0,0 +1,29 @@
+package service
+
+import (
+	"context"
+
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/embedding"
+)
+
+// articleVectors 以分块向量的均值作为文章向量，没有分块的文章不出现在结果中
+func articleVectors(ctx context.Context, chunkRepo *repository.ChunkRepository, embedder embedding.Provider, ids []uint) (map[uint][]float32, error) {
+	vectors := make(map[uint][]float32)
+	if embedder == nil || len(ids) == 0 {
+		return vectors, nil
+	}
+
+	chunks, err := chunkRepo.FindByArticleIDs(ctx, ids, embedder.Model())
+	if err != nil {
+		return nil, err
+	}
+	grouped := make(map[uint][][]float32)
+	for _, c := range chunks {
+		grouped[c.ArticleID] = append(grouped[c.ArticleID], c.Embedding)
+	}
+	for id, vs := range grouped {
+		vectors[id] = embedding.Mean(vs)
+	}
+	return vectors, nil
+}
@@ -0,0 +1,411 @@
+package service
+
+import (
+	"context"
+	"crypto/rand"
+	"errors"
+	"fmt"
+	"log"
+	"math/big"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/internal/domain"
+	"github.com/gorexlv/cabinet/scissor/internal/repository"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/extract"
+	"github.com/gorexlv/cabinet/scissor/pkg/textutil"
+	"github.com/gorexlv/cabinet/scissor/pkg/wechat"
+)
+
+var (
+	// ErrWechatMPDisabled 未配置公众号令牌
+	ErrWechatMPDisabled = errors.New("未配置公众号消息接口")
+	// ErrInvalidBindCode 绑定码不存在、已过期或不属于当前用户
+	ErrInvalidBindCode = errors.New("绑定码无效或已过期")
+	// ErrBindNotClaimed 尚未在公众号中发送绑定码
+	ErrBindNotClaimed = errors.New("尚未在公众号中发送绑定码")
+	// ErrWechatAlreadyBound 该微信已绑定其他账号
+	ErrWechatAlreadyBound = errors.New("该微信已绑定其他账号")
+)
+
+const (
+	// 公众号在5秒内未收到响应时会重试，同一条消息最多推送3次
+	wechatMaxAttempts = 3
+	// 最后一次推送必须在超时前回复
+	wechatReplyWait = 4500 * time.Millisecond
+	// 之前的推送等待超过微信的超时时间，让微信重试以争取更多处理时间
+	wechatRetryWait = 5500 * time.Millisecond
+	// 单条消息的处理时限，覆盖全部重试
+	wechatProcessTimeout = 14 * time.Second
+	// 处理结果保留一段时间，用于应答迟到的重试
+	wechatResultTTL = time.Minute
+	// 绑定码的有效期
+	wechatBindCodeTTL = 10 * time.Minute
+	// 绑定码长度，字符取自 wechatBindAlphabet，共 32^8 种
+	wechatBindCodeLength = 8
+	// 同一微信连续发送错误绑定码的次数上限，超过后锁定一段时间
+	wechatBindMaxFailures = 5
+	wechatBindLockout     = time.Hour
+	// 回复中摘要的最大字数
+	wechatSummaryLength = 500
+)
+
+var (
+	wechatURLPattern  = regexp.MustCompile(`https?://[^\s<>"'，。；）]+`)
+	wechatBindPattern = regexp.MustCompile(`^绑定\s*([0-9A-Za-z]{8})$`)
+)
+
+// 绑定码字符集，去掉了容易混淆的 0、O、1、I
+const wechatBindAlphabet = "ABCDEFGHJKLMNPQRSTUVWXYZ23456789"
+
+// WechatMPService 处理转发到公众号的消息：保存消息中的链接，并以被动回复返回摘要
+type WechatMPService struct {
+	userRepo       *repository.UserRepository
+	articleRepo    *repository.ArticleRepository
+	articleService *ArticleService
+	extractor      *extract.Client
+	token          string
+
+	mu           sync.Mutex
+	pending      map[string]*wechatPending
+	bindCodes    map[string]*wechatBindCode
+	bindFailures map[string]*wechatBindFailure
+}
+
+// wechatPending 一条消息的处理状态，微信重试时共享同一结果
+type wechatPending struct {
+	done     chan struct{}
+	attempts int
+	// title 文章已保存时的标题，处理超时时用于回复
+	title string
+	reply string
+}
+
+// wechatBindCode 绑定码，微信发送后记录openid，用户在 Scissor 中确认后才写入账号
+type wechatBindCode struct {
+	userID  uint
+	expires time.Time
+	openID  string
+}
+
+// wechatBindFailure 一个微信发送错误绑定码的记录
+type wechatBindFailure struct {
+	count int
+	last  time.Time
+	until time.Time
+}
+
+// NewWechatMPService 创建公众号消息服务，token为公众号后台配置的服务器令牌
+func NewWechatMPService(userRepo *repository.UserRepository, articleRepo *repository.ArticleRepository, articleService *ArticleService, extractor *extract.Client, token string) *WechatMPService {
+	return &WechatMPService{
+		userRepo:       userRepo,
+		articleRepo:    articleRepo,
+		articleService: articleService,
+		extractor:      extractor,
+		token:          token,
+		pending:        make(map[string]*wechatPending),
+		bindCodes:      make(map[string]*wechatBindCode),
+		bindFailures:   make(map[string]*wechatBindFailure),
+	}
+}
+
+// Verify 校验请求签名，未配置令牌时返回ErrWechatMPDisabled
+func (s *WechatMPService) Verify(signature, timestamp, nonce string) (bool, error) {
+	if s.token == "" {
+		return false, ErrWechatMPDisabled
+	}
+	return wechat.VerifySignature(s.token, signature, timestamp, nonce), nil
+}
+
+// BindCode 为用户生成绑定码，同时作废该用户之前的绑定码。
+// 用户向公众号发送「绑定 ABCD2345」后，还需要在 Scissor 中确认才会关联微信身份。
+func (s *WechatMPService) BindCode(userID uint) (*domain.WechatBindCode, error) {
+	if s.token == "" {
+		return nil, ErrWechatMPDisabled
+	}
+	code := make([]byte, wechatBindCodeLength)
+	for i := range code {
+		n, err := rand.Int(rand.Reader, big.NewInt(int64(len(wechatBindAlphabet))))
+		if err != nil {
+			return nil, err
+		}
+		code[i] = wechatBindAlphabet[n.Int64()]
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	s.pruneBindLocked(now)
+	for c, b := range s.bindCodes {
+		if b.userID == userID {
+			delete(s.bindCodes, c)
+		}
+	}
+	s.bindCodes[string(code)] = &wechatBindCode{userID: userID, expires: now.Add(wechatBindCodeTTL)}
+	return &domain.WechatBindCode{
+		Code:      string(code),
+		ExpiresIn: int(wechatBindCodeTTL.Seconds()),
+	}, nil
+}
+
+// BindStatus 返回用户当前的绑定码及是否已在公众号中发送，没有有效的绑定码时返回ErrInvalidBindCode
+func (s *WechatMPService) BindStatus(userID uint) (*domain.WechatBindCode, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	for c, b := range s.bindCodes {
+		if b.userID == userID && now.Before(b.expires) {
+			return &domain.WechatBindCode{
+				Code:      c,
+				ExpiresIn: int(b.expires.Sub(now).Seconds()),
+				Claimed:   b.openID != "",
+			}, nil
+		}
+	}
+	return nil, ErrInvalidBindCode
+}
+
+// ConfirmBind 确认将发送绑定码的微信关联到当前用户，覆盖用户原有的微信身份
+func (s *WechatMPService) ConfirmBind(ctx context.Context, userID uint, code string) error {
+	code = strings.ToUpper(strings.TrimSpace(code))
+	s.mu.Lock()
+	b, ok := s.bindCodes[code]
+	switch {
+	case !ok || b.userID != userID || time.Now().After(b.expires):
+		s.mu.Unlock()
+		return ErrInvalidBindCode
+	case b.openID == "":
+		s.mu.Unlock()
+		return ErrBindNotClaimed
+	}
+	delete(s.bindCodes, code)
+	s.mu.Unlock()
+
+	if existing, err := s.userRepo.FindByWxOpenID(ctx, b.openID); err == nil && existing.ID != int(userID) {
+		return ErrWechatAlreadyBound
+	}
+	_, err := s.userRepo.Update(ctx, int(userID), map[string]interface{}{
+		"wx_open_id": b.openID,
+	})
+	return err
+}
+
+// Handle 处理一条消息并返回被动回复的文本，返回空字符串表示不回复。
+// 保存文章和生成摘要通常超过微信的5秒时限，前两次推送故意等到超时让微信重试，
+// 最后一次推送在时限内回复已有的结果。
+func (s *WechatMPService) Handle(ctx context.Context, msg *wechat.Message) string {
+	p, attempt := s.begin(msg)
+	if attempt == 1 {
+		go s.process(p, msg)
+	}
+
+	wait := wechatRetryWait
+	if attempt >= wechatMaxAttempts {
+		wait = wechatReplyWait
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+
+	select {
+	case <-p.done:
+		return p.reply
+	case <-ctx.Done():
+		return ""
+	case <-timer.C:
+		s.mu.Lock()
+		defer s.mu.Unlock()
+		if p.title != "" {
+			return fmt.Sprintf("已保存《%s》，摘要生成后可在 Scissor 中查看", p.title)
+		}
+		return "已收到，正在保存，请稍后在 Scissor 中查看"
+	}
+}
+
+// begin 登记消息的一次推送，返回处理状态和这是第几次推送
+func (s *WechatMPService) begin(msg *wechat.Message) (*wechatPending, int) {
+	key := fmt.Sprintf("%s:%d", msg.FromUserName, msg.MsgID)
+	if msg.MsgID == 0 {
+		// 事件消息没有MsgId，以发送者和创建时间去重
+		key = fmt.Sprintf("%s@%d", msg.FromUserName, msg.CreateTime)
+	}
+
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	p, ok := s.pending[key]
+	if !ok {
+		p = &wechatPending{done: make(chan struct{})}
+		s.pending[key] = p
+		time.AfterFunc(wechatResultTTL, func() {
+			s.mu.Lock()
+			delete(s.pending, key)
+			s.mu.Unlock()
+		})
+	}
+	p.attempts++
+	return p, p.attempts
+}
+
+// process 在后台处理消息，不受单次HTTP请求的生命周期限制
+func (s *WechatMPService) process(p *wechatPending, msg *wechat.Message) {
+	ctx, cancel := context.WithTimeout(context.Background(), wechatProcessTimeout)
+	defer cancel()
+
+	reply := s.reply(ctx, p, msg)
+
+	s.mu.Lock()
+	p.reply = reply
+	s.mu.Unlock()
+	close(p.done)
+}
+
+func (s *WechatMPService) reply(ctx context.Context, p *wechatPending, msg *wechat.Message) string {
+	if msg.MsgType == wechat.MsgTypeEvent {
+		if msg.Event == wechat.EventSubscribe {
+			return "欢迎使用 Scissor！在 Scissor 中获取绑定码后发送「绑定 绑定码」完成绑定，之后分享给公众号的文章链接都会自动保存。"
+		}
+		return ""
+	}
+
+	content := strings.TrimSpace(msg.Content)
+	if m := wechatBindPattern.FindStringSubmatch(content); m != nil {
+		return s.bind(msg.FromUserName, strings.ToUpper(m[1]))
+	}
+
+	u, err := s.userRepo.FindByWxOpenID(ctx, msg.FromUserName)
+	if err != nil {
+		if ent.IsNotFound(err) {
+			return "尚未绑定 Scissor 账号。请在 Scissor 中获取绑定码，然后发送「绑定 绑定码」。"
+		}
+		log.Printf("Failed to find user by openid %s: %v", msg.FromUserName, err)
+		return "服务暂时不可用，请稍后再试"
+	}
+
+	var link, title string
+	switch msg.MsgType {
+	case wechat.MsgTypeLink:
+		link, title = msg.URL, msg.Title
+	case wechat.MsgTypeText:
+		link = wechatURLPattern.FindString(content)
+	}
+	if link == "" {
+		return "请分享文章链接，或发送包含链接的文字"
+	}
+
+	article, err := s.save(ctx, uint(u.ID), link, title)
+	if err != nil {
+		log.Printf("Failed to save article %s from wechat: %v", link, err)
+		return "保存失败：" + err.Error()
+	}
+
+	s.mu.Lock()
+	p.title = article.Title
+	s.mu.Unlock()
+
+	summary := s.waitSummary(ctx, article)
+	if summary == "" {
+		return fmt.Sprintf("已保存《%s》，摘要生成后可在 Scissor 中查看", article.Title)
+	}
+	return fmt.Sprintf("已保存《%s》\n\n%s", article.Title, textutil.Truncate(summary, wechatSummaryLength))
+}
+
+// save 抓取链接正文并保存到用户的文章库，已保存过的链接直接返回已有文章
+func (s *WechatMPService) save(ctx context.Context, userID uint, link, title string) (*ent.Article, error) {
+	article := &domain.Article{
+		Title:       firstNonEmpty(title, link),
+		URL:         link,
+		UserID:      userID,
+		PublishedAt: time.Now(),
+	}
+
+	doc, err := s.extractor.Fetch(ctx, link)
+	if err == nil && doc.Content != "" {
+		article.Title = firstNonEmpty(doc.Title, article.Title)
+		article.Content = doc.Content
+		article.Author = doc.Author
+		article.Source = doc.Source
+		if !doc.PublishedAt.IsZero() {
+			article.PublishedAt = doc.PublishedAt
+		}
+	} else {
+		// 抓取失败时交给后台任务重试
+		article.FetchStatus = domain.FetchStatusPending
+	}
+
+	created, err := s.articleService.Create(ctx, article)
+	if err != nil {
+		var dupErr *DuplicateError
+		if errors.As(err, &dupErr) {
+			return s.articleRepo.FindByID(ctx, int(dupErr.ArticleID))
+		}
+		return nil, err
+	}
+	return s.articleRepo.FindByID(ctx, int(created.ID))
+}
+
+// waitSummary 等待异步生成的摘要，超时返回空字符串
+func (s *WechatMPService) waitSummary(ctx context.Context, article *ent.Article) string {
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	for article.Summary == "" {
+		select {
+		case <-ctx.Done():
+			return ""
+		case <-ticker.C:
+		}
+		latest, err := s.articleRepo.FindByID(ctx, int(article.ID))
+		if err != nil {
+			return ""
+		}
+		article = latest
+	}
+	return article.Summary
+}
+
+// bind 记录发送绑定码的openid，等待用户在 Scissor 中确认。
+// 同一微信连续发送错误的绑定码超过次数后锁定一段时间，防止猜测他人的绑定码。
+func (s *WechatMPService) bind(openID, code string) string {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	now := time.Now()
+	s.pruneBindLocked(now)
+
+	f := s.bindFailures[openID]
+	if f != nil && now.Before(f.until) {
+		return "绑定码错误次数过多，请稍后再试"
+	}
+	b, ok := s.bindCodes[code]
+	// 已被其他微信发送过的绑定码同样视为无效
+	if !ok || now.After(b.expires) || (b.openID != "" && b.openID != openID) {
+		if f == nil {
+			f = &wechatBindFailure{}
+			s.bindFailures[openID] = f
+		}
+		f.count++
+		f.last = now
+		if f.count >= wechatBindMaxFailures {
+			f.count, f.until = 0, now.Add(wechatBindLockout)
+		}
+		return "绑定码无效或已过期，请在 Scissor 中重新获取"
+	}
+
+	delete(s.bindFailures, openID)
+	b.openID = openID
+	return "已收到绑定码，请回到 Scissor 确认绑定"
+}
+
+// pruneBindLocked 清理过期的绑定码和失败记录，调用方需持有锁
+func (s *WechatMPService) pruneBindLocked(now time.Time) {
+	for c, b := range s.bindCodes {
+		if now.After(b.expires) {
+			delete(s.bindCodes, c)
+		}
+	}
+	for openID, f := range s.bindFailures {
+		if now.After(f.until) && now.Sub(f.last) > wechatBindLockout {
+			delete(s.bindFailures, openID)
+		}
+	}
+}
@@ -1,11 +1,14 @@
 package main
 
 import (
+	"fmt"
 	"log"
 	"os"
 
 	"github.com/gin-gonic/gin"
+	"github.com/gorexlv/cabinet/scissor/internal/config"
 	"github.com/gorexlv/cabinet/scissor/pkg/kimi"
+	"github.com/gorexlv/cabinet/scissor/pkg/prompt"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 )
@@ -45,6 +48,25 @@ func main() {
 		log.Fatal("Failed to create Kimi client:", err)
 	}
 
+	// 摘要和标签使用内置的提示词模板
+	templates, err := prompt.System(config.PromptsConfig{})
+	if err != nil {
+		log.Fatal("Failed to load prompt templates:", err)
+	}
+	generate := func(c *gin.Context, name, content string) (string, error) {
+		for _, t := range templates {
+			if t.Name != name {
+				continue
+			}
+			text, err := t.Render(&prompt.Article{Content: content})
+			if err != nil {
+				return "", err
+			}
+			return kimiClient.Chat(c.Request.Context(), []kimi.Message{{Role: "user", Content: text}})
+		}
+		return "", fmt.Errorf("prompt template %q not found", name)
+	}
+
 	// 创建Gin路由
 	r := gin.Default()
 
@@ -57,7 +79,7 @@ func main() {
 		}
 
 		// 生成摘要
-		summary, err := kimiClient.GenerateSummary(article.Content)
+		summary, err := generate(c, prompt.Summary, article.Content)
 		if err != nil {
 			c.JSON(500, gin.H{"error": "Failed to generate summary: " + err.Error()})
 			return
@@ -65,7 +87,7 @@ func main() {
 		article.Summary = summary
 
 		// 生成标签
-		tags, err := kimiClient.GenerateTags(article.Content)
+		tags, err := generate(c, prompt.Tags, article.Content)
 		if err != nil {
 			c.JSON(500, gin.H{"error": "Failed to generate tags: " + err.Error()})
 			return
@@ -10,6 +10,9 @@ import (
 	_ "github.com/go-sql-driver/mysql"
 	"github.com/gorexlv/cabinet/scissor/internal/config"
 	"github.com/gorexlv/cabinet/scissor/pkg/ent"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/migrate"
+	// 字段默认值和正文清理钩子在 runtime 包中注册
+	_ "github.com/gorexlv/cabinet/scissor/pkg/ent/runtime"
 )
 
 // NewClient 创建一个新的数据库客户端
@@ -25,7 +28,8 @@ func NewClient(cfg config.DatabaseConfig) (*ent.Client, error) {
 	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
 	defer cancel()
 
-	if err := client.Schema.Create(ctx); err != nil {
+	// 允许删除结构中已不存在的索引，如改为按用户唯一后旧的全局唯一URL索引
+	if err := client.Schema.Create(ctx, migrate.WithDropIndex(true)); err != nil {
 		return nil, fmt.Errorf("failed to create schema: %w", err)
 	}
 
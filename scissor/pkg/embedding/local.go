@@ -0,0 +1,62 @@
+package embedding
+
+import (
+	"context"
+	"fmt"
+	"hash/fnv"
+	"math"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/textutil"
+)
+
+const defaultLocalDimensions = 512
+
+// LocalProvider 基于特征哈希的本地向量化实现，无需外部服务。
+// 只能捕捉字面相似度，无法跨语言匹配，适合开发环境或离线部署。
+type LocalProvider struct {
+	dimensions int
+}
+
+// NewLocalProvider 创建本地向量化服务
+func NewLocalProvider(dimensions int) *LocalProvider {
+	if dimensions <= 0 {
+		dimensions = defaultLocalDimensions
+	}
+	return &LocalProvider{dimensions: dimensions}
+}
+
+// Model 返回模型标识
+func (p *LocalProvider) Model() string {
+	return fmt.Sprintf("local-hash@%d", p.dimensions)
+}
+
+// Embed 将文本的词元哈希到固定维度，以对数词频加权
+func (p *LocalProvider) Embed(ctx context.Context, texts []string) ([][]float32, error) {
+	vectors := make([][]float32, len(texts))
+	for i, text := range texts {
+		if err := ctx.Err(); err != nil {
+			return nil, err
+		}
+
+		counts := make(map[string]int)
+		for _, token := range textutil.Tokens(text) {
+			counts[token]++
+		}
+
+		v := make([]float32, p.dimensions)
+		for token, n := range counts {
+			h := fnv.New64a()
+			h.Write([]byte(token))
+			sum := h.Sum64()
+			weight := float32(1 + math.Log(float64(n)))
+			// 用最高位决定符号，减小哈希冲突带来的偏差
+			if sum>>63 == 1 {
+				weight = -weight
+			}
+			v[sum%uint64(p.dimensions)] += weight
+		}
+		Normalize(v)
+		vectors[i] = v
+	}
+	return vectors, nil
+}
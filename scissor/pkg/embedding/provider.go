@@ -0,0 +1,83 @@
+// Package embedding 提供文本向量化能力，供语义检索等功能使用
+package embedding
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"github.com/gorexlv/cabinet/scissor/internal/config"
+)
+
+// Provider 文本向量化服务
+type Provider interface {
+	// Embed 将一组文本转换为向量，返回结果与输入顺序一致
+	Embed(ctx context.Context, texts []string) ([][]float32, error)
+	// Model 返回模型标识，模型变化时已存储的向量需要重新计算
+	Model() string
+}
+
+// NewProvider 根据配置创建向量化服务
+func NewProvider(cfg config.EmbeddingConfig) (Provider, error) {
+	switch cfg.Provider {
+	case "", "local":
+		return NewLocalProvider(cfg.Dimensions), nil
+	case "openai":
+		if cfg.APIKey == "" {
+			return nil, fmt.Errorf("embedding api key is not set")
+		}
+		return NewOpenAIProvider(cfg.BaseURL, cfg.APIKey, cfg.Model, cfg.Dimensions), nil
+	default:
+		return nil, fmt.Errorf("unknown embedding provider: %s", cfg.Provider)
+	}
+}
+
+// Cosine 计算两个向量的余弦相似度，维度不一致时返回0
+func Cosine(a, b []float32) float32 {
+	if len(a) != len(b) || len(a) == 0 {
+		return 0
+	}
+	var dot, na, nb float64
+	for i := range a {
+		dot += float64(a[i]) * float64(b[i])
+		na += float64(a[i]) * float64(a[i])
+		nb += float64(b[i]) * float64(b[i])
+	}
+	if na == 0 || nb == 0 {
+		return 0
+	}
+	return float32(dot / (math.Sqrt(na) * math.Sqrt(nb)))
+}
+
+// Normalize 将向量归一化为单位长度
+func Normalize(v []float32) {
+	var sum float64
+	for _, x := range v {
+		sum += float64(x) * float64(x)
+	}
+	if sum == 0 {
+		return
+	}
+	n := float32(math.Sqrt(sum))
+	for i := range v {
+		v[i] /= n
+	}
+}
+
+// Mean 计算一组向量的均值向量并归一化
+func Mean(vectors [][]float32) []float32 {
+	if len(vectors) == 0 {
+		return nil
+	}
+	mean := make([]float32, len(vectors[0]))
+	for _, v := range vectors {
+		if len(v) != len(mean) {
+			continue
+		}
+		for i, x := range v {
+			mean[i] += x
+		}
+	}
+	Normalize(mean)
+	return mean
+}
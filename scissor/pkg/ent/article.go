@@ -11,6 +11,7 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/topiccluster"
 	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
 )
 
@@ -23,8 +24,24 @@ type Article struct {
 	Title string `json:"title,omitempty"`
 	// Content holds the value of the "content" field.
 	Content string `json:"content,omitempty"`
+	// ContentText holds the value of the "content_text" field.
+	ContentText *string `json:"content_text,omitempty"`
+	// WordCount holds the value of the "word_count" field.
+	WordCount *int `json:"word_count,omitempty"`
+	// ReadingMinutes holds the value of the "reading_minutes" field.
+	ReadingMinutes int `json:"reading_minutes,omitempty"`
+	// Language holds the value of the "language" field.
+	Language string `json:"language,omitempty"`
+	// ImageCount holds the value of the "image_count" field.
+	ImageCount int `json:"image_count,omitempty"`
+	// LeadImage holds the value of the "lead_image" field.
+	LeadImage string `json:"lead_image,omitempty"`
 	// URL holds the value of the "url" field.
 	URL string `json:"url,omitempty"`
+	// CanonicalURL holds the value of the "canonical_url" field.
+	CanonicalURL string `json:"canonical_url,omitempty"`
+	// Fingerprint holds the value of the "fingerprint" field.
+	Fingerprint uint64 `json:"fingerprint,omitempty"`
 	// Author holds the value of the "author" field.
 	Author string `json:"author,omitempty"`
 	// Source holds the value of the "source" field.
@@ -35,24 +52,57 @@ type Article struct {
 	Tags []string `json:"tags,omitempty"`
 	// PublishedAt holds the value of the "published_at" field.
 	PublishedAt time.Time `json:"published_at,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// ClusterID holds the value of the "cluster_id" field.
+	ClusterID *int `json:"cluster_id,omitempty"`
+	// ClusterCheckedAt holds the value of the "cluster_checked_at" field.
+	ClusterCheckedAt *time.Time `json:"cluster_checked_at,omitempty"`
+	// FetchStatus holds the value of the "fetch_status" field.
+	FetchStatus article.FetchStatus `json:"fetch_status,omitempty"`
+	// FetchError holds the value of the "fetch_error" field.
+	FetchError string `json:"fetch_error,omitempty"`
+	// ReadAt holds the value of the "read_at" field.
+	ReadAt *time.Time `json:"read_at,omitempty"`
+	// SourceStatus holds the value of the "source_status" field.
+	SourceStatus article.SourceStatus `json:"source_status,omitempty"`
+	// SourceMovedTo holds the value of the "source_moved_to" field.
+	SourceMovedTo string `json:"source_moved_to,omitempty"`
+	// SourceCheckedAt holds the value of the "source_checked_at" field.
+	SourceCheckedAt *time.Time `json:"source_checked_at,omitempty"`
+	// SourceFingerprint holds the value of the "source_fingerprint" field.
+	SourceFingerprint uint64 `json:"source_fingerprint,omitempty"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
 	UpdatedAt time.Time `json:"updated_at,omitempty"`
 	// Edges holds the relations/edges for other nodes in the graph.
 	// The values are being populated by the ArticleQuery when eager-loading is set.
-	Edges         ArticleEdges `json:"edges"`
-	user_articles *int
-	selectValues  sql.SelectValues
+	Edges        ArticleEdges `json:"edges"`
+	selectValues sql.SelectValues
 }
 
 // ArticleEdges holds the relations/edges for other nodes in the graph.
 type ArticleEdges struct {
 	// User holds the value of the user edge.
 	User *User `json:"user,omitempty"`
+	// Cluster holds the value of the cluster edge.
+	Cluster *TopicCluster `json:"cluster,omitempty"`
+	// Chunks holds the value of the chunks edge.
+	Chunks []*ArticleChunk `json:"chunks,omitempty"`
+	// Highlights holds the value of the highlights edge.
+	Highlights []*Highlight `json:"highlights,omitempty"`
+	// Snapshots holds the value of the snapshots edge.
+	Snapshots []*Snapshot `json:"snapshots,omitempty"`
+	// Revisions holds the value of the revisions edge.
+	Revisions []*ArticleRevision `json:"revisions,omitempty"`
+	// Translations holds the value of the translations edge.
+	Translations []*ArticleTranslation `json:"translations,omitempty"`
+	// Enrichments holds the value of the enrichments edge.
+	Enrichments []*ArticleEnrichment `json:"enrichments,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [8]bool
 }
 
 // UserOrErr returns the User value or an error if the edge
@@ -66,6 +116,71 @@ func (e ArticleEdges) UserOrErr() (*User, error) {
 	return nil, &NotLoadedError{edge: "user"}
 }
 
+// ClusterOrErr returns the Cluster value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ArticleEdges) ClusterOrErr() (*TopicCluster, error) {
+	if e.Cluster != nil {
+		return e.Cluster, nil
+	} else if e.loadedTypes[1] {
+		return nil, &NotFoundError{label: topiccluster.Label}
+	}
+	return nil, &NotLoadedError{edge: "cluster"}
+}
+
+// ChunksOrErr returns the Chunks value or an error if the edge
+// was not loaded in eager-loading.
+func (e ArticleEdges) ChunksOrErr() ([]*ArticleChunk, error) {
+	if e.loadedTypes[2] {
+		return e.Chunks, nil
+	}
+	return nil, &NotLoadedError{edge: "chunks"}
+}
+
+// HighlightsOrErr returns the Highlights value or an error if the edge
+// was not loaded in eager-loading.
+func (e ArticleEdges) HighlightsOrErr() ([]*Highlight, error) {
+	if e.loadedTypes[3] {
+		return e.Highlights, nil
+	}
+	return nil, &NotLoadedError{edge: "highlights"}
+}
+
+// SnapshotsOrErr returns the Snapshots value or an error if the edge
+// was not loaded in eager-loading.
+func (e ArticleEdges) SnapshotsOrErr() ([]*Snapshot, error) {
+	if e.loadedTypes[4] {
+		return e.Snapshots, nil
+	}
+	return nil, &NotLoadedError{edge: "snapshots"}
+}
+
+// RevisionsOrErr returns the Revisions value or an error if the edge
+// was not loaded in eager-loading.
+func (e ArticleEdges) RevisionsOrErr() ([]*ArticleRevision, error) {
+	if e.loadedTypes[5] {
+		return e.Revisions, nil
+	}
+	return nil, &NotLoadedError{edge: "revisions"}
+}
+
+// TranslationsOrErr returns the Translations value or an error if the edge
+// was not loaded in eager-loading.
+func (e ArticleEdges) TranslationsOrErr() ([]*ArticleTranslation, error) {
+	if e.loadedTypes[6] {
+		return e.Translations, nil
+	}
+	return nil, &NotLoadedError{edge: "translations"}
+}
+
+// EnrichmentsOrErr returns the Enrichments value or an error if the edge
+// was not loaded in eager-loading.
+func (e ArticleEdges) EnrichmentsOrErr() ([]*ArticleEnrichment, error) {
+	if e.loadedTypes[7] {
+		return e.Enrichments, nil
+	}
+	return nil, &NotLoadedError{edge: "enrichments"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*Article) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -73,14 +188,12 @@ func (*Article) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case article.FieldTags:
 			values[i] = new([]byte)
-		case article.FieldID:
+		case article.FieldID, article.FieldWordCount, article.FieldReadingMinutes, article.FieldImageCount, article.FieldFingerprint, article.FieldUserID, article.FieldClusterID, article.FieldSourceFingerprint:
 			values[i] = new(sql.NullInt64)
-		case article.FieldTitle, article.FieldContent, article.FieldURL, article.FieldAuthor, article.FieldSource, article.FieldSummary:
+		case article.FieldTitle, article.FieldContent, article.FieldContentText, article.FieldLanguage, article.FieldLeadImage, article.FieldURL, article.FieldCanonicalURL, article.FieldAuthor, article.FieldSource, article.FieldSummary, article.FieldFetchStatus, article.FieldFetchError, article.FieldSourceStatus, article.FieldSourceMovedTo:
 			values[i] = new(sql.NullString)
-		case article.FieldPublishedAt, article.FieldCreatedAt, article.FieldUpdatedAt:
+		case article.FieldPublishedAt, article.FieldClusterCheckedAt, article.FieldReadAt, article.FieldSourceCheckedAt, article.FieldCreatedAt, article.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
-		case article.ForeignKeys[0]: // user_articles
-			values[i] = new(sql.NullInt64)
 		default:
 			values[i] = new(sql.UnknownType)
 		}
@@ -114,12 +227,62 @@ func (a *Article) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.Content = value.String
 			}
+		case article.FieldContentText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_text", values[i])
+			} else if value.Valid {
+				a.ContentText = new(string)
+				*a.ContentText = value.String
+			}
+		case article.FieldWordCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field word_count", values[i])
+			} else if value.Valid {
+				a.WordCount = new(int)
+				*a.WordCount = int(value.Int64)
+			}
+		case article.FieldReadingMinutes:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field reading_minutes", values[i])
+			} else if value.Valid {
+				a.ReadingMinutes = int(value.Int64)
+			}
+		case article.FieldLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				a.Language = value.String
+			}
+		case article.FieldImageCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field image_count", values[i])
+			} else if value.Valid {
+				a.ImageCount = int(value.Int64)
+			}
+		case article.FieldLeadImage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field lead_image", values[i])
+			} else if value.Valid {
+				a.LeadImage = value.String
+			}
 		case article.FieldURL:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field url", values[i])
 			} else if value.Valid {
 				a.URL = value.String
 			}
+		case article.FieldCanonicalURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field canonical_url", values[i])
+			} else if value.Valid {
+				a.CanonicalURL = value.String
+			}
+		case article.FieldFingerprint:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field fingerprint", values[i])
+			} else if value.Valid {
+				a.Fingerprint = uint64(value.Int64)
+			}
 		case article.FieldAuthor:
 			if value, ok := values[i].(*sql.NullString); !ok {
 				return fmt.Errorf("unexpected type %T for field author", values[i])
@@ -152,6 +315,70 @@ func (a *Article) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.PublishedAt = value.Time
 			}
+		case article.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				a.UserID = int(value.Int64)
+			}
+		case article.FieldClusterID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster_id", values[i])
+			} else if value.Valid {
+				a.ClusterID = new(int)
+				*a.ClusterID = int(value.Int64)
+			}
+		case article.FieldClusterCheckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field cluster_checked_at", values[i])
+			} else if value.Valid {
+				a.ClusterCheckedAt = new(time.Time)
+				*a.ClusterCheckedAt = value.Time
+			}
+		case article.FieldFetchStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fetch_status", values[i])
+			} else if value.Valid {
+				a.FetchStatus = article.FetchStatus(value.String)
+			}
+		case article.FieldFetchError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field fetch_error", values[i])
+			} else if value.Valid {
+				a.FetchError = value.String
+			}
+		case article.FieldReadAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field read_at", values[i])
+			} else if value.Valid {
+				a.ReadAt = new(time.Time)
+				*a.ReadAt = value.Time
+			}
+		case article.FieldSourceStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_status", values[i])
+			} else if value.Valid {
+				a.SourceStatus = article.SourceStatus(value.String)
+			}
+		case article.FieldSourceMovedTo:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_moved_to", values[i])
+			} else if value.Valid {
+				a.SourceMovedTo = value.String
+			}
+		case article.FieldSourceCheckedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field source_checked_at", values[i])
+			} else if value.Valid {
+				a.SourceCheckedAt = new(time.Time)
+				*a.SourceCheckedAt = value.Time
+			}
+		case article.FieldSourceFingerprint:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field source_fingerprint", values[i])
+			} else if value.Valid {
+				a.SourceFingerprint = uint64(value.Int64)
+			}
 		case article.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -164,13 +391,6 @@ func (a *Article) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				a.UpdatedAt = value.Time
 			}
-		case article.ForeignKeys[0]:
-			if value, ok := values[i].(*sql.NullInt64); !ok {
-				return fmt.Errorf("unexpected type %T for edge-field user_articles", value)
-			} else if value.Valid {
-				a.user_articles = new(int)
-				*a.user_articles = int(value.Int64)
-			}
 		default:
 			a.selectValues.Set(columns[i], values[i])
 		}
@@ -189,6 +409,41 @@ func (a *Article) QueryUser() *UserQuery {
 	return NewArticleClient(a.config).QueryUser(a)
 }
 
+// QueryCluster queries the "cluster" edge of the Article entity.
+func (a *Article) QueryCluster() *TopicClusterQuery {
+	return NewArticleClient(a.config).QueryCluster(a)
+}
+
+// QueryChunks queries the "chunks" edge of the Article entity.
+func (a *Article) QueryChunks() *ArticleChunkQuery {
+	return NewArticleClient(a.config).QueryChunks(a)
+}
+
+// QueryHighlights queries the "highlights" edge of the Article entity.
+func (a *Article) QueryHighlights() *HighlightQuery {
+	return NewArticleClient(a.config).QueryHighlights(a)
+}
+
+// QuerySnapshots queries the "snapshots" edge of the Article entity.
+func (a *Article) QuerySnapshots() *SnapshotQuery {
+	return NewArticleClient(a.config).QuerySnapshots(a)
+}
+
+// QueryRevisions queries the "revisions" edge of the Article entity.
+func (a *Article) QueryRevisions() *ArticleRevisionQuery {
+	return NewArticleClient(a.config).QueryRevisions(a)
+}
+
+// QueryTranslations queries the "translations" edge of the Article entity.
+func (a *Article) QueryTranslations() *ArticleTranslationQuery {
+	return NewArticleClient(a.config).QueryTranslations(a)
+}
+
+// QueryEnrichments queries the "enrichments" edge of the Article entity.
+func (a *Article) QueryEnrichments() *ArticleEnrichmentQuery {
+	return NewArticleClient(a.config).QueryEnrichments(a)
+}
+
 // Update returns a builder for updating this Article.
 // Note that you need to call Article.Unwrap() before calling this method if this Article
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -218,9 +473,37 @@ func (a *Article) String() string {
 	builder.WriteString("content=")
 	builder.WriteString(a.Content)
 	builder.WriteString(", ")
+	if v := a.ContentText; v != nil {
+		builder.WriteString("content_text=")
+		builder.WriteString(*v)
+	}
+	builder.WriteString(", ")
+	if v := a.WordCount; v != nil {
+		builder.WriteString("word_count=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("reading_minutes=")
+	builder.WriteString(fmt.Sprintf("%v", a.ReadingMinutes))
+	builder.WriteString(", ")
+	builder.WriteString("language=")
+	builder.WriteString(a.Language)
+	builder.WriteString(", ")
+	builder.WriteString("image_count=")
+	builder.WriteString(fmt.Sprintf("%v", a.ImageCount))
+	builder.WriteString(", ")
+	builder.WriteString("lead_image=")
+	builder.WriteString(a.LeadImage)
+	builder.WriteString(", ")
 	builder.WriteString("url=")
 	builder.WriteString(a.URL)
 	builder.WriteString(", ")
+	builder.WriteString("canonical_url=")
+	builder.WriteString(a.CanonicalURL)
+	builder.WriteString(", ")
+	builder.WriteString("fingerprint=")
+	builder.WriteString(fmt.Sprintf("%v", a.Fingerprint))
+	builder.WriteString(", ")
 	builder.WriteString("author=")
 	builder.WriteString(a.Author)
 	builder.WriteString(", ")
@@ -236,6 +519,44 @@ func (a *Article) String() string {
 	builder.WriteString("published_at=")
 	builder.WriteString(a.PublishedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", a.UserID))
+	builder.WriteString(", ")
+	if v := a.ClusterID; v != nil {
+		builder.WriteString("cluster_id=")
+		builder.WriteString(fmt.Sprintf("%v", *v))
+	}
+	builder.WriteString(", ")
+	if v := a.ClusterCheckedAt; v != nil {
+		builder.WriteString("cluster_checked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("fetch_status=")
+	builder.WriteString(fmt.Sprintf("%v", a.FetchStatus))
+	builder.WriteString(", ")
+	builder.WriteString("fetch_error=")
+	builder.WriteString(a.FetchError)
+	builder.WriteString(", ")
+	if v := a.ReadAt; v != nil {
+		builder.WriteString("read_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("source_status=")
+	builder.WriteString(fmt.Sprintf("%v", a.SourceStatus))
+	builder.WriteString(", ")
+	builder.WriteString("source_moved_to=")
+	builder.WriteString(a.SourceMovedTo)
+	builder.WriteString(", ")
+	if v := a.SourceCheckedAt; v != nil {
+		builder.WriteString("source_checked_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("source_fingerprint=")
+	builder.WriteString(fmt.Sprintf("%v", a.SourceFingerprint))
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(a.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -3,8 +3,10 @@
 package article
 
 import (
+	"fmt"
 	"time"
 
+	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
 )
@@ -18,8 +20,24 @@ const (
 	FieldTitle = "title"
 	// FieldContent holds the string denoting the content field in the database.
 	FieldContent = "content"
+	// FieldContentText holds the string denoting the content_text field in the database.
+	FieldContentText = "content_text"
+	// FieldWordCount holds the string denoting the word_count field in the database.
+	FieldWordCount = "word_count"
+	// FieldReadingMinutes holds the string denoting the reading_minutes field in the database.
+	FieldReadingMinutes = "reading_minutes"
+	// FieldLanguage holds the string denoting the language field in the database.
+	FieldLanguage = "language"
+	// FieldImageCount holds the string denoting the image_count field in the database.
+	FieldImageCount = "image_count"
+	// FieldLeadImage holds the string denoting the lead_image field in the database.
+	FieldLeadImage = "lead_image"
 	// FieldURL holds the string denoting the url field in the database.
 	FieldURL = "url"
+	// FieldCanonicalURL holds the string denoting the canonical_url field in the database.
+	FieldCanonicalURL = "canonical_url"
+	// FieldFingerprint holds the string denoting the fingerprint field in the database.
+	FieldFingerprint = "fingerprint"
 	// FieldAuthor holds the string denoting the author field in the database.
 	FieldAuthor = "author"
 	// FieldSource holds the string denoting the source field in the database.
@@ -30,12 +48,46 @@ const (
 	FieldTags = "tags"
 	// FieldPublishedAt holds the string denoting the published_at field in the database.
 	FieldPublishedAt = "published_at"
+	// FieldUserID holds the string denoting the user_id field in the database.
+	FieldUserID = "user_articles"
+	// FieldClusterID holds the string denoting the cluster_id field in the database.
+	FieldClusterID = "cluster_id"
+	// FieldClusterCheckedAt holds the string denoting the cluster_checked_at field in the database.
+	FieldClusterCheckedAt = "cluster_checked_at"
+	// FieldFetchStatus holds the string denoting the fetch_status field in the database.
+	FieldFetchStatus = "fetch_status"
+	// FieldFetchError holds the string denoting the fetch_error field in the database.
+	FieldFetchError = "fetch_error"
+	// FieldReadAt holds the string denoting the read_at field in the database.
+	FieldReadAt = "read_at"
+	// FieldSourceStatus holds the string denoting the source_status field in the database.
+	FieldSourceStatus = "source_status"
+	// FieldSourceMovedTo holds the string denoting the source_moved_to field in the database.
+	FieldSourceMovedTo = "source_moved_to"
+	// FieldSourceCheckedAt holds the string denoting the source_checked_at field in the database.
+	FieldSourceCheckedAt = "source_checked_at"
+	// FieldSourceFingerprint holds the string denoting the source_fingerprint field in the database.
+	FieldSourceFingerprint = "source_fingerprint"
 	// FieldCreatedAt holds the string denoting the created_at field in the database.
 	FieldCreatedAt = "created_at"
 	// FieldUpdatedAt holds the string denoting the updated_at field in the database.
 	FieldUpdatedAt = "updated_at"
 	// EdgeUser holds the string denoting the user edge name in mutations.
 	EdgeUser = "user"
+	// EdgeCluster holds the string denoting the cluster edge name in mutations.
+	EdgeCluster = "cluster"
+	// EdgeChunks holds the string denoting the chunks edge name in mutations.
+	EdgeChunks = "chunks"
+	// EdgeHighlights holds the string denoting the highlights edge name in mutations.
+	EdgeHighlights = "highlights"
+	// EdgeSnapshots holds the string denoting the snapshots edge name in mutations.
+	EdgeSnapshots = "snapshots"
+	// EdgeRevisions holds the string denoting the revisions edge name in mutations.
+	EdgeRevisions = "revisions"
+	// EdgeTranslations holds the string denoting the translations edge name in mutations.
+	EdgeTranslations = "translations"
+	// EdgeEnrichments holds the string denoting the enrichments edge name in mutations.
+	EdgeEnrichments = "enrichments"
 	// Table holds the table name of the article in the database.
 	Table = "articles"
 	// UserTable is the table that holds the user relation/edge.
@@ -45,6 +97,55 @@ const (
 	UserInverseTable = "users"
 	// UserColumn is the table column denoting the user relation/edge.
 	UserColumn = "user_articles"
+	// ClusterTable is the table that holds the cluster relation/edge.
+	ClusterTable = "articles"
+	// ClusterInverseTable is the table name for the TopicCluster entity.
+	// It exists in this package in order to avoid circular dependency with the "topiccluster" package.
+	ClusterInverseTable = "topic_clusters"
+	// ClusterColumn is the table column denoting the cluster relation/edge.
+	ClusterColumn = "cluster_id"
+	// ChunksTable is the table that holds the chunks relation/edge.
+	ChunksTable = "article_chunks"
+	// ChunksInverseTable is the table name for the ArticleChunk entity.
+	// It exists in this package in order to avoid circular dependency with the "articlechunk" package.
+	ChunksInverseTable = "article_chunks"
+	// ChunksColumn is the table column denoting the chunks relation/edge.
+	ChunksColumn = "article_id"
+	// HighlightsTable is the table that holds the highlights relation/edge.
+	HighlightsTable = "highlights"
+	// HighlightsInverseTable is the table name for the Highlight entity.
+	// It exists in this package in order to avoid circular dependency with the "highlight" package.
+	HighlightsInverseTable = "highlights"
+	// HighlightsColumn is the table column denoting the highlights relation/edge.
+	HighlightsColumn = "article_id"
+	// SnapshotsTable is the table that holds the snapshots relation/edge.
+	SnapshotsTable = "snapshots"
+	// SnapshotsInverseTable is the table name for the Snapshot entity.
+	// It exists in this package in order to avoid circular dependency with the "snapshot" package.
+	SnapshotsInverseTable = "snapshots"
+	// SnapshotsColumn is the table column denoting the snapshots relation/edge.
+	SnapshotsColumn = "article_id"
+	// RevisionsTable is the table that holds the revisions relation/edge.
+	RevisionsTable = "article_revisions"
+	// RevisionsInverseTable is the table name for the ArticleRevision entity.
+	// It exists in this package in order to avoid circular dependency with the "articlerevision" package.
+	RevisionsInverseTable = "article_revisions"
+	// RevisionsColumn is the table column denoting the revisions relation/edge.
+	RevisionsColumn = "article_id"
+	// TranslationsTable is the table that holds the translations relation/edge.
+	TranslationsTable = "article_translations"
+	// TranslationsInverseTable is the table name for the ArticleTranslation entity.
+	// It exists in this package in order to avoid circular dependency with the "articletranslation" package.
+	TranslationsInverseTable = "article_translations"
+	// TranslationsColumn is the table column denoting the translations relation/edge.
+	TranslationsColumn = "article_id"
+	// EnrichmentsTable is the table that holds the enrichments relation/edge.
+	EnrichmentsTable = "article_enrichments"
+	// EnrichmentsInverseTable is the table name for the ArticleEnrichment entity.
+	// It exists in this package in order to avoid circular dependency with the "articleenrichment" package.
+	EnrichmentsInverseTable = "article_enrichments"
+	// EnrichmentsColumn is the table column denoting the enrichments relation/edge.
+	EnrichmentsColumn = "article_id"
 )
 
 // Columns holds all SQL columns for article fields.
@@ -52,22 +153,34 @@ var Columns = []string{
 	FieldID,
 	FieldTitle,
 	FieldContent,
+	FieldContentText,
+	FieldWordCount,
+	FieldReadingMinutes,
+	FieldLanguage,
+	FieldImageCount,
+	FieldLeadImage,
 	FieldURL,
+	FieldCanonicalURL,
+	FieldFingerprint,
 	FieldAuthor,
 	FieldSource,
 	FieldSummary,
 	FieldTags,
 	FieldPublishedAt,
+	FieldUserID,
+	FieldClusterID,
+	FieldClusterCheckedAt,
+	FieldFetchStatus,
+	FieldFetchError,
+	FieldReadAt,
+	FieldSourceStatus,
+	FieldSourceMovedTo,
+	FieldSourceCheckedAt,
+	FieldSourceFingerprint,
 	FieldCreatedAt,
 	FieldUpdatedAt,
 }
 
-// ForeignKeys holds the SQL foreign-keys that are owned by the "articles"
-// table and are not defined as standalone fields in the schema.
-var ForeignKeys = []string{
-	"user_articles",
-}
-
 // ValidColumn reports if the column name is valid (part of the table columns).
 func ValidColumn(column string) bool {
 	for i := range Columns {
@@ -75,15 +188,16 @@ func ValidColumn(column string) bool {
 			return true
 		}
 	}
-	for i := range ForeignKeys {
-		if column == ForeignKeys[i] {
-			return true
-		}
-	}
 	return false
 }
 
+// Note that the variables below are initialized by the runtime
+// package on the initialization of the application. Therefore,
+// it should be imported in the main as follows:
+//
+//	import _ "github.com/gorexlv/cabinet/scissor/pkg/ent/runtime"
 var (
+	Hooks [1]ent.Hook
 	// DefaultCreatedAt holds the default value on creation for the "created_at" field.
 	DefaultCreatedAt func() time.Time
 	// DefaultUpdatedAt holds the default value on creation for the "updated_at" field.
@@ -94,6 +208,55 @@ var (
 	IDValidator func(uint) error
 )
 
+// FetchStatus defines the type for the "fetch_status" enum field.
+type FetchStatus string
+
+// FetchStatus values.
+const (
+	FetchStatusPending FetchStatus = "pending"
+	FetchStatusDone    FetchStatus = "done"
+	FetchStatusFailed  FetchStatus = "failed"
+)
+
+func (fs FetchStatus) String() string {
+	return string(fs)
+}
+
+// FetchStatusValidator is a validator for the "fetch_status" field enum values. It is called by the builders before save.
+func FetchStatusValidator(fs FetchStatus) error {
+	switch fs {
+	case FetchStatusPending, FetchStatusDone, FetchStatusFailed:
+		return nil
+	default:
+		return fmt.Errorf("article: invalid enum value for fetch_status field: %q", fs)
+	}
+}
+
+// SourceStatus defines the type for the "source_status" enum field.
+type SourceStatus string
+
+// SourceStatus values.
+const (
+	SourceStatusAlive   SourceStatus = "alive"
+	SourceStatusDeleted SourceStatus = "deleted"
+	SourceStatusMoved   SourceStatus = "moved"
+	SourceStatusChanged SourceStatus = "changed"
+)
+
+func (ss SourceStatus) String() string {
+	return string(ss)
+}
+
+// SourceStatusValidator is a validator for the "source_status" field enum values. It is called by the builders before save.
+func SourceStatusValidator(ss SourceStatus) error {
+	switch ss {
+	case SourceStatusAlive, SourceStatusDeleted, SourceStatusMoved, SourceStatusChanged:
+		return nil
+	default:
+		return fmt.Errorf("article: invalid enum value for source_status field: %q", ss)
+	}
+}
+
 // OrderOption defines the ordering options for the Article queries.
 type OrderOption func(*sql.Selector)
 
@@ -112,11 +275,51 @@ func ByContent(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldContent, opts...).ToFunc()
 }
 
+// ByContentText orders the results by the content_text field.
+func ByContentText(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldContentText, opts...).ToFunc()
+}
+
+// ByWordCount orders the results by the word_count field.
+func ByWordCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldWordCount, opts...).ToFunc()
+}
+
+// ByReadingMinutes orders the results by the reading_minutes field.
+func ByReadingMinutes(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReadingMinutes, opts...).ToFunc()
+}
+
+// ByLanguage orders the results by the language field.
+func ByLanguage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLanguage, opts...).ToFunc()
+}
+
+// ByImageCount orders the results by the image_count field.
+func ByImageCount(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldImageCount, opts...).ToFunc()
+}
+
+// ByLeadImage orders the results by the lead_image field.
+func ByLeadImage(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldLeadImage, opts...).ToFunc()
+}
+
 // ByURL orders the results by the url field.
 func ByURL(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldURL, opts...).ToFunc()
 }
 
+// ByCanonicalURL orders the results by the canonical_url field.
+func ByCanonicalURL(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldCanonicalURL, opts...).ToFunc()
+}
+
+// ByFingerprint orders the results by the fingerprint field.
+func ByFingerprint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFingerprint, opts...).ToFunc()
+}
+
 // ByAuthor orders the results by the author field.
 func ByAuthor(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldAuthor, opts...).ToFunc()
@@ -137,6 +340,56 @@ func ByPublishedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldPublishedAt, opts...).ToFunc()
 }
 
+// ByUserID orders the results by the user_id field.
+func ByUserID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldUserID, opts...).ToFunc()
+}
+
+// ByClusterID orders the results by the cluster_id field.
+func ByClusterID(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClusterID, opts...).ToFunc()
+}
+
+// ByClusterCheckedAt orders the results by the cluster_checked_at field.
+func ByClusterCheckedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldClusterCheckedAt, opts...).ToFunc()
+}
+
+// ByFetchStatus orders the results by the fetch_status field.
+func ByFetchStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFetchStatus, opts...).ToFunc()
+}
+
+// ByFetchError orders the results by the fetch_error field.
+func ByFetchError(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldFetchError, opts...).ToFunc()
+}
+
+// ByReadAt orders the results by the read_at field.
+func ByReadAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldReadAt, opts...).ToFunc()
+}
+
+// BySourceStatus orders the results by the source_status field.
+func BySourceStatus(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceStatus, opts...).ToFunc()
+}
+
+// BySourceMovedTo orders the results by the source_moved_to field.
+func BySourceMovedTo(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceMovedTo, opts...).ToFunc()
+}
+
+// BySourceCheckedAt orders the results by the source_checked_at field.
+func BySourceCheckedAt(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceCheckedAt, opts...).ToFunc()
+}
+
+// BySourceFingerprint orders the results by the source_fingerprint field.
+func BySourceFingerprint(opts ...sql.OrderTermOption) OrderOption {
+	return sql.OrderByField(FieldSourceFingerprint, opts...).ToFunc()
+}
+
 // ByCreatedAt orders the results by the created_at field.
 func ByCreatedAt(opts ...sql.OrderTermOption) OrderOption {
 	return sql.OrderByField(FieldCreatedAt, opts...).ToFunc()
@@ -153,6 +406,97 @@ func ByUserField(field string, opts ...sql.OrderTermOption) OrderOption {
 		sqlgraph.OrderByNeighborTerms(s, newUserStep(), sql.OrderByField(field, opts...))
 	}
 }
+
+// ByClusterField orders the results by cluster field.
+func ByClusterField(field string, opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newClusterStep(), sql.OrderByField(field, opts...))
+	}
+}
+
+// ByChunksCount orders the results by chunks count.
+func ByChunksCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newChunksStep(), opts...)
+	}
+}
+
+// ByChunks orders the results by chunks terms.
+func ByChunks(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newChunksStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByHighlightsCount orders the results by highlights count.
+func ByHighlightsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newHighlightsStep(), opts...)
+	}
+}
+
+// ByHighlights orders the results by highlights terms.
+func ByHighlights(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newHighlightsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// BySnapshotsCount orders the results by snapshots count.
+func BySnapshotsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newSnapshotsStep(), opts...)
+	}
+}
+
+// BySnapshots orders the results by snapshots terms.
+func BySnapshots(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newSnapshotsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByRevisionsCount orders the results by revisions count.
+func ByRevisionsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newRevisionsStep(), opts...)
+	}
+}
+
+// ByRevisions orders the results by revisions terms.
+func ByRevisions(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newRevisionsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByTranslationsCount orders the results by translations count.
+func ByTranslationsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newTranslationsStep(), opts...)
+	}
+}
+
+// ByTranslations orders the results by translations terms.
+func ByTranslations(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newTranslationsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
+
+// ByEnrichmentsCount orders the results by enrichments count.
+func ByEnrichmentsCount(opts ...sql.OrderTermOption) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborsCount(s, newEnrichmentsStep(), opts...)
+	}
+}
+
+// ByEnrichments orders the results by enrichments terms.
+func ByEnrichments(term sql.OrderTerm, terms ...sql.OrderTerm) OrderOption {
+	return func(s *sql.Selector) {
+		sqlgraph.OrderByNeighborTerms(s, newEnrichmentsStep(), append([]sql.OrderTerm{term}, terms...)...)
+	}
+}
 func newUserStep() *sqlgraph.Step {
 	return sqlgraph.NewStep(
 		sqlgraph.From(Table, FieldID),
@@ -160,3 +504,52 @@ func newUserStep() *sqlgraph.Step {
 		sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
 	)
 }
+func newClusterStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ClusterInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.M2O, true, ClusterTable, ClusterColumn),
+	)
+}
+func newChunksStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(ChunksInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, ChunksTable, ChunksColumn),
+	)
+}
+func newHighlightsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(HighlightsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, HighlightsTable, HighlightsColumn),
+	)
+}
+func newSnapshotsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(SnapshotsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, SnapshotsTable, SnapshotsColumn),
+	)
+}
+func newRevisionsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(RevisionsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+	)
+}
+func newTranslationsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(TranslationsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, TranslationsTable, TranslationsColumn),
+	)
+}
+func newEnrichmentsStep() *sqlgraph.Step {
+	return sqlgraph.NewStep(
+		sqlgraph.From(Table, FieldID),
+		sqlgraph.To(EnrichmentsInverseTable, FieldID),
+		sqlgraph.Edge(sqlgraph.O2M, false, EnrichmentsTable, EnrichmentsColumn),
+	)
+}
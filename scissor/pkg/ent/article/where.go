@@ -65,11 +65,51 @@ func Content(v string) predicate.Article {
 	return predicate.Article(sql.FieldEQ(FieldContent, v))
 }
 
+// ContentText applies equality check predicate on the "content_text" field. It's identical to ContentTextEQ.
+func ContentText(v string) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldContentText, v))
+}
+
+// WordCount applies equality check predicate on the "word_count" field. It's identical to WordCountEQ.
+func WordCount(v int) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldWordCount, v))
+}
+
+// ReadingMinutes applies equality check predicate on the "reading_minutes" field. It's identical to ReadingMinutesEQ.
+func ReadingMinutes(v int) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldReadingMinutes, v))
+}
+
+// Language applies equality check predicate on the "language" field. It's identical to LanguageEQ.
+func Language(v string) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldLanguage, v))
+}
+
+// ImageCount applies equality check predicate on the "image_count" field. It's identical to ImageCountEQ.
+func ImageCount(v int) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldImageCount, v))
+}
+
+// LeadImage applies equality check predicate on the "lead_image" field. It's identical to LeadImageEQ.
+func LeadImage(v string) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldLeadImage, v))
+}
+
 // URL applies equality check predicate on the "url" field. It's identical to URLEQ.
 func URL(v string) predicate.Article {
 	return predicate.Article(sql.FieldEQ(FieldURL, v))
 }
 
+// CanonicalURL applies equality check predicate on the "canonical_url" field. It's identical to CanonicalURLEQ.
+func CanonicalURL(v string) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldCanonicalURL, v))
+}
+
+// Fingerprint applies equality check predicate on the "fingerprint" field. It's identical to FingerprintEQ.
+func Fingerprint(v uint64) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldFingerprint, v))
+}
+
 // Author applies equality check predicate on the "author" field. It's identical to AuthorEQ.
 func Author(v string) predicate.Article {
 	return predicate.Article(sql.FieldEQ(FieldAuthor, v))
@@ -90,6 +130,46 @@ func PublishedAt(v time.Time) predicate.Article {
 	return predicate.Article(sql.FieldEQ(FieldPublishedAt, v))
 }
 
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldUserID, v))
+}
+
+// ClusterID applies equality check predicate on the "cluster_id" field. It's identical to ClusterIDEQ.
+func ClusterID(v int) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldClusterID, v))
+}
+
+// ClusterCheckedAt applies equality check predicate on the "cluster_checked_at" field. It's identical to ClusterCheckedAtEQ.
+func ClusterCheckedAt(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldClusterCheckedAt, v))
+}
+
+// FetchError applies equality check predicate on the "fetch_error" field. It's identical to FetchErrorEQ.
+func FetchError(v string) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldFetchError, v))
+}
+
+// ReadAt applies equality check predicate on the "read_at" field. It's identical to ReadAtEQ.
+func ReadAt(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldReadAt, v))
+}
+
+// SourceMovedTo applies equality check predicate on the "source_moved_to" field. It's identical to SourceMovedToEQ.
+func SourceMovedTo(v string) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldSourceMovedTo, v))
+}
+
+// SourceCheckedAt applies equality check predicate on the "source_checked_at" field. It's identical to SourceCheckedAtEQ.
+func SourceCheckedAt(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldSourceCheckedAt, v))
+}
+
+// SourceFingerprint applies equality check predicate on the "source_fingerprint" field. It's identical to SourceFingerprintEQ.
+func SourceFingerprint(v uint64) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldSourceFingerprint, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.Article {
 	return predicate.Article(sql.FieldEQ(FieldCreatedAt, v))
@@ -230,6 +310,381 @@ func ContentContainsFold(v string) predicate.Article {
 	return predicate.Article(sql.FieldContainsFold(FieldContent, v))
 }
 
+// ContentTextEQ applies the EQ predicate on the "content_text" field.
+func ContentTextEQ(v string) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldContentText, v))
+}
+
+// ContentTextNEQ applies the NEQ predicate on the "content_text" field.
+func ContentTextNEQ(v string) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldContentText, v))
+}
+
+// ContentTextIn applies the In predicate on the "content_text" field.
+func ContentTextIn(vs ...string) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldContentText, vs...))
+}
+
+// ContentTextNotIn applies the NotIn predicate on the "content_text" field.
+func ContentTextNotIn(vs ...string) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldContentText, vs...))
+}
+
+// ContentTextGT applies the GT predicate on the "content_text" field.
+func ContentTextGT(v string) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldContentText, v))
+}
+
+// ContentTextGTE applies the GTE predicate on the "content_text" field.
+func ContentTextGTE(v string) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldContentText, v))
+}
+
+// ContentTextLT applies the LT predicate on the "content_text" field.
+func ContentTextLT(v string) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldContentText, v))
+}
+
+// ContentTextLTE applies the LTE predicate on the "content_text" field.
+func ContentTextLTE(v string) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldContentText, v))
+}
+
+// ContentTextContains applies the Contains predicate on the "content_text" field.
+func ContentTextContains(v string) predicate.Article {
+	return predicate.Article(sql.FieldContains(FieldContentText, v))
+}
+
+// ContentTextHasPrefix applies the HasPrefix predicate on the "content_text" field.
+func ContentTextHasPrefix(v string) predicate.Article {
+	return predicate.Article(sql.FieldHasPrefix(FieldContentText, v))
+}
+
+// ContentTextHasSuffix applies the HasSuffix predicate on the "content_text" field.
+func ContentTextHasSuffix(v string) predicate.Article {
+	return predicate.Article(sql.FieldHasSuffix(FieldContentText, v))
+}
+
+// ContentTextIsNil applies the IsNil predicate on the "content_text" field.
+func ContentTextIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldContentText))
+}
+
+// ContentTextNotNil applies the NotNil predicate on the "content_text" field.
+func ContentTextNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldContentText))
+}
+
+// ContentTextEqualFold applies the EqualFold predicate on the "content_text" field.
+func ContentTextEqualFold(v string) predicate.Article {
+	return predicate.Article(sql.FieldEqualFold(FieldContentText, v))
+}
+
+// ContentTextContainsFold applies the ContainsFold predicate on the "content_text" field.
+func ContentTextContainsFold(v string) predicate.Article {
+	return predicate.Article(sql.FieldContainsFold(FieldContentText, v))
+}
+
+// WordCountEQ applies the EQ predicate on the "word_count" field.
+func WordCountEQ(v int) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldWordCount, v))
+}
+
+// WordCountNEQ applies the NEQ predicate on the "word_count" field.
+func WordCountNEQ(v int) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldWordCount, v))
+}
+
+// WordCountIn applies the In predicate on the "word_count" field.
+func WordCountIn(vs ...int) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldWordCount, vs...))
+}
+
+// WordCountNotIn applies the NotIn predicate on the "word_count" field.
+func WordCountNotIn(vs ...int) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldWordCount, vs...))
+}
+
+// WordCountGT applies the GT predicate on the "word_count" field.
+func WordCountGT(v int) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldWordCount, v))
+}
+
+// WordCountGTE applies the GTE predicate on the "word_count" field.
+func WordCountGTE(v int) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldWordCount, v))
+}
+
+// WordCountLT applies the LT predicate on the "word_count" field.
+func WordCountLT(v int) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldWordCount, v))
+}
+
+// WordCountLTE applies the LTE predicate on the "word_count" field.
+func WordCountLTE(v int) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldWordCount, v))
+}
+
+// WordCountIsNil applies the IsNil predicate on the "word_count" field.
+func WordCountIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldWordCount))
+}
+
+// WordCountNotNil applies the NotNil predicate on the "word_count" field.
+func WordCountNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldWordCount))
+}
+
+// ReadingMinutesEQ applies the EQ predicate on the "reading_minutes" field.
+func ReadingMinutesEQ(v int) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldReadingMinutes, v))
+}
+
+// ReadingMinutesNEQ applies the NEQ predicate on the "reading_minutes" field.
+func ReadingMinutesNEQ(v int) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldReadingMinutes, v))
+}
+
+// ReadingMinutesIn applies the In predicate on the "reading_minutes" field.
+func ReadingMinutesIn(vs ...int) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldReadingMinutes, vs...))
+}
+
+// ReadingMinutesNotIn applies the NotIn predicate on the "reading_minutes" field.
+func ReadingMinutesNotIn(vs ...int) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldReadingMinutes, vs...))
+}
+
+// ReadingMinutesGT applies the GT predicate on the "reading_minutes" field.
+func ReadingMinutesGT(v int) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldReadingMinutes, v))
+}
+
+// ReadingMinutesGTE applies the GTE predicate on the "reading_minutes" field.
+func ReadingMinutesGTE(v int) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldReadingMinutes, v))
+}
+
+// ReadingMinutesLT applies the LT predicate on the "reading_minutes" field.
+func ReadingMinutesLT(v int) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldReadingMinutes, v))
+}
+
+// ReadingMinutesLTE applies the LTE predicate on the "reading_minutes" field.
+func ReadingMinutesLTE(v int) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldReadingMinutes, v))
+}
+
+// ReadingMinutesIsNil applies the IsNil predicate on the "reading_minutes" field.
+func ReadingMinutesIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldReadingMinutes))
+}
+
+// ReadingMinutesNotNil applies the NotNil predicate on the "reading_minutes" field.
+func ReadingMinutesNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldReadingMinutes))
+}
+
+// LanguageEQ applies the EQ predicate on the "language" field.
+func LanguageEQ(v string) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldLanguage, v))
+}
+
+// LanguageNEQ applies the NEQ predicate on the "language" field.
+func LanguageNEQ(v string) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldLanguage, v))
+}
+
+// LanguageIn applies the In predicate on the "language" field.
+func LanguageIn(vs ...string) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldLanguage, vs...))
+}
+
+// LanguageNotIn applies the NotIn predicate on the "language" field.
+func LanguageNotIn(vs ...string) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldLanguage, vs...))
+}
+
+// LanguageGT applies the GT predicate on the "language" field.
+func LanguageGT(v string) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldLanguage, v))
+}
+
+// LanguageGTE applies the GTE predicate on the "language" field.
+func LanguageGTE(v string) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldLanguage, v))
+}
+
+// LanguageLT applies the LT predicate on the "language" field.
+func LanguageLT(v string) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldLanguage, v))
+}
+
+// LanguageLTE applies the LTE predicate on the "language" field.
+func LanguageLTE(v string) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldLanguage, v))
+}
+
+// LanguageContains applies the Contains predicate on the "language" field.
+func LanguageContains(v string) predicate.Article {
+	return predicate.Article(sql.FieldContains(FieldLanguage, v))
+}
+
+// LanguageHasPrefix applies the HasPrefix predicate on the "language" field.
+func LanguageHasPrefix(v string) predicate.Article {
+	return predicate.Article(sql.FieldHasPrefix(FieldLanguage, v))
+}
+
+// LanguageHasSuffix applies the HasSuffix predicate on the "language" field.
+func LanguageHasSuffix(v string) predicate.Article {
+	return predicate.Article(sql.FieldHasSuffix(FieldLanguage, v))
+}
+
+// LanguageIsNil applies the IsNil predicate on the "language" field.
+func LanguageIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldLanguage))
+}
+
+// LanguageNotNil applies the NotNil predicate on the "language" field.
+func LanguageNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldLanguage))
+}
+
+// LanguageEqualFold applies the EqualFold predicate on the "language" field.
+func LanguageEqualFold(v string) predicate.Article {
+	return predicate.Article(sql.FieldEqualFold(FieldLanguage, v))
+}
+
+// LanguageContainsFold applies the ContainsFold predicate on the "language" field.
+func LanguageContainsFold(v string) predicate.Article {
+	return predicate.Article(sql.FieldContainsFold(FieldLanguage, v))
+}
+
+// ImageCountEQ applies the EQ predicate on the "image_count" field.
+func ImageCountEQ(v int) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldImageCount, v))
+}
+
+// ImageCountNEQ applies the NEQ predicate on the "image_count" field.
+func ImageCountNEQ(v int) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldImageCount, v))
+}
+
+// ImageCountIn applies the In predicate on the "image_count" field.
+func ImageCountIn(vs ...int) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldImageCount, vs...))
+}
+
+// ImageCountNotIn applies the NotIn predicate on the "image_count" field.
+func ImageCountNotIn(vs ...int) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldImageCount, vs...))
+}
+
+// ImageCountGT applies the GT predicate on the "image_count" field.
+func ImageCountGT(v int) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldImageCount, v))
+}
+
+// ImageCountGTE applies the GTE predicate on the "image_count" field.
+func ImageCountGTE(v int) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldImageCount, v))
+}
+
+// ImageCountLT applies the LT predicate on the "image_count" field.
+func ImageCountLT(v int) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldImageCount, v))
+}
+
+// ImageCountLTE applies the LTE predicate on the "image_count" field.
+func ImageCountLTE(v int) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldImageCount, v))
+}
+
+// ImageCountIsNil applies the IsNil predicate on the "image_count" field.
+func ImageCountIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldImageCount))
+}
+
+// ImageCountNotNil applies the NotNil predicate on the "image_count" field.
+func ImageCountNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldImageCount))
+}
+
+// LeadImageEQ applies the EQ predicate on the "lead_image" field.
+func LeadImageEQ(v string) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldLeadImage, v))
+}
+
+// LeadImageNEQ applies the NEQ predicate on the "lead_image" field.
+func LeadImageNEQ(v string) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldLeadImage, v))
+}
+
+// LeadImageIn applies the In predicate on the "lead_image" field.
+func LeadImageIn(vs ...string) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldLeadImage, vs...))
+}
+
+// LeadImageNotIn applies the NotIn predicate on the "lead_image" field.
+func LeadImageNotIn(vs ...string) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldLeadImage, vs...))
+}
+
+// LeadImageGT applies the GT predicate on the "lead_image" field.
+func LeadImageGT(v string) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldLeadImage, v))
+}
+
+// LeadImageGTE applies the GTE predicate on the "lead_image" field.
+func LeadImageGTE(v string) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldLeadImage, v))
+}
+
+// LeadImageLT applies the LT predicate on the "lead_image" field.
+func LeadImageLT(v string) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldLeadImage, v))
+}
+
+// LeadImageLTE applies the LTE predicate on the "lead_image" field.
+func LeadImageLTE(v string) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldLeadImage, v))
+}
+
+// LeadImageContains applies the Contains predicate on the "lead_image" field.
+func LeadImageContains(v string) predicate.Article {
+	return predicate.Article(sql.FieldContains(FieldLeadImage, v))
+}
+
+// LeadImageHasPrefix applies the HasPrefix predicate on the "lead_image" field.
+func LeadImageHasPrefix(v string) predicate.Article {
+	return predicate.Article(sql.FieldHasPrefix(FieldLeadImage, v))
+}
+
+// LeadImageHasSuffix applies the HasSuffix predicate on the "lead_image" field.
+func LeadImageHasSuffix(v string) predicate.Article {
+	return predicate.Article(sql.FieldHasSuffix(FieldLeadImage, v))
+}
+
+// LeadImageIsNil applies the IsNil predicate on the "lead_image" field.
+func LeadImageIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldLeadImage))
+}
+
+// LeadImageNotNil applies the NotNil predicate on the "lead_image" field.
+func LeadImageNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldLeadImage))
+}
+
+// LeadImageEqualFold applies the EqualFold predicate on the "lead_image" field.
+func LeadImageEqualFold(v string) predicate.Article {
+	return predicate.Article(sql.FieldEqualFold(FieldLeadImage, v))
+}
+
+// LeadImageContainsFold applies the ContainsFold predicate on the "lead_image" field.
+func LeadImageContainsFold(v string) predicate.Article {
+	return predicate.Article(sql.FieldContainsFold(FieldLeadImage, v))
+}
+
 // URLEQ applies the EQ predicate on the "url" field.
 func URLEQ(v string) predicate.Article {
 	return predicate.Article(sql.FieldEQ(FieldURL, v))
@@ -295,6 +750,131 @@ func URLContainsFold(v string) predicate.Article {
 	return predicate.Article(sql.FieldContainsFold(FieldURL, v))
 }
 
+// CanonicalURLEQ applies the EQ predicate on the "canonical_url" field.
+func CanonicalURLEQ(v string) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldCanonicalURL, v))
+}
+
+// CanonicalURLNEQ applies the NEQ predicate on the "canonical_url" field.
+func CanonicalURLNEQ(v string) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldCanonicalURL, v))
+}
+
+// CanonicalURLIn applies the In predicate on the "canonical_url" field.
+func CanonicalURLIn(vs ...string) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldCanonicalURL, vs...))
+}
+
+// CanonicalURLNotIn applies the NotIn predicate on the "canonical_url" field.
+func CanonicalURLNotIn(vs ...string) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldCanonicalURL, vs...))
+}
+
+// CanonicalURLGT applies the GT predicate on the "canonical_url" field.
+func CanonicalURLGT(v string) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldCanonicalURL, v))
+}
+
+// CanonicalURLGTE applies the GTE predicate on the "canonical_url" field.
+func CanonicalURLGTE(v string) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldCanonicalURL, v))
+}
+
+// CanonicalURLLT applies the LT predicate on the "canonical_url" field.
+func CanonicalURLLT(v string) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldCanonicalURL, v))
+}
+
+// CanonicalURLLTE applies the LTE predicate on the "canonical_url" field.
+func CanonicalURLLTE(v string) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldCanonicalURL, v))
+}
+
+// CanonicalURLContains applies the Contains predicate on the "canonical_url" field.
+func CanonicalURLContains(v string) predicate.Article {
+	return predicate.Article(sql.FieldContains(FieldCanonicalURL, v))
+}
+
+// CanonicalURLHasPrefix applies the HasPrefix predicate on the "canonical_url" field.
+func CanonicalURLHasPrefix(v string) predicate.Article {
+	return predicate.Article(sql.FieldHasPrefix(FieldCanonicalURL, v))
+}
+
+// CanonicalURLHasSuffix applies the HasSuffix predicate on the "canonical_url" field.
+func CanonicalURLHasSuffix(v string) predicate.Article {
+	return predicate.Article(sql.FieldHasSuffix(FieldCanonicalURL, v))
+}
+
+// CanonicalURLIsNil applies the IsNil predicate on the "canonical_url" field.
+func CanonicalURLIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldCanonicalURL))
+}
+
+// CanonicalURLNotNil applies the NotNil predicate on the "canonical_url" field.
+func CanonicalURLNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldCanonicalURL))
+}
+
+// CanonicalURLEqualFold applies the EqualFold predicate on the "canonical_url" field.
+func CanonicalURLEqualFold(v string) predicate.Article {
+	return predicate.Article(sql.FieldEqualFold(FieldCanonicalURL, v))
+}
+
+// CanonicalURLContainsFold applies the ContainsFold predicate on the "canonical_url" field.
+func CanonicalURLContainsFold(v string) predicate.Article {
+	return predicate.Article(sql.FieldContainsFold(FieldCanonicalURL, v))
+}
+
+// FingerprintEQ applies the EQ predicate on the "fingerprint" field.
+func FingerprintEQ(v uint64) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldFingerprint, v))
+}
+
+// FingerprintNEQ applies the NEQ predicate on the "fingerprint" field.
+func FingerprintNEQ(v uint64) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldFingerprint, v))
+}
+
+// FingerprintIn applies the In predicate on the "fingerprint" field.
+func FingerprintIn(vs ...uint64) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldFingerprint, vs...))
+}
+
+// FingerprintNotIn applies the NotIn predicate on the "fingerprint" field.
+func FingerprintNotIn(vs ...uint64) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldFingerprint, vs...))
+}
+
+// FingerprintGT applies the GT predicate on the "fingerprint" field.
+func FingerprintGT(v uint64) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldFingerprint, v))
+}
+
+// FingerprintGTE applies the GTE predicate on the "fingerprint" field.
+func FingerprintGTE(v uint64) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldFingerprint, v))
+}
+
+// FingerprintLT applies the LT predicate on the "fingerprint" field.
+func FingerprintLT(v uint64) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldFingerprint, v))
+}
+
+// FingerprintLTE applies the LTE predicate on the "fingerprint" field.
+func FingerprintLTE(v uint64) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldFingerprint, v))
+}
+
+// FingerprintIsNil applies the IsNil predicate on the "fingerprint" field.
+func FingerprintIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldFingerprint))
+}
+
+// FingerprintNotNil applies the NotNil predicate on the "fingerprint" field.
+func FingerprintNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldFingerprint))
+}
+
 // AuthorEQ applies the EQ predicate on the "author" field.
 func AuthorEQ(v string) predicate.Article {
 	return predicate.Article(sql.FieldEQ(FieldAuthor, v))
@@ -550,44 +1130,504 @@ func PublishedAtLTE(v time.Time) predicate.Article {
 	return predicate.Article(sql.FieldLTE(FieldPublishedAt, v))
 }
 
-// CreatedAtEQ applies the EQ predicate on the "created_at" field.
-func CreatedAtEQ(v time.Time) predicate.Article {
-	return predicate.Article(sql.FieldEQ(FieldCreatedAt, v))
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldUserID, v))
 }
 
-// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
-func CreatedAtNEQ(v time.Time) predicate.Article {
-	return predicate.Article(sql.FieldNEQ(FieldCreatedAt, v))
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldUserID, v))
 }
 
-// CreatedAtIn applies the In predicate on the "created_at" field.
-func CreatedAtIn(vs ...time.Time) predicate.Article {
-	return predicate.Article(sql.FieldIn(FieldCreatedAt, vs...))
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldUserID, vs...))
 }
 
-// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
-func CreatedAtNotIn(vs ...time.Time) predicate.Article {
-	return predicate.Article(sql.FieldNotIn(FieldCreatedAt, vs...))
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldUserID, vs...))
 }
 
-// CreatedAtGT applies the GT predicate on the "created_at" field.
-func CreatedAtGT(v time.Time) predicate.Article {
-	return predicate.Article(sql.FieldGT(FieldCreatedAt, v))
+// ClusterIDEQ applies the EQ predicate on the "cluster_id" field.
+func ClusterIDEQ(v int) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldClusterID, v))
 }
 
-// CreatedAtGTE applies the GTE predicate on the "created_at" field.
-func CreatedAtGTE(v time.Time) predicate.Article {
-	return predicate.Article(sql.FieldGTE(FieldCreatedAt, v))
+// ClusterIDNEQ applies the NEQ predicate on the "cluster_id" field.
+func ClusterIDNEQ(v int) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldClusterID, v))
 }
 
-// CreatedAtLT applies the LT predicate on the "created_at" field.
-func CreatedAtLT(v time.Time) predicate.Article {
-	return predicate.Article(sql.FieldLT(FieldCreatedAt, v))
+// ClusterIDIn applies the In predicate on the "cluster_id" field.
+func ClusterIDIn(vs ...int) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldClusterID, vs...))
 }
 
-// CreatedAtLTE applies the LTE predicate on the "created_at" field.
-func CreatedAtLTE(v time.Time) predicate.Article {
-	return predicate.Article(sql.FieldLTE(FieldCreatedAt, v))
+// ClusterIDNotIn applies the NotIn predicate on the "cluster_id" field.
+func ClusterIDNotIn(vs ...int) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldClusterID, vs...))
+}
+
+// ClusterIDIsNil applies the IsNil predicate on the "cluster_id" field.
+func ClusterIDIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldClusterID))
+}
+
+// ClusterIDNotNil applies the NotNil predicate on the "cluster_id" field.
+func ClusterIDNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldClusterID))
+}
+
+// ClusterCheckedAtEQ applies the EQ predicate on the "cluster_checked_at" field.
+func ClusterCheckedAtEQ(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldClusterCheckedAt, v))
+}
+
+// ClusterCheckedAtNEQ applies the NEQ predicate on the "cluster_checked_at" field.
+func ClusterCheckedAtNEQ(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldClusterCheckedAt, v))
+}
+
+// ClusterCheckedAtIn applies the In predicate on the "cluster_checked_at" field.
+func ClusterCheckedAtIn(vs ...time.Time) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldClusterCheckedAt, vs...))
+}
+
+// ClusterCheckedAtNotIn applies the NotIn predicate on the "cluster_checked_at" field.
+func ClusterCheckedAtNotIn(vs ...time.Time) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldClusterCheckedAt, vs...))
+}
+
+// ClusterCheckedAtGT applies the GT predicate on the "cluster_checked_at" field.
+func ClusterCheckedAtGT(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldClusterCheckedAt, v))
+}
+
+// ClusterCheckedAtGTE applies the GTE predicate on the "cluster_checked_at" field.
+func ClusterCheckedAtGTE(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldClusterCheckedAt, v))
+}
+
+// ClusterCheckedAtLT applies the LT predicate on the "cluster_checked_at" field.
+func ClusterCheckedAtLT(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldClusterCheckedAt, v))
+}
+
+// ClusterCheckedAtLTE applies the LTE predicate on the "cluster_checked_at" field.
+func ClusterCheckedAtLTE(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldClusterCheckedAt, v))
+}
+
+// ClusterCheckedAtIsNil applies the IsNil predicate on the "cluster_checked_at" field.
+func ClusterCheckedAtIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldClusterCheckedAt))
+}
+
+// ClusterCheckedAtNotNil applies the NotNil predicate on the "cluster_checked_at" field.
+func ClusterCheckedAtNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldClusterCheckedAt))
+}
+
+// FetchStatusEQ applies the EQ predicate on the "fetch_status" field.
+func FetchStatusEQ(v FetchStatus) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldFetchStatus, v))
+}
+
+// FetchStatusNEQ applies the NEQ predicate on the "fetch_status" field.
+func FetchStatusNEQ(v FetchStatus) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldFetchStatus, v))
+}
+
+// FetchStatusIn applies the In predicate on the "fetch_status" field.
+func FetchStatusIn(vs ...FetchStatus) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldFetchStatus, vs...))
+}
+
+// FetchStatusNotIn applies the NotIn predicate on the "fetch_status" field.
+func FetchStatusNotIn(vs ...FetchStatus) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldFetchStatus, vs...))
+}
+
+// FetchStatusIsNil applies the IsNil predicate on the "fetch_status" field.
+func FetchStatusIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldFetchStatus))
+}
+
+// FetchStatusNotNil applies the NotNil predicate on the "fetch_status" field.
+func FetchStatusNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldFetchStatus))
+}
+
+// FetchErrorEQ applies the EQ predicate on the "fetch_error" field.
+func FetchErrorEQ(v string) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldFetchError, v))
+}
+
+// FetchErrorNEQ applies the NEQ predicate on the "fetch_error" field.
+func FetchErrorNEQ(v string) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldFetchError, v))
+}
+
+// FetchErrorIn applies the In predicate on the "fetch_error" field.
+func FetchErrorIn(vs ...string) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldFetchError, vs...))
+}
+
+// FetchErrorNotIn applies the NotIn predicate on the "fetch_error" field.
+func FetchErrorNotIn(vs ...string) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldFetchError, vs...))
+}
+
+// FetchErrorGT applies the GT predicate on the "fetch_error" field.
+func FetchErrorGT(v string) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldFetchError, v))
+}
+
+// FetchErrorGTE applies the GTE predicate on the "fetch_error" field.
+func FetchErrorGTE(v string) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldFetchError, v))
+}
+
+// FetchErrorLT applies the LT predicate on the "fetch_error" field.
+func FetchErrorLT(v string) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldFetchError, v))
+}
+
+// FetchErrorLTE applies the LTE predicate on the "fetch_error" field.
+func FetchErrorLTE(v string) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldFetchError, v))
+}
+
+// FetchErrorContains applies the Contains predicate on the "fetch_error" field.
+func FetchErrorContains(v string) predicate.Article {
+	return predicate.Article(sql.FieldContains(FieldFetchError, v))
+}
+
+// FetchErrorHasPrefix applies the HasPrefix predicate on the "fetch_error" field.
+func FetchErrorHasPrefix(v string) predicate.Article {
+	return predicate.Article(sql.FieldHasPrefix(FieldFetchError, v))
+}
+
+// FetchErrorHasSuffix applies the HasSuffix predicate on the "fetch_error" field.
+func FetchErrorHasSuffix(v string) predicate.Article {
+	return predicate.Article(sql.FieldHasSuffix(FieldFetchError, v))
+}
+
+// FetchErrorIsNil applies the IsNil predicate on the "fetch_error" field.
+func FetchErrorIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldFetchError))
+}
+
+// FetchErrorNotNil applies the NotNil predicate on the "fetch_error" field.
+func FetchErrorNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldFetchError))
+}
+
+// FetchErrorEqualFold applies the EqualFold predicate on the "fetch_error" field.
+func FetchErrorEqualFold(v string) predicate.Article {
+	return predicate.Article(sql.FieldEqualFold(FieldFetchError, v))
+}
+
+// FetchErrorContainsFold applies the ContainsFold predicate on the "fetch_error" field.
+func FetchErrorContainsFold(v string) predicate.Article {
+	return predicate.Article(sql.FieldContainsFold(FieldFetchError, v))
+}
+
+// ReadAtEQ applies the EQ predicate on the "read_at" field.
+func ReadAtEQ(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldReadAt, v))
+}
+
+// ReadAtNEQ applies the NEQ predicate on the "read_at" field.
+func ReadAtNEQ(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldReadAt, v))
+}
+
+// ReadAtIn applies the In predicate on the "read_at" field.
+func ReadAtIn(vs ...time.Time) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldReadAt, vs...))
+}
+
+// ReadAtNotIn applies the NotIn predicate on the "read_at" field.
+func ReadAtNotIn(vs ...time.Time) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldReadAt, vs...))
+}
+
+// ReadAtGT applies the GT predicate on the "read_at" field.
+func ReadAtGT(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldReadAt, v))
+}
+
+// ReadAtGTE applies the GTE predicate on the "read_at" field.
+func ReadAtGTE(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldReadAt, v))
+}
+
+// ReadAtLT applies the LT predicate on the "read_at" field.
+func ReadAtLT(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldReadAt, v))
+}
+
+// ReadAtLTE applies the LTE predicate on the "read_at" field.
+func ReadAtLTE(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldReadAt, v))
+}
+
+// ReadAtIsNil applies the IsNil predicate on the "read_at" field.
+func ReadAtIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldReadAt))
+}
+
+// ReadAtNotNil applies the NotNil predicate on the "read_at" field.
+func ReadAtNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldReadAt))
+}
+
+// SourceStatusEQ applies the EQ predicate on the "source_status" field.
+func SourceStatusEQ(v SourceStatus) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldSourceStatus, v))
+}
+
+// SourceStatusNEQ applies the NEQ predicate on the "source_status" field.
+func SourceStatusNEQ(v SourceStatus) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldSourceStatus, v))
+}
+
+// SourceStatusIn applies the In predicate on the "source_status" field.
+func SourceStatusIn(vs ...SourceStatus) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldSourceStatus, vs...))
+}
+
+// SourceStatusNotIn applies the NotIn predicate on the "source_status" field.
+func SourceStatusNotIn(vs ...SourceStatus) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldSourceStatus, vs...))
+}
+
+// SourceStatusIsNil applies the IsNil predicate on the "source_status" field.
+func SourceStatusIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldSourceStatus))
+}
+
+// SourceStatusNotNil applies the NotNil predicate on the "source_status" field.
+func SourceStatusNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldSourceStatus))
+}
+
+// SourceMovedToEQ applies the EQ predicate on the "source_moved_to" field.
+func SourceMovedToEQ(v string) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldSourceMovedTo, v))
+}
+
+// SourceMovedToNEQ applies the NEQ predicate on the "source_moved_to" field.
+func SourceMovedToNEQ(v string) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldSourceMovedTo, v))
+}
+
+// SourceMovedToIn applies the In predicate on the "source_moved_to" field.
+func SourceMovedToIn(vs ...string) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldSourceMovedTo, vs...))
+}
+
+// SourceMovedToNotIn applies the NotIn predicate on the "source_moved_to" field.
+func SourceMovedToNotIn(vs ...string) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldSourceMovedTo, vs...))
+}
+
+// SourceMovedToGT applies the GT predicate on the "source_moved_to" field.
+func SourceMovedToGT(v string) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldSourceMovedTo, v))
+}
+
+// SourceMovedToGTE applies the GTE predicate on the "source_moved_to" field.
+func SourceMovedToGTE(v string) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldSourceMovedTo, v))
+}
+
+// SourceMovedToLT applies the LT predicate on the "source_moved_to" field.
+func SourceMovedToLT(v string) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldSourceMovedTo, v))
+}
+
+// SourceMovedToLTE applies the LTE predicate on the "source_moved_to" field.
+func SourceMovedToLTE(v string) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldSourceMovedTo, v))
+}
+
+// SourceMovedToContains applies the Contains predicate on the "source_moved_to" field.
+func SourceMovedToContains(v string) predicate.Article {
+	return predicate.Article(sql.FieldContains(FieldSourceMovedTo, v))
+}
+
+// SourceMovedToHasPrefix applies the HasPrefix predicate on the "source_moved_to" field.
+func SourceMovedToHasPrefix(v string) predicate.Article {
+	return predicate.Article(sql.FieldHasPrefix(FieldSourceMovedTo, v))
+}
+
+// SourceMovedToHasSuffix applies the HasSuffix predicate on the "source_moved_to" field.
+func SourceMovedToHasSuffix(v string) predicate.Article {
+	return predicate.Article(sql.FieldHasSuffix(FieldSourceMovedTo, v))
+}
+
+// SourceMovedToIsNil applies the IsNil predicate on the "source_moved_to" field.
+func SourceMovedToIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldSourceMovedTo))
+}
+
+// SourceMovedToNotNil applies the NotNil predicate on the "source_moved_to" field.
+func SourceMovedToNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldSourceMovedTo))
+}
+
+// SourceMovedToEqualFold applies the EqualFold predicate on the "source_moved_to" field.
+func SourceMovedToEqualFold(v string) predicate.Article {
+	return predicate.Article(sql.FieldEqualFold(FieldSourceMovedTo, v))
+}
+
+// SourceMovedToContainsFold applies the ContainsFold predicate on the "source_moved_to" field.
+func SourceMovedToContainsFold(v string) predicate.Article {
+	return predicate.Article(sql.FieldContainsFold(FieldSourceMovedTo, v))
+}
+
+// SourceCheckedAtEQ applies the EQ predicate on the "source_checked_at" field.
+func SourceCheckedAtEQ(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldSourceCheckedAt, v))
+}
+
+// SourceCheckedAtNEQ applies the NEQ predicate on the "source_checked_at" field.
+func SourceCheckedAtNEQ(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldSourceCheckedAt, v))
+}
+
+// SourceCheckedAtIn applies the In predicate on the "source_checked_at" field.
+func SourceCheckedAtIn(vs ...time.Time) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldSourceCheckedAt, vs...))
+}
+
+// SourceCheckedAtNotIn applies the NotIn predicate on the "source_checked_at" field.
+func SourceCheckedAtNotIn(vs ...time.Time) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldSourceCheckedAt, vs...))
+}
+
+// SourceCheckedAtGT applies the GT predicate on the "source_checked_at" field.
+func SourceCheckedAtGT(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldSourceCheckedAt, v))
+}
+
+// SourceCheckedAtGTE applies the GTE predicate on the "source_checked_at" field.
+func SourceCheckedAtGTE(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldSourceCheckedAt, v))
+}
+
+// SourceCheckedAtLT applies the LT predicate on the "source_checked_at" field.
+func SourceCheckedAtLT(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldSourceCheckedAt, v))
+}
+
+// SourceCheckedAtLTE applies the LTE predicate on the "source_checked_at" field.
+func SourceCheckedAtLTE(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldSourceCheckedAt, v))
+}
+
+// SourceCheckedAtIsNil applies the IsNil predicate on the "source_checked_at" field.
+func SourceCheckedAtIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldSourceCheckedAt))
+}
+
+// SourceCheckedAtNotNil applies the NotNil predicate on the "source_checked_at" field.
+func SourceCheckedAtNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldSourceCheckedAt))
+}
+
+// SourceFingerprintEQ applies the EQ predicate on the "source_fingerprint" field.
+func SourceFingerprintEQ(v uint64) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldSourceFingerprint, v))
+}
+
+// SourceFingerprintNEQ applies the NEQ predicate on the "source_fingerprint" field.
+func SourceFingerprintNEQ(v uint64) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldSourceFingerprint, v))
+}
+
+// SourceFingerprintIn applies the In predicate on the "source_fingerprint" field.
+func SourceFingerprintIn(vs ...uint64) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldSourceFingerprint, vs...))
+}
+
+// SourceFingerprintNotIn applies the NotIn predicate on the "source_fingerprint" field.
+func SourceFingerprintNotIn(vs ...uint64) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldSourceFingerprint, vs...))
+}
+
+// SourceFingerprintGT applies the GT predicate on the "source_fingerprint" field.
+func SourceFingerprintGT(v uint64) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldSourceFingerprint, v))
+}
+
+// SourceFingerprintGTE applies the GTE predicate on the "source_fingerprint" field.
+func SourceFingerprintGTE(v uint64) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldSourceFingerprint, v))
+}
+
+// SourceFingerprintLT applies the LT predicate on the "source_fingerprint" field.
+func SourceFingerprintLT(v uint64) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldSourceFingerprint, v))
+}
+
+// SourceFingerprintLTE applies the LTE predicate on the "source_fingerprint" field.
+func SourceFingerprintLTE(v uint64) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldSourceFingerprint, v))
+}
+
+// SourceFingerprintIsNil applies the IsNil predicate on the "source_fingerprint" field.
+func SourceFingerprintIsNil() predicate.Article {
+	return predicate.Article(sql.FieldIsNull(FieldSourceFingerprint))
+}
+
+// SourceFingerprintNotNil applies the NotNil predicate on the "source_fingerprint" field.
+func SourceFingerprintNotNil() predicate.Article {
+	return predicate.Article(sql.FieldNotNull(FieldSourceFingerprint))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Article {
+	return predicate.Article(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Article {
+	return predicate.Article(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Article {
+	return predicate.Article(sql.FieldLTE(FieldCreatedAt, v))
 }
 
 // UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
@@ -653,6 +1693,167 @@ func HasUserWith(preds ...predicate.User) predicate.Article {
 	})
 }
 
+// HasCluster applies the HasEdge predicate on the "cluster" edge.
+func HasCluster() predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ClusterTable, ClusterColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasClusterWith applies the HasEdge predicate on the "cluster" edge with a given conditions (other predicates).
+func HasClusterWith(preds ...predicate.TopicCluster) predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := newClusterStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasChunks applies the HasEdge predicate on the "chunks" edge.
+func HasChunks() predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ChunksTable, ChunksColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasChunksWith applies the HasEdge predicate on the "chunks" edge with a given conditions (other predicates).
+func HasChunksWith(preds ...predicate.ArticleChunk) predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := newChunksStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasHighlights applies the HasEdge predicate on the "highlights" edge.
+func HasHighlights() predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, HighlightsTable, HighlightsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasHighlightsWith applies the HasEdge predicate on the "highlights" edge with a given conditions (other predicates).
+func HasHighlightsWith(preds ...predicate.Highlight) predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := newHighlightsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasSnapshots applies the HasEdge predicate on the "snapshots" edge.
+func HasSnapshots() predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, SnapshotsTable, SnapshotsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasSnapshotsWith applies the HasEdge predicate on the "snapshots" edge with a given conditions (other predicates).
+func HasSnapshotsWith(preds ...predicate.Snapshot) predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := newSnapshotsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasRevisions applies the HasEdge predicate on the "revisions" edge.
+func HasRevisions() predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, RevisionsTable, RevisionsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasRevisionsWith applies the HasEdge predicate on the "revisions" edge with a given conditions (other predicates).
+func HasRevisionsWith(preds ...predicate.ArticleRevision) predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := newRevisionsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasTranslations applies the HasEdge predicate on the "translations" edge.
+func HasTranslations() predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, TranslationsTable, TranslationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasTranslationsWith applies the HasEdge predicate on the "translations" edge with a given conditions (other predicates).
+func HasTranslationsWith(preds ...predicate.ArticleTranslation) predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := newTranslationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasEnrichments applies the HasEdge predicate on the "enrichments" edge.
+func HasEnrichments() predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, EnrichmentsTable, EnrichmentsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasEnrichmentsWith applies the HasEdge predicate on the "enrichments" edge with a given conditions (other predicates).
+func HasEnrichmentsWith(preds ...predicate.ArticleEnrichment) predicate.Article {
+	return predicate.Article(func(s *sql.Selector) {
+		step := newEnrichmentsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.Article) predicate.Article {
 	return predicate.Article(sql.AndPredicates(predicates...))
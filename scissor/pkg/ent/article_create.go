@@ -11,6 +11,13 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articlechunk"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articleenrichment"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articlerevision"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articletranslation"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/highlight"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/snapshot"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/topiccluster"
 	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
 )
 
@@ -33,12 +40,124 @@ func (ac *ArticleCreate) SetContent(s string) *ArticleCreate {
 	return ac
 }
 
+// SetContentText sets the "content_text" field.
+func (ac *ArticleCreate) SetContentText(s string) *ArticleCreate {
+	ac.mutation.SetContentText(s)
+	return ac
+}
+
+// SetNillableContentText sets the "content_text" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableContentText(s *string) *ArticleCreate {
+	if s != nil {
+		ac.SetContentText(*s)
+	}
+	return ac
+}
+
+// SetWordCount sets the "word_count" field.
+func (ac *ArticleCreate) SetWordCount(i int) *ArticleCreate {
+	ac.mutation.SetWordCount(i)
+	return ac
+}
+
+// SetNillableWordCount sets the "word_count" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableWordCount(i *int) *ArticleCreate {
+	if i != nil {
+		ac.SetWordCount(*i)
+	}
+	return ac
+}
+
+// SetReadingMinutes sets the "reading_minutes" field.
+func (ac *ArticleCreate) SetReadingMinutes(i int) *ArticleCreate {
+	ac.mutation.SetReadingMinutes(i)
+	return ac
+}
+
+// SetNillableReadingMinutes sets the "reading_minutes" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableReadingMinutes(i *int) *ArticleCreate {
+	if i != nil {
+		ac.SetReadingMinutes(*i)
+	}
+	return ac
+}
+
+// SetLanguage sets the "language" field.
+func (ac *ArticleCreate) SetLanguage(s string) *ArticleCreate {
+	ac.mutation.SetLanguage(s)
+	return ac
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableLanguage(s *string) *ArticleCreate {
+	if s != nil {
+		ac.SetLanguage(*s)
+	}
+	return ac
+}
+
+// SetImageCount sets the "image_count" field.
+func (ac *ArticleCreate) SetImageCount(i int) *ArticleCreate {
+	ac.mutation.SetImageCount(i)
+	return ac
+}
+
+// SetNillableImageCount sets the "image_count" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableImageCount(i *int) *ArticleCreate {
+	if i != nil {
+		ac.SetImageCount(*i)
+	}
+	return ac
+}
+
+// SetLeadImage sets the "lead_image" field.
+func (ac *ArticleCreate) SetLeadImage(s string) *ArticleCreate {
+	ac.mutation.SetLeadImage(s)
+	return ac
+}
+
+// SetNillableLeadImage sets the "lead_image" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableLeadImage(s *string) *ArticleCreate {
+	if s != nil {
+		ac.SetLeadImage(*s)
+	}
+	return ac
+}
+
 // SetURL sets the "url" field.
 func (ac *ArticleCreate) SetURL(s string) *ArticleCreate {
 	ac.mutation.SetURL(s)
 	return ac
 }
 
+// SetCanonicalURL sets the "canonical_url" field.
+func (ac *ArticleCreate) SetCanonicalURL(s string) *ArticleCreate {
+	ac.mutation.SetCanonicalURL(s)
+	return ac
+}
+
+// SetNillableCanonicalURL sets the "canonical_url" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableCanonicalURL(s *string) *ArticleCreate {
+	if s != nil {
+		ac.SetCanonicalURL(*s)
+	}
+	return ac
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (ac *ArticleCreate) SetFingerprint(u uint64) *ArticleCreate {
+	ac.mutation.SetFingerprint(u)
+	return ac
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableFingerprint(u *uint64) *ArticleCreate {
+	if u != nil {
+		ac.SetFingerprint(*u)
+	}
+	return ac
+}
+
 // SetAuthor sets the "author" field.
 func (ac *ArticleCreate) SetAuthor(s string) *ArticleCreate {
 	ac.mutation.SetAuthor(s)
@@ -77,6 +196,138 @@ func (ac *ArticleCreate) SetPublishedAt(t time.Time) *ArticleCreate {
 	return ac
 }
 
+// SetUserID sets the "user_id" field.
+func (ac *ArticleCreate) SetUserID(i int) *ArticleCreate {
+	ac.mutation.SetUserID(i)
+	return ac
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (ac *ArticleCreate) SetClusterID(i int) *ArticleCreate {
+	ac.mutation.SetClusterID(i)
+	return ac
+}
+
+// SetNillableClusterID sets the "cluster_id" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableClusterID(i *int) *ArticleCreate {
+	if i != nil {
+		ac.SetClusterID(*i)
+	}
+	return ac
+}
+
+// SetClusterCheckedAt sets the "cluster_checked_at" field.
+func (ac *ArticleCreate) SetClusterCheckedAt(t time.Time) *ArticleCreate {
+	ac.mutation.SetClusterCheckedAt(t)
+	return ac
+}
+
+// SetNillableClusterCheckedAt sets the "cluster_checked_at" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableClusterCheckedAt(t *time.Time) *ArticleCreate {
+	if t != nil {
+		ac.SetClusterCheckedAt(*t)
+	}
+	return ac
+}
+
+// SetFetchStatus sets the "fetch_status" field.
+func (ac *ArticleCreate) SetFetchStatus(as article.FetchStatus) *ArticleCreate {
+	ac.mutation.SetFetchStatus(as)
+	return ac
+}
+
+// SetNillableFetchStatus sets the "fetch_status" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableFetchStatus(as *article.FetchStatus) *ArticleCreate {
+	if as != nil {
+		ac.SetFetchStatus(*as)
+	}
+	return ac
+}
+
+// SetFetchError sets the "fetch_error" field.
+func (ac *ArticleCreate) SetFetchError(s string) *ArticleCreate {
+	ac.mutation.SetFetchError(s)
+	return ac
+}
+
+// SetNillableFetchError sets the "fetch_error" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableFetchError(s *string) *ArticleCreate {
+	if s != nil {
+		ac.SetFetchError(*s)
+	}
+	return ac
+}
+
+// SetReadAt sets the "read_at" field.
+func (ac *ArticleCreate) SetReadAt(t time.Time) *ArticleCreate {
+	ac.mutation.SetReadAt(t)
+	return ac
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableReadAt(t *time.Time) *ArticleCreate {
+	if t != nil {
+		ac.SetReadAt(*t)
+	}
+	return ac
+}
+
+// SetSourceStatus sets the "source_status" field.
+func (ac *ArticleCreate) SetSourceStatus(as article.SourceStatus) *ArticleCreate {
+	ac.mutation.SetSourceStatus(as)
+	return ac
+}
+
+// SetNillableSourceStatus sets the "source_status" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableSourceStatus(as *article.SourceStatus) *ArticleCreate {
+	if as != nil {
+		ac.SetSourceStatus(*as)
+	}
+	return ac
+}
+
+// SetSourceMovedTo sets the "source_moved_to" field.
+func (ac *ArticleCreate) SetSourceMovedTo(s string) *ArticleCreate {
+	ac.mutation.SetSourceMovedTo(s)
+	return ac
+}
+
+// SetNillableSourceMovedTo sets the "source_moved_to" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableSourceMovedTo(s *string) *ArticleCreate {
+	if s != nil {
+		ac.SetSourceMovedTo(*s)
+	}
+	return ac
+}
+
+// SetSourceCheckedAt sets the "source_checked_at" field.
+func (ac *ArticleCreate) SetSourceCheckedAt(t time.Time) *ArticleCreate {
+	ac.mutation.SetSourceCheckedAt(t)
+	return ac
+}
+
+// SetNillableSourceCheckedAt sets the "source_checked_at" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableSourceCheckedAt(t *time.Time) *ArticleCreate {
+	if t != nil {
+		ac.SetSourceCheckedAt(*t)
+	}
+	return ac
+}
+
+// SetSourceFingerprint sets the "source_fingerprint" field.
+func (ac *ArticleCreate) SetSourceFingerprint(u uint64) *ArticleCreate {
+	ac.mutation.SetSourceFingerprint(u)
+	return ac
+}
+
+// SetNillableSourceFingerprint sets the "source_fingerprint" field if the given value is not nil.
+func (ac *ArticleCreate) SetNillableSourceFingerprint(u *uint64) *ArticleCreate {
+	if u != nil {
+		ac.SetSourceFingerprint(*u)
+	}
+	return ac
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (ac *ArticleCreate) SetCreatedAt(t time.Time) *ArticleCreate {
 	ac.mutation.SetCreatedAt(t)
@@ -111,17 +362,106 @@ func (ac *ArticleCreate) SetID(u uint) *ArticleCreate {
 	return ac
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (ac *ArticleCreate) SetUserID(id int) *ArticleCreate {
-	ac.mutation.SetUserID(id)
-	return ac
-}
-
 // SetUser sets the "user" edge to the User entity.
 func (ac *ArticleCreate) SetUser(u *User) *ArticleCreate {
 	return ac.SetUserID(u.ID)
 }
 
+// SetCluster sets the "cluster" edge to the TopicCluster entity.
+func (ac *ArticleCreate) SetCluster(t *TopicCluster) *ArticleCreate {
+	return ac.SetClusterID(t.ID)
+}
+
+// AddChunkIDs adds the "chunks" edge to the ArticleChunk entity by IDs.
+func (ac *ArticleCreate) AddChunkIDs(ids ...int) *ArticleCreate {
+	ac.mutation.AddChunkIDs(ids...)
+	return ac
+}
+
+// AddChunks adds the "chunks" edges to the ArticleChunk entity.
+func (ac *ArticleCreate) AddChunks(a ...*ArticleChunk) *ArticleCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ac.AddChunkIDs(ids...)
+}
+
+// AddHighlightIDs adds the "highlights" edge to the Highlight entity by IDs.
+func (ac *ArticleCreate) AddHighlightIDs(ids ...int) *ArticleCreate {
+	ac.mutation.AddHighlightIDs(ids...)
+	return ac
+}
+
+// AddHighlights adds the "highlights" edges to the Highlight entity.
+func (ac *ArticleCreate) AddHighlights(h ...*Highlight) *ArticleCreate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return ac.AddHighlightIDs(ids...)
+}
+
+// AddSnapshotIDs adds the "snapshots" edge to the Snapshot entity by IDs.
+func (ac *ArticleCreate) AddSnapshotIDs(ids ...int) *ArticleCreate {
+	ac.mutation.AddSnapshotIDs(ids...)
+	return ac
+}
+
+// AddSnapshots adds the "snapshots" edges to the Snapshot entity.
+func (ac *ArticleCreate) AddSnapshots(s ...*Snapshot) *ArticleCreate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return ac.AddSnapshotIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the ArticleRevision entity by IDs.
+func (ac *ArticleCreate) AddRevisionIDs(ids ...int) *ArticleCreate {
+	ac.mutation.AddRevisionIDs(ids...)
+	return ac
+}
+
+// AddRevisions adds the "revisions" edges to the ArticleRevision entity.
+func (ac *ArticleCreate) AddRevisions(a ...*ArticleRevision) *ArticleCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ac.AddRevisionIDs(ids...)
+}
+
+// AddTranslationIDs adds the "translations" edge to the ArticleTranslation entity by IDs.
+func (ac *ArticleCreate) AddTranslationIDs(ids ...int) *ArticleCreate {
+	ac.mutation.AddTranslationIDs(ids...)
+	return ac
+}
+
+// AddTranslations adds the "translations" edges to the ArticleTranslation entity.
+func (ac *ArticleCreate) AddTranslations(a ...*ArticleTranslation) *ArticleCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ac.AddTranslationIDs(ids...)
+}
+
+// AddEnrichmentIDs adds the "enrichments" edge to the ArticleEnrichment entity by IDs.
+func (ac *ArticleCreate) AddEnrichmentIDs(ids ...int) *ArticleCreate {
+	ac.mutation.AddEnrichmentIDs(ids...)
+	return ac
+}
+
+// AddEnrichments adds the "enrichments" edges to the ArticleEnrichment entity.
+func (ac *ArticleCreate) AddEnrichments(a ...*ArticleEnrichment) *ArticleCreate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return ac.AddEnrichmentIDs(ids...)
+}
+
 // Mutation returns the ArticleMutation object of the builder.
 func (ac *ArticleCreate) Mutation() *ArticleMutation {
 	return ac.mutation
@@ -129,7 +469,9 @@ func (ac *ArticleCreate) Mutation() *ArticleMutation {
 
 // Save creates the Article in the database.
 func (ac *ArticleCreate) Save(ctx context.Context) (*Article, error) {
-	ac.defaults()
+	if err := ac.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, ac.sqlSave, ac.mutation, ac.hooks)
 }
 
@@ -156,15 +498,22 @@ func (ac *ArticleCreate) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (ac *ArticleCreate) defaults() {
+func (ac *ArticleCreate) defaults() error {
 	if _, ok := ac.mutation.CreatedAt(); !ok {
+		if article.DefaultCreatedAt == nil {
+			return fmt.Errorf("ent: uninitialized article.DefaultCreatedAt (forgotten import ent/runtime?)")
+		}
 		v := article.DefaultCreatedAt()
 		ac.mutation.SetCreatedAt(v)
 	}
 	if _, ok := ac.mutation.UpdatedAt(); !ok {
+		if article.DefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized article.DefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := article.DefaultUpdatedAt()
 		ac.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
@@ -187,6 +536,19 @@ func (ac *ArticleCreate) check() error {
 	if _, ok := ac.mutation.PublishedAt(); !ok {
 		return &ValidationError{Name: "published_at", err: errors.New(`ent: missing required field "Article.published_at"`)}
 	}
+	if _, ok := ac.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Article.user_id"`)}
+	}
+	if v, ok := ac.mutation.FetchStatus(); ok {
+		if err := article.FetchStatusValidator(v); err != nil {
+			return &ValidationError{Name: "fetch_status", err: fmt.Errorf(`ent: validator failed for field "Article.fetch_status": %w`, err)}
+		}
+	}
+	if v, ok := ac.mutation.SourceStatus(); ok {
+		if err := article.SourceStatusValidator(v); err != nil {
+			return &ValidationError{Name: "source_status", err: fmt.Errorf(`ent: validator failed for field "Article.source_status": %w`, err)}
+		}
+	}
 	if _, ok := ac.mutation.CreatedAt(); !ok {
 		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Article.created_at"`)}
 	}
@@ -241,10 +603,42 @@ func (ac *ArticleCreate) createSpec() (*Article, *sqlgraph.CreateSpec) {
 		_spec.SetField(article.FieldContent, field.TypeString, value)
 		_node.Content = value
 	}
+	if value, ok := ac.mutation.ContentText(); ok {
+		_spec.SetField(article.FieldContentText, field.TypeString, value)
+		_node.ContentText = &value
+	}
+	if value, ok := ac.mutation.WordCount(); ok {
+		_spec.SetField(article.FieldWordCount, field.TypeInt, value)
+		_node.WordCount = &value
+	}
+	if value, ok := ac.mutation.ReadingMinutes(); ok {
+		_spec.SetField(article.FieldReadingMinutes, field.TypeInt, value)
+		_node.ReadingMinutes = value
+	}
+	if value, ok := ac.mutation.Language(); ok {
+		_spec.SetField(article.FieldLanguage, field.TypeString, value)
+		_node.Language = value
+	}
+	if value, ok := ac.mutation.ImageCount(); ok {
+		_spec.SetField(article.FieldImageCount, field.TypeInt, value)
+		_node.ImageCount = value
+	}
+	if value, ok := ac.mutation.LeadImage(); ok {
+		_spec.SetField(article.FieldLeadImage, field.TypeString, value)
+		_node.LeadImage = value
+	}
 	if value, ok := ac.mutation.URL(); ok {
 		_spec.SetField(article.FieldURL, field.TypeString, value)
 		_node.URL = value
 	}
+	if value, ok := ac.mutation.CanonicalURL(); ok {
+		_spec.SetField(article.FieldCanonicalURL, field.TypeString, value)
+		_node.CanonicalURL = value
+	}
+	if value, ok := ac.mutation.Fingerprint(); ok {
+		_spec.SetField(article.FieldFingerprint, field.TypeUint64, value)
+		_node.Fingerprint = value
+	}
 	if value, ok := ac.mutation.Author(); ok {
 		_spec.SetField(article.FieldAuthor, field.TypeString, value)
 		_node.Author = value
@@ -265,6 +659,38 @@ func (ac *ArticleCreate) createSpec() (*Article, *sqlgraph.CreateSpec) {
 		_spec.SetField(article.FieldPublishedAt, field.TypeTime, value)
 		_node.PublishedAt = value
 	}
+	if value, ok := ac.mutation.ClusterCheckedAt(); ok {
+		_spec.SetField(article.FieldClusterCheckedAt, field.TypeTime, value)
+		_node.ClusterCheckedAt = &value
+	}
+	if value, ok := ac.mutation.FetchStatus(); ok {
+		_spec.SetField(article.FieldFetchStatus, field.TypeEnum, value)
+		_node.FetchStatus = value
+	}
+	if value, ok := ac.mutation.FetchError(); ok {
+		_spec.SetField(article.FieldFetchError, field.TypeString, value)
+		_node.FetchError = value
+	}
+	if value, ok := ac.mutation.ReadAt(); ok {
+		_spec.SetField(article.FieldReadAt, field.TypeTime, value)
+		_node.ReadAt = &value
+	}
+	if value, ok := ac.mutation.SourceStatus(); ok {
+		_spec.SetField(article.FieldSourceStatus, field.TypeEnum, value)
+		_node.SourceStatus = value
+	}
+	if value, ok := ac.mutation.SourceMovedTo(); ok {
+		_spec.SetField(article.FieldSourceMovedTo, field.TypeString, value)
+		_node.SourceMovedTo = value
+	}
+	if value, ok := ac.mutation.SourceCheckedAt(); ok {
+		_spec.SetField(article.FieldSourceCheckedAt, field.TypeTime, value)
+		_node.SourceCheckedAt = &value
+	}
+	if value, ok := ac.mutation.SourceFingerprint(); ok {
+		_spec.SetField(article.FieldSourceFingerprint, field.TypeUint64, value)
+		_node.SourceFingerprint = value
+	}
 	if value, ok := ac.mutation.CreatedAt(); ok {
 		_spec.SetField(article.FieldCreatedAt, field.TypeTime, value)
 		_node.CreatedAt = value
@@ -287,7 +713,120 @@ func (ac *ArticleCreate) createSpec() (*Article, *sqlgraph.CreateSpec) {
 		for _, k := range nodes {
 			edge.Target.Nodes = append(edge.Target.Nodes, k)
 		}
-		_node.user_articles = &nodes[0]
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.ClusterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   article.ClusterTable,
+			Columns: []string{article.ClusterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(topiccluster.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ClusterID = &nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.ChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.ChunksTable,
+			Columns: []string{article.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlechunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.HighlightsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.HighlightsTable,
+			Columns: []string{article.HighlightsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(highlight.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.SnapshotsTable,
+			Columns: []string{article.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.RevisionsTable,
+			Columns: []string{article.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlerevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.TranslationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.TranslationsTable,
+			Columns: []string{article.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articletranslation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := ac.mutation.EnrichmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.EnrichmentsTable,
+			Columns: []string{article.EnrichmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articleenrichment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
 		_spec.Edges = append(_spec.Edges, edge)
 	}
 	return _node, _spec
@@ -4,6 +4,7 @@ package ent
 
 import (
 	"context"
+	"database/sql/driver"
 	"fmt"
 	"math"
 
@@ -11,19 +12,32 @@ import (
 	"entgo.io/ent/dialect/sql/sqlgraph"
 	"entgo.io/ent/schema/field"
 	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articlechunk"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articleenrichment"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articlerevision"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articletranslation"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/highlight"
 	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/snapshot"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/topiccluster"
 	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
 )
 
 // ArticleQuery is the builder for querying Article entities.
 type ArticleQuery struct {
 	config
-	ctx        *QueryContext
-	order      []article.OrderOption
-	inters     []Interceptor
-	predicates []predicate.Article
-	withUser   *UserQuery
-	withFKs    bool
+	ctx              *QueryContext
+	order            []article.OrderOption
+	inters           []Interceptor
+	predicates       []predicate.Article
+	withUser         *UserQuery
+	withCluster      *TopicClusterQuery
+	withChunks       *ArticleChunkQuery
+	withHighlights   *HighlightQuery
+	withSnapshots    *SnapshotQuery
+	withRevisions    *ArticleRevisionQuery
+	withTranslations *ArticleTranslationQuery
+	withEnrichments  *ArticleEnrichmentQuery
 	// intermediate query (i.e. traversal path).
 	sql  *sql.Selector
 	path func(context.Context) (*sql.Selector, error)
@@ -82,6 +96,160 @@ func (aq *ArticleQuery) QueryUser() *UserQuery {
 	return query
 }
 
+// QueryCluster chains the current query on the "cluster" edge.
+func (aq *ArticleQuery) QueryCluster() *TopicClusterQuery {
+	query := (&TopicClusterClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(article.Table, article.FieldID, selector),
+			sqlgraph.To(topiccluster.Table, topiccluster.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, article.ClusterTable, article.ClusterColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryChunks chains the current query on the "chunks" edge.
+func (aq *ArticleQuery) QueryChunks() *ArticleChunkQuery {
+	query := (&ArticleChunkClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(article.Table, article.FieldID, selector),
+			sqlgraph.To(articlechunk.Table, articlechunk.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, article.ChunksTable, article.ChunksColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryHighlights chains the current query on the "highlights" edge.
+func (aq *ArticleQuery) QueryHighlights() *HighlightQuery {
+	query := (&HighlightClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(article.Table, article.FieldID, selector),
+			sqlgraph.To(highlight.Table, highlight.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, article.HighlightsTable, article.HighlightsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QuerySnapshots chains the current query on the "snapshots" edge.
+func (aq *ArticleQuery) QuerySnapshots() *SnapshotQuery {
+	query := (&SnapshotClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(article.Table, article.FieldID, selector),
+			sqlgraph.To(snapshot.Table, snapshot.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, article.SnapshotsTable, article.SnapshotsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryRevisions chains the current query on the "revisions" edge.
+func (aq *ArticleQuery) QueryRevisions() *ArticleRevisionQuery {
+	query := (&ArticleRevisionClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(article.Table, article.FieldID, selector),
+			sqlgraph.To(articlerevision.Table, articlerevision.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, article.RevisionsTable, article.RevisionsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryTranslations chains the current query on the "translations" edge.
+func (aq *ArticleQuery) QueryTranslations() *ArticleTranslationQuery {
+	query := (&ArticleTranslationClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(article.Table, article.FieldID, selector),
+			sqlgraph.To(articletranslation.Table, articletranslation.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, article.TranslationsTable, article.TranslationsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryEnrichments chains the current query on the "enrichments" edge.
+func (aq *ArticleQuery) QueryEnrichments() *ArticleEnrichmentQuery {
+	query := (&ArticleEnrichmentClient{config: aq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(article.Table, article.FieldID, selector),
+			sqlgraph.To(articleenrichment.Table, articleenrichment.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, article.EnrichmentsTable, article.EnrichmentsColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
 // First returns the first Article entity from the query.
 // Returns a *NotFoundError when no Article was found.
 func (aq *ArticleQuery) First(ctx context.Context) (*Article, error) {
@@ -269,12 +437,19 @@ func (aq *ArticleQuery) Clone() *ArticleQuery {
 		return nil
 	}
 	return &ArticleQuery{
-		config:     aq.config,
-		ctx:        aq.ctx.Clone(),
-		order:      append([]article.OrderOption{}, aq.order...),
-		inters:     append([]Interceptor{}, aq.inters...),
-		predicates: append([]predicate.Article{}, aq.predicates...),
-		withUser:   aq.withUser.Clone(),
+		config:           aq.config,
+		ctx:              aq.ctx.Clone(),
+		order:            append([]article.OrderOption{}, aq.order...),
+		inters:           append([]Interceptor{}, aq.inters...),
+		predicates:       append([]predicate.Article{}, aq.predicates...),
+		withUser:         aq.withUser.Clone(),
+		withCluster:      aq.withCluster.Clone(),
+		withChunks:       aq.withChunks.Clone(),
+		withHighlights:   aq.withHighlights.Clone(),
+		withSnapshots:    aq.withSnapshots.Clone(),
+		withRevisions:    aq.withRevisions.Clone(),
+		withTranslations: aq.withTranslations.Clone(),
+		withEnrichments:  aq.withEnrichments.Clone(),
 		// clone intermediate query.
 		sql:  aq.sql.Clone(),
 		path: aq.path,
@@ -292,6 +467,83 @@ func (aq *ArticleQuery) WithUser(opts ...func(*UserQuery)) *ArticleQuery {
 	return aq
 }
 
+// WithCluster tells the query-builder to eager-load the nodes that are connected to
+// the "cluster" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *ArticleQuery) WithCluster(opts ...func(*TopicClusterQuery)) *ArticleQuery {
+	query := (&TopicClusterClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withCluster = query
+	return aq
+}
+
+// WithChunks tells the query-builder to eager-load the nodes that are connected to
+// the "chunks" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *ArticleQuery) WithChunks(opts ...func(*ArticleChunkQuery)) *ArticleQuery {
+	query := (&ArticleChunkClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withChunks = query
+	return aq
+}
+
+// WithHighlights tells the query-builder to eager-load the nodes that are connected to
+// the "highlights" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *ArticleQuery) WithHighlights(opts ...func(*HighlightQuery)) *ArticleQuery {
+	query := (&HighlightClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withHighlights = query
+	return aq
+}
+
+// WithSnapshots tells the query-builder to eager-load the nodes that are connected to
+// the "snapshots" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *ArticleQuery) WithSnapshots(opts ...func(*SnapshotQuery)) *ArticleQuery {
+	query := (&SnapshotClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withSnapshots = query
+	return aq
+}
+
+// WithRevisions tells the query-builder to eager-load the nodes that are connected to
+// the "revisions" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *ArticleQuery) WithRevisions(opts ...func(*ArticleRevisionQuery)) *ArticleQuery {
+	query := (&ArticleRevisionClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withRevisions = query
+	return aq
+}
+
+// WithTranslations tells the query-builder to eager-load the nodes that are connected to
+// the "translations" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *ArticleQuery) WithTranslations(opts ...func(*ArticleTranslationQuery)) *ArticleQuery {
+	query := (&ArticleTranslationClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withTranslations = query
+	return aq
+}
+
+// WithEnrichments tells the query-builder to eager-load the nodes that are connected to
+// the "enrichments" edge. The optional arguments are used to configure the query builder of the edge.
+func (aq *ArticleQuery) WithEnrichments(opts ...func(*ArticleEnrichmentQuery)) *ArticleQuery {
+	query := (&ArticleEnrichmentClient{config: aq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aq.withEnrichments = query
+	return aq
+}
+
 // GroupBy is used to group vertices by one or more fields/columns.
 // It is often used with aggregate functions, like: count, max, mean, min, sum.
 //
@@ -369,18 +621,18 @@ func (aq *ArticleQuery) prepareQuery(ctx context.Context) error {
 func (aq *ArticleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Article, error) {
 	var (
 		nodes       = []*Article{}
-		withFKs     = aq.withFKs
 		_spec       = aq.querySpec()
-		loadedTypes = [1]bool{
+		loadedTypes = [8]bool{
 			aq.withUser != nil,
+			aq.withCluster != nil,
+			aq.withChunks != nil,
+			aq.withHighlights != nil,
+			aq.withSnapshots != nil,
+			aq.withRevisions != nil,
+			aq.withTranslations != nil,
+			aq.withEnrichments != nil,
 		}
 	)
-	if aq.withUser != nil {
-		withFKs = true
-	}
-	if withFKs {
-		_spec.Node.Columns = append(_spec.Node.Columns, article.ForeignKeys...)
-	}
 	_spec.ScanValues = func(columns []string) ([]any, error) {
 		return (*Article).scanValues(nil, columns)
 	}
@@ -405,6 +657,54 @@ func (aq *ArticleQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Arti
 			return nil, err
 		}
 	}
+	if query := aq.withCluster; query != nil {
+		if err := aq.loadCluster(ctx, query, nodes, nil,
+			func(n *Article, e *TopicCluster) { n.Edges.Cluster = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withChunks; query != nil {
+		if err := aq.loadChunks(ctx, query, nodes,
+			func(n *Article) { n.Edges.Chunks = []*ArticleChunk{} },
+			func(n *Article, e *ArticleChunk) { n.Edges.Chunks = append(n.Edges.Chunks, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withHighlights; query != nil {
+		if err := aq.loadHighlights(ctx, query, nodes,
+			func(n *Article) { n.Edges.Highlights = []*Highlight{} },
+			func(n *Article, e *Highlight) { n.Edges.Highlights = append(n.Edges.Highlights, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withSnapshots; query != nil {
+		if err := aq.loadSnapshots(ctx, query, nodes,
+			func(n *Article) { n.Edges.Snapshots = []*Snapshot{} },
+			func(n *Article, e *Snapshot) { n.Edges.Snapshots = append(n.Edges.Snapshots, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withRevisions; query != nil {
+		if err := aq.loadRevisions(ctx, query, nodes,
+			func(n *Article) { n.Edges.Revisions = []*ArticleRevision{} },
+			func(n *Article, e *ArticleRevision) { n.Edges.Revisions = append(n.Edges.Revisions, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withTranslations; query != nil {
+		if err := aq.loadTranslations(ctx, query, nodes,
+			func(n *Article) { n.Edges.Translations = []*ArticleTranslation{} },
+			func(n *Article, e *ArticleTranslation) { n.Edges.Translations = append(n.Edges.Translations, e) }); err != nil {
+			return nil, err
+		}
+	}
+	if query := aq.withEnrichments; query != nil {
+		if err := aq.loadEnrichments(ctx, query, nodes,
+			func(n *Article) { n.Edges.Enrichments = []*ArticleEnrichment{} },
+			func(n *Article, e *ArticleEnrichment) { n.Edges.Enrichments = append(n.Edges.Enrichments, e) }); err != nil {
+			return nil, err
+		}
+	}
 	return nodes, nil
 }
 
@@ -412,10 +712,39 @@ func (aq *ArticleQuery) loadUser(ctx context.Context, query *UserQuery, nodes []
 	ids := make([]int, 0, len(nodes))
 	nodeids := make(map[int][]*Article)
 	for i := range nodes {
-		if nodes[i].user_articles == nil {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (aq *ArticleQuery) loadCluster(ctx context.Context, query *TopicClusterQuery, nodes []*Article, init func(*Article), assign func(*Article, *TopicCluster)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*Article)
+	for i := range nodes {
+		if nodes[i].ClusterID == nil {
 			continue
 		}
-		fk := *nodes[i].user_articles
+		fk := *nodes[i].ClusterID
 		if _, ok := nodeids[fk]; !ok {
 			ids = append(ids, fk)
 		}
@@ -424,7 +753,7 @@ func (aq *ArticleQuery) loadUser(ctx context.Context, query *UserQuery, nodes []
 	if len(ids) == 0 {
 		return nil
 	}
-	query.Where(user.IDIn(ids...))
+	query.Where(topiccluster.IDIn(ids...))
 	neighbors, err := query.All(ctx)
 	if err != nil {
 		return err
@@ -432,7 +761,7 @@ func (aq *ArticleQuery) loadUser(ctx context.Context, query *UserQuery, nodes []
 	for _, n := range neighbors {
 		nodes, ok := nodeids[n.ID]
 		if !ok {
-			return fmt.Errorf(`unexpected foreign-key "user_articles" returned %v`, n.ID)
+			return fmt.Errorf(`unexpected foreign-key "cluster_id" returned %v`, n.ID)
 		}
 		for i := range nodes {
 			assign(nodes[i], n)
@@ -440,6 +769,186 @@ func (aq *ArticleQuery) loadUser(ctx context.Context, query *UserQuery, nodes []
 	}
 	return nil
 }
+func (aq *ArticleQuery) loadChunks(ctx context.Context, query *ArticleChunkQuery, nodes []*Article, init func(*Article), assign func(*Article, *ArticleChunk)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*Article)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(articlechunk.FieldArticleID)
+	}
+	query.Where(predicate.ArticleChunk(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(article.ChunksColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ArticleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "article_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *ArticleQuery) loadHighlights(ctx context.Context, query *HighlightQuery, nodes []*Article, init func(*Article), assign func(*Article, *Highlight)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*Article)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(highlight.FieldArticleID)
+	}
+	query.Where(predicate.Highlight(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(article.HighlightsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ArticleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "article_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *ArticleQuery) loadSnapshots(ctx context.Context, query *SnapshotQuery, nodes []*Article, init func(*Article), assign func(*Article, *Snapshot)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*Article)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(snapshot.FieldArticleID)
+	}
+	query.Where(predicate.Snapshot(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(article.SnapshotsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ArticleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "article_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *ArticleQuery) loadRevisions(ctx context.Context, query *ArticleRevisionQuery, nodes []*Article, init func(*Article), assign func(*Article, *ArticleRevision)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*Article)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(articlerevision.FieldArticleID)
+	}
+	query.Where(predicate.ArticleRevision(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(article.RevisionsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ArticleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "article_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *ArticleQuery) loadTranslations(ctx context.Context, query *ArticleTranslationQuery, nodes []*Article, init func(*Article), assign func(*Article, *ArticleTranslation)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*Article)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(articletranslation.FieldArticleID)
+	}
+	query.Where(predicate.ArticleTranslation(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(article.TranslationsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ArticleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "article_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+func (aq *ArticleQuery) loadEnrichments(ctx context.Context, query *ArticleEnrichmentQuery, nodes []*Article, init func(*Article), assign func(*Article, *ArticleEnrichment)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[uint]*Article)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(articleenrichment.FieldArticleID)
+	}
+	query.Where(predicate.ArticleEnrichment(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(article.EnrichmentsColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ArticleID
+		node, ok := nodeids[fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "article_id" returned %v for node %v`, fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
 
 func (aq *ArticleQuery) sqlCount(ctx context.Context) (int, error) {
 	_spec := aq.querySpec()
@@ -466,6 +975,12 @@ func (aq *ArticleQuery) querySpec() *sqlgraph.QuerySpec {
 				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
 			}
 		}
+		if aq.withUser != nil {
+			_spec.Node.AddColumnOnce(article.FieldUserID)
+		}
+		if aq.withCluster != nil {
+			_spec.Node.AddColumnOnce(article.FieldClusterID)
+		}
 	}
 	if ps := aq.predicates; len(ps) > 0 {
 		_spec.Predicate = func(selector *sql.Selector) {
@@ -13,7 +13,14 @@ import (
 	"entgo.io/ent/dialect/sql/sqljson"
 	"entgo.io/ent/schema/field"
 	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articlechunk"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articleenrichment"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articlerevision"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articletranslation"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/highlight"
 	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/snapshot"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/topiccluster"
 	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
 )
 
@@ -58,6 +65,147 @@ func (au *ArticleUpdate) SetNillableContent(s *string) *ArticleUpdate {
 	return au
 }
 
+// SetContentText sets the "content_text" field.
+func (au *ArticleUpdate) SetContentText(s string) *ArticleUpdate {
+	au.mutation.SetContentText(s)
+	return au
+}
+
+// SetNillableContentText sets the "content_text" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableContentText(s *string) *ArticleUpdate {
+	if s != nil {
+		au.SetContentText(*s)
+	}
+	return au
+}
+
+// ClearContentText clears the value of the "content_text" field.
+func (au *ArticleUpdate) ClearContentText() *ArticleUpdate {
+	au.mutation.ClearContentText()
+	return au
+}
+
+// SetWordCount sets the "word_count" field.
+func (au *ArticleUpdate) SetWordCount(i int) *ArticleUpdate {
+	au.mutation.ResetWordCount()
+	au.mutation.SetWordCount(i)
+	return au
+}
+
+// SetNillableWordCount sets the "word_count" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableWordCount(i *int) *ArticleUpdate {
+	if i != nil {
+		au.SetWordCount(*i)
+	}
+	return au
+}
+
+// AddWordCount adds i to the "word_count" field.
+func (au *ArticleUpdate) AddWordCount(i int) *ArticleUpdate {
+	au.mutation.AddWordCount(i)
+	return au
+}
+
+// ClearWordCount clears the value of the "word_count" field.
+func (au *ArticleUpdate) ClearWordCount() *ArticleUpdate {
+	au.mutation.ClearWordCount()
+	return au
+}
+
+// SetReadingMinutes sets the "reading_minutes" field.
+func (au *ArticleUpdate) SetReadingMinutes(i int) *ArticleUpdate {
+	au.mutation.ResetReadingMinutes()
+	au.mutation.SetReadingMinutes(i)
+	return au
+}
+
+// SetNillableReadingMinutes sets the "reading_minutes" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableReadingMinutes(i *int) *ArticleUpdate {
+	if i != nil {
+		au.SetReadingMinutes(*i)
+	}
+	return au
+}
+
+// AddReadingMinutes adds i to the "reading_minutes" field.
+func (au *ArticleUpdate) AddReadingMinutes(i int) *ArticleUpdate {
+	au.mutation.AddReadingMinutes(i)
+	return au
+}
+
+// ClearReadingMinutes clears the value of the "reading_minutes" field.
+func (au *ArticleUpdate) ClearReadingMinutes() *ArticleUpdate {
+	au.mutation.ClearReadingMinutes()
+	return au
+}
+
+// SetLanguage sets the "language" field.
+func (au *ArticleUpdate) SetLanguage(s string) *ArticleUpdate {
+	au.mutation.SetLanguage(s)
+	return au
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableLanguage(s *string) *ArticleUpdate {
+	if s != nil {
+		au.SetLanguage(*s)
+	}
+	return au
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (au *ArticleUpdate) ClearLanguage() *ArticleUpdate {
+	au.mutation.ClearLanguage()
+	return au
+}
+
+// SetImageCount sets the "image_count" field.
+func (au *ArticleUpdate) SetImageCount(i int) *ArticleUpdate {
+	au.mutation.ResetImageCount()
+	au.mutation.SetImageCount(i)
+	return au
+}
+
+// SetNillableImageCount sets the "image_count" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableImageCount(i *int) *ArticleUpdate {
+	if i != nil {
+		au.SetImageCount(*i)
+	}
+	return au
+}
+
+// AddImageCount adds i to the "image_count" field.
+func (au *ArticleUpdate) AddImageCount(i int) *ArticleUpdate {
+	au.mutation.AddImageCount(i)
+	return au
+}
+
+// ClearImageCount clears the value of the "image_count" field.
+func (au *ArticleUpdate) ClearImageCount() *ArticleUpdate {
+	au.mutation.ClearImageCount()
+	return au
+}
+
+// SetLeadImage sets the "lead_image" field.
+func (au *ArticleUpdate) SetLeadImage(s string) *ArticleUpdate {
+	au.mutation.SetLeadImage(s)
+	return au
+}
+
+// SetNillableLeadImage sets the "lead_image" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableLeadImage(s *string) *ArticleUpdate {
+	if s != nil {
+		au.SetLeadImage(*s)
+	}
+	return au
+}
+
+// ClearLeadImage clears the value of the "lead_image" field.
+func (au *ArticleUpdate) ClearLeadImage() *ArticleUpdate {
+	au.mutation.ClearLeadImage()
+	return au
+}
+
 // SetURL sets the "url" field.
 func (au *ArticleUpdate) SetURL(s string) *ArticleUpdate {
 	au.mutation.SetURL(s)
@@ -72,6 +220,53 @@ func (au *ArticleUpdate) SetNillableURL(s *string) *ArticleUpdate {
 	return au
 }
 
+// SetCanonicalURL sets the "canonical_url" field.
+func (au *ArticleUpdate) SetCanonicalURL(s string) *ArticleUpdate {
+	au.mutation.SetCanonicalURL(s)
+	return au
+}
+
+// SetNillableCanonicalURL sets the "canonical_url" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableCanonicalURL(s *string) *ArticleUpdate {
+	if s != nil {
+		au.SetCanonicalURL(*s)
+	}
+	return au
+}
+
+// ClearCanonicalURL clears the value of the "canonical_url" field.
+func (au *ArticleUpdate) ClearCanonicalURL() *ArticleUpdate {
+	au.mutation.ClearCanonicalURL()
+	return au
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (au *ArticleUpdate) SetFingerprint(u uint64) *ArticleUpdate {
+	au.mutation.ResetFingerprint()
+	au.mutation.SetFingerprint(u)
+	return au
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableFingerprint(u *uint64) *ArticleUpdate {
+	if u != nil {
+		au.SetFingerprint(*u)
+	}
+	return au
+}
+
+// AddFingerprint adds u to the "fingerprint" field.
+func (au *ArticleUpdate) AddFingerprint(u int64) *ArticleUpdate {
+	au.mutation.AddFingerprint(u)
+	return au
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (au *ArticleUpdate) ClearFingerprint() *ArticleUpdate {
+	au.mutation.ClearFingerprint()
+	return au
+}
+
 // SetAuthor sets the "author" field.
 func (au *ArticleUpdate) SetAuthor(s string) *ArticleUpdate {
 	au.mutation.SetAuthor(s)
@@ -152,6 +347,207 @@ func (au *ArticleUpdate) SetNillablePublishedAt(t *time.Time) *ArticleUpdate {
 	return au
 }
 
+// SetUserID sets the "user_id" field.
+func (au *ArticleUpdate) SetUserID(i int) *ArticleUpdate {
+	au.mutation.SetUserID(i)
+	return au
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableUserID(i *int) *ArticleUpdate {
+	if i != nil {
+		au.SetUserID(*i)
+	}
+	return au
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (au *ArticleUpdate) SetClusterID(i int) *ArticleUpdate {
+	au.mutation.SetClusterID(i)
+	return au
+}
+
+// SetNillableClusterID sets the "cluster_id" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableClusterID(i *int) *ArticleUpdate {
+	if i != nil {
+		au.SetClusterID(*i)
+	}
+	return au
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (au *ArticleUpdate) ClearClusterID() *ArticleUpdate {
+	au.mutation.ClearClusterID()
+	return au
+}
+
+// SetClusterCheckedAt sets the "cluster_checked_at" field.
+func (au *ArticleUpdate) SetClusterCheckedAt(t time.Time) *ArticleUpdate {
+	au.mutation.SetClusterCheckedAt(t)
+	return au
+}
+
+// SetNillableClusterCheckedAt sets the "cluster_checked_at" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableClusterCheckedAt(t *time.Time) *ArticleUpdate {
+	if t != nil {
+		au.SetClusterCheckedAt(*t)
+	}
+	return au
+}
+
+// ClearClusterCheckedAt clears the value of the "cluster_checked_at" field.
+func (au *ArticleUpdate) ClearClusterCheckedAt() *ArticleUpdate {
+	au.mutation.ClearClusterCheckedAt()
+	return au
+}
+
+// SetFetchStatus sets the "fetch_status" field.
+func (au *ArticleUpdate) SetFetchStatus(as article.FetchStatus) *ArticleUpdate {
+	au.mutation.SetFetchStatus(as)
+	return au
+}
+
+// SetNillableFetchStatus sets the "fetch_status" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableFetchStatus(as *article.FetchStatus) *ArticleUpdate {
+	if as != nil {
+		au.SetFetchStatus(*as)
+	}
+	return au
+}
+
+// ClearFetchStatus clears the value of the "fetch_status" field.
+func (au *ArticleUpdate) ClearFetchStatus() *ArticleUpdate {
+	au.mutation.ClearFetchStatus()
+	return au
+}
+
+// SetFetchError sets the "fetch_error" field.
+func (au *ArticleUpdate) SetFetchError(s string) *ArticleUpdate {
+	au.mutation.SetFetchError(s)
+	return au
+}
+
+// SetNillableFetchError sets the "fetch_error" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableFetchError(s *string) *ArticleUpdate {
+	if s != nil {
+		au.SetFetchError(*s)
+	}
+	return au
+}
+
+// ClearFetchError clears the value of the "fetch_error" field.
+func (au *ArticleUpdate) ClearFetchError() *ArticleUpdate {
+	au.mutation.ClearFetchError()
+	return au
+}
+
+// SetReadAt sets the "read_at" field.
+func (au *ArticleUpdate) SetReadAt(t time.Time) *ArticleUpdate {
+	au.mutation.SetReadAt(t)
+	return au
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableReadAt(t *time.Time) *ArticleUpdate {
+	if t != nil {
+		au.SetReadAt(*t)
+	}
+	return au
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (au *ArticleUpdate) ClearReadAt() *ArticleUpdate {
+	au.mutation.ClearReadAt()
+	return au
+}
+
+// SetSourceStatus sets the "source_status" field.
+func (au *ArticleUpdate) SetSourceStatus(as article.SourceStatus) *ArticleUpdate {
+	au.mutation.SetSourceStatus(as)
+	return au
+}
+
+// SetNillableSourceStatus sets the "source_status" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableSourceStatus(as *article.SourceStatus) *ArticleUpdate {
+	if as != nil {
+		au.SetSourceStatus(*as)
+	}
+	return au
+}
+
+// ClearSourceStatus clears the value of the "source_status" field.
+func (au *ArticleUpdate) ClearSourceStatus() *ArticleUpdate {
+	au.mutation.ClearSourceStatus()
+	return au
+}
+
+// SetSourceMovedTo sets the "source_moved_to" field.
+func (au *ArticleUpdate) SetSourceMovedTo(s string) *ArticleUpdate {
+	au.mutation.SetSourceMovedTo(s)
+	return au
+}
+
+// SetNillableSourceMovedTo sets the "source_moved_to" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableSourceMovedTo(s *string) *ArticleUpdate {
+	if s != nil {
+		au.SetSourceMovedTo(*s)
+	}
+	return au
+}
+
+// ClearSourceMovedTo clears the value of the "source_moved_to" field.
+func (au *ArticleUpdate) ClearSourceMovedTo() *ArticleUpdate {
+	au.mutation.ClearSourceMovedTo()
+	return au
+}
+
+// SetSourceCheckedAt sets the "source_checked_at" field.
+func (au *ArticleUpdate) SetSourceCheckedAt(t time.Time) *ArticleUpdate {
+	au.mutation.SetSourceCheckedAt(t)
+	return au
+}
+
+// SetNillableSourceCheckedAt sets the "source_checked_at" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableSourceCheckedAt(t *time.Time) *ArticleUpdate {
+	if t != nil {
+		au.SetSourceCheckedAt(*t)
+	}
+	return au
+}
+
+// ClearSourceCheckedAt clears the value of the "source_checked_at" field.
+func (au *ArticleUpdate) ClearSourceCheckedAt() *ArticleUpdate {
+	au.mutation.ClearSourceCheckedAt()
+	return au
+}
+
+// SetSourceFingerprint sets the "source_fingerprint" field.
+func (au *ArticleUpdate) SetSourceFingerprint(u uint64) *ArticleUpdate {
+	au.mutation.ResetSourceFingerprint()
+	au.mutation.SetSourceFingerprint(u)
+	return au
+}
+
+// SetNillableSourceFingerprint sets the "source_fingerprint" field if the given value is not nil.
+func (au *ArticleUpdate) SetNillableSourceFingerprint(u *uint64) *ArticleUpdate {
+	if u != nil {
+		au.SetSourceFingerprint(*u)
+	}
+	return au
+}
+
+// AddSourceFingerprint adds u to the "source_fingerprint" field.
+func (au *ArticleUpdate) AddSourceFingerprint(u int64) *ArticleUpdate {
+	au.mutation.AddSourceFingerprint(u)
+	return au
+}
+
+// ClearSourceFingerprint clears the value of the "source_fingerprint" field.
+func (au *ArticleUpdate) ClearSourceFingerprint() *ArticleUpdate {
+	au.mutation.ClearSourceFingerprint()
+	return au
+}
+
 // SetCreatedAt sets the "created_at" field.
 func (au *ArticleUpdate) SetCreatedAt(t time.Time) *ArticleUpdate {
 	au.mutation.SetCreatedAt(t)
@@ -172,79 +568,316 @@ func (au *ArticleUpdate) SetUpdatedAt(t time.Time) *ArticleUpdate {
 	return au
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (au *ArticleUpdate) SetUserID(id int) *ArticleUpdate {
-	au.mutation.SetUserID(id)
-	return au
-}
-
 // SetUser sets the "user" edge to the User entity.
 func (au *ArticleUpdate) SetUser(u *User) *ArticleUpdate {
 	return au.SetUserID(u.ID)
 }
 
-// Mutation returns the ArticleMutation object of the builder.
-func (au *ArticleUpdate) Mutation() *ArticleMutation {
-	return au.mutation
+// SetCluster sets the "cluster" edge to the TopicCluster entity.
+func (au *ArticleUpdate) SetCluster(t *TopicCluster) *ArticleUpdate {
+	return au.SetClusterID(t.ID)
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (au *ArticleUpdate) ClearUser() *ArticleUpdate {
-	au.mutation.ClearUser()
+// AddChunkIDs adds the "chunks" edge to the ArticleChunk entity by IDs.
+func (au *ArticleUpdate) AddChunkIDs(ids ...int) *ArticleUpdate {
+	au.mutation.AddChunkIDs(ids...)
 	return au
 }
 
-// Save executes the query and returns the number of nodes affected by the update operation.
-func (au *ArticleUpdate) Save(ctx context.Context) (int, error) {
-	au.defaults()
-	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+// AddChunks adds the "chunks" edges to the ArticleChunk entity.
+func (au *ArticleUpdate) AddChunks(a ...*ArticleChunk) *ArticleUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.AddChunkIDs(ids...)
 }
 
-// SaveX is like Save, but panics if an error occurs.
-func (au *ArticleUpdate) SaveX(ctx context.Context) int {
-	affected, err := au.Save(ctx)
-	if err != nil {
-		panic(err)
+// AddHighlightIDs adds the "highlights" edge to the Highlight entity by IDs.
+func (au *ArticleUpdate) AddHighlightIDs(ids ...int) *ArticleUpdate {
+	au.mutation.AddHighlightIDs(ids...)
+	return au
+}
+
+// AddHighlights adds the "highlights" edges to the Highlight entity.
+func (au *ArticleUpdate) AddHighlights(h ...*Highlight) *ArticleUpdate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
 	}
-	return affected
+	return au.AddHighlightIDs(ids...)
 }
 
-// Exec executes the query.
-func (au *ArticleUpdate) Exec(ctx context.Context) error {
-	_, err := au.Save(ctx)
-	return err
+// AddSnapshotIDs adds the "snapshots" edge to the Snapshot entity by IDs.
+func (au *ArticleUpdate) AddSnapshotIDs(ids ...int) *ArticleUpdate {
+	au.mutation.AddSnapshotIDs(ids...)
+	return au
 }
 
-// ExecX is like Exec, but panics if an error occurs.
-func (au *ArticleUpdate) ExecX(ctx context.Context) {
-	if err := au.Exec(ctx); err != nil {
-		panic(err)
+// AddSnapshots adds the "snapshots" edges to the Snapshot entity.
+func (au *ArticleUpdate) AddSnapshots(s ...*Snapshot) *ArticleUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
 	}
+	return au.AddSnapshotIDs(ids...)
 }
 
-// defaults sets the default values of the builder before save.
-func (au *ArticleUpdate) defaults() {
-	if _, ok := au.mutation.UpdatedAt(); !ok {
-		v := article.UpdateDefaultUpdatedAt()
-		au.mutation.SetUpdatedAt(v)
+// AddRevisionIDs adds the "revisions" edge to the ArticleRevision entity by IDs.
+func (au *ArticleUpdate) AddRevisionIDs(ids ...int) *ArticleUpdate {
+	au.mutation.AddRevisionIDs(ids...)
+	return au
+}
+
+// AddRevisions adds the "revisions" edges to the ArticleRevision entity.
+func (au *ArticleUpdate) AddRevisions(a ...*ArticleRevision) *ArticleUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
+	return au.AddRevisionIDs(ids...)
 }
 
-// check runs all checks and user-defined validators on the builder.
-func (au *ArticleUpdate) check() error {
-	if _, ok := au.mutation.UserID(); au.mutation.UserCleared() && !ok {
-		return errors.New(`ent: clearing a required unique edge "Article.user"`)
+// AddTranslationIDs adds the "translations" edge to the ArticleTranslation entity by IDs.
+func (au *ArticleUpdate) AddTranslationIDs(ids ...int) *ArticleUpdate {
+	au.mutation.AddTranslationIDs(ids...)
+	return au
+}
+
+// AddTranslations adds the "translations" edges to the ArticleTranslation entity.
+func (au *ArticleUpdate) AddTranslations(a ...*ArticleTranslation) *ArticleUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return nil
+	return au.AddTranslationIDs(ids...)
 }
 
-func (au *ArticleUpdate) sqlSave(ctx context.Context) (n int, err error) {
-	if err := au.check(); err != nil {
-		return n, err
+// AddEnrichmentIDs adds the "enrichments" edge to the ArticleEnrichment entity by IDs.
+func (au *ArticleUpdate) AddEnrichmentIDs(ids ...int) *ArticleUpdate {
+	au.mutation.AddEnrichmentIDs(ids...)
+	return au
+}
+
+// AddEnrichments adds the "enrichments" edges to the ArticleEnrichment entity.
+func (au *ArticleUpdate) AddEnrichments(a ...*ArticleEnrichment) *ArticleUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	_spec := sqlgraph.NewUpdateSpec(article.Table, article.Columns, sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint))
-	if ps := au.mutation.predicates; len(ps) > 0 {
-		_spec.Predicate = func(selector *sql.Selector) {
+	return au.AddEnrichmentIDs(ids...)
+}
+
+// Mutation returns the ArticleMutation object of the builder.
+func (au *ArticleUpdate) Mutation() *ArticleMutation {
+	return au.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (au *ArticleUpdate) ClearUser() *ArticleUpdate {
+	au.mutation.ClearUser()
+	return au
+}
+
+// ClearCluster clears the "cluster" edge to the TopicCluster entity.
+func (au *ArticleUpdate) ClearCluster() *ArticleUpdate {
+	au.mutation.ClearCluster()
+	return au
+}
+
+// ClearChunks clears all "chunks" edges to the ArticleChunk entity.
+func (au *ArticleUpdate) ClearChunks() *ArticleUpdate {
+	au.mutation.ClearChunks()
+	return au
+}
+
+// RemoveChunkIDs removes the "chunks" edge to ArticleChunk entities by IDs.
+func (au *ArticleUpdate) RemoveChunkIDs(ids ...int) *ArticleUpdate {
+	au.mutation.RemoveChunkIDs(ids...)
+	return au
+}
+
+// RemoveChunks removes "chunks" edges to ArticleChunk entities.
+func (au *ArticleUpdate) RemoveChunks(a ...*ArticleChunk) *ArticleUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.RemoveChunkIDs(ids...)
+}
+
+// ClearHighlights clears all "highlights" edges to the Highlight entity.
+func (au *ArticleUpdate) ClearHighlights() *ArticleUpdate {
+	au.mutation.ClearHighlights()
+	return au
+}
+
+// RemoveHighlightIDs removes the "highlights" edge to Highlight entities by IDs.
+func (au *ArticleUpdate) RemoveHighlightIDs(ids ...int) *ArticleUpdate {
+	au.mutation.RemoveHighlightIDs(ids...)
+	return au
+}
+
+// RemoveHighlights removes "highlights" edges to Highlight entities.
+func (au *ArticleUpdate) RemoveHighlights(h ...*Highlight) *ArticleUpdate {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return au.RemoveHighlightIDs(ids...)
+}
+
+// ClearSnapshots clears all "snapshots" edges to the Snapshot entity.
+func (au *ArticleUpdate) ClearSnapshots() *ArticleUpdate {
+	au.mutation.ClearSnapshots()
+	return au
+}
+
+// RemoveSnapshotIDs removes the "snapshots" edge to Snapshot entities by IDs.
+func (au *ArticleUpdate) RemoveSnapshotIDs(ids ...int) *ArticleUpdate {
+	au.mutation.RemoveSnapshotIDs(ids...)
+	return au
+}
+
+// RemoveSnapshots removes "snapshots" edges to Snapshot entities.
+func (au *ArticleUpdate) RemoveSnapshots(s ...*Snapshot) *ArticleUpdate {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return au.RemoveSnapshotIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the ArticleRevision entity.
+func (au *ArticleUpdate) ClearRevisions() *ArticleUpdate {
+	au.mutation.ClearRevisions()
+	return au
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to ArticleRevision entities by IDs.
+func (au *ArticleUpdate) RemoveRevisionIDs(ids ...int) *ArticleUpdate {
+	au.mutation.RemoveRevisionIDs(ids...)
+	return au
+}
+
+// RemoveRevisions removes "revisions" edges to ArticleRevision entities.
+func (au *ArticleUpdate) RemoveRevisions(a ...*ArticleRevision) *ArticleUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.RemoveRevisionIDs(ids...)
+}
+
+// ClearTranslations clears all "translations" edges to the ArticleTranslation entity.
+func (au *ArticleUpdate) ClearTranslations() *ArticleUpdate {
+	au.mutation.ClearTranslations()
+	return au
+}
+
+// RemoveTranslationIDs removes the "translations" edge to ArticleTranslation entities by IDs.
+func (au *ArticleUpdate) RemoveTranslationIDs(ids ...int) *ArticleUpdate {
+	au.mutation.RemoveTranslationIDs(ids...)
+	return au
+}
+
+// RemoveTranslations removes "translations" edges to ArticleTranslation entities.
+func (au *ArticleUpdate) RemoveTranslations(a ...*ArticleTranslation) *ArticleUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.RemoveTranslationIDs(ids...)
+}
+
+// ClearEnrichments clears all "enrichments" edges to the ArticleEnrichment entity.
+func (au *ArticleUpdate) ClearEnrichments() *ArticleUpdate {
+	au.mutation.ClearEnrichments()
+	return au
+}
+
+// RemoveEnrichmentIDs removes the "enrichments" edge to ArticleEnrichment entities by IDs.
+func (au *ArticleUpdate) RemoveEnrichmentIDs(ids ...int) *ArticleUpdate {
+	au.mutation.RemoveEnrichmentIDs(ids...)
+	return au
+}
+
+// RemoveEnrichments removes "enrichments" edges to ArticleEnrichment entities.
+func (au *ArticleUpdate) RemoveEnrichments(a ...*ArticleEnrichment) *ArticleUpdate {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return au.RemoveEnrichmentIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (au *ArticleUpdate) Save(ctx context.Context) (int, error) {
+	if err := au.defaults(); err != nil {
+		return 0, err
+	}
+	return withHooks(ctx, au.sqlSave, au.mutation, au.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (au *ArticleUpdate) SaveX(ctx context.Context) int {
+	affected, err := au.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (au *ArticleUpdate) Exec(ctx context.Context) error {
+	_, err := au.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (au *ArticleUpdate) ExecX(ctx context.Context) {
+	if err := au.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (au *ArticleUpdate) defaults() error {
+	if _, ok := au.mutation.UpdatedAt(); !ok {
+		if article.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized article.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
+		v := article.UpdateDefaultUpdatedAt()
+		au.mutation.SetUpdatedAt(v)
+	}
+	return nil
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (au *ArticleUpdate) check() error {
+	if v, ok := au.mutation.FetchStatus(); ok {
+		if err := article.FetchStatusValidator(v); err != nil {
+			return &ValidationError{Name: "fetch_status", err: fmt.Errorf(`ent: validator failed for field "Article.fetch_status": %w`, err)}
+		}
+	}
+	if v, ok := au.mutation.SourceStatus(); ok {
+		if err := article.SourceStatusValidator(v); err != nil {
+			return &ValidationError{Name: "source_status", err: fmt.Errorf(`ent: validator failed for field "Article.source_status": %w`, err)}
+		}
+	}
+	if _, ok := au.mutation.UserID(); au.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Article.user"`)
+	}
+	return nil
+}
+
+func (au *ArticleUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := au.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(article.Table, article.Columns, sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint))
+	if ps := au.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
 			for i := range ps {
 				ps[i](selector)
 			}
@@ -256,9 +889,69 @@ func (au *ArticleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.Content(); ok {
 		_spec.SetField(article.FieldContent, field.TypeString, value)
 	}
+	if value, ok := au.mutation.ContentText(); ok {
+		_spec.SetField(article.FieldContentText, field.TypeString, value)
+	}
+	if au.mutation.ContentTextCleared() {
+		_spec.ClearField(article.FieldContentText, field.TypeString)
+	}
+	if value, ok := au.mutation.WordCount(); ok {
+		_spec.SetField(article.FieldWordCount, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedWordCount(); ok {
+		_spec.AddField(article.FieldWordCount, field.TypeInt, value)
+	}
+	if au.mutation.WordCountCleared() {
+		_spec.ClearField(article.FieldWordCount, field.TypeInt)
+	}
+	if value, ok := au.mutation.ReadingMinutes(); ok {
+		_spec.SetField(article.FieldReadingMinutes, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedReadingMinutes(); ok {
+		_spec.AddField(article.FieldReadingMinutes, field.TypeInt, value)
+	}
+	if au.mutation.ReadingMinutesCleared() {
+		_spec.ClearField(article.FieldReadingMinutes, field.TypeInt)
+	}
+	if value, ok := au.mutation.Language(); ok {
+		_spec.SetField(article.FieldLanguage, field.TypeString, value)
+	}
+	if au.mutation.LanguageCleared() {
+		_spec.ClearField(article.FieldLanguage, field.TypeString)
+	}
+	if value, ok := au.mutation.ImageCount(); ok {
+		_spec.SetField(article.FieldImageCount, field.TypeInt, value)
+	}
+	if value, ok := au.mutation.AddedImageCount(); ok {
+		_spec.AddField(article.FieldImageCount, field.TypeInt, value)
+	}
+	if au.mutation.ImageCountCleared() {
+		_spec.ClearField(article.FieldImageCount, field.TypeInt)
+	}
+	if value, ok := au.mutation.LeadImage(); ok {
+		_spec.SetField(article.FieldLeadImage, field.TypeString, value)
+	}
+	if au.mutation.LeadImageCleared() {
+		_spec.ClearField(article.FieldLeadImage, field.TypeString)
+	}
 	if value, ok := au.mutation.URL(); ok {
 		_spec.SetField(article.FieldURL, field.TypeString, value)
 	}
+	if value, ok := au.mutation.CanonicalURL(); ok {
+		_spec.SetField(article.FieldCanonicalURL, field.TypeString, value)
+	}
+	if au.mutation.CanonicalURLCleared() {
+		_spec.ClearField(article.FieldCanonicalURL, field.TypeString)
+	}
+	if value, ok := au.mutation.Fingerprint(); ok {
+		_spec.SetField(article.FieldFingerprint, field.TypeUint64, value)
+	}
+	if value, ok := au.mutation.AddedFingerprint(); ok {
+		_spec.AddField(article.FieldFingerprint, field.TypeUint64, value)
+	}
+	if au.mutation.FingerprintCleared() {
+		_spec.ClearField(article.FieldFingerprint, field.TypeUint64)
+	}
 	if value, ok := au.mutation.Author(); ok {
 		_spec.SetField(article.FieldAuthor, field.TypeString, value)
 	}
@@ -285,6 +978,57 @@ func (au *ArticleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 	if value, ok := au.mutation.PublishedAt(); ok {
 		_spec.SetField(article.FieldPublishedAt, field.TypeTime, value)
 	}
+	if value, ok := au.mutation.ClusterCheckedAt(); ok {
+		_spec.SetField(article.FieldClusterCheckedAt, field.TypeTime, value)
+	}
+	if au.mutation.ClusterCheckedAtCleared() {
+		_spec.ClearField(article.FieldClusterCheckedAt, field.TypeTime)
+	}
+	if value, ok := au.mutation.FetchStatus(); ok {
+		_spec.SetField(article.FieldFetchStatus, field.TypeEnum, value)
+	}
+	if au.mutation.FetchStatusCleared() {
+		_spec.ClearField(article.FieldFetchStatus, field.TypeEnum)
+	}
+	if value, ok := au.mutation.FetchError(); ok {
+		_spec.SetField(article.FieldFetchError, field.TypeString, value)
+	}
+	if au.mutation.FetchErrorCleared() {
+		_spec.ClearField(article.FieldFetchError, field.TypeString)
+	}
+	if value, ok := au.mutation.ReadAt(); ok {
+		_spec.SetField(article.FieldReadAt, field.TypeTime, value)
+	}
+	if au.mutation.ReadAtCleared() {
+		_spec.ClearField(article.FieldReadAt, field.TypeTime)
+	}
+	if value, ok := au.mutation.SourceStatus(); ok {
+		_spec.SetField(article.FieldSourceStatus, field.TypeEnum, value)
+	}
+	if au.mutation.SourceStatusCleared() {
+		_spec.ClearField(article.FieldSourceStatus, field.TypeEnum)
+	}
+	if value, ok := au.mutation.SourceMovedTo(); ok {
+		_spec.SetField(article.FieldSourceMovedTo, field.TypeString, value)
+	}
+	if au.mutation.SourceMovedToCleared() {
+		_spec.ClearField(article.FieldSourceMovedTo, field.TypeString)
+	}
+	if value, ok := au.mutation.SourceCheckedAt(); ok {
+		_spec.SetField(article.FieldSourceCheckedAt, field.TypeTime, value)
+	}
+	if au.mutation.SourceCheckedAtCleared() {
+		_spec.ClearField(article.FieldSourceCheckedAt, field.TypeTime)
+	}
+	if value, ok := au.mutation.SourceFingerprint(); ok {
+		_spec.SetField(article.FieldSourceFingerprint, field.TypeUint64, value)
+	}
+	if value, ok := au.mutation.AddedSourceFingerprint(); ok {
+		_spec.AddField(article.FieldSourceFingerprint, field.TypeUint64, value)
+	}
+	if au.mutation.SourceFingerprintCleared() {
+		_spec.ClearField(article.FieldSourceFingerprint, field.TypeUint64)
+	}
 	if value, ok := au.mutation.CreatedAt(); ok {
 		_spec.SetField(article.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -320,6 +1064,305 @@ func (au *ArticleUpdate) sqlSave(ctx context.Context) (n int, err error) {
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if au.mutation.ClusterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   article.ClusterTable,
+			Columns: []string{article.ClusterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(topiccluster.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.ClusterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   article.ClusterTable,
+			Columns: []string{article.ClusterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(topiccluster.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.ChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.ChunksTable,
+			Columns: []string{article.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlechunk.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedChunksIDs(); len(nodes) > 0 && !au.mutation.ChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.ChunksTable,
+			Columns: []string{article.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlechunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.ChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.ChunksTable,
+			Columns: []string{article.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlechunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.HighlightsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.HighlightsTable,
+			Columns: []string{article.HighlightsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(highlight.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedHighlightsIDs(); len(nodes) > 0 && !au.mutation.HighlightsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.HighlightsTable,
+			Columns: []string{article.HighlightsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(highlight.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.HighlightsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.HighlightsTable,
+			Columns: []string{article.HighlightsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(highlight.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.SnapshotsTable,
+			Columns: []string{article.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedSnapshotsIDs(); len(nodes) > 0 && !au.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.SnapshotsTable,
+			Columns: []string{article.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.SnapshotsTable,
+			Columns: []string{article.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.RevisionsTable,
+			Columns: []string{article.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlerevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !au.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.RevisionsTable,
+			Columns: []string{article.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlerevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.RevisionsTable,
+			Columns: []string{article.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlerevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.TranslationsTable,
+			Columns: []string{article.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articletranslation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedTranslationsIDs(); len(nodes) > 0 && !au.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.TranslationsTable,
+			Columns: []string{article.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articletranslation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.TranslationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.TranslationsTable,
+			Columns: []string{article.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articletranslation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if au.mutation.EnrichmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.EnrichmentsTable,
+			Columns: []string{article.EnrichmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articleenrichment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.RemovedEnrichmentsIDs(); len(nodes) > 0 && !au.mutation.EnrichmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.EnrichmentsTable,
+			Columns: []string{article.EnrichmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articleenrichment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := au.mutation.EnrichmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.EnrichmentsTable,
+			Columns: []string{article.EnrichmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articleenrichment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	if n, err = sqlgraph.UpdateNodes(ctx, au.driver, _spec); err != nil {
 		if _, ok := err.(*sqlgraph.NotFoundError); ok {
 			err = &NotFoundError{article.Label}
@@ -340,34 +1383,175 @@ type ArticleUpdateOne struct {
 	mutation *ArticleMutation
 }
 
-// SetTitle sets the "title" field.
-func (auo *ArticleUpdateOne) SetTitle(s string) *ArticleUpdateOne {
-	auo.mutation.SetTitle(s)
+// SetTitle sets the "title" field.
+func (auo *ArticleUpdateOne) SetTitle(s string) *ArticleUpdateOne {
+	auo.mutation.SetTitle(s)
+	return auo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableTitle(s *string) *ArticleUpdateOne {
+	if s != nil {
+		auo.SetTitle(*s)
+	}
+	return auo
+}
+
+// SetContent sets the "content" field.
+func (auo *ArticleUpdateOne) SetContent(s string) *ArticleUpdateOne {
+	auo.mutation.SetContent(s)
+	return auo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableContent(s *string) *ArticleUpdateOne {
+	if s != nil {
+		auo.SetContent(*s)
+	}
+	return auo
+}
+
+// SetContentText sets the "content_text" field.
+func (auo *ArticleUpdateOne) SetContentText(s string) *ArticleUpdateOne {
+	auo.mutation.SetContentText(s)
+	return auo
+}
+
+// SetNillableContentText sets the "content_text" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableContentText(s *string) *ArticleUpdateOne {
+	if s != nil {
+		auo.SetContentText(*s)
+	}
+	return auo
+}
+
+// ClearContentText clears the value of the "content_text" field.
+func (auo *ArticleUpdateOne) ClearContentText() *ArticleUpdateOne {
+	auo.mutation.ClearContentText()
+	return auo
+}
+
+// SetWordCount sets the "word_count" field.
+func (auo *ArticleUpdateOne) SetWordCount(i int) *ArticleUpdateOne {
+	auo.mutation.ResetWordCount()
+	auo.mutation.SetWordCount(i)
+	return auo
+}
+
+// SetNillableWordCount sets the "word_count" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableWordCount(i *int) *ArticleUpdateOne {
+	if i != nil {
+		auo.SetWordCount(*i)
+	}
+	return auo
+}
+
+// AddWordCount adds i to the "word_count" field.
+func (auo *ArticleUpdateOne) AddWordCount(i int) *ArticleUpdateOne {
+	auo.mutation.AddWordCount(i)
+	return auo
+}
+
+// ClearWordCount clears the value of the "word_count" field.
+func (auo *ArticleUpdateOne) ClearWordCount() *ArticleUpdateOne {
+	auo.mutation.ClearWordCount()
+	return auo
+}
+
+// SetReadingMinutes sets the "reading_minutes" field.
+func (auo *ArticleUpdateOne) SetReadingMinutes(i int) *ArticleUpdateOne {
+	auo.mutation.ResetReadingMinutes()
+	auo.mutation.SetReadingMinutes(i)
+	return auo
+}
+
+// SetNillableReadingMinutes sets the "reading_minutes" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableReadingMinutes(i *int) *ArticleUpdateOne {
+	if i != nil {
+		auo.SetReadingMinutes(*i)
+	}
+	return auo
+}
+
+// AddReadingMinutes adds i to the "reading_minutes" field.
+func (auo *ArticleUpdateOne) AddReadingMinutes(i int) *ArticleUpdateOne {
+	auo.mutation.AddReadingMinutes(i)
+	return auo
+}
+
+// ClearReadingMinutes clears the value of the "reading_minutes" field.
+func (auo *ArticleUpdateOne) ClearReadingMinutes() *ArticleUpdateOne {
+	auo.mutation.ClearReadingMinutes()
+	return auo
+}
+
+// SetLanguage sets the "language" field.
+func (auo *ArticleUpdateOne) SetLanguage(s string) *ArticleUpdateOne {
+	auo.mutation.SetLanguage(s)
+	return auo
+}
+
+// SetNillableLanguage sets the "language" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableLanguage(s *string) *ArticleUpdateOne {
+	if s != nil {
+		auo.SetLanguage(*s)
+	}
+	return auo
+}
+
+// ClearLanguage clears the value of the "language" field.
+func (auo *ArticleUpdateOne) ClearLanguage() *ArticleUpdateOne {
+	auo.mutation.ClearLanguage()
+	return auo
+}
+
+// SetImageCount sets the "image_count" field.
+func (auo *ArticleUpdateOne) SetImageCount(i int) *ArticleUpdateOne {
+	auo.mutation.ResetImageCount()
+	auo.mutation.SetImageCount(i)
 	return auo
 }
 
-// SetNillableTitle sets the "title" field if the given value is not nil.
-func (auo *ArticleUpdateOne) SetNillableTitle(s *string) *ArticleUpdateOne {
-	if s != nil {
-		auo.SetTitle(*s)
+// SetNillableImageCount sets the "image_count" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableImageCount(i *int) *ArticleUpdateOne {
+	if i != nil {
+		auo.SetImageCount(*i)
 	}
 	return auo
 }
 
-// SetContent sets the "content" field.
-func (auo *ArticleUpdateOne) SetContent(s string) *ArticleUpdateOne {
-	auo.mutation.SetContent(s)
+// AddImageCount adds i to the "image_count" field.
+func (auo *ArticleUpdateOne) AddImageCount(i int) *ArticleUpdateOne {
+	auo.mutation.AddImageCount(i)
 	return auo
 }
 
-// SetNillableContent sets the "content" field if the given value is not nil.
-func (auo *ArticleUpdateOne) SetNillableContent(s *string) *ArticleUpdateOne {
+// ClearImageCount clears the value of the "image_count" field.
+func (auo *ArticleUpdateOne) ClearImageCount() *ArticleUpdateOne {
+	auo.mutation.ClearImageCount()
+	return auo
+}
+
+// SetLeadImage sets the "lead_image" field.
+func (auo *ArticleUpdateOne) SetLeadImage(s string) *ArticleUpdateOne {
+	auo.mutation.SetLeadImage(s)
+	return auo
+}
+
+// SetNillableLeadImage sets the "lead_image" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableLeadImage(s *string) *ArticleUpdateOne {
 	if s != nil {
-		auo.SetContent(*s)
+		auo.SetLeadImage(*s)
 	}
 	return auo
 }
 
+// ClearLeadImage clears the value of the "lead_image" field.
+func (auo *ArticleUpdateOne) ClearLeadImage() *ArticleUpdateOne {
+	auo.mutation.ClearLeadImage()
+	return auo
+}
+
 // SetURL sets the "url" field.
 func (auo *ArticleUpdateOne) SetURL(s string) *ArticleUpdateOne {
 	auo.mutation.SetURL(s)
@@ -382,6 +1566,53 @@ func (auo *ArticleUpdateOne) SetNillableURL(s *string) *ArticleUpdateOne {
 	return auo
 }
 
+// SetCanonicalURL sets the "canonical_url" field.
+func (auo *ArticleUpdateOne) SetCanonicalURL(s string) *ArticleUpdateOne {
+	auo.mutation.SetCanonicalURL(s)
+	return auo
+}
+
+// SetNillableCanonicalURL sets the "canonical_url" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableCanonicalURL(s *string) *ArticleUpdateOne {
+	if s != nil {
+		auo.SetCanonicalURL(*s)
+	}
+	return auo
+}
+
+// ClearCanonicalURL clears the value of the "canonical_url" field.
+func (auo *ArticleUpdateOne) ClearCanonicalURL() *ArticleUpdateOne {
+	auo.mutation.ClearCanonicalURL()
+	return auo
+}
+
+// SetFingerprint sets the "fingerprint" field.
+func (auo *ArticleUpdateOne) SetFingerprint(u uint64) *ArticleUpdateOne {
+	auo.mutation.ResetFingerprint()
+	auo.mutation.SetFingerprint(u)
+	return auo
+}
+
+// SetNillableFingerprint sets the "fingerprint" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableFingerprint(u *uint64) *ArticleUpdateOne {
+	if u != nil {
+		auo.SetFingerprint(*u)
+	}
+	return auo
+}
+
+// AddFingerprint adds u to the "fingerprint" field.
+func (auo *ArticleUpdateOne) AddFingerprint(u int64) *ArticleUpdateOne {
+	auo.mutation.AddFingerprint(u)
+	return auo
+}
+
+// ClearFingerprint clears the value of the "fingerprint" field.
+func (auo *ArticleUpdateOne) ClearFingerprint() *ArticleUpdateOne {
+	auo.mutation.ClearFingerprint()
+	return auo
+}
+
 // SetAuthor sets the "author" field.
 func (auo *ArticleUpdateOne) SetAuthor(s string) *ArticleUpdateOne {
 	auo.mutation.SetAuthor(s)
@@ -462,48 +1693,470 @@ func (auo *ArticleUpdateOne) SetNillablePublishedAt(t *time.Time) *ArticleUpdate
 	return auo
 }
 
-// SetCreatedAt sets the "created_at" field.
-func (auo *ArticleUpdateOne) SetCreatedAt(t time.Time) *ArticleUpdateOne {
-	auo.mutation.SetCreatedAt(t)
+// SetUserID sets the "user_id" field.
+func (auo *ArticleUpdateOne) SetUserID(i int) *ArticleUpdateOne {
+	auo.mutation.SetUserID(i)
+	return auo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableUserID(i *int) *ArticleUpdateOne {
+	if i != nil {
+		auo.SetUserID(*i)
+	}
+	return auo
+}
+
+// SetClusterID sets the "cluster_id" field.
+func (auo *ArticleUpdateOne) SetClusterID(i int) *ArticleUpdateOne {
+	auo.mutation.SetClusterID(i)
+	return auo
+}
+
+// SetNillableClusterID sets the "cluster_id" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableClusterID(i *int) *ArticleUpdateOne {
+	if i != nil {
+		auo.SetClusterID(*i)
+	}
+	return auo
+}
+
+// ClearClusterID clears the value of the "cluster_id" field.
+func (auo *ArticleUpdateOne) ClearClusterID() *ArticleUpdateOne {
+	auo.mutation.ClearClusterID()
+	return auo
+}
+
+// SetClusterCheckedAt sets the "cluster_checked_at" field.
+func (auo *ArticleUpdateOne) SetClusterCheckedAt(t time.Time) *ArticleUpdateOne {
+	auo.mutation.SetClusterCheckedAt(t)
+	return auo
+}
+
+// SetNillableClusterCheckedAt sets the "cluster_checked_at" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableClusterCheckedAt(t *time.Time) *ArticleUpdateOne {
+	if t != nil {
+		auo.SetClusterCheckedAt(*t)
+	}
+	return auo
+}
+
+// ClearClusterCheckedAt clears the value of the "cluster_checked_at" field.
+func (auo *ArticleUpdateOne) ClearClusterCheckedAt() *ArticleUpdateOne {
+	auo.mutation.ClearClusterCheckedAt()
+	return auo
+}
+
+// SetFetchStatus sets the "fetch_status" field.
+func (auo *ArticleUpdateOne) SetFetchStatus(as article.FetchStatus) *ArticleUpdateOne {
+	auo.mutation.SetFetchStatus(as)
+	return auo
+}
+
+// SetNillableFetchStatus sets the "fetch_status" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableFetchStatus(as *article.FetchStatus) *ArticleUpdateOne {
+	if as != nil {
+		auo.SetFetchStatus(*as)
+	}
+	return auo
+}
+
+// ClearFetchStatus clears the value of the "fetch_status" field.
+func (auo *ArticleUpdateOne) ClearFetchStatus() *ArticleUpdateOne {
+	auo.mutation.ClearFetchStatus()
+	return auo
+}
+
+// SetFetchError sets the "fetch_error" field.
+func (auo *ArticleUpdateOne) SetFetchError(s string) *ArticleUpdateOne {
+	auo.mutation.SetFetchError(s)
+	return auo
+}
+
+// SetNillableFetchError sets the "fetch_error" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableFetchError(s *string) *ArticleUpdateOne {
+	if s != nil {
+		auo.SetFetchError(*s)
+	}
+	return auo
+}
+
+// ClearFetchError clears the value of the "fetch_error" field.
+func (auo *ArticleUpdateOne) ClearFetchError() *ArticleUpdateOne {
+	auo.mutation.ClearFetchError()
+	return auo
+}
+
+// SetReadAt sets the "read_at" field.
+func (auo *ArticleUpdateOne) SetReadAt(t time.Time) *ArticleUpdateOne {
+	auo.mutation.SetReadAt(t)
+	return auo
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableReadAt(t *time.Time) *ArticleUpdateOne {
+	if t != nil {
+		auo.SetReadAt(*t)
+	}
+	return auo
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (auo *ArticleUpdateOne) ClearReadAt() *ArticleUpdateOne {
+	auo.mutation.ClearReadAt()
+	return auo
+}
+
+// SetSourceStatus sets the "source_status" field.
+func (auo *ArticleUpdateOne) SetSourceStatus(as article.SourceStatus) *ArticleUpdateOne {
+	auo.mutation.SetSourceStatus(as)
+	return auo
+}
+
+// SetNillableSourceStatus sets the "source_status" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableSourceStatus(as *article.SourceStatus) *ArticleUpdateOne {
+	if as != nil {
+		auo.SetSourceStatus(*as)
+	}
+	return auo
+}
+
+// ClearSourceStatus clears the value of the "source_status" field.
+func (auo *ArticleUpdateOne) ClearSourceStatus() *ArticleUpdateOne {
+	auo.mutation.ClearSourceStatus()
+	return auo
+}
+
+// SetSourceMovedTo sets the "source_moved_to" field.
+func (auo *ArticleUpdateOne) SetSourceMovedTo(s string) *ArticleUpdateOne {
+	auo.mutation.SetSourceMovedTo(s)
+	return auo
+}
+
+// SetNillableSourceMovedTo sets the "source_moved_to" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableSourceMovedTo(s *string) *ArticleUpdateOne {
+	if s != nil {
+		auo.SetSourceMovedTo(*s)
+	}
+	return auo
+}
+
+// ClearSourceMovedTo clears the value of the "source_moved_to" field.
+func (auo *ArticleUpdateOne) ClearSourceMovedTo() *ArticleUpdateOne {
+	auo.mutation.ClearSourceMovedTo()
+	return auo
+}
+
+// SetSourceCheckedAt sets the "source_checked_at" field.
+func (auo *ArticleUpdateOne) SetSourceCheckedAt(t time.Time) *ArticleUpdateOne {
+	auo.mutation.SetSourceCheckedAt(t)
+	return auo
+}
+
+// SetNillableSourceCheckedAt sets the "source_checked_at" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableSourceCheckedAt(t *time.Time) *ArticleUpdateOne {
+	if t != nil {
+		auo.SetSourceCheckedAt(*t)
+	}
+	return auo
+}
+
+// ClearSourceCheckedAt clears the value of the "source_checked_at" field.
+func (auo *ArticleUpdateOne) ClearSourceCheckedAt() *ArticleUpdateOne {
+	auo.mutation.ClearSourceCheckedAt()
+	return auo
+}
+
+// SetSourceFingerprint sets the "source_fingerprint" field.
+func (auo *ArticleUpdateOne) SetSourceFingerprint(u uint64) *ArticleUpdateOne {
+	auo.mutation.ResetSourceFingerprint()
+	auo.mutation.SetSourceFingerprint(u)
+	return auo
+}
+
+// SetNillableSourceFingerprint sets the "source_fingerprint" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableSourceFingerprint(u *uint64) *ArticleUpdateOne {
+	if u != nil {
+		auo.SetSourceFingerprint(*u)
+	}
+	return auo
+}
+
+// AddSourceFingerprint adds u to the "source_fingerprint" field.
+func (auo *ArticleUpdateOne) AddSourceFingerprint(u int64) *ArticleUpdateOne {
+	auo.mutation.AddSourceFingerprint(u)
+	return auo
+}
+
+// ClearSourceFingerprint clears the value of the "source_fingerprint" field.
+func (auo *ArticleUpdateOne) ClearSourceFingerprint() *ArticleUpdateOne {
+	auo.mutation.ClearSourceFingerprint()
+	return auo
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (auo *ArticleUpdateOne) SetCreatedAt(t time.Time) *ArticleUpdateOne {
+	auo.mutation.SetCreatedAt(t)
+	return auo
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (auo *ArticleUpdateOne) SetNillableCreatedAt(t *time.Time) *ArticleUpdateOne {
+	if t != nil {
+		auo.SetCreatedAt(*t)
+	}
+	return auo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (auo *ArticleUpdateOne) SetUpdatedAt(t time.Time) *ArticleUpdateOne {
+	auo.mutation.SetUpdatedAt(t)
+	return auo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (auo *ArticleUpdateOne) SetUser(u *User) *ArticleUpdateOne {
+	return auo.SetUserID(u.ID)
+}
+
+// SetCluster sets the "cluster" edge to the TopicCluster entity.
+func (auo *ArticleUpdateOne) SetCluster(t *TopicCluster) *ArticleUpdateOne {
+	return auo.SetClusterID(t.ID)
+}
+
+// AddChunkIDs adds the "chunks" edge to the ArticleChunk entity by IDs.
+func (auo *ArticleUpdateOne) AddChunkIDs(ids ...int) *ArticleUpdateOne {
+	auo.mutation.AddChunkIDs(ids...)
+	return auo
+}
+
+// AddChunks adds the "chunks" edges to the ArticleChunk entity.
+func (auo *ArticleUpdateOne) AddChunks(a ...*ArticleChunk) *ArticleUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.AddChunkIDs(ids...)
+}
+
+// AddHighlightIDs adds the "highlights" edge to the Highlight entity by IDs.
+func (auo *ArticleUpdateOne) AddHighlightIDs(ids ...int) *ArticleUpdateOne {
+	auo.mutation.AddHighlightIDs(ids...)
+	return auo
+}
+
+// AddHighlights adds the "highlights" edges to the Highlight entity.
+func (auo *ArticleUpdateOne) AddHighlights(h ...*Highlight) *ArticleUpdateOne {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return auo.AddHighlightIDs(ids...)
+}
+
+// AddSnapshotIDs adds the "snapshots" edge to the Snapshot entity by IDs.
+func (auo *ArticleUpdateOne) AddSnapshotIDs(ids ...int) *ArticleUpdateOne {
+	auo.mutation.AddSnapshotIDs(ids...)
+	return auo
+}
+
+// AddSnapshots adds the "snapshots" edges to the Snapshot entity.
+func (auo *ArticleUpdateOne) AddSnapshots(s ...*Snapshot) *ArticleUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return auo.AddSnapshotIDs(ids...)
+}
+
+// AddRevisionIDs adds the "revisions" edge to the ArticleRevision entity by IDs.
+func (auo *ArticleUpdateOne) AddRevisionIDs(ids ...int) *ArticleUpdateOne {
+	auo.mutation.AddRevisionIDs(ids...)
+	return auo
+}
+
+// AddRevisions adds the "revisions" edges to the ArticleRevision entity.
+func (auo *ArticleUpdateOne) AddRevisions(a ...*ArticleRevision) *ArticleUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.AddRevisionIDs(ids...)
+}
+
+// AddTranslationIDs adds the "translations" edge to the ArticleTranslation entity by IDs.
+func (auo *ArticleUpdateOne) AddTranslationIDs(ids ...int) *ArticleUpdateOne {
+	auo.mutation.AddTranslationIDs(ids...)
+	return auo
+}
+
+// AddTranslations adds the "translations" edges to the ArticleTranslation entity.
+func (auo *ArticleUpdateOne) AddTranslations(a ...*ArticleTranslation) *ArticleUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.AddTranslationIDs(ids...)
+}
+
+// AddEnrichmentIDs adds the "enrichments" edge to the ArticleEnrichment entity by IDs.
+func (auo *ArticleUpdateOne) AddEnrichmentIDs(ids ...int) *ArticleUpdateOne {
+	auo.mutation.AddEnrichmentIDs(ids...)
+	return auo
+}
+
+// AddEnrichments adds the "enrichments" edges to the ArticleEnrichment entity.
+func (auo *ArticleUpdateOne) AddEnrichments(a ...*ArticleEnrichment) *ArticleUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.AddEnrichmentIDs(ids...)
+}
+
+// Mutation returns the ArticleMutation object of the builder.
+func (auo *ArticleUpdateOne) Mutation() *ArticleMutation {
+	return auo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (auo *ArticleUpdateOne) ClearUser() *ArticleUpdateOne {
+	auo.mutation.ClearUser()
+	return auo
+}
+
+// ClearCluster clears the "cluster" edge to the TopicCluster entity.
+func (auo *ArticleUpdateOne) ClearCluster() *ArticleUpdateOne {
+	auo.mutation.ClearCluster()
+	return auo
+}
+
+// ClearChunks clears all "chunks" edges to the ArticleChunk entity.
+func (auo *ArticleUpdateOne) ClearChunks() *ArticleUpdateOne {
+	auo.mutation.ClearChunks()
+	return auo
+}
+
+// RemoveChunkIDs removes the "chunks" edge to ArticleChunk entities by IDs.
+func (auo *ArticleUpdateOne) RemoveChunkIDs(ids ...int) *ArticleUpdateOne {
+	auo.mutation.RemoveChunkIDs(ids...)
+	return auo
+}
+
+// RemoveChunks removes "chunks" edges to ArticleChunk entities.
+func (auo *ArticleUpdateOne) RemoveChunks(a ...*ArticleChunk) *ArticleUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.RemoveChunkIDs(ids...)
+}
+
+// ClearHighlights clears all "highlights" edges to the Highlight entity.
+func (auo *ArticleUpdateOne) ClearHighlights() *ArticleUpdateOne {
+	auo.mutation.ClearHighlights()
+	return auo
+}
+
+// RemoveHighlightIDs removes the "highlights" edge to Highlight entities by IDs.
+func (auo *ArticleUpdateOne) RemoveHighlightIDs(ids ...int) *ArticleUpdateOne {
+	auo.mutation.RemoveHighlightIDs(ids...)
+	return auo
+}
+
+// RemoveHighlights removes "highlights" edges to Highlight entities.
+func (auo *ArticleUpdateOne) RemoveHighlights(h ...*Highlight) *ArticleUpdateOne {
+	ids := make([]int, len(h))
+	for i := range h {
+		ids[i] = h[i].ID
+	}
+	return auo.RemoveHighlightIDs(ids...)
+}
+
+// ClearSnapshots clears all "snapshots" edges to the Snapshot entity.
+func (auo *ArticleUpdateOne) ClearSnapshots() *ArticleUpdateOne {
+	auo.mutation.ClearSnapshots()
+	return auo
+}
+
+// RemoveSnapshotIDs removes the "snapshots" edge to Snapshot entities by IDs.
+func (auo *ArticleUpdateOne) RemoveSnapshotIDs(ids ...int) *ArticleUpdateOne {
+	auo.mutation.RemoveSnapshotIDs(ids...)
+	return auo
+}
+
+// RemoveSnapshots removes "snapshots" edges to Snapshot entities.
+func (auo *ArticleUpdateOne) RemoveSnapshots(s ...*Snapshot) *ArticleUpdateOne {
+	ids := make([]int, len(s))
+	for i := range s {
+		ids[i] = s[i].ID
+	}
+	return auo.RemoveSnapshotIDs(ids...)
+}
+
+// ClearRevisions clears all "revisions" edges to the ArticleRevision entity.
+func (auo *ArticleUpdateOne) ClearRevisions() *ArticleUpdateOne {
+	auo.mutation.ClearRevisions()
+	return auo
+}
+
+// RemoveRevisionIDs removes the "revisions" edge to ArticleRevision entities by IDs.
+func (auo *ArticleUpdateOne) RemoveRevisionIDs(ids ...int) *ArticleUpdateOne {
+	auo.mutation.RemoveRevisionIDs(ids...)
 	return auo
 }
 
-// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
-func (auo *ArticleUpdateOne) SetNillableCreatedAt(t *time.Time) *ArticleUpdateOne {
-	if t != nil {
-		auo.SetCreatedAt(*t)
+// RemoveRevisions removes "revisions" edges to ArticleRevision entities.
+func (auo *ArticleUpdateOne) RemoveRevisions(a ...*ArticleRevision) *ArticleUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
 	}
-	return auo
+	return auo.RemoveRevisionIDs(ids...)
 }
 
-// SetUpdatedAt sets the "updated_at" field.
-func (auo *ArticleUpdateOne) SetUpdatedAt(t time.Time) *ArticleUpdateOne {
-	auo.mutation.SetUpdatedAt(t)
+// ClearTranslations clears all "translations" edges to the ArticleTranslation entity.
+func (auo *ArticleUpdateOne) ClearTranslations() *ArticleUpdateOne {
+	auo.mutation.ClearTranslations()
 	return auo
 }
 
-// SetUserID sets the "user" edge to the User entity by ID.
-func (auo *ArticleUpdateOne) SetUserID(id int) *ArticleUpdateOne {
-	auo.mutation.SetUserID(id)
+// RemoveTranslationIDs removes the "translations" edge to ArticleTranslation entities by IDs.
+func (auo *ArticleUpdateOne) RemoveTranslationIDs(ids ...int) *ArticleUpdateOne {
+	auo.mutation.RemoveTranslationIDs(ids...)
 	return auo
 }
 
-// SetUser sets the "user" edge to the User entity.
-func (auo *ArticleUpdateOne) SetUser(u *User) *ArticleUpdateOne {
-	return auo.SetUserID(u.ID)
+// RemoveTranslations removes "translations" edges to ArticleTranslation entities.
+func (auo *ArticleUpdateOne) RemoveTranslations(a ...*ArticleTranslation) *ArticleUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.RemoveTranslationIDs(ids...)
 }
 
-// Mutation returns the ArticleMutation object of the builder.
-func (auo *ArticleUpdateOne) Mutation() *ArticleMutation {
-	return auo.mutation
+// ClearEnrichments clears all "enrichments" edges to the ArticleEnrichment entity.
+func (auo *ArticleUpdateOne) ClearEnrichments() *ArticleUpdateOne {
+	auo.mutation.ClearEnrichments()
+	return auo
 }
 
-// ClearUser clears the "user" edge to the User entity.
-func (auo *ArticleUpdateOne) ClearUser() *ArticleUpdateOne {
-	auo.mutation.ClearUser()
+// RemoveEnrichmentIDs removes the "enrichments" edge to ArticleEnrichment entities by IDs.
+func (auo *ArticleUpdateOne) RemoveEnrichmentIDs(ids ...int) *ArticleUpdateOne {
+	auo.mutation.RemoveEnrichmentIDs(ids...)
 	return auo
 }
 
+// RemoveEnrichments removes "enrichments" edges to ArticleEnrichment entities.
+func (auo *ArticleUpdateOne) RemoveEnrichments(a ...*ArticleEnrichment) *ArticleUpdateOne {
+	ids := make([]int, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return auo.RemoveEnrichmentIDs(ids...)
+}
+
 // Where appends a list predicates to the ArticleUpdate builder.
 func (auo *ArticleUpdateOne) Where(ps ...predicate.Article) *ArticleUpdateOne {
 	auo.mutation.Where(ps...)
@@ -519,7 +2172,9 @@ func (auo *ArticleUpdateOne) Select(field string, fields ...string) *ArticleUpda
 
 // Save executes the query and returns the updated Article entity.
 func (auo *ArticleUpdateOne) Save(ctx context.Context) (*Article, error) {
-	auo.defaults()
+	if err := auo.defaults(); err != nil {
+		return nil, err
+	}
 	return withHooks(ctx, auo.sqlSave, auo.mutation, auo.hooks)
 }
 
@@ -546,15 +2201,29 @@ func (auo *ArticleUpdateOne) ExecX(ctx context.Context) {
 }
 
 // defaults sets the default values of the builder before save.
-func (auo *ArticleUpdateOne) defaults() {
+func (auo *ArticleUpdateOne) defaults() error {
 	if _, ok := auo.mutation.UpdatedAt(); !ok {
+		if article.UpdateDefaultUpdatedAt == nil {
+			return fmt.Errorf("ent: uninitialized article.UpdateDefaultUpdatedAt (forgotten import ent/runtime?)")
+		}
 		v := article.UpdateDefaultUpdatedAt()
 		auo.mutation.SetUpdatedAt(v)
 	}
+	return nil
 }
 
 // check runs all checks and user-defined validators on the builder.
 func (auo *ArticleUpdateOne) check() error {
+	if v, ok := auo.mutation.FetchStatus(); ok {
+		if err := article.FetchStatusValidator(v); err != nil {
+			return &ValidationError{Name: "fetch_status", err: fmt.Errorf(`ent: validator failed for field "Article.fetch_status": %w`, err)}
+		}
+	}
+	if v, ok := auo.mutation.SourceStatus(); ok {
+		if err := article.SourceStatusValidator(v); err != nil {
+			return &ValidationError{Name: "source_status", err: fmt.Errorf(`ent: validator failed for field "Article.source_status": %w`, err)}
+		}
+	}
 	if _, ok := auo.mutation.UserID(); auo.mutation.UserCleared() && !ok {
 		return errors.New(`ent: clearing a required unique edge "Article.user"`)
 	}
@@ -596,9 +2265,69 @@ func (auo *ArticleUpdateOne) sqlSave(ctx context.Context) (_node *Article, err e
 	if value, ok := auo.mutation.Content(); ok {
 		_spec.SetField(article.FieldContent, field.TypeString, value)
 	}
+	if value, ok := auo.mutation.ContentText(); ok {
+		_spec.SetField(article.FieldContentText, field.TypeString, value)
+	}
+	if auo.mutation.ContentTextCleared() {
+		_spec.ClearField(article.FieldContentText, field.TypeString)
+	}
+	if value, ok := auo.mutation.WordCount(); ok {
+		_spec.SetField(article.FieldWordCount, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedWordCount(); ok {
+		_spec.AddField(article.FieldWordCount, field.TypeInt, value)
+	}
+	if auo.mutation.WordCountCleared() {
+		_spec.ClearField(article.FieldWordCount, field.TypeInt)
+	}
+	if value, ok := auo.mutation.ReadingMinutes(); ok {
+		_spec.SetField(article.FieldReadingMinutes, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedReadingMinutes(); ok {
+		_spec.AddField(article.FieldReadingMinutes, field.TypeInt, value)
+	}
+	if auo.mutation.ReadingMinutesCleared() {
+		_spec.ClearField(article.FieldReadingMinutes, field.TypeInt)
+	}
+	if value, ok := auo.mutation.Language(); ok {
+		_spec.SetField(article.FieldLanguage, field.TypeString, value)
+	}
+	if auo.mutation.LanguageCleared() {
+		_spec.ClearField(article.FieldLanguage, field.TypeString)
+	}
+	if value, ok := auo.mutation.ImageCount(); ok {
+		_spec.SetField(article.FieldImageCount, field.TypeInt, value)
+	}
+	if value, ok := auo.mutation.AddedImageCount(); ok {
+		_spec.AddField(article.FieldImageCount, field.TypeInt, value)
+	}
+	if auo.mutation.ImageCountCleared() {
+		_spec.ClearField(article.FieldImageCount, field.TypeInt)
+	}
+	if value, ok := auo.mutation.LeadImage(); ok {
+		_spec.SetField(article.FieldLeadImage, field.TypeString, value)
+	}
+	if auo.mutation.LeadImageCleared() {
+		_spec.ClearField(article.FieldLeadImage, field.TypeString)
+	}
 	if value, ok := auo.mutation.URL(); ok {
 		_spec.SetField(article.FieldURL, field.TypeString, value)
 	}
+	if value, ok := auo.mutation.CanonicalURL(); ok {
+		_spec.SetField(article.FieldCanonicalURL, field.TypeString, value)
+	}
+	if auo.mutation.CanonicalURLCleared() {
+		_spec.ClearField(article.FieldCanonicalURL, field.TypeString)
+	}
+	if value, ok := auo.mutation.Fingerprint(); ok {
+		_spec.SetField(article.FieldFingerprint, field.TypeUint64, value)
+	}
+	if value, ok := auo.mutation.AddedFingerprint(); ok {
+		_spec.AddField(article.FieldFingerprint, field.TypeUint64, value)
+	}
+	if auo.mutation.FingerprintCleared() {
+		_spec.ClearField(article.FieldFingerprint, field.TypeUint64)
+	}
 	if value, ok := auo.mutation.Author(); ok {
 		_spec.SetField(article.FieldAuthor, field.TypeString, value)
 	}
@@ -625,6 +2354,57 @@ func (auo *ArticleUpdateOne) sqlSave(ctx context.Context) (_node *Article, err e
 	if value, ok := auo.mutation.PublishedAt(); ok {
 		_spec.SetField(article.FieldPublishedAt, field.TypeTime, value)
 	}
+	if value, ok := auo.mutation.ClusterCheckedAt(); ok {
+		_spec.SetField(article.FieldClusterCheckedAt, field.TypeTime, value)
+	}
+	if auo.mutation.ClusterCheckedAtCleared() {
+		_spec.ClearField(article.FieldClusterCheckedAt, field.TypeTime)
+	}
+	if value, ok := auo.mutation.FetchStatus(); ok {
+		_spec.SetField(article.FieldFetchStatus, field.TypeEnum, value)
+	}
+	if auo.mutation.FetchStatusCleared() {
+		_spec.ClearField(article.FieldFetchStatus, field.TypeEnum)
+	}
+	if value, ok := auo.mutation.FetchError(); ok {
+		_spec.SetField(article.FieldFetchError, field.TypeString, value)
+	}
+	if auo.mutation.FetchErrorCleared() {
+		_spec.ClearField(article.FieldFetchError, field.TypeString)
+	}
+	if value, ok := auo.mutation.ReadAt(); ok {
+		_spec.SetField(article.FieldReadAt, field.TypeTime, value)
+	}
+	if auo.mutation.ReadAtCleared() {
+		_spec.ClearField(article.FieldReadAt, field.TypeTime)
+	}
+	if value, ok := auo.mutation.SourceStatus(); ok {
+		_spec.SetField(article.FieldSourceStatus, field.TypeEnum, value)
+	}
+	if auo.mutation.SourceStatusCleared() {
+		_spec.ClearField(article.FieldSourceStatus, field.TypeEnum)
+	}
+	if value, ok := auo.mutation.SourceMovedTo(); ok {
+		_spec.SetField(article.FieldSourceMovedTo, field.TypeString, value)
+	}
+	if auo.mutation.SourceMovedToCleared() {
+		_spec.ClearField(article.FieldSourceMovedTo, field.TypeString)
+	}
+	if value, ok := auo.mutation.SourceCheckedAt(); ok {
+		_spec.SetField(article.FieldSourceCheckedAt, field.TypeTime, value)
+	}
+	if auo.mutation.SourceCheckedAtCleared() {
+		_spec.ClearField(article.FieldSourceCheckedAt, field.TypeTime)
+	}
+	if value, ok := auo.mutation.SourceFingerprint(); ok {
+		_spec.SetField(article.FieldSourceFingerprint, field.TypeUint64, value)
+	}
+	if value, ok := auo.mutation.AddedSourceFingerprint(); ok {
+		_spec.AddField(article.FieldSourceFingerprint, field.TypeUint64, value)
+	}
+	if auo.mutation.SourceFingerprintCleared() {
+		_spec.ClearField(article.FieldSourceFingerprint, field.TypeUint64)
+	}
 	if value, ok := auo.mutation.CreatedAt(); ok {
 		_spec.SetField(article.FieldCreatedAt, field.TypeTime, value)
 	}
@@ -660,6 +2440,305 @@ func (auo *ArticleUpdateOne) sqlSave(ctx context.Context) (_node *Article, err e
 		}
 		_spec.Edges.Add = append(_spec.Edges.Add, edge)
 	}
+	if auo.mutation.ClusterCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   article.ClusterTable,
+			Columns: []string{article.ClusterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(topiccluster.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.ClusterIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   article.ClusterTable,
+			Columns: []string{article.ClusterColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(topiccluster.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.ChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.ChunksTable,
+			Columns: []string{article.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlechunk.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedChunksIDs(); len(nodes) > 0 && !auo.mutation.ChunksCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.ChunksTable,
+			Columns: []string{article.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlechunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.ChunksIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.ChunksTable,
+			Columns: []string{article.ChunksColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlechunk.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.HighlightsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.HighlightsTable,
+			Columns: []string{article.HighlightsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(highlight.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedHighlightsIDs(); len(nodes) > 0 && !auo.mutation.HighlightsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.HighlightsTable,
+			Columns: []string{article.HighlightsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(highlight.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.HighlightsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.HighlightsTable,
+			Columns: []string{article.HighlightsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(highlight.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.SnapshotsTable,
+			Columns: []string{article.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedSnapshotsIDs(); len(nodes) > 0 && !auo.mutation.SnapshotsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.SnapshotsTable,
+			Columns: []string{article.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.SnapshotsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.SnapshotsTable,
+			Columns: []string{article.SnapshotsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.RevisionsTable,
+			Columns: []string{article.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlerevision.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedRevisionsIDs(); len(nodes) > 0 && !auo.mutation.RevisionsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.RevisionsTable,
+			Columns: []string{article.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlerevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RevisionsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.RevisionsTable,
+			Columns: []string{article.RevisionsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articlerevision.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.TranslationsTable,
+			Columns: []string{article.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articletranslation.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedTranslationsIDs(); len(nodes) > 0 && !auo.mutation.TranslationsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.TranslationsTable,
+			Columns: []string{article.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articletranslation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.TranslationsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.TranslationsTable,
+			Columns: []string{article.TranslationsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articletranslation.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if auo.mutation.EnrichmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.EnrichmentsTable,
+			Columns: []string{article.EnrichmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articleenrichment.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.RemovedEnrichmentsIDs(); len(nodes) > 0 && !auo.mutation.EnrichmentsCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.EnrichmentsTable,
+			Columns: []string{article.EnrichmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articleenrichment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := auo.mutation.EnrichmentsIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   article.EnrichmentsTable,
+			Columns: []string{article.EnrichmentsColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(articleenrichment.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
 	_node = &Article{config: auo.config}
 	_spec.Assign = _node.assignValues
 	_spec.ScanValues = _node.scanValues
@@ -0,0 +1,419 @@
+// Code generated by ent, DO NOT EDIT.
+
+package articlechunk
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldLTE(FieldID, id))
+}
+
+// ArticleID applies equality check predicate on the "article_id" field. It's identical to ArticleIDEQ.
+func ArticleID(v uint) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldEQ(FieldArticleID, v))
+}
+
+// Seq applies equality check predicate on the "seq" field. It's identical to SeqEQ.
+func Seq(v int) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldEQ(FieldSeq, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentHash applies equality check predicate on the "content_hash" field. It's identical to ContentHashEQ.
+func ContentHash(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldEQ(FieldContentHash, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldEQ(FieldModel, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ArticleIDEQ applies the EQ predicate on the "article_id" field.
+func ArticleIDEQ(v uint) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldEQ(FieldArticleID, v))
+}
+
+// ArticleIDNEQ applies the NEQ predicate on the "article_id" field.
+func ArticleIDNEQ(v uint) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldNEQ(FieldArticleID, v))
+}
+
+// ArticleIDIn applies the In predicate on the "article_id" field.
+func ArticleIDIn(vs ...uint) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldIn(FieldArticleID, vs...))
+}
+
+// ArticleIDNotIn applies the NotIn predicate on the "article_id" field.
+func ArticleIDNotIn(vs ...uint) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldNotIn(FieldArticleID, vs...))
+}
+
+// SeqEQ applies the EQ predicate on the "seq" field.
+func SeqEQ(v int) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldEQ(FieldSeq, v))
+}
+
+// SeqNEQ applies the NEQ predicate on the "seq" field.
+func SeqNEQ(v int) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldNEQ(FieldSeq, v))
+}
+
+// SeqIn applies the In predicate on the "seq" field.
+func SeqIn(vs ...int) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldIn(FieldSeq, vs...))
+}
+
+// SeqNotIn applies the NotIn predicate on the "seq" field.
+func SeqNotIn(vs ...int) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldNotIn(FieldSeq, vs...))
+}
+
+// SeqGT applies the GT predicate on the "seq" field.
+func SeqGT(v int) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldGT(FieldSeq, v))
+}
+
+// SeqGTE applies the GTE predicate on the "seq" field.
+func SeqGTE(v int) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldGTE(FieldSeq, v))
+}
+
+// SeqLT applies the LT predicate on the "seq" field.
+func SeqLT(v int) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldLT(FieldSeq, v))
+}
+
+// SeqLTE applies the LTE predicate on the "seq" field.
+func SeqLTE(v int) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldLTE(FieldSeq, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldContainsFold(FieldContent, v))
+}
+
+// ContentHashEQ applies the EQ predicate on the "content_hash" field.
+func ContentHashEQ(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldEQ(FieldContentHash, v))
+}
+
+// ContentHashNEQ applies the NEQ predicate on the "content_hash" field.
+func ContentHashNEQ(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldNEQ(FieldContentHash, v))
+}
+
+// ContentHashIn applies the In predicate on the "content_hash" field.
+func ContentHashIn(vs ...string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldIn(FieldContentHash, vs...))
+}
+
+// ContentHashNotIn applies the NotIn predicate on the "content_hash" field.
+func ContentHashNotIn(vs ...string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldNotIn(FieldContentHash, vs...))
+}
+
+// ContentHashGT applies the GT predicate on the "content_hash" field.
+func ContentHashGT(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldGT(FieldContentHash, v))
+}
+
+// ContentHashGTE applies the GTE predicate on the "content_hash" field.
+func ContentHashGTE(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldGTE(FieldContentHash, v))
+}
+
+// ContentHashLT applies the LT predicate on the "content_hash" field.
+func ContentHashLT(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldLT(FieldContentHash, v))
+}
+
+// ContentHashLTE applies the LTE predicate on the "content_hash" field.
+func ContentHashLTE(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldLTE(FieldContentHash, v))
+}
+
+// ContentHashContains applies the Contains predicate on the "content_hash" field.
+func ContentHashContains(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldContains(FieldContentHash, v))
+}
+
+// ContentHashHasPrefix applies the HasPrefix predicate on the "content_hash" field.
+func ContentHashHasPrefix(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldHasPrefix(FieldContentHash, v))
+}
+
+// ContentHashHasSuffix applies the HasSuffix predicate on the "content_hash" field.
+func ContentHashHasSuffix(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldHasSuffix(FieldContentHash, v))
+}
+
+// ContentHashEqualFold applies the EqualFold predicate on the "content_hash" field.
+func ContentHashEqualFold(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldEqualFold(FieldContentHash, v))
+}
+
+// ContentHashContainsFold applies the ContainsFold predicate on the "content_hash" field.
+func ContentHashContainsFold(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldContainsFold(FieldContentHash, v))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldContainsFold(FieldModel, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasArticle applies the HasEdge predicate on the "article" edge.
+func HasArticle() predicate.ArticleChunk {
+	return predicate.ArticleChunk(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ArticleTable, ArticleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArticleWith applies the HasEdge predicate on the "article" edge with a given conditions (other predicates).
+func HasArticleWith(preds ...predicate.Article) predicate.ArticleChunk {
+	return predicate.ArticleChunk(func(s *sql.Selector) {
+		step := newArticleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ArticleChunk) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ArticleChunk) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ArticleChunk) predicate.ArticleChunk {
+	return predicate.ArticleChunk(sql.NotPredicates(p))
+}
@@ -0,0 +1,307 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articlechunk"
+)
+
+// ArticleChunkCreate is the builder for creating a ArticleChunk entity.
+type ArticleChunkCreate struct {
+	config
+	mutation *ArticleChunkMutation
+	hooks    []Hook
+}
+
+// SetArticleID sets the "article_id" field.
+func (acc *ArticleChunkCreate) SetArticleID(u uint) *ArticleChunkCreate {
+	acc.mutation.SetArticleID(u)
+	return acc
+}
+
+// SetSeq sets the "seq" field.
+func (acc *ArticleChunkCreate) SetSeq(i int) *ArticleChunkCreate {
+	acc.mutation.SetSeq(i)
+	return acc
+}
+
+// SetContent sets the "content" field.
+func (acc *ArticleChunkCreate) SetContent(s string) *ArticleChunkCreate {
+	acc.mutation.SetContent(s)
+	return acc
+}
+
+// SetContentHash sets the "content_hash" field.
+func (acc *ArticleChunkCreate) SetContentHash(s string) *ArticleChunkCreate {
+	acc.mutation.SetContentHash(s)
+	return acc
+}
+
+// SetModel sets the "model" field.
+func (acc *ArticleChunkCreate) SetModel(s string) *ArticleChunkCreate {
+	acc.mutation.SetModel(s)
+	return acc
+}
+
+// SetEmbedding sets the "embedding" field.
+func (acc *ArticleChunkCreate) SetEmbedding(f []float32) *ArticleChunkCreate {
+	acc.mutation.SetEmbedding(f)
+	return acc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (acc *ArticleChunkCreate) SetCreatedAt(t time.Time) *ArticleChunkCreate {
+	acc.mutation.SetCreatedAt(t)
+	return acc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (acc *ArticleChunkCreate) SetNillableCreatedAt(t *time.Time) *ArticleChunkCreate {
+	if t != nil {
+		acc.SetCreatedAt(*t)
+	}
+	return acc
+}
+
+// SetArticle sets the "article" edge to the Article entity.
+func (acc *ArticleChunkCreate) SetArticle(a *Article) *ArticleChunkCreate {
+	return acc.SetArticleID(a.ID)
+}
+
+// Mutation returns the ArticleChunkMutation object of the builder.
+func (acc *ArticleChunkCreate) Mutation() *ArticleChunkMutation {
+	return acc.mutation
+}
+
+// Save creates the ArticleChunk in the database.
+func (acc *ArticleChunkCreate) Save(ctx context.Context) (*ArticleChunk, error) {
+	acc.defaults()
+	return withHooks(ctx, acc.sqlSave, acc.mutation, acc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (acc *ArticleChunkCreate) SaveX(ctx context.Context) *ArticleChunk {
+	v, err := acc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (acc *ArticleChunkCreate) Exec(ctx context.Context) error {
+	_, err := acc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acc *ArticleChunkCreate) ExecX(ctx context.Context) {
+	if err := acc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (acc *ArticleChunkCreate) defaults() {
+	if _, ok := acc.mutation.CreatedAt(); !ok {
+		v := articlechunk.DefaultCreatedAt()
+		acc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (acc *ArticleChunkCreate) check() error {
+	if _, ok := acc.mutation.ArticleID(); !ok {
+		return &ValidationError{Name: "article_id", err: errors.New(`ent: missing required field "ArticleChunk.article_id"`)}
+	}
+	if _, ok := acc.mutation.Seq(); !ok {
+		return &ValidationError{Name: "seq", err: errors.New(`ent: missing required field "ArticleChunk.seq"`)}
+	}
+	if v, ok := acc.mutation.Seq(); ok {
+		if err := articlechunk.SeqValidator(v); err != nil {
+			return &ValidationError{Name: "seq", err: fmt.Errorf(`ent: validator failed for field "ArticleChunk.seq": %w`, err)}
+		}
+	}
+	if _, ok := acc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "ArticleChunk.content"`)}
+	}
+	if _, ok := acc.mutation.ContentHash(); !ok {
+		return &ValidationError{Name: "content_hash", err: errors.New(`ent: missing required field "ArticleChunk.content_hash"`)}
+	}
+	if _, ok := acc.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "ArticleChunk.model"`)}
+	}
+	if _, ok := acc.mutation.Embedding(); !ok {
+		return &ValidationError{Name: "embedding", err: errors.New(`ent: missing required field "ArticleChunk.embedding"`)}
+	}
+	if _, ok := acc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ArticleChunk.created_at"`)}
+	}
+	if _, ok := acc.mutation.ArticleID(); !ok {
+		return &ValidationError{Name: "article", err: errors.New(`ent: missing required edge "ArticleChunk.article"`)}
+	}
+	return nil
+}
+
+func (acc *ArticleChunkCreate) sqlSave(ctx context.Context) (*ArticleChunk, error) {
+	if err := acc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := acc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, acc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	acc.mutation.id = &_node.ID
+	acc.mutation.done = true
+	return _node, nil
+}
+
+func (acc *ArticleChunkCreate) createSpec() (*ArticleChunk, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ArticleChunk{config: acc.config}
+		_spec = sqlgraph.NewCreateSpec(articlechunk.Table, sqlgraph.NewFieldSpec(articlechunk.FieldID, field.TypeInt))
+	)
+	if value, ok := acc.mutation.Seq(); ok {
+		_spec.SetField(articlechunk.FieldSeq, field.TypeInt, value)
+		_node.Seq = value
+	}
+	if value, ok := acc.mutation.Content(); ok {
+		_spec.SetField(articlechunk.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := acc.mutation.ContentHash(); ok {
+		_spec.SetField(articlechunk.FieldContentHash, field.TypeString, value)
+		_node.ContentHash = value
+	}
+	if value, ok := acc.mutation.Model(); ok {
+		_spec.SetField(articlechunk.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := acc.mutation.Embedding(); ok {
+		_spec.SetField(articlechunk.FieldEmbedding, field.TypeJSON, value)
+		_node.Embedding = value
+	}
+	if value, ok := acc.mutation.CreatedAt(); ok {
+		_spec.SetField(articlechunk.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := acc.mutation.ArticleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articlechunk.ArticleTable,
+			Columns: []string{articlechunk.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ArticleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ArticleChunkCreateBulk is the builder for creating many ArticleChunk entities in bulk.
+type ArticleChunkCreateBulk struct {
+	config
+	err      error
+	builders []*ArticleChunkCreate
+}
+
+// Save creates the ArticleChunk entities in the database.
+func (accb *ArticleChunkCreateBulk) Save(ctx context.Context) ([]*ArticleChunk, error) {
+	if accb.err != nil {
+		return nil, accb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(accb.builders))
+	nodes := make([]*ArticleChunk, len(accb.builders))
+	mutators := make([]Mutator, len(accb.builders))
+	for i := range accb.builders {
+		func(i int, root context.Context) {
+			builder := accb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ArticleChunkMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, accb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, accb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, accb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (accb *ArticleChunkCreateBulk) SaveX(ctx context.Context) []*ArticleChunk {
+	v, err := accb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (accb *ArticleChunkCreateBulk) Exec(ctx context.Context) error {
+	_, err := accb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (accb *ArticleChunkCreateBulk) ExecX(ctx context.Context) {
+	if err := accb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articlechunk"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+)
+
+// ArticleChunkDelete is the builder for deleting a ArticleChunk entity.
+type ArticleChunkDelete struct {
+	config
+	hooks    []Hook
+	mutation *ArticleChunkMutation
+}
+
+// Where appends a list predicates to the ArticleChunkDelete builder.
+func (acd *ArticleChunkDelete) Where(ps ...predicate.ArticleChunk) *ArticleChunkDelete {
+	acd.mutation.Where(ps...)
+	return acd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (acd *ArticleChunkDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, acd.sqlExec, acd.mutation, acd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acd *ArticleChunkDelete) ExecX(ctx context.Context) int {
+	n, err := acd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (acd *ArticleChunkDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(articlechunk.Table, sqlgraph.NewFieldSpec(articlechunk.FieldID, field.TypeInt))
+	if ps := acd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, acd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	acd.mutation.done = true
+	return affected, err
+}
+
+// ArticleChunkDeleteOne is the builder for deleting a single ArticleChunk entity.
+type ArticleChunkDeleteOne struct {
+	acd *ArticleChunkDelete
+}
+
+// Where appends a list predicates to the ArticleChunkDelete builder.
+func (acdo *ArticleChunkDeleteOne) Where(ps ...predicate.ArticleChunk) *ArticleChunkDeleteOne {
+	acdo.acd.mutation.Where(ps...)
+	return acdo
+}
+
+// Exec executes the deletion query.
+func (acdo *ArticleChunkDeleteOne) Exec(ctx context.Context) error {
+	n, err := acdo.acd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{articlechunk.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acdo *ArticleChunkDeleteOne) ExecX(ctx context.Context) {
+	if err := acdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
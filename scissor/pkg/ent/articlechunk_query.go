@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articlechunk"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+)
+
+// ArticleChunkQuery is the builder for querying ArticleChunk entities.
+type ArticleChunkQuery struct {
+	config
+	ctx         *QueryContext
+	order       []articlechunk.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ArticleChunk
+	withArticle *ArticleQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ArticleChunkQuery builder.
+func (acq *ArticleChunkQuery) Where(ps ...predicate.ArticleChunk) *ArticleChunkQuery {
+	acq.predicates = append(acq.predicates, ps...)
+	return acq
+}
+
+// Limit the number of records to be returned by this query.
+func (acq *ArticleChunkQuery) Limit(limit int) *ArticleChunkQuery {
+	acq.ctx.Limit = &limit
+	return acq
+}
+
+// Offset to start from.
+func (acq *ArticleChunkQuery) Offset(offset int) *ArticleChunkQuery {
+	acq.ctx.Offset = &offset
+	return acq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (acq *ArticleChunkQuery) Unique(unique bool) *ArticleChunkQuery {
+	acq.ctx.Unique = &unique
+	return acq
+}
+
+// Order specifies how the records should be ordered.
+func (acq *ArticleChunkQuery) Order(o ...articlechunk.OrderOption) *ArticleChunkQuery {
+	acq.order = append(acq.order, o...)
+	return acq
+}
+
+// QueryArticle chains the current query on the "article" edge.
+func (acq *ArticleChunkQuery) QueryArticle() *ArticleQuery {
+	query := (&ArticleClient{config: acq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := acq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := acq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(articlechunk.Table, articlechunk.FieldID, selector),
+			sqlgraph.To(article.Table, article.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, articlechunk.ArticleTable, articlechunk.ArticleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(acq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ArticleChunk entity from the query.
+// Returns a *NotFoundError when no ArticleChunk was found.
+func (acq *ArticleChunkQuery) First(ctx context.Context) (*ArticleChunk, error) {
+	nodes, err := acq.Limit(1).All(setContextOp(ctx, acq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{articlechunk.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (acq *ArticleChunkQuery) FirstX(ctx context.Context) *ArticleChunk {
+	node, err := acq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ArticleChunk ID from the query.
+// Returns a *NotFoundError when no ArticleChunk ID was found.
+func (acq *ArticleChunkQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = acq.Limit(1).IDs(setContextOp(ctx, acq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{articlechunk.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (acq *ArticleChunkQuery) FirstIDX(ctx context.Context) int {
+	id, err := acq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ArticleChunk entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ArticleChunk entity is found.
+// Returns a *NotFoundError when no ArticleChunk entities are found.
+func (acq *ArticleChunkQuery) Only(ctx context.Context) (*ArticleChunk, error) {
+	nodes, err := acq.Limit(2).All(setContextOp(ctx, acq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{articlechunk.Label}
+	default:
+		return nil, &NotSingularError{articlechunk.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (acq *ArticleChunkQuery) OnlyX(ctx context.Context) *ArticleChunk {
+	node, err := acq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ArticleChunk ID in the query.
+// Returns a *NotSingularError when more than one ArticleChunk ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (acq *ArticleChunkQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = acq.Limit(2).IDs(setContextOp(ctx, acq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{articlechunk.Label}
+	default:
+		err = &NotSingularError{articlechunk.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (acq *ArticleChunkQuery) OnlyIDX(ctx context.Context) int {
+	id, err := acq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ArticleChunks.
+func (acq *ArticleChunkQuery) All(ctx context.Context) ([]*ArticleChunk, error) {
+	ctx = setContextOp(ctx, acq.ctx, "All")
+	if err := acq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ArticleChunk, *ArticleChunkQuery]()
+	return withInterceptors[[]*ArticleChunk](ctx, acq, qr, acq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (acq *ArticleChunkQuery) AllX(ctx context.Context) []*ArticleChunk {
+	nodes, err := acq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ArticleChunk IDs.
+func (acq *ArticleChunkQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if acq.ctx.Unique == nil && acq.path != nil {
+		acq.Unique(true)
+	}
+	ctx = setContextOp(ctx, acq.ctx, "IDs")
+	if err = acq.Select(articlechunk.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (acq *ArticleChunkQuery) IDsX(ctx context.Context) []int {
+	ids, err := acq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (acq *ArticleChunkQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, acq.ctx, "Count")
+	if err := acq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, acq, querierCount[*ArticleChunkQuery](), acq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (acq *ArticleChunkQuery) CountX(ctx context.Context) int {
+	count, err := acq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (acq *ArticleChunkQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, acq.ctx, "Exist")
+	switch _, err := acq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (acq *ArticleChunkQuery) ExistX(ctx context.Context) bool {
+	exist, err := acq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ArticleChunkQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (acq *ArticleChunkQuery) Clone() *ArticleChunkQuery {
+	if acq == nil {
+		return nil
+	}
+	return &ArticleChunkQuery{
+		config:      acq.config,
+		ctx:         acq.ctx.Clone(),
+		order:       append([]articlechunk.OrderOption{}, acq.order...),
+		inters:      append([]Interceptor{}, acq.inters...),
+		predicates:  append([]predicate.ArticleChunk{}, acq.predicates...),
+		withArticle: acq.withArticle.Clone(),
+		// clone intermediate query.
+		sql:  acq.sql.Clone(),
+		path: acq.path,
+	}
+}
+
+// WithArticle tells the query-builder to eager-load the nodes that are connected to
+// the "article" edge. The optional arguments are used to configure the query builder of the edge.
+func (acq *ArticleChunkQuery) WithArticle(opts ...func(*ArticleQuery)) *ArticleChunkQuery {
+	query := (&ArticleClient{config: acq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	acq.withArticle = query
+	return acq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ArticleID uint `json:"article_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ArticleChunk.Query().
+//		GroupBy(articlechunk.FieldArticleID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (acq *ArticleChunkQuery) GroupBy(field string, fields ...string) *ArticleChunkGroupBy {
+	acq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ArticleChunkGroupBy{build: acq}
+	grbuild.flds = &acq.ctx.Fields
+	grbuild.label = articlechunk.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ArticleID uint `json:"article_id,omitempty"`
+//	}
+//
+//	client.ArticleChunk.Query().
+//		Select(articlechunk.FieldArticleID).
+//		Scan(ctx, &v)
+func (acq *ArticleChunkQuery) Select(fields ...string) *ArticleChunkSelect {
+	acq.ctx.Fields = append(acq.ctx.Fields, fields...)
+	sbuild := &ArticleChunkSelect{ArticleChunkQuery: acq}
+	sbuild.label = articlechunk.Label
+	sbuild.flds, sbuild.scan = &acq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ArticleChunkSelect configured with the given aggregations.
+func (acq *ArticleChunkQuery) Aggregate(fns ...AggregateFunc) *ArticleChunkSelect {
+	return acq.Select().Aggregate(fns...)
+}
+
+func (acq *ArticleChunkQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range acq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, acq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range acq.ctx.Fields {
+		if !articlechunk.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if acq.path != nil {
+		prev, err := acq.path(ctx)
+		if err != nil {
+			return err
+		}
+		acq.sql = prev
+	}
+	return nil
+}
+
+func (acq *ArticleChunkQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ArticleChunk, error) {
+	var (
+		nodes       = []*ArticleChunk{}
+		_spec       = acq.querySpec()
+		loadedTypes = [1]bool{
+			acq.withArticle != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ArticleChunk).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ArticleChunk{config: acq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, acq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := acq.withArticle; query != nil {
+		if err := acq.loadArticle(ctx, query, nodes, nil,
+			func(n *ArticleChunk, e *Article) { n.Edges.Article = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (acq *ArticleChunkQuery) loadArticle(ctx context.Context, query *ArticleQuery, nodes []*ArticleChunk, init func(*ArticleChunk), assign func(*ArticleChunk, *Article)) error {
+	ids := make([]uint, 0, len(nodes))
+	nodeids := make(map[uint][]*ArticleChunk)
+	for i := range nodes {
+		fk := nodes[i].ArticleID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(article.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "article_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (acq *ArticleChunkQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := acq.querySpec()
+	_spec.Node.Columns = acq.ctx.Fields
+	if len(acq.ctx.Fields) > 0 {
+		_spec.Unique = acq.ctx.Unique != nil && *acq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, acq.driver, _spec)
+}
+
+func (acq *ArticleChunkQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(articlechunk.Table, articlechunk.Columns, sqlgraph.NewFieldSpec(articlechunk.FieldID, field.TypeInt))
+	_spec.From = acq.sql
+	if unique := acq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if acq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := acq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, articlechunk.FieldID)
+		for i := range fields {
+			if fields[i] != articlechunk.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if acq.withArticle != nil {
+			_spec.Node.AddColumnOnce(articlechunk.FieldArticleID)
+		}
+	}
+	if ps := acq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := acq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := acq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := acq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (acq *ArticleChunkQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(acq.driver.Dialect())
+	t1 := builder.Table(articlechunk.Table)
+	columns := acq.ctx.Fields
+	if len(columns) == 0 {
+		columns = articlechunk.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if acq.sql != nil {
+		selector = acq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if acq.ctx.Unique != nil && *acq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range acq.predicates {
+		p(selector)
+	}
+	for _, p := range acq.order {
+		p(selector)
+	}
+	if offset := acq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := acq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ArticleChunkGroupBy is the group-by builder for ArticleChunk entities.
+type ArticleChunkGroupBy struct {
+	selector
+	build *ArticleChunkQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (acgb *ArticleChunkGroupBy) Aggregate(fns ...AggregateFunc) *ArticleChunkGroupBy {
+	acgb.fns = append(acgb.fns, fns...)
+	return acgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (acgb *ArticleChunkGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, acgb.build.ctx, "GroupBy")
+	if err := acgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ArticleChunkQuery, *ArticleChunkGroupBy](ctx, acgb.build, acgb, acgb.build.inters, v)
+}
+
+func (acgb *ArticleChunkGroupBy) sqlScan(ctx context.Context, root *ArticleChunkQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(acgb.fns))
+	for _, fn := range acgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*acgb.flds)+len(acgb.fns))
+		for _, f := range *acgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*acgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := acgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ArticleChunkSelect is the builder for selecting fields of ArticleChunk entities.
+type ArticleChunkSelect struct {
+	*ArticleChunkQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (acs *ArticleChunkSelect) Aggregate(fns ...AggregateFunc) *ArticleChunkSelect {
+	acs.fns = append(acs.fns, fns...)
+	return acs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (acs *ArticleChunkSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, acs.ctx, "Select")
+	if err := acs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ArticleChunkQuery, *ArticleChunkSelect](ctx, acs.ArticleChunkQuery, acs, acs.inters, v)
+}
+
+func (acs *ArticleChunkSelect) sqlScan(ctx context.Context, root *ArticleChunkQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(acs.fns))
+	for _, fn := range acs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*acs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := acs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,513 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articlechunk"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+)
+
+// ArticleChunkUpdate is the builder for updating ArticleChunk entities.
+type ArticleChunkUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ArticleChunkMutation
+}
+
+// Where appends a list predicates to the ArticleChunkUpdate builder.
+func (acu *ArticleChunkUpdate) Where(ps ...predicate.ArticleChunk) *ArticleChunkUpdate {
+	acu.mutation.Where(ps...)
+	return acu
+}
+
+// SetArticleID sets the "article_id" field.
+func (acu *ArticleChunkUpdate) SetArticleID(u uint) *ArticleChunkUpdate {
+	acu.mutation.SetArticleID(u)
+	return acu
+}
+
+// SetNillableArticleID sets the "article_id" field if the given value is not nil.
+func (acu *ArticleChunkUpdate) SetNillableArticleID(u *uint) *ArticleChunkUpdate {
+	if u != nil {
+		acu.SetArticleID(*u)
+	}
+	return acu
+}
+
+// SetSeq sets the "seq" field.
+func (acu *ArticleChunkUpdate) SetSeq(i int) *ArticleChunkUpdate {
+	acu.mutation.ResetSeq()
+	acu.mutation.SetSeq(i)
+	return acu
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (acu *ArticleChunkUpdate) SetNillableSeq(i *int) *ArticleChunkUpdate {
+	if i != nil {
+		acu.SetSeq(*i)
+	}
+	return acu
+}
+
+// AddSeq adds i to the "seq" field.
+func (acu *ArticleChunkUpdate) AddSeq(i int) *ArticleChunkUpdate {
+	acu.mutation.AddSeq(i)
+	return acu
+}
+
+// SetContent sets the "content" field.
+func (acu *ArticleChunkUpdate) SetContent(s string) *ArticleChunkUpdate {
+	acu.mutation.SetContent(s)
+	return acu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (acu *ArticleChunkUpdate) SetNillableContent(s *string) *ArticleChunkUpdate {
+	if s != nil {
+		acu.SetContent(*s)
+	}
+	return acu
+}
+
+// SetContentHash sets the "content_hash" field.
+func (acu *ArticleChunkUpdate) SetContentHash(s string) *ArticleChunkUpdate {
+	acu.mutation.SetContentHash(s)
+	return acu
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (acu *ArticleChunkUpdate) SetNillableContentHash(s *string) *ArticleChunkUpdate {
+	if s != nil {
+		acu.SetContentHash(*s)
+	}
+	return acu
+}
+
+// SetModel sets the "model" field.
+func (acu *ArticleChunkUpdate) SetModel(s string) *ArticleChunkUpdate {
+	acu.mutation.SetModel(s)
+	return acu
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (acu *ArticleChunkUpdate) SetNillableModel(s *string) *ArticleChunkUpdate {
+	if s != nil {
+		acu.SetModel(*s)
+	}
+	return acu
+}
+
+// SetEmbedding sets the "embedding" field.
+func (acu *ArticleChunkUpdate) SetEmbedding(f []float32) *ArticleChunkUpdate {
+	acu.mutation.SetEmbedding(f)
+	return acu
+}
+
+// AppendEmbedding appends f to the "embedding" field.
+func (acu *ArticleChunkUpdate) AppendEmbedding(f []float32) *ArticleChunkUpdate {
+	acu.mutation.AppendEmbedding(f)
+	return acu
+}
+
+// SetArticle sets the "article" edge to the Article entity.
+func (acu *ArticleChunkUpdate) SetArticle(a *Article) *ArticleChunkUpdate {
+	return acu.SetArticleID(a.ID)
+}
+
+// Mutation returns the ArticleChunkMutation object of the builder.
+func (acu *ArticleChunkUpdate) Mutation() *ArticleChunkMutation {
+	return acu.mutation
+}
+
+// ClearArticle clears the "article" edge to the Article entity.
+func (acu *ArticleChunkUpdate) ClearArticle() *ArticleChunkUpdate {
+	acu.mutation.ClearArticle()
+	return acu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (acu *ArticleChunkUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, acu.sqlSave, acu.mutation, acu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acu *ArticleChunkUpdate) SaveX(ctx context.Context) int {
+	affected, err := acu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (acu *ArticleChunkUpdate) Exec(ctx context.Context) error {
+	_, err := acu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acu *ArticleChunkUpdate) ExecX(ctx context.Context) {
+	if err := acu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (acu *ArticleChunkUpdate) check() error {
+	if v, ok := acu.mutation.Seq(); ok {
+		if err := articlechunk.SeqValidator(v); err != nil {
+			return &ValidationError{Name: "seq", err: fmt.Errorf(`ent: validator failed for field "ArticleChunk.seq": %w`, err)}
+		}
+	}
+	if _, ok := acu.mutation.ArticleID(); acu.mutation.ArticleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ArticleChunk.article"`)
+	}
+	return nil
+}
+
+func (acu *ArticleChunkUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := acu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(articlechunk.Table, articlechunk.Columns, sqlgraph.NewFieldSpec(articlechunk.FieldID, field.TypeInt))
+	if ps := acu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := acu.mutation.Seq(); ok {
+		_spec.SetField(articlechunk.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.AddedSeq(); ok {
+		_spec.AddField(articlechunk.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := acu.mutation.Content(); ok {
+		_spec.SetField(articlechunk.FieldContent, field.TypeString, value)
+	}
+	if value, ok := acu.mutation.ContentHash(); ok {
+		_spec.SetField(articlechunk.FieldContentHash, field.TypeString, value)
+	}
+	if value, ok := acu.mutation.Model(); ok {
+		_spec.SetField(articlechunk.FieldModel, field.TypeString, value)
+	}
+	if value, ok := acu.mutation.Embedding(); ok {
+		_spec.SetField(articlechunk.FieldEmbedding, field.TypeJSON, value)
+	}
+	if value, ok := acu.mutation.AppendedEmbedding(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, articlechunk.FieldEmbedding, value)
+		})
+	}
+	if acu.mutation.ArticleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articlechunk.ArticleTable,
+			Columns: []string{articlechunk.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acu.mutation.ArticleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articlechunk.ArticleTable,
+			Columns: []string{articlechunk.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, acu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{articlechunk.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	acu.mutation.done = true
+	return n, nil
+}
+
+// ArticleChunkUpdateOne is the builder for updating a single ArticleChunk entity.
+type ArticleChunkUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ArticleChunkMutation
+}
+
+// SetArticleID sets the "article_id" field.
+func (acuo *ArticleChunkUpdateOne) SetArticleID(u uint) *ArticleChunkUpdateOne {
+	acuo.mutation.SetArticleID(u)
+	return acuo
+}
+
+// SetNillableArticleID sets the "article_id" field if the given value is not nil.
+func (acuo *ArticleChunkUpdateOne) SetNillableArticleID(u *uint) *ArticleChunkUpdateOne {
+	if u != nil {
+		acuo.SetArticleID(*u)
+	}
+	return acuo
+}
+
+// SetSeq sets the "seq" field.
+func (acuo *ArticleChunkUpdateOne) SetSeq(i int) *ArticleChunkUpdateOne {
+	acuo.mutation.ResetSeq()
+	acuo.mutation.SetSeq(i)
+	return acuo
+}
+
+// SetNillableSeq sets the "seq" field if the given value is not nil.
+func (acuo *ArticleChunkUpdateOne) SetNillableSeq(i *int) *ArticleChunkUpdateOne {
+	if i != nil {
+		acuo.SetSeq(*i)
+	}
+	return acuo
+}
+
+// AddSeq adds i to the "seq" field.
+func (acuo *ArticleChunkUpdateOne) AddSeq(i int) *ArticleChunkUpdateOne {
+	acuo.mutation.AddSeq(i)
+	return acuo
+}
+
+// SetContent sets the "content" field.
+func (acuo *ArticleChunkUpdateOne) SetContent(s string) *ArticleChunkUpdateOne {
+	acuo.mutation.SetContent(s)
+	return acuo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (acuo *ArticleChunkUpdateOne) SetNillableContent(s *string) *ArticleChunkUpdateOne {
+	if s != nil {
+		acuo.SetContent(*s)
+	}
+	return acuo
+}
+
+// SetContentHash sets the "content_hash" field.
+func (acuo *ArticleChunkUpdateOne) SetContentHash(s string) *ArticleChunkUpdateOne {
+	acuo.mutation.SetContentHash(s)
+	return acuo
+}
+
+// SetNillableContentHash sets the "content_hash" field if the given value is not nil.
+func (acuo *ArticleChunkUpdateOne) SetNillableContentHash(s *string) *ArticleChunkUpdateOne {
+	if s != nil {
+		acuo.SetContentHash(*s)
+	}
+	return acuo
+}
+
+// SetModel sets the "model" field.
+func (acuo *ArticleChunkUpdateOne) SetModel(s string) *ArticleChunkUpdateOne {
+	acuo.mutation.SetModel(s)
+	return acuo
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (acuo *ArticleChunkUpdateOne) SetNillableModel(s *string) *ArticleChunkUpdateOne {
+	if s != nil {
+		acuo.SetModel(*s)
+	}
+	return acuo
+}
+
+// SetEmbedding sets the "embedding" field.
+func (acuo *ArticleChunkUpdateOne) SetEmbedding(f []float32) *ArticleChunkUpdateOne {
+	acuo.mutation.SetEmbedding(f)
+	return acuo
+}
+
+// AppendEmbedding appends f to the "embedding" field.
+func (acuo *ArticleChunkUpdateOne) AppendEmbedding(f []float32) *ArticleChunkUpdateOne {
+	acuo.mutation.AppendEmbedding(f)
+	return acuo
+}
+
+// SetArticle sets the "article" edge to the Article entity.
+func (acuo *ArticleChunkUpdateOne) SetArticle(a *Article) *ArticleChunkUpdateOne {
+	return acuo.SetArticleID(a.ID)
+}
+
+// Mutation returns the ArticleChunkMutation object of the builder.
+func (acuo *ArticleChunkUpdateOne) Mutation() *ArticleChunkMutation {
+	return acuo.mutation
+}
+
+// ClearArticle clears the "article" edge to the Article entity.
+func (acuo *ArticleChunkUpdateOne) ClearArticle() *ArticleChunkUpdateOne {
+	acuo.mutation.ClearArticle()
+	return acuo
+}
+
+// Where appends a list predicates to the ArticleChunkUpdate builder.
+func (acuo *ArticleChunkUpdateOne) Where(ps ...predicate.ArticleChunk) *ArticleChunkUpdateOne {
+	acuo.mutation.Where(ps...)
+	return acuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (acuo *ArticleChunkUpdateOne) Select(field string, fields ...string) *ArticleChunkUpdateOne {
+	acuo.fields = append([]string{field}, fields...)
+	return acuo
+}
+
+// Save executes the query and returns the updated ArticleChunk entity.
+func (acuo *ArticleChunkUpdateOne) Save(ctx context.Context) (*ArticleChunk, error) {
+	return withHooks(ctx, acuo.sqlSave, acuo.mutation, acuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (acuo *ArticleChunkUpdateOne) SaveX(ctx context.Context) *ArticleChunk {
+	node, err := acuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (acuo *ArticleChunkUpdateOne) Exec(ctx context.Context) error {
+	_, err := acuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (acuo *ArticleChunkUpdateOne) ExecX(ctx context.Context) {
+	if err := acuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (acuo *ArticleChunkUpdateOne) check() error {
+	if v, ok := acuo.mutation.Seq(); ok {
+		if err := articlechunk.SeqValidator(v); err != nil {
+			return &ValidationError{Name: "seq", err: fmt.Errorf(`ent: validator failed for field "ArticleChunk.seq": %w`, err)}
+		}
+	}
+	if _, ok := acuo.mutation.ArticleID(); acuo.mutation.ArticleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ArticleChunk.article"`)
+	}
+	return nil
+}
+
+func (acuo *ArticleChunkUpdateOne) sqlSave(ctx context.Context) (_node *ArticleChunk, err error) {
+	if err := acuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(articlechunk.Table, articlechunk.Columns, sqlgraph.NewFieldSpec(articlechunk.FieldID, field.TypeInt))
+	id, ok := acuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ArticleChunk.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := acuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, articlechunk.FieldID)
+		for _, f := range fields {
+			if !articlechunk.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != articlechunk.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := acuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := acuo.mutation.Seq(); ok {
+		_spec.SetField(articlechunk.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.AddedSeq(); ok {
+		_spec.AddField(articlechunk.FieldSeq, field.TypeInt, value)
+	}
+	if value, ok := acuo.mutation.Content(); ok {
+		_spec.SetField(articlechunk.FieldContent, field.TypeString, value)
+	}
+	if value, ok := acuo.mutation.ContentHash(); ok {
+		_spec.SetField(articlechunk.FieldContentHash, field.TypeString, value)
+	}
+	if value, ok := acuo.mutation.Model(); ok {
+		_spec.SetField(articlechunk.FieldModel, field.TypeString, value)
+	}
+	if value, ok := acuo.mutation.Embedding(); ok {
+		_spec.SetField(articlechunk.FieldEmbedding, field.TypeJSON, value)
+	}
+	if value, ok := acuo.mutation.AppendedEmbedding(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, articlechunk.FieldEmbedding, value)
+		})
+	}
+	if acuo.mutation.ArticleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articlechunk.ArticleTable,
+			Columns: []string{articlechunk.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := acuo.mutation.ArticleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articlechunk.ArticleTable,
+			Columns: []string{articlechunk.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &ArticleChunk{config: acuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, acuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{articlechunk.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	acuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,206 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articleenrichment"
+)
+
+// ArticleEnrichment is the model entity for the ArticleEnrichment schema.
+type ArticleEnrichment struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ArticleID holds the value of the "article_id" field.
+	ArticleID uint `json:"article_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// Data holds the value of the "data" field.
+	Data json.RawMessage `json:"data,omitempty"`
+	// Format holds the value of the "format" field.
+	Format articleenrichment.Format `json:"format,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ArticleEnrichmentQuery when eager-loading is set.
+	Edges        ArticleEnrichmentEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ArticleEnrichmentEdges holds the relations/edges for other nodes in the graph.
+type ArticleEnrichmentEdges struct {
+	// Article holds the value of the article edge.
+	Article *Article `json:"article,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ArticleOrErr returns the Article value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ArticleEnrichmentEdges) ArticleOrErr() (*Article, error) {
+	if e.Article != nil {
+		return e.Article, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: article.Label}
+	}
+	return nil, &NotLoadedError{edge: "article"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ArticleEnrichment) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case articleenrichment.FieldData:
+			values[i] = new([]byte)
+		case articleenrichment.FieldID, articleenrichment.FieldArticleID:
+			values[i] = new(sql.NullInt64)
+		case articleenrichment.FieldName, articleenrichment.FieldContent, articleenrichment.FieldFormat:
+			values[i] = new(sql.NullString)
+		case articleenrichment.FieldCreatedAt, articleenrichment.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ArticleEnrichment fields.
+func (ae *ArticleEnrichment) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case articleenrichment.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ae.ID = int(value.Int64)
+		case articleenrichment.FieldArticleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field article_id", values[i])
+			} else if value.Valid {
+				ae.ArticleID = uint(value.Int64)
+			}
+		case articleenrichment.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				ae.Name = value.String
+			}
+		case articleenrichment.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				ae.Content = value.String
+			}
+		case articleenrichment.FieldData:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field data", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ae.Data); err != nil {
+					return fmt.Errorf("unmarshal field data: %w", err)
+				}
+			}
+		case articleenrichment.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				ae.Format = articleenrichment.Format(value.String)
+			}
+		case articleenrichment.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ae.CreatedAt = value.Time
+			}
+		case articleenrichment.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				ae.UpdatedAt = value.Time
+			}
+		default:
+			ae.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ArticleEnrichment.
+// This includes values selected through modifiers, order, etc.
+func (ae *ArticleEnrichment) Value(name string) (ent.Value, error) {
+	return ae.selectValues.Get(name)
+}
+
+// QueryArticle queries the "article" edge of the ArticleEnrichment entity.
+func (ae *ArticleEnrichment) QueryArticle() *ArticleQuery {
+	return NewArticleEnrichmentClient(ae.config).QueryArticle(ae)
+}
+
+// Update returns a builder for updating this ArticleEnrichment.
+// Note that you need to call ArticleEnrichment.Unwrap() before calling this method if this ArticleEnrichment
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ae *ArticleEnrichment) Update() *ArticleEnrichmentUpdateOne {
+	return NewArticleEnrichmentClient(ae.config).UpdateOne(ae)
+}
+
+// Unwrap unwraps the ArticleEnrichment entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ae *ArticleEnrichment) Unwrap() *ArticleEnrichment {
+	_tx, ok := ae.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ArticleEnrichment is not a transactional entity")
+	}
+	ae.config.driver = _tx.drv
+	return ae
+}
+
+// String implements the fmt.Stringer.
+func (ae *ArticleEnrichment) String() string {
+	var builder strings.Builder
+	builder.WriteString("ArticleEnrichment(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ae.ID))
+	builder.WriteString("article_id=")
+	builder.WriteString(fmt.Sprintf("%v", ae.ArticleID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(ae.Name)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(ae.Content)
+	builder.WriteString(", ")
+	builder.WriteString("data=")
+	builder.WriteString(fmt.Sprintf("%v", ae.Data))
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(fmt.Sprintf("%v", ae.Format))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ae.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(ae.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ArticleEnrichments is a parsable slice of ArticleEnrichment.
+type ArticleEnrichments []*ArticleEnrichment
@@ -0,0 +1,379 @@
+// Code generated by ent, DO NOT EDIT.
+
+package articleenrichment
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldLTE(FieldID, id))
+}
+
+// ArticleID applies equality check predicate on the "article_id" field. It's identical to ArticleIDEQ.
+func ArticleID(v uint) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldEQ(FieldArticleID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldEQ(FieldName, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldEQ(FieldContent, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ArticleIDEQ applies the EQ predicate on the "article_id" field.
+func ArticleIDEQ(v uint) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldEQ(FieldArticleID, v))
+}
+
+// ArticleIDNEQ applies the NEQ predicate on the "article_id" field.
+func ArticleIDNEQ(v uint) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldNEQ(FieldArticleID, v))
+}
+
+// ArticleIDIn applies the In predicate on the "article_id" field.
+func ArticleIDIn(vs ...uint) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldIn(FieldArticleID, vs...))
+}
+
+// ArticleIDNotIn applies the NotIn predicate on the "article_id" field.
+func ArticleIDNotIn(vs ...uint) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldNotIn(FieldArticleID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldContainsFold(FieldName, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldContainsFold(FieldContent, v))
+}
+
+// DataIsNil applies the IsNil predicate on the "data" field.
+func DataIsNil() predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldIsNull(FieldData))
+}
+
+// DataNotNil applies the NotNil predicate on the "data" field.
+func DataNotNil() predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldNotNull(FieldData))
+}
+
+// FormatEQ applies the EQ predicate on the "format" field.
+func FormatEQ(v Format) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldEQ(FieldFormat, v))
+}
+
+// FormatNEQ applies the NEQ predicate on the "format" field.
+func FormatNEQ(v Format) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldNEQ(FieldFormat, v))
+}
+
+// FormatIn applies the In predicate on the "format" field.
+func FormatIn(vs ...Format) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldIn(FieldFormat, vs...))
+}
+
+// FormatNotIn applies the NotIn predicate on the "format" field.
+func FormatNotIn(vs ...Format) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldNotIn(FieldFormat, vs...))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasArticle applies the HasEdge predicate on the "article" edge.
+func HasArticle() predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ArticleTable, ArticleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArticleWith applies the HasEdge predicate on the "article" edge with a given conditions (other predicates).
+func HasArticleWith(preds ...predicate.Article) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(func(s *sql.Selector) {
+		step := newArticleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ArticleEnrichment) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ArticleEnrichment) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ArticleEnrichment) predicate.ArticleEnrichment {
+	return predicate.ArticleEnrichment(sql.NotPredicates(p))
+}
@@ -0,0 +1,329 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articleenrichment"
+)
+
+// ArticleEnrichmentCreate is the builder for creating a ArticleEnrichment entity.
+type ArticleEnrichmentCreate struct {
+	config
+	mutation *ArticleEnrichmentMutation
+	hooks    []Hook
+}
+
+// SetArticleID sets the "article_id" field.
+func (aec *ArticleEnrichmentCreate) SetArticleID(u uint) *ArticleEnrichmentCreate {
+	aec.mutation.SetArticleID(u)
+	return aec
+}
+
+// SetName sets the "name" field.
+func (aec *ArticleEnrichmentCreate) SetName(s string) *ArticleEnrichmentCreate {
+	aec.mutation.SetName(s)
+	return aec
+}
+
+// SetContent sets the "content" field.
+func (aec *ArticleEnrichmentCreate) SetContent(s string) *ArticleEnrichmentCreate {
+	aec.mutation.SetContent(s)
+	return aec
+}
+
+// SetData sets the "data" field.
+func (aec *ArticleEnrichmentCreate) SetData(jm json.RawMessage) *ArticleEnrichmentCreate {
+	aec.mutation.SetData(jm)
+	return aec
+}
+
+// SetFormat sets the "format" field.
+func (aec *ArticleEnrichmentCreate) SetFormat(a articleenrichment.Format) *ArticleEnrichmentCreate {
+	aec.mutation.SetFormat(a)
+	return aec
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (aec *ArticleEnrichmentCreate) SetNillableFormat(a *articleenrichment.Format) *ArticleEnrichmentCreate {
+	if a != nil {
+		aec.SetFormat(*a)
+	}
+	return aec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (aec *ArticleEnrichmentCreate) SetCreatedAt(t time.Time) *ArticleEnrichmentCreate {
+	aec.mutation.SetCreatedAt(t)
+	return aec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (aec *ArticleEnrichmentCreate) SetNillableCreatedAt(t *time.Time) *ArticleEnrichmentCreate {
+	if t != nil {
+		aec.SetCreatedAt(*t)
+	}
+	return aec
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aec *ArticleEnrichmentCreate) SetUpdatedAt(t time.Time) *ArticleEnrichmentCreate {
+	aec.mutation.SetUpdatedAt(t)
+	return aec
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (aec *ArticleEnrichmentCreate) SetNillableUpdatedAt(t *time.Time) *ArticleEnrichmentCreate {
+	if t != nil {
+		aec.SetUpdatedAt(*t)
+	}
+	return aec
+}
+
+// SetArticle sets the "article" edge to the Article entity.
+func (aec *ArticleEnrichmentCreate) SetArticle(a *Article) *ArticleEnrichmentCreate {
+	return aec.SetArticleID(a.ID)
+}
+
+// Mutation returns the ArticleEnrichmentMutation object of the builder.
+func (aec *ArticleEnrichmentCreate) Mutation() *ArticleEnrichmentMutation {
+	return aec.mutation
+}
+
+// Save creates the ArticleEnrichment in the database.
+func (aec *ArticleEnrichmentCreate) Save(ctx context.Context) (*ArticleEnrichment, error) {
+	aec.defaults()
+	return withHooks(ctx, aec.sqlSave, aec.mutation, aec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (aec *ArticleEnrichmentCreate) SaveX(ctx context.Context) *ArticleEnrichment {
+	v, err := aec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aec *ArticleEnrichmentCreate) Exec(ctx context.Context) error {
+	_, err := aec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aec *ArticleEnrichmentCreate) ExecX(ctx context.Context) {
+	if err := aec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aec *ArticleEnrichmentCreate) defaults() {
+	if _, ok := aec.mutation.Format(); !ok {
+		v := articleenrichment.DefaultFormat
+		aec.mutation.SetFormat(v)
+	}
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		v := articleenrichment.DefaultCreatedAt()
+		aec.mutation.SetCreatedAt(v)
+	}
+	if _, ok := aec.mutation.UpdatedAt(); !ok {
+		v := articleenrichment.DefaultUpdatedAt()
+		aec.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aec *ArticleEnrichmentCreate) check() error {
+	if _, ok := aec.mutation.ArticleID(); !ok {
+		return &ValidationError{Name: "article_id", err: errors.New(`ent: missing required field "ArticleEnrichment.article_id"`)}
+	}
+	if _, ok := aec.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "ArticleEnrichment.name"`)}
+	}
+	if _, ok := aec.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "ArticleEnrichment.content"`)}
+	}
+	if _, ok := aec.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "ArticleEnrichment.format"`)}
+	}
+	if v, ok := aec.mutation.Format(); ok {
+		if err := articleenrichment.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "ArticleEnrichment.format": %w`, err)}
+		}
+	}
+	if _, ok := aec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ArticleEnrichment.created_at"`)}
+	}
+	if _, ok := aec.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ArticleEnrichment.updated_at"`)}
+	}
+	if _, ok := aec.mutation.ArticleID(); !ok {
+		return &ValidationError{Name: "article", err: errors.New(`ent: missing required edge "ArticleEnrichment.article"`)}
+	}
+	return nil
+}
+
+func (aec *ArticleEnrichmentCreate) sqlSave(ctx context.Context) (*ArticleEnrichment, error) {
+	if err := aec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := aec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, aec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	aec.mutation.id = &_node.ID
+	aec.mutation.done = true
+	return _node, nil
+}
+
+func (aec *ArticleEnrichmentCreate) createSpec() (*ArticleEnrichment, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ArticleEnrichment{config: aec.config}
+		_spec = sqlgraph.NewCreateSpec(articleenrichment.Table, sqlgraph.NewFieldSpec(articleenrichment.FieldID, field.TypeInt))
+	)
+	if value, ok := aec.mutation.Name(); ok {
+		_spec.SetField(articleenrichment.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := aec.mutation.Content(); ok {
+		_spec.SetField(articleenrichment.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := aec.mutation.Data(); ok {
+		_spec.SetField(articleenrichment.FieldData, field.TypeJSON, value)
+		_node.Data = value
+	}
+	if value, ok := aec.mutation.Format(); ok {
+		_spec.SetField(articleenrichment.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := aec.mutation.CreatedAt(); ok {
+		_spec.SetField(articleenrichment.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := aec.mutation.UpdatedAt(); ok {
+		_spec.SetField(articleenrichment.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := aec.mutation.ArticleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articleenrichment.ArticleTable,
+			Columns: []string{articleenrichment.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ArticleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ArticleEnrichmentCreateBulk is the builder for creating many ArticleEnrichment entities in bulk.
+type ArticleEnrichmentCreateBulk struct {
+	config
+	err      error
+	builders []*ArticleEnrichmentCreate
+}
+
+// Save creates the ArticleEnrichment entities in the database.
+func (aecb *ArticleEnrichmentCreateBulk) Save(ctx context.Context) ([]*ArticleEnrichment, error) {
+	if aecb.err != nil {
+		return nil, aecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(aecb.builders))
+	nodes := make([]*ArticleEnrichment, len(aecb.builders))
+	mutators := make([]Mutator, len(aecb.builders))
+	for i := range aecb.builders {
+		func(i int, root context.Context) {
+			builder := aecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ArticleEnrichmentMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, aecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, aecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, aecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aecb *ArticleEnrichmentCreateBulk) SaveX(ctx context.Context) []*ArticleEnrichment {
+	v, err := aecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (aecb *ArticleEnrichmentCreateBulk) Exec(ctx context.Context) error {
+	_, err := aecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aecb *ArticleEnrichmentCreateBulk) ExecX(ctx context.Context) {
+	if err := aecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articleenrichment"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+)
+
+// ArticleEnrichmentDelete is the builder for deleting a ArticleEnrichment entity.
+type ArticleEnrichmentDelete struct {
+	config
+	hooks    []Hook
+	mutation *ArticleEnrichmentMutation
+}
+
+// Where appends a list predicates to the ArticleEnrichmentDelete builder.
+func (aed *ArticleEnrichmentDelete) Where(ps ...predicate.ArticleEnrichment) *ArticleEnrichmentDelete {
+	aed.mutation.Where(ps...)
+	return aed
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (aed *ArticleEnrichmentDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, aed.sqlExec, aed.mutation, aed.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aed *ArticleEnrichmentDelete) ExecX(ctx context.Context) int {
+	n, err := aed.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (aed *ArticleEnrichmentDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(articleenrichment.Table, sqlgraph.NewFieldSpec(articleenrichment.FieldID, field.TypeInt))
+	if ps := aed.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, aed.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	aed.mutation.done = true
+	return affected, err
+}
+
+// ArticleEnrichmentDeleteOne is the builder for deleting a single ArticleEnrichment entity.
+type ArticleEnrichmentDeleteOne struct {
+	aed *ArticleEnrichmentDelete
+}
+
+// Where appends a list predicates to the ArticleEnrichmentDelete builder.
+func (aedo *ArticleEnrichmentDeleteOne) Where(ps ...predicate.ArticleEnrichment) *ArticleEnrichmentDeleteOne {
+	aedo.aed.mutation.Where(ps...)
+	return aedo
+}
+
+// Exec executes the deletion query.
+func (aedo *ArticleEnrichmentDeleteOne) Exec(ctx context.Context) error {
+	n, err := aedo.aed.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{articleenrichment.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aedo *ArticleEnrichmentDeleteOne) ExecX(ctx context.Context) {
+	if err := aedo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
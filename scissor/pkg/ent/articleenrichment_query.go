@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articleenrichment"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+)
+
+// ArticleEnrichmentQuery is the builder for querying ArticleEnrichment entities.
+type ArticleEnrichmentQuery struct {
+	config
+	ctx         *QueryContext
+	order       []articleenrichment.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ArticleEnrichment
+	withArticle *ArticleQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ArticleEnrichmentQuery builder.
+func (aeq *ArticleEnrichmentQuery) Where(ps ...predicate.ArticleEnrichment) *ArticleEnrichmentQuery {
+	aeq.predicates = append(aeq.predicates, ps...)
+	return aeq
+}
+
+// Limit the number of records to be returned by this query.
+func (aeq *ArticleEnrichmentQuery) Limit(limit int) *ArticleEnrichmentQuery {
+	aeq.ctx.Limit = &limit
+	return aeq
+}
+
+// Offset to start from.
+func (aeq *ArticleEnrichmentQuery) Offset(offset int) *ArticleEnrichmentQuery {
+	aeq.ctx.Offset = &offset
+	return aeq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (aeq *ArticleEnrichmentQuery) Unique(unique bool) *ArticleEnrichmentQuery {
+	aeq.ctx.Unique = &unique
+	return aeq
+}
+
+// Order specifies how the records should be ordered.
+func (aeq *ArticleEnrichmentQuery) Order(o ...articleenrichment.OrderOption) *ArticleEnrichmentQuery {
+	aeq.order = append(aeq.order, o...)
+	return aeq
+}
+
+// QueryArticle chains the current query on the "article" edge.
+func (aeq *ArticleEnrichmentQuery) QueryArticle() *ArticleQuery {
+	query := (&ArticleClient{config: aeq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := aeq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := aeq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(articleenrichment.Table, articleenrichment.FieldID, selector),
+			sqlgraph.To(article.Table, article.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, articleenrichment.ArticleTable, articleenrichment.ArticleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(aeq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ArticleEnrichment entity from the query.
+// Returns a *NotFoundError when no ArticleEnrichment was found.
+func (aeq *ArticleEnrichmentQuery) First(ctx context.Context) (*ArticleEnrichment, error) {
+	nodes, err := aeq.Limit(1).All(setContextOp(ctx, aeq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{articleenrichment.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (aeq *ArticleEnrichmentQuery) FirstX(ctx context.Context) *ArticleEnrichment {
+	node, err := aeq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ArticleEnrichment ID from the query.
+// Returns a *NotFoundError when no ArticleEnrichment ID was found.
+func (aeq *ArticleEnrichmentQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = aeq.Limit(1).IDs(setContextOp(ctx, aeq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{articleenrichment.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (aeq *ArticleEnrichmentQuery) FirstIDX(ctx context.Context) int {
+	id, err := aeq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ArticleEnrichment entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ArticleEnrichment entity is found.
+// Returns a *NotFoundError when no ArticleEnrichment entities are found.
+func (aeq *ArticleEnrichmentQuery) Only(ctx context.Context) (*ArticleEnrichment, error) {
+	nodes, err := aeq.Limit(2).All(setContextOp(ctx, aeq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{articleenrichment.Label}
+	default:
+		return nil, &NotSingularError{articleenrichment.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (aeq *ArticleEnrichmentQuery) OnlyX(ctx context.Context) *ArticleEnrichment {
+	node, err := aeq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ArticleEnrichment ID in the query.
+// Returns a *NotSingularError when more than one ArticleEnrichment ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (aeq *ArticleEnrichmentQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = aeq.Limit(2).IDs(setContextOp(ctx, aeq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{articleenrichment.Label}
+	default:
+		err = &NotSingularError{articleenrichment.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (aeq *ArticleEnrichmentQuery) OnlyIDX(ctx context.Context) int {
+	id, err := aeq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ArticleEnrichments.
+func (aeq *ArticleEnrichmentQuery) All(ctx context.Context) ([]*ArticleEnrichment, error) {
+	ctx = setContextOp(ctx, aeq.ctx, "All")
+	if err := aeq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ArticleEnrichment, *ArticleEnrichmentQuery]()
+	return withInterceptors[[]*ArticleEnrichment](ctx, aeq, qr, aeq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (aeq *ArticleEnrichmentQuery) AllX(ctx context.Context) []*ArticleEnrichment {
+	nodes, err := aeq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ArticleEnrichment IDs.
+func (aeq *ArticleEnrichmentQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if aeq.ctx.Unique == nil && aeq.path != nil {
+		aeq.Unique(true)
+	}
+	ctx = setContextOp(ctx, aeq.ctx, "IDs")
+	if err = aeq.Select(articleenrichment.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (aeq *ArticleEnrichmentQuery) IDsX(ctx context.Context) []int {
+	ids, err := aeq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (aeq *ArticleEnrichmentQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, aeq.ctx, "Count")
+	if err := aeq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, aeq, querierCount[*ArticleEnrichmentQuery](), aeq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (aeq *ArticleEnrichmentQuery) CountX(ctx context.Context) int {
+	count, err := aeq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (aeq *ArticleEnrichmentQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, aeq.ctx, "Exist")
+	switch _, err := aeq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (aeq *ArticleEnrichmentQuery) ExistX(ctx context.Context) bool {
+	exist, err := aeq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ArticleEnrichmentQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (aeq *ArticleEnrichmentQuery) Clone() *ArticleEnrichmentQuery {
+	if aeq == nil {
+		return nil
+	}
+	return &ArticleEnrichmentQuery{
+		config:      aeq.config,
+		ctx:         aeq.ctx.Clone(),
+		order:       append([]articleenrichment.OrderOption{}, aeq.order...),
+		inters:      append([]Interceptor{}, aeq.inters...),
+		predicates:  append([]predicate.ArticleEnrichment{}, aeq.predicates...),
+		withArticle: aeq.withArticle.Clone(),
+		// clone intermediate query.
+		sql:  aeq.sql.Clone(),
+		path: aeq.path,
+	}
+}
+
+// WithArticle tells the query-builder to eager-load the nodes that are connected to
+// the "article" edge. The optional arguments are used to configure the query builder of the edge.
+func (aeq *ArticleEnrichmentQuery) WithArticle(opts ...func(*ArticleQuery)) *ArticleEnrichmentQuery {
+	query := (&ArticleClient{config: aeq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	aeq.withArticle = query
+	return aeq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ArticleID uint `json:"article_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ArticleEnrichment.Query().
+//		GroupBy(articleenrichment.FieldArticleID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (aeq *ArticleEnrichmentQuery) GroupBy(field string, fields ...string) *ArticleEnrichmentGroupBy {
+	aeq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ArticleEnrichmentGroupBy{build: aeq}
+	grbuild.flds = &aeq.ctx.Fields
+	grbuild.label = articleenrichment.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ArticleID uint `json:"article_id,omitempty"`
+//	}
+//
+//	client.ArticleEnrichment.Query().
+//		Select(articleenrichment.FieldArticleID).
+//		Scan(ctx, &v)
+func (aeq *ArticleEnrichmentQuery) Select(fields ...string) *ArticleEnrichmentSelect {
+	aeq.ctx.Fields = append(aeq.ctx.Fields, fields...)
+	sbuild := &ArticleEnrichmentSelect{ArticleEnrichmentQuery: aeq}
+	sbuild.label = articleenrichment.Label
+	sbuild.flds, sbuild.scan = &aeq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ArticleEnrichmentSelect configured with the given aggregations.
+func (aeq *ArticleEnrichmentQuery) Aggregate(fns ...AggregateFunc) *ArticleEnrichmentSelect {
+	return aeq.Select().Aggregate(fns...)
+}
+
+func (aeq *ArticleEnrichmentQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range aeq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, aeq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range aeq.ctx.Fields {
+		if !articleenrichment.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if aeq.path != nil {
+		prev, err := aeq.path(ctx)
+		if err != nil {
+			return err
+		}
+		aeq.sql = prev
+	}
+	return nil
+}
+
+func (aeq *ArticleEnrichmentQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ArticleEnrichment, error) {
+	var (
+		nodes       = []*ArticleEnrichment{}
+		_spec       = aeq.querySpec()
+		loadedTypes = [1]bool{
+			aeq.withArticle != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ArticleEnrichment).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ArticleEnrichment{config: aeq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, aeq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := aeq.withArticle; query != nil {
+		if err := aeq.loadArticle(ctx, query, nodes, nil,
+			func(n *ArticleEnrichment, e *Article) { n.Edges.Article = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (aeq *ArticleEnrichmentQuery) loadArticle(ctx context.Context, query *ArticleQuery, nodes []*ArticleEnrichment, init func(*ArticleEnrichment), assign func(*ArticleEnrichment, *Article)) error {
+	ids := make([]uint, 0, len(nodes))
+	nodeids := make(map[uint][]*ArticleEnrichment)
+	for i := range nodes {
+		fk := nodes[i].ArticleID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(article.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "article_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (aeq *ArticleEnrichmentQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := aeq.querySpec()
+	_spec.Node.Columns = aeq.ctx.Fields
+	if len(aeq.ctx.Fields) > 0 {
+		_spec.Unique = aeq.ctx.Unique != nil && *aeq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, aeq.driver, _spec)
+}
+
+func (aeq *ArticleEnrichmentQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(articleenrichment.Table, articleenrichment.Columns, sqlgraph.NewFieldSpec(articleenrichment.FieldID, field.TypeInt))
+	_spec.From = aeq.sql
+	if unique := aeq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if aeq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := aeq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, articleenrichment.FieldID)
+		for i := range fields {
+			if fields[i] != articleenrichment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if aeq.withArticle != nil {
+			_spec.Node.AddColumnOnce(articleenrichment.FieldArticleID)
+		}
+	}
+	if ps := aeq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := aeq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := aeq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := aeq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (aeq *ArticleEnrichmentQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(aeq.driver.Dialect())
+	t1 := builder.Table(articleenrichment.Table)
+	columns := aeq.ctx.Fields
+	if len(columns) == 0 {
+		columns = articleenrichment.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if aeq.sql != nil {
+		selector = aeq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if aeq.ctx.Unique != nil && *aeq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range aeq.predicates {
+		p(selector)
+	}
+	for _, p := range aeq.order {
+		p(selector)
+	}
+	if offset := aeq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := aeq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ArticleEnrichmentGroupBy is the group-by builder for ArticleEnrichment entities.
+type ArticleEnrichmentGroupBy struct {
+	selector
+	build *ArticleEnrichmentQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (aegb *ArticleEnrichmentGroupBy) Aggregate(fns ...AggregateFunc) *ArticleEnrichmentGroupBy {
+	aegb.fns = append(aegb.fns, fns...)
+	return aegb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (aegb *ArticleEnrichmentGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, aegb.build.ctx, "GroupBy")
+	if err := aegb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ArticleEnrichmentQuery, *ArticleEnrichmentGroupBy](ctx, aegb.build, aegb, aegb.build.inters, v)
+}
+
+func (aegb *ArticleEnrichmentGroupBy) sqlScan(ctx context.Context, root *ArticleEnrichmentQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(aegb.fns))
+	for _, fn := range aegb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*aegb.flds)+len(aegb.fns))
+		for _, f := range *aegb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*aegb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := aegb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ArticleEnrichmentSelect is the builder for selecting fields of ArticleEnrichment entities.
+type ArticleEnrichmentSelect struct {
+	*ArticleEnrichmentQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (aes *ArticleEnrichmentSelect) Aggregate(fns ...AggregateFunc) *ArticleEnrichmentSelect {
+	aes.fns = append(aes.fns, fns...)
+	return aes
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (aes *ArticleEnrichmentSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, aes.ctx, "Select")
+	if err := aes.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ArticleEnrichmentQuery, *ArticleEnrichmentSelect](ctx, aes.ArticleEnrichmentQuery, aes, aes.inters, v)
+}
+
+func (aes *ArticleEnrichmentSelect) sqlScan(ctx context.Context, root *ArticleEnrichmentQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(aes.fns))
+	for _, fn := range aes.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*aes.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := aes.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
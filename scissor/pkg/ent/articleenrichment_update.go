@@ -0,0 +1,372 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articleenrichment"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+)
+
+// ArticleEnrichmentUpdate is the builder for updating ArticleEnrichment entities.
+type ArticleEnrichmentUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ArticleEnrichmentMutation
+}
+
+// Where appends a list predicates to the ArticleEnrichmentUpdate builder.
+func (aeu *ArticleEnrichmentUpdate) Where(ps ...predicate.ArticleEnrichment) *ArticleEnrichmentUpdate {
+	aeu.mutation.Where(ps...)
+	return aeu
+}
+
+// SetContent sets the "content" field.
+func (aeu *ArticleEnrichmentUpdate) SetContent(s string) *ArticleEnrichmentUpdate {
+	aeu.mutation.SetContent(s)
+	return aeu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (aeu *ArticleEnrichmentUpdate) SetNillableContent(s *string) *ArticleEnrichmentUpdate {
+	if s != nil {
+		aeu.SetContent(*s)
+	}
+	return aeu
+}
+
+// SetData sets the "data" field.
+func (aeu *ArticleEnrichmentUpdate) SetData(jm json.RawMessage) *ArticleEnrichmentUpdate {
+	aeu.mutation.SetData(jm)
+	return aeu
+}
+
+// AppendData appends jm to the "data" field.
+func (aeu *ArticleEnrichmentUpdate) AppendData(jm json.RawMessage) *ArticleEnrichmentUpdate {
+	aeu.mutation.AppendData(jm)
+	return aeu
+}
+
+// ClearData clears the value of the "data" field.
+func (aeu *ArticleEnrichmentUpdate) ClearData() *ArticleEnrichmentUpdate {
+	aeu.mutation.ClearData()
+	return aeu
+}
+
+// SetFormat sets the "format" field.
+func (aeu *ArticleEnrichmentUpdate) SetFormat(a articleenrichment.Format) *ArticleEnrichmentUpdate {
+	aeu.mutation.SetFormat(a)
+	return aeu
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (aeu *ArticleEnrichmentUpdate) SetNillableFormat(a *articleenrichment.Format) *ArticleEnrichmentUpdate {
+	if a != nil {
+		aeu.SetFormat(*a)
+	}
+	return aeu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aeu *ArticleEnrichmentUpdate) SetUpdatedAt(t time.Time) *ArticleEnrichmentUpdate {
+	aeu.mutation.SetUpdatedAt(t)
+	return aeu
+}
+
+// Mutation returns the ArticleEnrichmentMutation object of the builder.
+func (aeu *ArticleEnrichmentUpdate) Mutation() *ArticleEnrichmentMutation {
+	return aeu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aeu *ArticleEnrichmentUpdate) Save(ctx context.Context) (int, error) {
+	aeu.defaults()
+	return withHooks(ctx, aeu.sqlSave, aeu.mutation, aeu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeu *ArticleEnrichmentUpdate) SaveX(ctx context.Context) int {
+	affected, err := aeu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aeu *ArticleEnrichmentUpdate) Exec(ctx context.Context) error {
+	_, err := aeu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeu *ArticleEnrichmentUpdate) ExecX(ctx context.Context) {
+	if err := aeu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aeu *ArticleEnrichmentUpdate) defaults() {
+	if _, ok := aeu.mutation.UpdatedAt(); !ok {
+		v := articleenrichment.UpdateDefaultUpdatedAt()
+		aeu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aeu *ArticleEnrichmentUpdate) check() error {
+	if v, ok := aeu.mutation.Format(); ok {
+		if err := articleenrichment.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "ArticleEnrichment.format": %w`, err)}
+		}
+	}
+	if _, ok := aeu.mutation.ArticleID(); aeu.mutation.ArticleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ArticleEnrichment.article"`)
+	}
+	return nil
+}
+
+func (aeu *ArticleEnrichmentUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aeu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(articleenrichment.Table, articleenrichment.Columns, sqlgraph.NewFieldSpec(articleenrichment.FieldID, field.TypeInt))
+	if ps := aeu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aeu.mutation.Content(); ok {
+		_spec.SetField(articleenrichment.FieldContent, field.TypeString, value)
+	}
+	if value, ok := aeu.mutation.Data(); ok {
+		_spec.SetField(articleenrichment.FieldData, field.TypeJSON, value)
+	}
+	if value, ok := aeu.mutation.AppendedData(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, articleenrichment.FieldData, value)
+		})
+	}
+	if aeu.mutation.DataCleared() {
+		_spec.ClearField(articleenrichment.FieldData, field.TypeJSON)
+	}
+	if value, ok := aeu.mutation.Format(); ok {
+		_spec.SetField(articleenrichment.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := aeu.mutation.UpdatedAt(); ok {
+		_spec.SetField(articleenrichment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aeu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{articleenrichment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aeu.mutation.done = true
+	return n, nil
+}
+
+// ArticleEnrichmentUpdateOne is the builder for updating a single ArticleEnrichment entity.
+type ArticleEnrichmentUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ArticleEnrichmentMutation
+}
+
+// SetContent sets the "content" field.
+func (aeuo *ArticleEnrichmentUpdateOne) SetContent(s string) *ArticleEnrichmentUpdateOne {
+	aeuo.mutation.SetContent(s)
+	return aeuo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (aeuo *ArticleEnrichmentUpdateOne) SetNillableContent(s *string) *ArticleEnrichmentUpdateOne {
+	if s != nil {
+		aeuo.SetContent(*s)
+	}
+	return aeuo
+}
+
+// SetData sets the "data" field.
+func (aeuo *ArticleEnrichmentUpdateOne) SetData(jm json.RawMessage) *ArticleEnrichmentUpdateOne {
+	aeuo.mutation.SetData(jm)
+	return aeuo
+}
+
+// AppendData appends jm to the "data" field.
+func (aeuo *ArticleEnrichmentUpdateOne) AppendData(jm json.RawMessage) *ArticleEnrichmentUpdateOne {
+	aeuo.mutation.AppendData(jm)
+	return aeuo
+}
+
+// ClearData clears the value of the "data" field.
+func (aeuo *ArticleEnrichmentUpdateOne) ClearData() *ArticleEnrichmentUpdateOne {
+	aeuo.mutation.ClearData()
+	return aeuo
+}
+
+// SetFormat sets the "format" field.
+func (aeuo *ArticleEnrichmentUpdateOne) SetFormat(a articleenrichment.Format) *ArticleEnrichmentUpdateOne {
+	aeuo.mutation.SetFormat(a)
+	return aeuo
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (aeuo *ArticleEnrichmentUpdateOne) SetNillableFormat(a *articleenrichment.Format) *ArticleEnrichmentUpdateOne {
+	if a != nil {
+		aeuo.SetFormat(*a)
+	}
+	return aeuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (aeuo *ArticleEnrichmentUpdateOne) SetUpdatedAt(t time.Time) *ArticleEnrichmentUpdateOne {
+	aeuo.mutation.SetUpdatedAt(t)
+	return aeuo
+}
+
+// Mutation returns the ArticleEnrichmentMutation object of the builder.
+func (aeuo *ArticleEnrichmentUpdateOne) Mutation() *ArticleEnrichmentMutation {
+	return aeuo.mutation
+}
+
+// Where appends a list predicates to the ArticleEnrichmentUpdate builder.
+func (aeuo *ArticleEnrichmentUpdateOne) Where(ps ...predicate.ArticleEnrichment) *ArticleEnrichmentUpdateOne {
+	aeuo.mutation.Where(ps...)
+	return aeuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aeuo *ArticleEnrichmentUpdateOne) Select(field string, fields ...string) *ArticleEnrichmentUpdateOne {
+	aeuo.fields = append([]string{field}, fields...)
+	return aeuo
+}
+
+// Save executes the query and returns the updated ArticleEnrichment entity.
+func (aeuo *ArticleEnrichmentUpdateOne) Save(ctx context.Context) (*ArticleEnrichment, error) {
+	aeuo.defaults()
+	return withHooks(ctx, aeuo.sqlSave, aeuo.mutation, aeuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aeuo *ArticleEnrichmentUpdateOne) SaveX(ctx context.Context) *ArticleEnrichment {
+	node, err := aeuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aeuo *ArticleEnrichmentUpdateOne) Exec(ctx context.Context) error {
+	_, err := aeuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aeuo *ArticleEnrichmentUpdateOne) ExecX(ctx context.Context) {
+	if err := aeuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (aeuo *ArticleEnrichmentUpdateOne) defaults() {
+	if _, ok := aeuo.mutation.UpdatedAt(); !ok {
+		v := articleenrichment.UpdateDefaultUpdatedAt()
+		aeuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aeuo *ArticleEnrichmentUpdateOne) check() error {
+	if v, ok := aeuo.mutation.Format(); ok {
+		if err := articleenrichment.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "ArticleEnrichment.format": %w`, err)}
+		}
+	}
+	if _, ok := aeuo.mutation.ArticleID(); aeuo.mutation.ArticleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ArticleEnrichment.article"`)
+	}
+	return nil
+}
+
+func (aeuo *ArticleEnrichmentUpdateOne) sqlSave(ctx context.Context) (_node *ArticleEnrichment, err error) {
+	if err := aeuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(articleenrichment.Table, articleenrichment.Columns, sqlgraph.NewFieldSpec(articleenrichment.FieldID, field.TypeInt))
+	id, ok := aeuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ArticleEnrichment.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aeuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, articleenrichment.FieldID)
+		for _, f := range fields {
+			if !articleenrichment.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != articleenrichment.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aeuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := aeuo.mutation.Content(); ok {
+		_spec.SetField(articleenrichment.FieldContent, field.TypeString, value)
+	}
+	if value, ok := aeuo.mutation.Data(); ok {
+		_spec.SetField(articleenrichment.FieldData, field.TypeJSON, value)
+	}
+	if value, ok := aeuo.mutation.AppendedData(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, articleenrichment.FieldData, value)
+		})
+	}
+	if aeuo.mutation.DataCleared() {
+		_spec.ClearField(articleenrichment.FieldData, field.TypeJSON)
+	}
+	if value, ok := aeuo.mutation.Format(); ok {
+		_spec.SetField(articleenrichment.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := aeuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(articleenrichment.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ArticleEnrichment{config: aeuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aeuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{articleenrichment.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aeuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,195 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articlerevision"
+)
+
+// ArticleRevision is the model entity for the ArticleRevision schema.
+type ArticleRevision struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ArticleID holds the value of the "article_id" field.
+	ArticleID uint `json:"article_id,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// Summary holds the value of the "summary" field.
+	Summary string `json:"summary,omitempty"`
+	// Tags holds the value of the "tags" field.
+	Tags []string `json:"tags,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ArticleRevisionQuery when eager-loading is set.
+	Edges        ArticleRevisionEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ArticleRevisionEdges holds the relations/edges for other nodes in the graph.
+type ArticleRevisionEdges struct {
+	// Article holds the value of the article edge.
+	Article *Article `json:"article,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ArticleOrErr returns the Article value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ArticleRevisionEdges) ArticleOrErr() (*Article, error) {
+	if e.Article != nil {
+		return e.Article, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: article.Label}
+	}
+	return nil, &NotLoadedError{edge: "article"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ArticleRevision) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case articlerevision.FieldTags:
+			values[i] = new([]byte)
+		case articlerevision.FieldID, articlerevision.FieldArticleID:
+			values[i] = new(sql.NullInt64)
+		case articlerevision.FieldTitle, articlerevision.FieldContent, articlerevision.FieldSummary:
+			values[i] = new(sql.NullString)
+		case articlerevision.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ArticleRevision fields.
+func (ar *ArticleRevision) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case articlerevision.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			ar.ID = int(value.Int64)
+		case articlerevision.FieldArticleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field article_id", values[i])
+			} else if value.Valid {
+				ar.ArticleID = uint(value.Int64)
+			}
+		case articlerevision.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				ar.Title = value.String
+			}
+		case articlerevision.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				ar.Content = value.String
+			}
+		case articlerevision.FieldSummary:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field summary", values[i])
+			} else if value.Valid {
+				ar.Summary = value.String
+			}
+		case articlerevision.FieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &ar.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
+		case articlerevision.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				ar.CreatedAt = value.Time
+			}
+		default:
+			ar.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ArticleRevision.
+// This includes values selected through modifiers, order, etc.
+func (ar *ArticleRevision) Value(name string) (ent.Value, error) {
+	return ar.selectValues.Get(name)
+}
+
+// QueryArticle queries the "article" edge of the ArticleRevision entity.
+func (ar *ArticleRevision) QueryArticle() *ArticleQuery {
+	return NewArticleRevisionClient(ar.config).QueryArticle(ar)
+}
+
+// Update returns a builder for updating this ArticleRevision.
+// Note that you need to call ArticleRevision.Unwrap() before calling this method if this ArticleRevision
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (ar *ArticleRevision) Update() *ArticleRevisionUpdateOne {
+	return NewArticleRevisionClient(ar.config).UpdateOne(ar)
+}
+
+// Unwrap unwraps the ArticleRevision entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (ar *ArticleRevision) Unwrap() *ArticleRevision {
+	_tx, ok := ar.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ArticleRevision is not a transactional entity")
+	}
+	ar.config.driver = _tx.drv
+	return ar
+}
+
+// String implements the fmt.Stringer.
+func (ar *ArticleRevision) String() string {
+	var builder strings.Builder
+	builder.WriteString("ArticleRevision(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", ar.ID))
+	builder.WriteString("article_id=")
+	builder.WriteString(fmt.Sprintf("%v", ar.ArticleID))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(ar.Title)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(ar.Content)
+	builder.WriteString(", ")
+	builder.WriteString("summary=")
+	builder.WriteString(ar.Summary)
+	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(fmt.Sprintf("%v", ar.Tags))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(ar.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ArticleRevisions is a parsable slice of ArticleRevision.
+type ArticleRevisions []*ArticleRevision
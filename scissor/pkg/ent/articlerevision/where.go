@@ -0,0 +1,394 @@
+// Code generated by ent, DO NOT EDIT.
+
+package articlerevision
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldLTE(FieldID, id))
+}
+
+// ArticleID applies equality check predicate on the "article_id" field. It's identical to ArticleIDEQ.
+func ArticleID(v uint) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldEQ(FieldArticleID, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldEQ(FieldTitle, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldEQ(FieldContent, v))
+}
+
+// Summary applies equality check predicate on the "summary" field. It's identical to SummaryEQ.
+func Summary(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldEQ(FieldSummary, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// ArticleIDEQ applies the EQ predicate on the "article_id" field.
+func ArticleIDEQ(v uint) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldEQ(FieldArticleID, v))
+}
+
+// ArticleIDNEQ applies the NEQ predicate on the "article_id" field.
+func ArticleIDNEQ(v uint) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldNEQ(FieldArticleID, v))
+}
+
+// ArticleIDIn applies the In predicate on the "article_id" field.
+func ArticleIDIn(vs ...uint) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldIn(FieldArticleID, vs...))
+}
+
+// ArticleIDNotIn applies the NotIn predicate on the "article_id" field.
+func ArticleIDNotIn(vs ...uint) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldNotIn(FieldArticleID, vs...))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldContainsFold(FieldContent, v))
+}
+
+// SummaryEQ applies the EQ predicate on the "summary" field.
+func SummaryEQ(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldEQ(FieldSummary, v))
+}
+
+// SummaryNEQ applies the NEQ predicate on the "summary" field.
+func SummaryNEQ(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldNEQ(FieldSummary, v))
+}
+
+// SummaryIn applies the In predicate on the "summary" field.
+func SummaryIn(vs ...string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldIn(FieldSummary, vs...))
+}
+
+// SummaryNotIn applies the NotIn predicate on the "summary" field.
+func SummaryNotIn(vs ...string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldNotIn(FieldSummary, vs...))
+}
+
+// SummaryGT applies the GT predicate on the "summary" field.
+func SummaryGT(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldGT(FieldSummary, v))
+}
+
+// SummaryGTE applies the GTE predicate on the "summary" field.
+func SummaryGTE(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldGTE(FieldSummary, v))
+}
+
+// SummaryLT applies the LT predicate on the "summary" field.
+func SummaryLT(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldLT(FieldSummary, v))
+}
+
+// SummaryLTE applies the LTE predicate on the "summary" field.
+func SummaryLTE(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldLTE(FieldSummary, v))
+}
+
+// SummaryContains applies the Contains predicate on the "summary" field.
+func SummaryContains(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldContains(FieldSummary, v))
+}
+
+// SummaryHasPrefix applies the HasPrefix predicate on the "summary" field.
+func SummaryHasPrefix(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldHasPrefix(FieldSummary, v))
+}
+
+// SummaryHasSuffix applies the HasSuffix predicate on the "summary" field.
+func SummaryHasSuffix(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldHasSuffix(FieldSummary, v))
+}
+
+// SummaryIsNil applies the IsNil predicate on the "summary" field.
+func SummaryIsNil() predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldIsNull(FieldSummary))
+}
+
+// SummaryNotNil applies the NotNil predicate on the "summary" field.
+func SummaryNotNil() predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldNotNull(FieldSummary))
+}
+
+// SummaryEqualFold applies the EqualFold predicate on the "summary" field.
+func SummaryEqualFold(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldEqualFold(FieldSummary, v))
+}
+
+// SummaryContainsFold applies the ContainsFold predicate on the "summary" field.
+func SummaryContainsFold(v string) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldContainsFold(FieldSummary, v))
+}
+
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldNotNull(FieldTags))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// HasArticle applies the HasEdge predicate on the "article" edge.
+func HasArticle() predicate.ArticleRevision {
+	return predicate.ArticleRevision(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ArticleTable, ArticleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArticleWith applies the HasEdge predicate on the "article" edge with a given conditions (other predicates).
+func HasArticleWith(preds ...predicate.Article) predicate.ArticleRevision {
+	return predicate.ArticleRevision(func(s *sql.Selector) {
+		step := newArticleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ArticleRevision) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ArticleRevision) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ArticleRevision) predicate.ArticleRevision {
+	return predicate.ArticleRevision(sql.NotPredicates(p))
+}
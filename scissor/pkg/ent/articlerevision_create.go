@@ -0,0 +1,291 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articlerevision"
+)
+
+// ArticleRevisionCreate is the builder for creating a ArticleRevision entity.
+type ArticleRevisionCreate struct {
+	config
+	mutation *ArticleRevisionMutation
+	hooks    []Hook
+}
+
+// SetArticleID sets the "article_id" field.
+func (arc *ArticleRevisionCreate) SetArticleID(u uint) *ArticleRevisionCreate {
+	arc.mutation.SetArticleID(u)
+	return arc
+}
+
+// SetTitle sets the "title" field.
+func (arc *ArticleRevisionCreate) SetTitle(s string) *ArticleRevisionCreate {
+	arc.mutation.SetTitle(s)
+	return arc
+}
+
+// SetContent sets the "content" field.
+func (arc *ArticleRevisionCreate) SetContent(s string) *ArticleRevisionCreate {
+	arc.mutation.SetContent(s)
+	return arc
+}
+
+// SetSummary sets the "summary" field.
+func (arc *ArticleRevisionCreate) SetSummary(s string) *ArticleRevisionCreate {
+	arc.mutation.SetSummary(s)
+	return arc
+}
+
+// SetNillableSummary sets the "summary" field if the given value is not nil.
+func (arc *ArticleRevisionCreate) SetNillableSummary(s *string) *ArticleRevisionCreate {
+	if s != nil {
+		arc.SetSummary(*s)
+	}
+	return arc
+}
+
+// SetTags sets the "tags" field.
+func (arc *ArticleRevisionCreate) SetTags(s []string) *ArticleRevisionCreate {
+	arc.mutation.SetTags(s)
+	return arc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (arc *ArticleRevisionCreate) SetCreatedAt(t time.Time) *ArticleRevisionCreate {
+	arc.mutation.SetCreatedAt(t)
+	return arc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (arc *ArticleRevisionCreate) SetNillableCreatedAt(t *time.Time) *ArticleRevisionCreate {
+	if t != nil {
+		arc.SetCreatedAt(*t)
+	}
+	return arc
+}
+
+// SetArticle sets the "article" edge to the Article entity.
+func (arc *ArticleRevisionCreate) SetArticle(a *Article) *ArticleRevisionCreate {
+	return arc.SetArticleID(a.ID)
+}
+
+// Mutation returns the ArticleRevisionMutation object of the builder.
+func (arc *ArticleRevisionCreate) Mutation() *ArticleRevisionMutation {
+	return arc.mutation
+}
+
+// Save creates the ArticleRevision in the database.
+func (arc *ArticleRevisionCreate) Save(ctx context.Context) (*ArticleRevision, error) {
+	arc.defaults()
+	return withHooks(ctx, arc.sqlSave, arc.mutation, arc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (arc *ArticleRevisionCreate) SaveX(ctx context.Context) *ArticleRevision {
+	v, err := arc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arc *ArticleRevisionCreate) Exec(ctx context.Context) error {
+	_, err := arc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arc *ArticleRevisionCreate) ExecX(ctx context.Context) {
+	if err := arc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (arc *ArticleRevisionCreate) defaults() {
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		v := articlerevision.DefaultCreatedAt()
+		arc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (arc *ArticleRevisionCreate) check() error {
+	if _, ok := arc.mutation.ArticleID(); !ok {
+		return &ValidationError{Name: "article_id", err: errors.New(`ent: missing required field "ArticleRevision.article_id"`)}
+	}
+	if _, ok := arc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "ArticleRevision.title"`)}
+	}
+	if _, ok := arc.mutation.Content(); !ok {
+		return &ValidationError{Name: "content", err: errors.New(`ent: missing required field "ArticleRevision.content"`)}
+	}
+	if _, ok := arc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ArticleRevision.created_at"`)}
+	}
+	if _, ok := arc.mutation.ArticleID(); !ok {
+		return &ValidationError{Name: "article", err: errors.New(`ent: missing required edge "ArticleRevision.article"`)}
+	}
+	return nil
+}
+
+func (arc *ArticleRevisionCreate) sqlSave(ctx context.Context) (*ArticleRevision, error) {
+	if err := arc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := arc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, arc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	arc.mutation.id = &_node.ID
+	arc.mutation.done = true
+	return _node, nil
+}
+
+func (arc *ArticleRevisionCreate) createSpec() (*ArticleRevision, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ArticleRevision{config: arc.config}
+		_spec = sqlgraph.NewCreateSpec(articlerevision.Table, sqlgraph.NewFieldSpec(articlerevision.FieldID, field.TypeInt))
+	)
+	if value, ok := arc.mutation.Title(); ok {
+		_spec.SetField(articlerevision.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := arc.mutation.Content(); ok {
+		_spec.SetField(articlerevision.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := arc.mutation.Summary(); ok {
+		_spec.SetField(articlerevision.FieldSummary, field.TypeString, value)
+		_node.Summary = value
+	}
+	if value, ok := arc.mutation.Tags(); ok {
+		_spec.SetField(articlerevision.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := arc.mutation.CreatedAt(); ok {
+		_spec.SetField(articlerevision.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := arc.mutation.ArticleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articlerevision.ArticleTable,
+			Columns: []string{articlerevision.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ArticleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ArticleRevisionCreateBulk is the builder for creating many ArticleRevision entities in bulk.
+type ArticleRevisionCreateBulk struct {
+	config
+	err      error
+	builders []*ArticleRevisionCreate
+}
+
+// Save creates the ArticleRevision entities in the database.
+func (arcb *ArticleRevisionCreateBulk) Save(ctx context.Context) ([]*ArticleRevision, error) {
+	if arcb.err != nil {
+		return nil, arcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(arcb.builders))
+	nodes := make([]*ArticleRevision, len(arcb.builders))
+	mutators := make([]Mutator, len(arcb.builders))
+	for i := range arcb.builders {
+		func(i int, root context.Context) {
+			builder := arcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ArticleRevisionMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, arcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, arcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, arcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (arcb *ArticleRevisionCreateBulk) SaveX(ctx context.Context) []*ArticleRevision {
+	v, err := arcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (arcb *ArticleRevisionCreateBulk) Exec(ctx context.Context) error {
+	_, err := arcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (arcb *ArticleRevisionCreateBulk) ExecX(ctx context.Context) {
+	if err := arcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articlerevision"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+)
+
+// ArticleRevisionQuery is the builder for querying ArticleRevision entities.
+type ArticleRevisionQuery struct {
+	config
+	ctx         *QueryContext
+	order       []articlerevision.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ArticleRevision
+	withArticle *ArticleQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ArticleRevisionQuery builder.
+func (arq *ArticleRevisionQuery) Where(ps ...predicate.ArticleRevision) *ArticleRevisionQuery {
+	arq.predicates = append(arq.predicates, ps...)
+	return arq
+}
+
+// Limit the number of records to be returned by this query.
+func (arq *ArticleRevisionQuery) Limit(limit int) *ArticleRevisionQuery {
+	arq.ctx.Limit = &limit
+	return arq
+}
+
+// Offset to start from.
+func (arq *ArticleRevisionQuery) Offset(offset int) *ArticleRevisionQuery {
+	arq.ctx.Offset = &offset
+	return arq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (arq *ArticleRevisionQuery) Unique(unique bool) *ArticleRevisionQuery {
+	arq.ctx.Unique = &unique
+	return arq
+}
+
+// Order specifies how the records should be ordered.
+func (arq *ArticleRevisionQuery) Order(o ...articlerevision.OrderOption) *ArticleRevisionQuery {
+	arq.order = append(arq.order, o...)
+	return arq
+}
+
+// QueryArticle chains the current query on the "article" edge.
+func (arq *ArticleRevisionQuery) QueryArticle() *ArticleQuery {
+	query := (&ArticleClient{config: arq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := arq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := arq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(articlerevision.Table, articlerevision.FieldID, selector),
+			sqlgraph.To(article.Table, article.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, articlerevision.ArticleTable, articlerevision.ArticleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(arq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ArticleRevision entity from the query.
+// Returns a *NotFoundError when no ArticleRevision was found.
+func (arq *ArticleRevisionQuery) First(ctx context.Context) (*ArticleRevision, error) {
+	nodes, err := arq.Limit(1).All(setContextOp(ctx, arq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{articlerevision.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (arq *ArticleRevisionQuery) FirstX(ctx context.Context) *ArticleRevision {
+	node, err := arq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ArticleRevision ID from the query.
+// Returns a *NotFoundError when no ArticleRevision ID was found.
+func (arq *ArticleRevisionQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = arq.Limit(1).IDs(setContextOp(ctx, arq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{articlerevision.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (arq *ArticleRevisionQuery) FirstIDX(ctx context.Context) int {
+	id, err := arq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ArticleRevision entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ArticleRevision entity is found.
+// Returns a *NotFoundError when no ArticleRevision entities are found.
+func (arq *ArticleRevisionQuery) Only(ctx context.Context) (*ArticleRevision, error) {
+	nodes, err := arq.Limit(2).All(setContextOp(ctx, arq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{articlerevision.Label}
+	default:
+		return nil, &NotSingularError{articlerevision.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (arq *ArticleRevisionQuery) OnlyX(ctx context.Context) *ArticleRevision {
+	node, err := arq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ArticleRevision ID in the query.
+// Returns a *NotSingularError when more than one ArticleRevision ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (arq *ArticleRevisionQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = arq.Limit(2).IDs(setContextOp(ctx, arq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{articlerevision.Label}
+	default:
+		err = &NotSingularError{articlerevision.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (arq *ArticleRevisionQuery) OnlyIDX(ctx context.Context) int {
+	id, err := arq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ArticleRevisions.
+func (arq *ArticleRevisionQuery) All(ctx context.Context) ([]*ArticleRevision, error) {
+	ctx = setContextOp(ctx, arq.ctx, "All")
+	if err := arq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ArticleRevision, *ArticleRevisionQuery]()
+	return withInterceptors[[]*ArticleRevision](ctx, arq, qr, arq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (arq *ArticleRevisionQuery) AllX(ctx context.Context) []*ArticleRevision {
+	nodes, err := arq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ArticleRevision IDs.
+func (arq *ArticleRevisionQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if arq.ctx.Unique == nil && arq.path != nil {
+		arq.Unique(true)
+	}
+	ctx = setContextOp(ctx, arq.ctx, "IDs")
+	if err = arq.Select(articlerevision.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (arq *ArticleRevisionQuery) IDsX(ctx context.Context) []int {
+	ids, err := arq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (arq *ArticleRevisionQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, arq.ctx, "Count")
+	if err := arq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, arq, querierCount[*ArticleRevisionQuery](), arq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (arq *ArticleRevisionQuery) CountX(ctx context.Context) int {
+	count, err := arq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (arq *ArticleRevisionQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, arq.ctx, "Exist")
+	switch _, err := arq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (arq *ArticleRevisionQuery) ExistX(ctx context.Context) bool {
+	exist, err := arq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ArticleRevisionQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (arq *ArticleRevisionQuery) Clone() *ArticleRevisionQuery {
+	if arq == nil {
+		return nil
+	}
+	return &ArticleRevisionQuery{
+		config:      arq.config,
+		ctx:         arq.ctx.Clone(),
+		order:       append([]articlerevision.OrderOption{}, arq.order...),
+		inters:      append([]Interceptor{}, arq.inters...),
+		predicates:  append([]predicate.ArticleRevision{}, arq.predicates...),
+		withArticle: arq.withArticle.Clone(),
+		// clone intermediate query.
+		sql:  arq.sql.Clone(),
+		path: arq.path,
+	}
+}
+
+// WithArticle tells the query-builder to eager-load the nodes that are connected to
+// the "article" edge. The optional arguments are used to configure the query builder of the edge.
+func (arq *ArticleRevisionQuery) WithArticle(opts ...func(*ArticleQuery)) *ArticleRevisionQuery {
+	query := (&ArticleClient{config: arq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	arq.withArticle = query
+	return arq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ArticleID uint `json:"article_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ArticleRevision.Query().
+//		GroupBy(articlerevision.FieldArticleID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (arq *ArticleRevisionQuery) GroupBy(field string, fields ...string) *ArticleRevisionGroupBy {
+	arq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ArticleRevisionGroupBy{build: arq}
+	grbuild.flds = &arq.ctx.Fields
+	grbuild.label = articlerevision.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ArticleID uint `json:"article_id,omitempty"`
+//	}
+//
+//	client.ArticleRevision.Query().
+//		Select(articlerevision.FieldArticleID).
+//		Scan(ctx, &v)
+func (arq *ArticleRevisionQuery) Select(fields ...string) *ArticleRevisionSelect {
+	arq.ctx.Fields = append(arq.ctx.Fields, fields...)
+	sbuild := &ArticleRevisionSelect{ArticleRevisionQuery: arq}
+	sbuild.label = articlerevision.Label
+	sbuild.flds, sbuild.scan = &arq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ArticleRevisionSelect configured with the given aggregations.
+func (arq *ArticleRevisionQuery) Aggregate(fns ...AggregateFunc) *ArticleRevisionSelect {
+	return arq.Select().Aggregate(fns...)
+}
+
+func (arq *ArticleRevisionQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range arq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, arq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range arq.ctx.Fields {
+		if !articlerevision.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if arq.path != nil {
+		prev, err := arq.path(ctx)
+		if err != nil {
+			return err
+		}
+		arq.sql = prev
+	}
+	return nil
+}
+
+func (arq *ArticleRevisionQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ArticleRevision, error) {
+	var (
+		nodes       = []*ArticleRevision{}
+		_spec       = arq.querySpec()
+		loadedTypes = [1]bool{
+			arq.withArticle != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ArticleRevision).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ArticleRevision{config: arq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, arq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := arq.withArticle; query != nil {
+		if err := arq.loadArticle(ctx, query, nodes, nil,
+			func(n *ArticleRevision, e *Article) { n.Edges.Article = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (arq *ArticleRevisionQuery) loadArticle(ctx context.Context, query *ArticleQuery, nodes []*ArticleRevision, init func(*ArticleRevision), assign func(*ArticleRevision, *Article)) error {
+	ids := make([]uint, 0, len(nodes))
+	nodeids := make(map[uint][]*ArticleRevision)
+	for i := range nodes {
+		fk := nodes[i].ArticleID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(article.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "article_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (arq *ArticleRevisionQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := arq.querySpec()
+	_spec.Node.Columns = arq.ctx.Fields
+	if len(arq.ctx.Fields) > 0 {
+		_spec.Unique = arq.ctx.Unique != nil && *arq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, arq.driver, _spec)
+}
+
+func (arq *ArticleRevisionQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(articlerevision.Table, articlerevision.Columns, sqlgraph.NewFieldSpec(articlerevision.FieldID, field.TypeInt))
+	_spec.From = arq.sql
+	if unique := arq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if arq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := arq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, articlerevision.FieldID)
+		for i := range fields {
+			if fields[i] != articlerevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if arq.withArticle != nil {
+			_spec.Node.AddColumnOnce(articlerevision.FieldArticleID)
+		}
+	}
+	if ps := arq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := arq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := arq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := arq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (arq *ArticleRevisionQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(arq.driver.Dialect())
+	t1 := builder.Table(articlerevision.Table)
+	columns := arq.ctx.Fields
+	if len(columns) == 0 {
+		columns = articlerevision.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if arq.sql != nil {
+		selector = arq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if arq.ctx.Unique != nil && *arq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range arq.predicates {
+		p(selector)
+	}
+	for _, p := range arq.order {
+		p(selector)
+	}
+	if offset := arq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := arq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ArticleRevisionGroupBy is the group-by builder for ArticleRevision entities.
+type ArticleRevisionGroupBy struct {
+	selector
+	build *ArticleRevisionQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (argb *ArticleRevisionGroupBy) Aggregate(fns ...AggregateFunc) *ArticleRevisionGroupBy {
+	argb.fns = append(argb.fns, fns...)
+	return argb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (argb *ArticleRevisionGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, argb.build.ctx, "GroupBy")
+	if err := argb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ArticleRevisionQuery, *ArticleRevisionGroupBy](ctx, argb.build, argb, argb.build.inters, v)
+}
+
+func (argb *ArticleRevisionGroupBy) sqlScan(ctx context.Context, root *ArticleRevisionQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(argb.fns))
+	for _, fn := range argb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*argb.flds)+len(argb.fns))
+		for _, f := range *argb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*argb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := argb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ArticleRevisionSelect is the builder for selecting fields of ArticleRevision entities.
+type ArticleRevisionSelect struct {
+	*ArticleRevisionQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ars *ArticleRevisionSelect) Aggregate(fns ...AggregateFunc) *ArticleRevisionSelect {
+	ars.fns = append(ars.fns, fns...)
+	return ars
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ars *ArticleRevisionSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ars.ctx, "Select")
+	if err := ars.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ArticleRevisionQuery, *ArticleRevisionSelect](ctx, ars.ArticleRevisionQuery, ars, ars.inters, v)
+}
+
+func (ars *ArticleRevisionSelect) sqlScan(ctx context.Context, root *ArticleRevisionQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ars.fns))
+	for _, fn := range ars.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ars.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ars.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
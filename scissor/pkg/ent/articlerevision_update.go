@@ -0,0 +1,209 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articlerevision"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+)
+
+// ArticleRevisionUpdate is the builder for updating ArticleRevision entities.
+type ArticleRevisionUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ArticleRevisionMutation
+}
+
+// Where appends a list predicates to the ArticleRevisionUpdate builder.
+func (aru *ArticleRevisionUpdate) Where(ps ...predicate.ArticleRevision) *ArticleRevisionUpdate {
+	aru.mutation.Where(ps...)
+	return aru
+}
+
+// Mutation returns the ArticleRevisionMutation object of the builder.
+func (aru *ArticleRevisionUpdate) Mutation() *ArticleRevisionMutation {
+	return aru.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (aru *ArticleRevisionUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, aru.sqlSave, aru.mutation, aru.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aru *ArticleRevisionUpdate) SaveX(ctx context.Context) int {
+	affected, err := aru.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (aru *ArticleRevisionUpdate) Exec(ctx context.Context) error {
+	_, err := aru.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aru *ArticleRevisionUpdate) ExecX(ctx context.Context) {
+	if err := aru.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aru *ArticleRevisionUpdate) check() error {
+	if _, ok := aru.mutation.ArticleID(); aru.mutation.ArticleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ArticleRevision.article"`)
+	}
+	return nil
+}
+
+func (aru *ArticleRevisionUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := aru.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(articlerevision.Table, articlerevision.Columns, sqlgraph.NewFieldSpec(articlerevision.FieldID, field.TypeInt))
+	if ps := aru.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if aru.mutation.SummaryCleared() {
+		_spec.ClearField(articlerevision.FieldSummary, field.TypeString)
+	}
+	if aru.mutation.TagsCleared() {
+		_spec.ClearField(articlerevision.FieldTags, field.TypeJSON)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, aru.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{articlerevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	aru.mutation.done = true
+	return n, nil
+}
+
+// ArticleRevisionUpdateOne is the builder for updating a single ArticleRevision entity.
+type ArticleRevisionUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ArticleRevisionMutation
+}
+
+// Mutation returns the ArticleRevisionMutation object of the builder.
+func (aruo *ArticleRevisionUpdateOne) Mutation() *ArticleRevisionMutation {
+	return aruo.mutation
+}
+
+// Where appends a list predicates to the ArticleRevisionUpdate builder.
+func (aruo *ArticleRevisionUpdateOne) Where(ps ...predicate.ArticleRevision) *ArticleRevisionUpdateOne {
+	aruo.mutation.Where(ps...)
+	return aruo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (aruo *ArticleRevisionUpdateOne) Select(field string, fields ...string) *ArticleRevisionUpdateOne {
+	aruo.fields = append([]string{field}, fields...)
+	return aruo
+}
+
+// Save executes the query and returns the updated ArticleRevision entity.
+func (aruo *ArticleRevisionUpdateOne) Save(ctx context.Context) (*ArticleRevision, error) {
+	return withHooks(ctx, aruo.sqlSave, aruo.mutation, aruo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (aruo *ArticleRevisionUpdateOne) SaveX(ctx context.Context) *ArticleRevision {
+	node, err := aruo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (aruo *ArticleRevisionUpdateOne) Exec(ctx context.Context) error {
+	_, err := aruo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (aruo *ArticleRevisionUpdateOne) ExecX(ctx context.Context) {
+	if err := aruo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (aruo *ArticleRevisionUpdateOne) check() error {
+	if _, ok := aruo.mutation.ArticleID(); aruo.mutation.ArticleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ArticleRevision.article"`)
+	}
+	return nil
+}
+
+func (aruo *ArticleRevisionUpdateOne) sqlSave(ctx context.Context) (_node *ArticleRevision, err error) {
+	if err := aruo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(articlerevision.Table, articlerevision.Columns, sqlgraph.NewFieldSpec(articlerevision.FieldID, field.TypeInt))
+	id, ok := aruo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ArticleRevision.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := aruo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, articlerevision.FieldID)
+		for _, f := range fields {
+			if !articlerevision.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != articlerevision.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := aruo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if aruo.mutation.SummaryCleared() {
+		_spec.ClearField(articlerevision.FieldSummary, field.TypeString)
+	}
+	if aruo.mutation.TagsCleared() {
+		_spec.ClearField(articlerevision.FieldTags, field.TypeJSON)
+	}
+	_node = &ArticleRevision{config: aruo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, aruo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{articlerevision.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	aruo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,234 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articletranslation"
+)
+
+// ArticleTranslation is the model entity for the ArticleTranslation schema.
+type ArticleTranslation struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ArticleID holds the value of the "article_id" field.
+	ArticleID uint `json:"article_id,omitempty"`
+	// Language holds the value of the "language" field.
+	Language string `json:"language,omitempty"`
+	// Status holds the value of the "status" field.
+	Status articletranslation.Status `json:"status,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Content holds the value of the "content" field.
+	Content string `json:"content,omitempty"`
+	// Bilingual holds the value of the "bilingual" field.
+	Bilingual string `json:"bilingual,omitempty"`
+	// SourceFingerprint holds the value of the "source_fingerprint" field.
+	SourceFingerprint uint64 `json:"source_fingerprint,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ArticleTranslationQuery when eager-loading is set.
+	Edges        ArticleTranslationEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ArticleTranslationEdges holds the relations/edges for other nodes in the graph.
+type ArticleTranslationEdges struct {
+	// Article holds the value of the article edge.
+	Article *Article `json:"article,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ArticleOrErr returns the Article value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ArticleTranslationEdges) ArticleOrErr() (*Article, error) {
+	if e.Article != nil {
+		return e.Article, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: article.Label}
+	}
+	return nil, &NotLoadedError{edge: "article"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*ArticleTranslation) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case articletranslation.FieldID, articletranslation.FieldArticleID, articletranslation.FieldSourceFingerprint:
+			values[i] = new(sql.NullInt64)
+		case articletranslation.FieldLanguage, articletranslation.FieldStatus, articletranslation.FieldTitle, articletranslation.FieldContent, articletranslation.FieldBilingual, articletranslation.FieldError:
+			values[i] = new(sql.NullString)
+		case articletranslation.FieldCreatedAt, articletranslation.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the ArticleTranslation fields.
+func (at *ArticleTranslation) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case articletranslation.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			at.ID = int(value.Int64)
+		case articletranslation.FieldArticleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field article_id", values[i])
+			} else if value.Valid {
+				at.ArticleID = uint(value.Int64)
+			}
+		case articletranslation.FieldLanguage:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field language", values[i])
+			} else if value.Valid {
+				at.Language = value.String
+			}
+		case articletranslation.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				at.Status = articletranslation.Status(value.String)
+			}
+		case articletranslation.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				at.Title = value.String
+			}
+		case articletranslation.FieldContent:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content", values[i])
+			} else if value.Valid {
+				at.Content = value.String
+			}
+		case articletranslation.FieldBilingual:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field bilingual", values[i])
+			} else if value.Valid {
+				at.Bilingual = value.String
+			}
+		case articletranslation.FieldSourceFingerprint:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field source_fingerprint", values[i])
+			} else if value.Valid {
+				at.SourceFingerprint = uint64(value.Int64)
+			}
+		case articletranslation.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				at.Error = value.String
+			}
+		case articletranslation.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				at.CreatedAt = value.Time
+			}
+		case articletranslation.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				at.UpdatedAt = value.Time
+			}
+		default:
+			at.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the ArticleTranslation.
+// This includes values selected through modifiers, order, etc.
+func (at *ArticleTranslation) Value(name string) (ent.Value, error) {
+	return at.selectValues.Get(name)
+}
+
+// QueryArticle queries the "article" edge of the ArticleTranslation entity.
+func (at *ArticleTranslation) QueryArticle() *ArticleQuery {
+	return NewArticleTranslationClient(at.config).QueryArticle(at)
+}
+
+// Update returns a builder for updating this ArticleTranslation.
+// Note that you need to call ArticleTranslation.Unwrap() before calling this method if this ArticleTranslation
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (at *ArticleTranslation) Update() *ArticleTranslationUpdateOne {
+	return NewArticleTranslationClient(at.config).UpdateOne(at)
+}
+
+// Unwrap unwraps the ArticleTranslation entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (at *ArticleTranslation) Unwrap() *ArticleTranslation {
+	_tx, ok := at.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: ArticleTranslation is not a transactional entity")
+	}
+	at.config.driver = _tx.drv
+	return at
+}
+
+// String implements the fmt.Stringer.
+func (at *ArticleTranslation) String() string {
+	var builder strings.Builder
+	builder.WriteString("ArticleTranslation(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", at.ID))
+	builder.WriteString("article_id=")
+	builder.WriteString(fmt.Sprintf("%v", at.ArticleID))
+	builder.WriteString(", ")
+	builder.WriteString("language=")
+	builder.WriteString(at.Language)
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", at.Status))
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(at.Title)
+	builder.WriteString(", ")
+	builder.WriteString("content=")
+	builder.WriteString(at.Content)
+	builder.WriteString(", ")
+	builder.WriteString("bilingual=")
+	builder.WriteString(at.Bilingual)
+	builder.WriteString(", ")
+	builder.WriteString("source_fingerprint=")
+	builder.WriteString(fmt.Sprintf("%v", at.SourceFingerprint))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(at.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(at.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(at.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// ArticleTranslations is a parsable slice of ArticleTranslation.
+type ArticleTranslations []*ArticleTranslation
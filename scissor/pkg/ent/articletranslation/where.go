@@ -0,0 +1,674 @@
+// Code generated by ent, DO NOT EDIT.
+
+package articletranslation
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldLTE(FieldID, id))
+}
+
+// ArticleID applies equality check predicate on the "article_id" field. It's identical to ArticleIDEQ.
+func ArticleID(v uint) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEQ(FieldArticleID, v))
+}
+
+// Language applies equality check predicate on the "language" field. It's identical to LanguageEQ.
+func Language(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEQ(FieldLanguage, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEQ(FieldTitle, v))
+}
+
+// Content applies equality check predicate on the "content" field. It's identical to ContentEQ.
+func Content(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEQ(FieldContent, v))
+}
+
+// Bilingual applies equality check predicate on the "bilingual" field. It's identical to BilingualEQ.
+func Bilingual(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEQ(FieldBilingual, v))
+}
+
+// SourceFingerprint applies equality check predicate on the "source_fingerprint" field. It's identical to SourceFingerprintEQ.
+func SourceFingerprint(v uint64) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEQ(FieldSourceFingerprint, v))
+}
+
+// Error applies equality check predicate on the "error" field. It's identical to ErrorEQ.
+func Error(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEQ(FieldError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// ArticleIDEQ applies the EQ predicate on the "article_id" field.
+func ArticleIDEQ(v uint) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEQ(FieldArticleID, v))
+}
+
+// ArticleIDNEQ applies the NEQ predicate on the "article_id" field.
+func ArticleIDNEQ(v uint) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNEQ(FieldArticleID, v))
+}
+
+// ArticleIDIn applies the In predicate on the "article_id" field.
+func ArticleIDIn(vs ...uint) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldIn(FieldArticleID, vs...))
+}
+
+// ArticleIDNotIn applies the NotIn predicate on the "article_id" field.
+func ArticleIDNotIn(vs ...uint) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNotIn(FieldArticleID, vs...))
+}
+
+// LanguageEQ applies the EQ predicate on the "language" field.
+func LanguageEQ(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEQ(FieldLanguage, v))
+}
+
+// LanguageNEQ applies the NEQ predicate on the "language" field.
+func LanguageNEQ(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNEQ(FieldLanguage, v))
+}
+
+// LanguageIn applies the In predicate on the "language" field.
+func LanguageIn(vs ...string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldIn(FieldLanguage, vs...))
+}
+
+// LanguageNotIn applies the NotIn predicate on the "language" field.
+func LanguageNotIn(vs ...string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNotIn(FieldLanguage, vs...))
+}
+
+// LanguageGT applies the GT predicate on the "language" field.
+func LanguageGT(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldGT(FieldLanguage, v))
+}
+
+// LanguageGTE applies the GTE predicate on the "language" field.
+func LanguageGTE(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldGTE(FieldLanguage, v))
+}
+
+// LanguageLT applies the LT predicate on the "language" field.
+func LanguageLT(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldLT(FieldLanguage, v))
+}
+
+// LanguageLTE applies the LTE predicate on the "language" field.
+func LanguageLTE(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldLTE(FieldLanguage, v))
+}
+
+// LanguageContains applies the Contains predicate on the "language" field.
+func LanguageContains(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldContains(FieldLanguage, v))
+}
+
+// LanguageHasPrefix applies the HasPrefix predicate on the "language" field.
+func LanguageHasPrefix(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldHasPrefix(FieldLanguage, v))
+}
+
+// LanguageHasSuffix applies the HasSuffix predicate on the "language" field.
+func LanguageHasSuffix(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldHasSuffix(FieldLanguage, v))
+}
+
+// LanguageEqualFold applies the EqualFold predicate on the "language" field.
+func LanguageEqualFold(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEqualFold(FieldLanguage, v))
+}
+
+// LanguageContainsFold applies the ContainsFold predicate on the "language" field.
+func LanguageContainsFold(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldContainsFold(FieldLanguage, v))
+}
+
+// StatusEQ applies the EQ predicate on the "status" field.
+func StatusEQ(v Status) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEQ(FieldStatus, v))
+}
+
+// StatusNEQ applies the NEQ predicate on the "status" field.
+func StatusNEQ(v Status) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNEQ(FieldStatus, v))
+}
+
+// StatusIn applies the In predicate on the "status" field.
+func StatusIn(vs ...Status) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldIn(FieldStatus, vs...))
+}
+
+// StatusNotIn applies the NotIn predicate on the "status" field.
+func StatusNotIn(vs ...Status) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNotIn(FieldStatus, vs...))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// ContentEQ applies the EQ predicate on the "content" field.
+func ContentEQ(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEQ(FieldContent, v))
+}
+
+// ContentNEQ applies the NEQ predicate on the "content" field.
+func ContentNEQ(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNEQ(FieldContent, v))
+}
+
+// ContentIn applies the In predicate on the "content" field.
+func ContentIn(vs ...string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldIn(FieldContent, vs...))
+}
+
+// ContentNotIn applies the NotIn predicate on the "content" field.
+func ContentNotIn(vs ...string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNotIn(FieldContent, vs...))
+}
+
+// ContentGT applies the GT predicate on the "content" field.
+func ContentGT(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldGT(FieldContent, v))
+}
+
+// ContentGTE applies the GTE predicate on the "content" field.
+func ContentGTE(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldGTE(FieldContent, v))
+}
+
+// ContentLT applies the LT predicate on the "content" field.
+func ContentLT(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldLT(FieldContent, v))
+}
+
+// ContentLTE applies the LTE predicate on the "content" field.
+func ContentLTE(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldLTE(FieldContent, v))
+}
+
+// ContentContains applies the Contains predicate on the "content" field.
+func ContentContains(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldContains(FieldContent, v))
+}
+
+// ContentHasPrefix applies the HasPrefix predicate on the "content" field.
+func ContentHasPrefix(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldHasPrefix(FieldContent, v))
+}
+
+// ContentHasSuffix applies the HasSuffix predicate on the "content" field.
+func ContentHasSuffix(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldHasSuffix(FieldContent, v))
+}
+
+// ContentIsNil applies the IsNil predicate on the "content" field.
+func ContentIsNil() predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldIsNull(FieldContent))
+}
+
+// ContentNotNil applies the NotNil predicate on the "content" field.
+func ContentNotNil() predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNotNull(FieldContent))
+}
+
+// ContentEqualFold applies the EqualFold predicate on the "content" field.
+func ContentEqualFold(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEqualFold(FieldContent, v))
+}
+
+// ContentContainsFold applies the ContainsFold predicate on the "content" field.
+func ContentContainsFold(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldContainsFold(FieldContent, v))
+}
+
+// BilingualEQ applies the EQ predicate on the "bilingual" field.
+func BilingualEQ(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEQ(FieldBilingual, v))
+}
+
+// BilingualNEQ applies the NEQ predicate on the "bilingual" field.
+func BilingualNEQ(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNEQ(FieldBilingual, v))
+}
+
+// BilingualIn applies the In predicate on the "bilingual" field.
+func BilingualIn(vs ...string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldIn(FieldBilingual, vs...))
+}
+
+// BilingualNotIn applies the NotIn predicate on the "bilingual" field.
+func BilingualNotIn(vs ...string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNotIn(FieldBilingual, vs...))
+}
+
+// BilingualGT applies the GT predicate on the "bilingual" field.
+func BilingualGT(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldGT(FieldBilingual, v))
+}
+
+// BilingualGTE applies the GTE predicate on the "bilingual" field.
+func BilingualGTE(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldGTE(FieldBilingual, v))
+}
+
+// BilingualLT applies the LT predicate on the "bilingual" field.
+func BilingualLT(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldLT(FieldBilingual, v))
+}
+
+// BilingualLTE applies the LTE predicate on the "bilingual" field.
+func BilingualLTE(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldLTE(FieldBilingual, v))
+}
+
+// BilingualContains applies the Contains predicate on the "bilingual" field.
+func BilingualContains(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldContains(FieldBilingual, v))
+}
+
+// BilingualHasPrefix applies the HasPrefix predicate on the "bilingual" field.
+func BilingualHasPrefix(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldHasPrefix(FieldBilingual, v))
+}
+
+// BilingualHasSuffix applies the HasSuffix predicate on the "bilingual" field.
+func BilingualHasSuffix(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldHasSuffix(FieldBilingual, v))
+}
+
+// BilingualIsNil applies the IsNil predicate on the "bilingual" field.
+func BilingualIsNil() predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldIsNull(FieldBilingual))
+}
+
+// BilingualNotNil applies the NotNil predicate on the "bilingual" field.
+func BilingualNotNil() predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNotNull(FieldBilingual))
+}
+
+// BilingualEqualFold applies the EqualFold predicate on the "bilingual" field.
+func BilingualEqualFold(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEqualFold(FieldBilingual, v))
+}
+
+// BilingualContainsFold applies the ContainsFold predicate on the "bilingual" field.
+func BilingualContainsFold(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldContainsFold(FieldBilingual, v))
+}
+
+// SourceFingerprintEQ applies the EQ predicate on the "source_fingerprint" field.
+func SourceFingerprintEQ(v uint64) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEQ(FieldSourceFingerprint, v))
+}
+
+// SourceFingerprintNEQ applies the NEQ predicate on the "source_fingerprint" field.
+func SourceFingerprintNEQ(v uint64) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNEQ(FieldSourceFingerprint, v))
+}
+
+// SourceFingerprintIn applies the In predicate on the "source_fingerprint" field.
+func SourceFingerprintIn(vs ...uint64) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldIn(FieldSourceFingerprint, vs...))
+}
+
+// SourceFingerprintNotIn applies the NotIn predicate on the "source_fingerprint" field.
+func SourceFingerprintNotIn(vs ...uint64) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNotIn(FieldSourceFingerprint, vs...))
+}
+
+// SourceFingerprintGT applies the GT predicate on the "source_fingerprint" field.
+func SourceFingerprintGT(v uint64) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldGT(FieldSourceFingerprint, v))
+}
+
+// SourceFingerprintGTE applies the GTE predicate on the "source_fingerprint" field.
+func SourceFingerprintGTE(v uint64) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldGTE(FieldSourceFingerprint, v))
+}
+
+// SourceFingerprintLT applies the LT predicate on the "source_fingerprint" field.
+func SourceFingerprintLT(v uint64) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldLT(FieldSourceFingerprint, v))
+}
+
+// SourceFingerprintLTE applies the LTE predicate on the "source_fingerprint" field.
+func SourceFingerprintLTE(v uint64) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldLTE(FieldSourceFingerprint, v))
+}
+
+// SourceFingerprintIsNil applies the IsNil predicate on the "source_fingerprint" field.
+func SourceFingerprintIsNil() predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldIsNull(FieldSourceFingerprint))
+}
+
+// SourceFingerprintNotNil applies the NotNil predicate on the "source_fingerprint" field.
+func SourceFingerprintNotNil() predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNotNull(FieldSourceFingerprint))
+}
+
+// ErrorEQ applies the EQ predicate on the "error" field.
+func ErrorEQ(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEQ(FieldError, v))
+}
+
+// ErrorNEQ applies the NEQ predicate on the "error" field.
+func ErrorNEQ(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNEQ(FieldError, v))
+}
+
+// ErrorIn applies the In predicate on the "error" field.
+func ErrorIn(vs ...string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldIn(FieldError, vs...))
+}
+
+// ErrorNotIn applies the NotIn predicate on the "error" field.
+func ErrorNotIn(vs ...string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNotIn(FieldError, vs...))
+}
+
+// ErrorGT applies the GT predicate on the "error" field.
+func ErrorGT(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldGT(FieldError, v))
+}
+
+// ErrorGTE applies the GTE predicate on the "error" field.
+func ErrorGTE(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldGTE(FieldError, v))
+}
+
+// ErrorLT applies the LT predicate on the "error" field.
+func ErrorLT(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldLT(FieldError, v))
+}
+
+// ErrorLTE applies the LTE predicate on the "error" field.
+func ErrorLTE(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldLTE(FieldError, v))
+}
+
+// ErrorContains applies the Contains predicate on the "error" field.
+func ErrorContains(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldContains(FieldError, v))
+}
+
+// ErrorHasPrefix applies the HasPrefix predicate on the "error" field.
+func ErrorHasPrefix(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldHasPrefix(FieldError, v))
+}
+
+// ErrorHasSuffix applies the HasSuffix predicate on the "error" field.
+func ErrorHasSuffix(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldHasSuffix(FieldError, v))
+}
+
+// ErrorIsNil applies the IsNil predicate on the "error" field.
+func ErrorIsNil() predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldIsNull(FieldError))
+}
+
+// ErrorNotNil applies the NotNil predicate on the "error" field.
+func ErrorNotNil() predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNotNull(FieldError))
+}
+
+// ErrorEqualFold applies the EqualFold predicate on the "error" field.
+func ErrorEqualFold(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEqualFold(FieldError, v))
+}
+
+// ErrorContainsFold applies the ContainsFold predicate on the "error" field.
+func ErrorContainsFold(v string) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldContainsFold(FieldError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasArticle applies the HasEdge predicate on the "article" edge.
+func HasArticle() predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, ArticleTable, ArticleColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArticleWith applies the HasEdge predicate on the "article" edge with a given conditions (other predicates).
+func HasArticleWith(preds ...predicate.Article) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(func(s *sql.Selector) {
+		step := newArticleStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.ArticleTranslation) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.ArticleTranslation) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.ArticleTranslation) predicate.ArticleTranslation {
+	return predicate.ArticleTranslation(sql.NotPredicates(p))
+}
@@ -0,0 +1,395 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articletranslation"
+)
+
+// ArticleTranslationCreate is the builder for creating a ArticleTranslation entity.
+type ArticleTranslationCreate struct {
+	config
+	mutation *ArticleTranslationMutation
+	hooks    []Hook
+}
+
+// SetArticleID sets the "article_id" field.
+func (atc *ArticleTranslationCreate) SetArticleID(u uint) *ArticleTranslationCreate {
+	atc.mutation.SetArticleID(u)
+	return atc
+}
+
+// SetLanguage sets the "language" field.
+func (atc *ArticleTranslationCreate) SetLanguage(s string) *ArticleTranslationCreate {
+	atc.mutation.SetLanguage(s)
+	return atc
+}
+
+// SetStatus sets the "status" field.
+func (atc *ArticleTranslationCreate) SetStatus(a articletranslation.Status) *ArticleTranslationCreate {
+	atc.mutation.SetStatus(a)
+	return atc
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (atc *ArticleTranslationCreate) SetNillableStatus(a *articletranslation.Status) *ArticleTranslationCreate {
+	if a != nil {
+		atc.SetStatus(*a)
+	}
+	return atc
+}
+
+// SetTitle sets the "title" field.
+func (atc *ArticleTranslationCreate) SetTitle(s string) *ArticleTranslationCreate {
+	atc.mutation.SetTitle(s)
+	return atc
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (atc *ArticleTranslationCreate) SetNillableTitle(s *string) *ArticleTranslationCreate {
+	if s != nil {
+		atc.SetTitle(*s)
+	}
+	return atc
+}
+
+// SetContent sets the "content" field.
+func (atc *ArticleTranslationCreate) SetContent(s string) *ArticleTranslationCreate {
+	atc.mutation.SetContent(s)
+	return atc
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (atc *ArticleTranslationCreate) SetNillableContent(s *string) *ArticleTranslationCreate {
+	if s != nil {
+		atc.SetContent(*s)
+	}
+	return atc
+}
+
+// SetBilingual sets the "bilingual" field.
+func (atc *ArticleTranslationCreate) SetBilingual(s string) *ArticleTranslationCreate {
+	atc.mutation.SetBilingual(s)
+	return atc
+}
+
+// SetNillableBilingual sets the "bilingual" field if the given value is not nil.
+func (atc *ArticleTranslationCreate) SetNillableBilingual(s *string) *ArticleTranslationCreate {
+	if s != nil {
+		atc.SetBilingual(*s)
+	}
+	return atc
+}
+
+// SetSourceFingerprint sets the "source_fingerprint" field.
+func (atc *ArticleTranslationCreate) SetSourceFingerprint(u uint64) *ArticleTranslationCreate {
+	atc.mutation.SetSourceFingerprint(u)
+	return atc
+}
+
+// SetNillableSourceFingerprint sets the "source_fingerprint" field if the given value is not nil.
+func (atc *ArticleTranslationCreate) SetNillableSourceFingerprint(u *uint64) *ArticleTranslationCreate {
+	if u != nil {
+		atc.SetSourceFingerprint(*u)
+	}
+	return atc
+}
+
+// SetError sets the "error" field.
+func (atc *ArticleTranslationCreate) SetError(s string) *ArticleTranslationCreate {
+	atc.mutation.SetError(s)
+	return atc
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (atc *ArticleTranslationCreate) SetNillableError(s *string) *ArticleTranslationCreate {
+	if s != nil {
+		atc.SetError(*s)
+	}
+	return atc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (atc *ArticleTranslationCreate) SetCreatedAt(t time.Time) *ArticleTranslationCreate {
+	atc.mutation.SetCreatedAt(t)
+	return atc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (atc *ArticleTranslationCreate) SetNillableCreatedAt(t *time.Time) *ArticleTranslationCreate {
+	if t != nil {
+		atc.SetCreatedAt(*t)
+	}
+	return atc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (atc *ArticleTranslationCreate) SetUpdatedAt(t time.Time) *ArticleTranslationCreate {
+	atc.mutation.SetUpdatedAt(t)
+	return atc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (atc *ArticleTranslationCreate) SetNillableUpdatedAt(t *time.Time) *ArticleTranslationCreate {
+	if t != nil {
+		atc.SetUpdatedAt(*t)
+	}
+	return atc
+}
+
+// SetArticle sets the "article" edge to the Article entity.
+func (atc *ArticleTranslationCreate) SetArticle(a *Article) *ArticleTranslationCreate {
+	return atc.SetArticleID(a.ID)
+}
+
+// Mutation returns the ArticleTranslationMutation object of the builder.
+func (atc *ArticleTranslationCreate) Mutation() *ArticleTranslationMutation {
+	return atc.mutation
+}
+
+// Save creates the ArticleTranslation in the database.
+func (atc *ArticleTranslationCreate) Save(ctx context.Context) (*ArticleTranslation, error) {
+	atc.defaults()
+	return withHooks(ctx, atc.sqlSave, atc.mutation, atc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (atc *ArticleTranslationCreate) SaveX(ctx context.Context) *ArticleTranslation {
+	v, err := atc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atc *ArticleTranslationCreate) Exec(ctx context.Context) error {
+	_, err := atc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atc *ArticleTranslationCreate) ExecX(ctx context.Context) {
+	if err := atc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (atc *ArticleTranslationCreate) defaults() {
+	if _, ok := atc.mutation.Status(); !ok {
+		v := articletranslation.DefaultStatus
+		atc.mutation.SetStatus(v)
+	}
+	if _, ok := atc.mutation.CreatedAt(); !ok {
+		v := articletranslation.DefaultCreatedAt()
+		atc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := atc.mutation.UpdatedAt(); !ok {
+		v := articletranslation.DefaultUpdatedAt()
+		atc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atc *ArticleTranslationCreate) check() error {
+	if _, ok := atc.mutation.ArticleID(); !ok {
+		return &ValidationError{Name: "article_id", err: errors.New(`ent: missing required field "ArticleTranslation.article_id"`)}
+	}
+	if _, ok := atc.mutation.Language(); !ok {
+		return &ValidationError{Name: "language", err: errors.New(`ent: missing required field "ArticleTranslation.language"`)}
+	}
+	if _, ok := atc.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "ArticleTranslation.status"`)}
+	}
+	if v, ok := atc.mutation.Status(); ok {
+		if err := articletranslation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ArticleTranslation.status": %w`, err)}
+		}
+	}
+	if _, ok := atc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "ArticleTranslation.created_at"`)}
+	}
+	if _, ok := atc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "ArticleTranslation.updated_at"`)}
+	}
+	if _, ok := atc.mutation.ArticleID(); !ok {
+		return &ValidationError{Name: "article", err: errors.New(`ent: missing required edge "ArticleTranslation.article"`)}
+	}
+	return nil
+}
+
+func (atc *ArticleTranslationCreate) sqlSave(ctx context.Context) (*ArticleTranslation, error) {
+	if err := atc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := atc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, atc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	atc.mutation.id = &_node.ID
+	atc.mutation.done = true
+	return _node, nil
+}
+
+func (atc *ArticleTranslationCreate) createSpec() (*ArticleTranslation, *sqlgraph.CreateSpec) {
+	var (
+		_node = &ArticleTranslation{config: atc.config}
+		_spec = sqlgraph.NewCreateSpec(articletranslation.Table, sqlgraph.NewFieldSpec(articletranslation.FieldID, field.TypeInt))
+	)
+	if value, ok := atc.mutation.Language(); ok {
+		_spec.SetField(articletranslation.FieldLanguage, field.TypeString, value)
+		_node.Language = value
+	}
+	if value, ok := atc.mutation.Status(); ok {
+		_spec.SetField(articletranslation.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := atc.mutation.Title(); ok {
+		_spec.SetField(articletranslation.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := atc.mutation.Content(); ok {
+		_spec.SetField(articletranslation.FieldContent, field.TypeString, value)
+		_node.Content = value
+	}
+	if value, ok := atc.mutation.Bilingual(); ok {
+		_spec.SetField(articletranslation.FieldBilingual, field.TypeString, value)
+		_node.Bilingual = value
+	}
+	if value, ok := atc.mutation.SourceFingerprint(); ok {
+		_spec.SetField(articletranslation.FieldSourceFingerprint, field.TypeUint64, value)
+		_node.SourceFingerprint = value
+	}
+	if value, ok := atc.mutation.Error(); ok {
+		_spec.SetField(articletranslation.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := atc.mutation.CreatedAt(); ok {
+		_spec.SetField(articletranslation.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := atc.mutation.UpdatedAt(); ok {
+		_spec.SetField(articletranslation.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := atc.mutation.ArticleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   articletranslation.ArticleTable,
+			Columns: []string{articletranslation.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ArticleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ArticleTranslationCreateBulk is the builder for creating many ArticleTranslation entities in bulk.
+type ArticleTranslationCreateBulk struct {
+	config
+	err      error
+	builders []*ArticleTranslationCreate
+}
+
+// Save creates the ArticleTranslation entities in the database.
+func (atcb *ArticleTranslationCreateBulk) Save(ctx context.Context) ([]*ArticleTranslation, error) {
+	if atcb.err != nil {
+		return nil, atcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(atcb.builders))
+	nodes := make([]*ArticleTranslation, len(atcb.builders))
+	mutators := make([]Mutator, len(atcb.builders))
+	for i := range atcb.builders {
+		func(i int, root context.Context) {
+			builder := atcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ArticleTranslationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, atcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, atcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, atcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atcb *ArticleTranslationCreateBulk) SaveX(ctx context.Context) []*ArticleTranslation {
+	v, err := atcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (atcb *ArticleTranslationCreateBulk) Exec(ctx context.Context) error {
+	_, err := atcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atcb *ArticleTranslationCreateBulk) ExecX(ctx context.Context) {
+	if err := atcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articletranslation"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+)
+
+// ArticleTranslationQuery is the builder for querying ArticleTranslation entities.
+type ArticleTranslationQuery struct {
+	config
+	ctx         *QueryContext
+	order       []articletranslation.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.ArticleTranslation
+	withArticle *ArticleQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the ArticleTranslationQuery builder.
+func (atq *ArticleTranslationQuery) Where(ps ...predicate.ArticleTranslation) *ArticleTranslationQuery {
+	atq.predicates = append(atq.predicates, ps...)
+	return atq
+}
+
+// Limit the number of records to be returned by this query.
+func (atq *ArticleTranslationQuery) Limit(limit int) *ArticleTranslationQuery {
+	atq.ctx.Limit = &limit
+	return atq
+}
+
+// Offset to start from.
+func (atq *ArticleTranslationQuery) Offset(offset int) *ArticleTranslationQuery {
+	atq.ctx.Offset = &offset
+	return atq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (atq *ArticleTranslationQuery) Unique(unique bool) *ArticleTranslationQuery {
+	atq.ctx.Unique = &unique
+	return atq
+}
+
+// Order specifies how the records should be ordered.
+func (atq *ArticleTranslationQuery) Order(o ...articletranslation.OrderOption) *ArticleTranslationQuery {
+	atq.order = append(atq.order, o...)
+	return atq
+}
+
+// QueryArticle chains the current query on the "article" edge.
+func (atq *ArticleTranslationQuery) QueryArticle() *ArticleQuery {
+	query := (&ArticleClient{config: atq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := atq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := atq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(articletranslation.Table, articletranslation.FieldID, selector),
+			sqlgraph.To(article.Table, article.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, articletranslation.ArticleTable, articletranslation.ArticleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(atq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first ArticleTranslation entity from the query.
+// Returns a *NotFoundError when no ArticleTranslation was found.
+func (atq *ArticleTranslationQuery) First(ctx context.Context) (*ArticleTranslation, error) {
+	nodes, err := atq.Limit(1).All(setContextOp(ctx, atq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{articletranslation.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (atq *ArticleTranslationQuery) FirstX(ctx context.Context) *ArticleTranslation {
+	node, err := atq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first ArticleTranslation ID from the query.
+// Returns a *NotFoundError when no ArticleTranslation ID was found.
+func (atq *ArticleTranslationQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = atq.Limit(1).IDs(setContextOp(ctx, atq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{articletranslation.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (atq *ArticleTranslationQuery) FirstIDX(ctx context.Context) int {
+	id, err := atq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single ArticleTranslation entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one ArticleTranslation entity is found.
+// Returns a *NotFoundError when no ArticleTranslation entities are found.
+func (atq *ArticleTranslationQuery) Only(ctx context.Context) (*ArticleTranslation, error) {
+	nodes, err := atq.Limit(2).All(setContextOp(ctx, atq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{articletranslation.Label}
+	default:
+		return nil, &NotSingularError{articletranslation.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (atq *ArticleTranslationQuery) OnlyX(ctx context.Context) *ArticleTranslation {
+	node, err := atq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only ArticleTranslation ID in the query.
+// Returns a *NotSingularError when more than one ArticleTranslation ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (atq *ArticleTranslationQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = atq.Limit(2).IDs(setContextOp(ctx, atq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{articletranslation.Label}
+	default:
+		err = &NotSingularError{articletranslation.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (atq *ArticleTranslationQuery) OnlyIDX(ctx context.Context) int {
+	id, err := atq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of ArticleTranslations.
+func (atq *ArticleTranslationQuery) All(ctx context.Context) ([]*ArticleTranslation, error) {
+	ctx = setContextOp(ctx, atq.ctx, "All")
+	if err := atq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*ArticleTranslation, *ArticleTranslationQuery]()
+	return withInterceptors[[]*ArticleTranslation](ctx, atq, qr, atq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (atq *ArticleTranslationQuery) AllX(ctx context.Context) []*ArticleTranslation {
+	nodes, err := atq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of ArticleTranslation IDs.
+func (atq *ArticleTranslationQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if atq.ctx.Unique == nil && atq.path != nil {
+		atq.Unique(true)
+	}
+	ctx = setContextOp(ctx, atq.ctx, "IDs")
+	if err = atq.Select(articletranslation.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (atq *ArticleTranslationQuery) IDsX(ctx context.Context) []int {
+	ids, err := atq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (atq *ArticleTranslationQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, atq.ctx, "Count")
+	if err := atq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, atq, querierCount[*ArticleTranslationQuery](), atq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (atq *ArticleTranslationQuery) CountX(ctx context.Context) int {
+	count, err := atq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (atq *ArticleTranslationQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, atq.ctx, "Exist")
+	switch _, err := atq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (atq *ArticleTranslationQuery) ExistX(ctx context.Context) bool {
+	exist, err := atq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the ArticleTranslationQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (atq *ArticleTranslationQuery) Clone() *ArticleTranslationQuery {
+	if atq == nil {
+		return nil
+	}
+	return &ArticleTranslationQuery{
+		config:      atq.config,
+		ctx:         atq.ctx.Clone(),
+		order:       append([]articletranslation.OrderOption{}, atq.order...),
+		inters:      append([]Interceptor{}, atq.inters...),
+		predicates:  append([]predicate.ArticleTranslation{}, atq.predicates...),
+		withArticle: atq.withArticle.Clone(),
+		// clone intermediate query.
+		sql:  atq.sql.Clone(),
+		path: atq.path,
+	}
+}
+
+// WithArticle tells the query-builder to eager-load the nodes that are connected to
+// the "article" edge. The optional arguments are used to configure the query builder of the edge.
+func (atq *ArticleTranslationQuery) WithArticle(opts ...func(*ArticleQuery)) *ArticleTranslationQuery {
+	query := (&ArticleClient{config: atq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	atq.withArticle = query
+	return atq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ArticleID uint `json:"article_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.ArticleTranslation.Query().
+//		GroupBy(articletranslation.FieldArticleID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (atq *ArticleTranslationQuery) GroupBy(field string, fields ...string) *ArticleTranslationGroupBy {
+	atq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &ArticleTranslationGroupBy{build: atq}
+	grbuild.flds = &atq.ctx.Fields
+	grbuild.label = articletranslation.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ArticleID uint `json:"article_id,omitempty"`
+//	}
+//
+//	client.ArticleTranslation.Query().
+//		Select(articletranslation.FieldArticleID).
+//		Scan(ctx, &v)
+func (atq *ArticleTranslationQuery) Select(fields ...string) *ArticleTranslationSelect {
+	atq.ctx.Fields = append(atq.ctx.Fields, fields...)
+	sbuild := &ArticleTranslationSelect{ArticleTranslationQuery: atq}
+	sbuild.label = articletranslation.Label
+	sbuild.flds, sbuild.scan = &atq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a ArticleTranslationSelect configured with the given aggregations.
+func (atq *ArticleTranslationQuery) Aggregate(fns ...AggregateFunc) *ArticleTranslationSelect {
+	return atq.Select().Aggregate(fns...)
+}
+
+func (atq *ArticleTranslationQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range atq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, atq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range atq.ctx.Fields {
+		if !articletranslation.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if atq.path != nil {
+		prev, err := atq.path(ctx)
+		if err != nil {
+			return err
+		}
+		atq.sql = prev
+	}
+	return nil
+}
+
+func (atq *ArticleTranslationQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*ArticleTranslation, error) {
+	var (
+		nodes       = []*ArticleTranslation{}
+		_spec       = atq.querySpec()
+		loadedTypes = [1]bool{
+			atq.withArticle != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*ArticleTranslation).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &ArticleTranslation{config: atq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, atq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := atq.withArticle; query != nil {
+		if err := atq.loadArticle(ctx, query, nodes, nil,
+			func(n *ArticleTranslation, e *Article) { n.Edges.Article = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (atq *ArticleTranslationQuery) loadArticle(ctx context.Context, query *ArticleQuery, nodes []*ArticleTranslation, init func(*ArticleTranslation), assign func(*ArticleTranslation, *Article)) error {
+	ids := make([]uint, 0, len(nodes))
+	nodeids := make(map[uint][]*ArticleTranslation)
+	for i := range nodes {
+		fk := nodes[i].ArticleID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(article.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "article_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (atq *ArticleTranslationQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := atq.querySpec()
+	_spec.Node.Columns = atq.ctx.Fields
+	if len(atq.ctx.Fields) > 0 {
+		_spec.Unique = atq.ctx.Unique != nil && *atq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, atq.driver, _spec)
+}
+
+func (atq *ArticleTranslationQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(articletranslation.Table, articletranslation.Columns, sqlgraph.NewFieldSpec(articletranslation.FieldID, field.TypeInt))
+	_spec.From = atq.sql
+	if unique := atq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if atq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := atq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, articletranslation.FieldID)
+		for i := range fields {
+			if fields[i] != articletranslation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if atq.withArticle != nil {
+			_spec.Node.AddColumnOnce(articletranslation.FieldArticleID)
+		}
+	}
+	if ps := atq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := atq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := atq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := atq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (atq *ArticleTranslationQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(atq.driver.Dialect())
+	t1 := builder.Table(articletranslation.Table)
+	columns := atq.ctx.Fields
+	if len(columns) == 0 {
+		columns = articletranslation.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if atq.sql != nil {
+		selector = atq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if atq.ctx.Unique != nil && *atq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range atq.predicates {
+		p(selector)
+	}
+	for _, p := range atq.order {
+		p(selector)
+	}
+	if offset := atq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := atq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// ArticleTranslationGroupBy is the group-by builder for ArticleTranslation entities.
+type ArticleTranslationGroupBy struct {
+	selector
+	build *ArticleTranslationQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (atgb *ArticleTranslationGroupBy) Aggregate(fns ...AggregateFunc) *ArticleTranslationGroupBy {
+	atgb.fns = append(atgb.fns, fns...)
+	return atgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (atgb *ArticleTranslationGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, atgb.build.ctx, "GroupBy")
+	if err := atgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ArticleTranslationQuery, *ArticleTranslationGroupBy](ctx, atgb.build, atgb, atgb.build.inters, v)
+}
+
+func (atgb *ArticleTranslationGroupBy) sqlScan(ctx context.Context, root *ArticleTranslationQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(atgb.fns))
+	for _, fn := range atgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*atgb.flds)+len(atgb.fns))
+		for _, f := range *atgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*atgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := atgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// ArticleTranslationSelect is the builder for selecting fields of ArticleTranslation entities.
+type ArticleTranslationSelect struct {
+	*ArticleTranslationQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (ats *ArticleTranslationSelect) Aggregate(fns ...AggregateFunc) *ArticleTranslationSelect {
+	ats.fns = append(ats.fns, fns...)
+	return ats
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ats *ArticleTranslationSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ats.ctx, "Select")
+	if err := ats.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*ArticleTranslationQuery, *ArticleTranslationSelect](ctx, ats.ArticleTranslationQuery, ats, ats.inters, v)
+}
+
+func (ats *ArticleTranslationSelect) sqlScan(ctx context.Context, root *ArticleTranslationQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(ats.fns))
+	for _, fn := range ats.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*ats.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ats.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
@@ -0,0 +1,558 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articletranslation"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+)
+
+// ArticleTranslationUpdate is the builder for updating ArticleTranslation entities.
+type ArticleTranslationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ArticleTranslationMutation
+}
+
+// Where appends a list predicates to the ArticleTranslationUpdate builder.
+func (atu *ArticleTranslationUpdate) Where(ps ...predicate.ArticleTranslation) *ArticleTranslationUpdate {
+	atu.mutation.Where(ps...)
+	return atu
+}
+
+// SetStatus sets the "status" field.
+func (atu *ArticleTranslationUpdate) SetStatus(a articletranslation.Status) *ArticleTranslationUpdate {
+	atu.mutation.SetStatus(a)
+	return atu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (atu *ArticleTranslationUpdate) SetNillableStatus(a *articletranslation.Status) *ArticleTranslationUpdate {
+	if a != nil {
+		atu.SetStatus(*a)
+	}
+	return atu
+}
+
+// SetTitle sets the "title" field.
+func (atu *ArticleTranslationUpdate) SetTitle(s string) *ArticleTranslationUpdate {
+	atu.mutation.SetTitle(s)
+	return atu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (atu *ArticleTranslationUpdate) SetNillableTitle(s *string) *ArticleTranslationUpdate {
+	if s != nil {
+		atu.SetTitle(*s)
+	}
+	return atu
+}
+
+// ClearTitle clears the value of the "title" field.
+func (atu *ArticleTranslationUpdate) ClearTitle() *ArticleTranslationUpdate {
+	atu.mutation.ClearTitle()
+	return atu
+}
+
+// SetContent sets the "content" field.
+func (atu *ArticleTranslationUpdate) SetContent(s string) *ArticleTranslationUpdate {
+	atu.mutation.SetContent(s)
+	return atu
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (atu *ArticleTranslationUpdate) SetNillableContent(s *string) *ArticleTranslationUpdate {
+	if s != nil {
+		atu.SetContent(*s)
+	}
+	return atu
+}
+
+// ClearContent clears the value of the "content" field.
+func (atu *ArticleTranslationUpdate) ClearContent() *ArticleTranslationUpdate {
+	atu.mutation.ClearContent()
+	return atu
+}
+
+// SetBilingual sets the "bilingual" field.
+func (atu *ArticleTranslationUpdate) SetBilingual(s string) *ArticleTranslationUpdate {
+	atu.mutation.SetBilingual(s)
+	return atu
+}
+
+// SetNillableBilingual sets the "bilingual" field if the given value is not nil.
+func (atu *ArticleTranslationUpdate) SetNillableBilingual(s *string) *ArticleTranslationUpdate {
+	if s != nil {
+		atu.SetBilingual(*s)
+	}
+	return atu
+}
+
+// ClearBilingual clears the value of the "bilingual" field.
+func (atu *ArticleTranslationUpdate) ClearBilingual() *ArticleTranslationUpdate {
+	atu.mutation.ClearBilingual()
+	return atu
+}
+
+// SetSourceFingerprint sets the "source_fingerprint" field.
+func (atu *ArticleTranslationUpdate) SetSourceFingerprint(u uint64) *ArticleTranslationUpdate {
+	atu.mutation.ResetSourceFingerprint()
+	atu.mutation.SetSourceFingerprint(u)
+	return atu
+}
+
+// SetNillableSourceFingerprint sets the "source_fingerprint" field if the given value is not nil.
+func (atu *ArticleTranslationUpdate) SetNillableSourceFingerprint(u *uint64) *ArticleTranslationUpdate {
+	if u != nil {
+		atu.SetSourceFingerprint(*u)
+	}
+	return atu
+}
+
+// AddSourceFingerprint adds u to the "source_fingerprint" field.
+func (atu *ArticleTranslationUpdate) AddSourceFingerprint(u int64) *ArticleTranslationUpdate {
+	atu.mutation.AddSourceFingerprint(u)
+	return atu
+}
+
+// ClearSourceFingerprint clears the value of the "source_fingerprint" field.
+func (atu *ArticleTranslationUpdate) ClearSourceFingerprint() *ArticleTranslationUpdate {
+	atu.mutation.ClearSourceFingerprint()
+	return atu
+}
+
+// SetError sets the "error" field.
+func (atu *ArticleTranslationUpdate) SetError(s string) *ArticleTranslationUpdate {
+	atu.mutation.SetError(s)
+	return atu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (atu *ArticleTranslationUpdate) SetNillableError(s *string) *ArticleTranslationUpdate {
+	if s != nil {
+		atu.SetError(*s)
+	}
+	return atu
+}
+
+// ClearError clears the value of the "error" field.
+func (atu *ArticleTranslationUpdate) ClearError() *ArticleTranslationUpdate {
+	atu.mutation.ClearError()
+	return atu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (atu *ArticleTranslationUpdate) SetUpdatedAt(t time.Time) *ArticleTranslationUpdate {
+	atu.mutation.SetUpdatedAt(t)
+	return atu
+}
+
+// Mutation returns the ArticleTranslationMutation object of the builder.
+func (atu *ArticleTranslationUpdate) Mutation() *ArticleTranslationMutation {
+	return atu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (atu *ArticleTranslationUpdate) Save(ctx context.Context) (int, error) {
+	atu.defaults()
+	return withHooks(ctx, atu.sqlSave, atu.mutation, atu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atu *ArticleTranslationUpdate) SaveX(ctx context.Context) int {
+	affected, err := atu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (atu *ArticleTranslationUpdate) Exec(ctx context.Context) error {
+	_, err := atu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atu *ArticleTranslationUpdate) ExecX(ctx context.Context) {
+	if err := atu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (atu *ArticleTranslationUpdate) defaults() {
+	if _, ok := atu.mutation.UpdatedAt(); !ok {
+		v := articletranslation.UpdateDefaultUpdatedAt()
+		atu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atu *ArticleTranslationUpdate) check() error {
+	if v, ok := atu.mutation.Status(); ok {
+		if err := articletranslation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ArticleTranslation.status": %w`, err)}
+		}
+	}
+	if _, ok := atu.mutation.ArticleID(); atu.mutation.ArticleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ArticleTranslation.article"`)
+	}
+	return nil
+}
+
+func (atu *ArticleTranslationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := atu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(articletranslation.Table, articletranslation.Columns, sqlgraph.NewFieldSpec(articletranslation.FieldID, field.TypeInt))
+	if ps := atu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atu.mutation.Status(); ok {
+		_spec.SetField(articletranslation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := atu.mutation.Title(); ok {
+		_spec.SetField(articletranslation.FieldTitle, field.TypeString, value)
+	}
+	if atu.mutation.TitleCleared() {
+		_spec.ClearField(articletranslation.FieldTitle, field.TypeString)
+	}
+	if value, ok := atu.mutation.Content(); ok {
+		_spec.SetField(articletranslation.FieldContent, field.TypeString, value)
+	}
+	if atu.mutation.ContentCleared() {
+		_spec.ClearField(articletranslation.FieldContent, field.TypeString)
+	}
+	if value, ok := atu.mutation.Bilingual(); ok {
+		_spec.SetField(articletranslation.FieldBilingual, field.TypeString, value)
+	}
+	if atu.mutation.BilingualCleared() {
+		_spec.ClearField(articletranslation.FieldBilingual, field.TypeString)
+	}
+	if value, ok := atu.mutation.SourceFingerprint(); ok {
+		_spec.SetField(articletranslation.FieldSourceFingerprint, field.TypeUint64, value)
+	}
+	if value, ok := atu.mutation.AddedSourceFingerprint(); ok {
+		_spec.AddField(articletranslation.FieldSourceFingerprint, field.TypeUint64, value)
+	}
+	if atu.mutation.SourceFingerprintCleared() {
+		_spec.ClearField(articletranslation.FieldSourceFingerprint, field.TypeUint64)
+	}
+	if value, ok := atu.mutation.Error(); ok {
+		_spec.SetField(articletranslation.FieldError, field.TypeString, value)
+	}
+	if atu.mutation.ErrorCleared() {
+		_spec.ClearField(articletranslation.FieldError, field.TypeString)
+	}
+	if value, ok := atu.mutation.UpdatedAt(); ok {
+		_spec.SetField(articletranslation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, atu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{articletranslation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	atu.mutation.done = true
+	return n, nil
+}
+
+// ArticleTranslationUpdateOne is the builder for updating a single ArticleTranslation entity.
+type ArticleTranslationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ArticleTranslationMutation
+}
+
+// SetStatus sets the "status" field.
+func (atuo *ArticleTranslationUpdateOne) SetStatus(a articletranslation.Status) *ArticleTranslationUpdateOne {
+	atuo.mutation.SetStatus(a)
+	return atuo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (atuo *ArticleTranslationUpdateOne) SetNillableStatus(a *articletranslation.Status) *ArticleTranslationUpdateOne {
+	if a != nil {
+		atuo.SetStatus(*a)
+	}
+	return atuo
+}
+
+// SetTitle sets the "title" field.
+func (atuo *ArticleTranslationUpdateOne) SetTitle(s string) *ArticleTranslationUpdateOne {
+	atuo.mutation.SetTitle(s)
+	return atuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (atuo *ArticleTranslationUpdateOne) SetNillableTitle(s *string) *ArticleTranslationUpdateOne {
+	if s != nil {
+		atuo.SetTitle(*s)
+	}
+	return atuo
+}
+
+// ClearTitle clears the value of the "title" field.
+func (atuo *ArticleTranslationUpdateOne) ClearTitle() *ArticleTranslationUpdateOne {
+	atuo.mutation.ClearTitle()
+	return atuo
+}
+
+// SetContent sets the "content" field.
+func (atuo *ArticleTranslationUpdateOne) SetContent(s string) *ArticleTranslationUpdateOne {
+	atuo.mutation.SetContent(s)
+	return atuo
+}
+
+// SetNillableContent sets the "content" field if the given value is not nil.
+func (atuo *ArticleTranslationUpdateOne) SetNillableContent(s *string) *ArticleTranslationUpdateOne {
+	if s != nil {
+		atuo.SetContent(*s)
+	}
+	return atuo
+}
+
+// ClearContent clears the value of the "content" field.
+func (atuo *ArticleTranslationUpdateOne) ClearContent() *ArticleTranslationUpdateOne {
+	atuo.mutation.ClearContent()
+	return atuo
+}
+
+// SetBilingual sets the "bilingual" field.
+func (atuo *ArticleTranslationUpdateOne) SetBilingual(s string) *ArticleTranslationUpdateOne {
+	atuo.mutation.SetBilingual(s)
+	return atuo
+}
+
+// SetNillableBilingual sets the "bilingual" field if the given value is not nil.
+func (atuo *ArticleTranslationUpdateOne) SetNillableBilingual(s *string) *ArticleTranslationUpdateOne {
+	if s != nil {
+		atuo.SetBilingual(*s)
+	}
+	return atuo
+}
+
+// ClearBilingual clears the value of the "bilingual" field.
+func (atuo *ArticleTranslationUpdateOne) ClearBilingual() *ArticleTranslationUpdateOne {
+	atuo.mutation.ClearBilingual()
+	return atuo
+}
+
+// SetSourceFingerprint sets the "source_fingerprint" field.
+func (atuo *ArticleTranslationUpdateOne) SetSourceFingerprint(u uint64) *ArticleTranslationUpdateOne {
+	atuo.mutation.ResetSourceFingerprint()
+	atuo.mutation.SetSourceFingerprint(u)
+	return atuo
+}
+
+// SetNillableSourceFingerprint sets the "source_fingerprint" field if the given value is not nil.
+func (atuo *ArticleTranslationUpdateOne) SetNillableSourceFingerprint(u *uint64) *ArticleTranslationUpdateOne {
+	if u != nil {
+		atuo.SetSourceFingerprint(*u)
+	}
+	return atuo
+}
+
+// AddSourceFingerprint adds u to the "source_fingerprint" field.
+func (atuo *ArticleTranslationUpdateOne) AddSourceFingerprint(u int64) *ArticleTranslationUpdateOne {
+	atuo.mutation.AddSourceFingerprint(u)
+	return atuo
+}
+
+// ClearSourceFingerprint clears the value of the "source_fingerprint" field.
+func (atuo *ArticleTranslationUpdateOne) ClearSourceFingerprint() *ArticleTranslationUpdateOne {
+	atuo.mutation.ClearSourceFingerprint()
+	return atuo
+}
+
+// SetError sets the "error" field.
+func (atuo *ArticleTranslationUpdateOne) SetError(s string) *ArticleTranslationUpdateOne {
+	atuo.mutation.SetError(s)
+	return atuo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (atuo *ArticleTranslationUpdateOne) SetNillableError(s *string) *ArticleTranslationUpdateOne {
+	if s != nil {
+		atuo.SetError(*s)
+	}
+	return atuo
+}
+
+// ClearError clears the value of the "error" field.
+func (atuo *ArticleTranslationUpdateOne) ClearError() *ArticleTranslationUpdateOne {
+	atuo.mutation.ClearError()
+	return atuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (atuo *ArticleTranslationUpdateOne) SetUpdatedAt(t time.Time) *ArticleTranslationUpdateOne {
+	atuo.mutation.SetUpdatedAt(t)
+	return atuo
+}
+
+// Mutation returns the ArticleTranslationMutation object of the builder.
+func (atuo *ArticleTranslationUpdateOne) Mutation() *ArticleTranslationMutation {
+	return atuo.mutation
+}
+
+// Where appends a list predicates to the ArticleTranslationUpdate builder.
+func (atuo *ArticleTranslationUpdateOne) Where(ps ...predicate.ArticleTranslation) *ArticleTranslationUpdateOne {
+	atuo.mutation.Where(ps...)
+	return atuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (atuo *ArticleTranslationUpdateOne) Select(field string, fields ...string) *ArticleTranslationUpdateOne {
+	atuo.fields = append([]string{field}, fields...)
+	return atuo
+}
+
+// Save executes the query and returns the updated ArticleTranslation entity.
+func (atuo *ArticleTranslationUpdateOne) Save(ctx context.Context) (*ArticleTranslation, error) {
+	atuo.defaults()
+	return withHooks(ctx, atuo.sqlSave, atuo.mutation, atuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (atuo *ArticleTranslationUpdateOne) SaveX(ctx context.Context) *ArticleTranslation {
+	node, err := atuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (atuo *ArticleTranslationUpdateOne) Exec(ctx context.Context) error {
+	_, err := atuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (atuo *ArticleTranslationUpdateOne) ExecX(ctx context.Context) {
+	if err := atuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (atuo *ArticleTranslationUpdateOne) defaults() {
+	if _, ok := atuo.mutation.UpdatedAt(); !ok {
+		v := articletranslation.UpdateDefaultUpdatedAt()
+		atuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (atuo *ArticleTranslationUpdateOne) check() error {
+	if v, ok := atuo.mutation.Status(); ok {
+		if err := articletranslation.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "ArticleTranslation.status": %w`, err)}
+		}
+	}
+	if _, ok := atuo.mutation.ArticleID(); atuo.mutation.ArticleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "ArticleTranslation.article"`)
+	}
+	return nil
+}
+
+func (atuo *ArticleTranslationUpdateOne) sqlSave(ctx context.Context) (_node *ArticleTranslation, err error) {
+	if err := atuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(articletranslation.Table, articletranslation.Columns, sqlgraph.NewFieldSpec(articletranslation.FieldID, field.TypeInt))
+	id, ok := atuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "ArticleTranslation.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := atuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, articletranslation.FieldID)
+		for _, f := range fields {
+			if !articletranslation.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != articletranslation.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := atuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := atuo.mutation.Status(); ok {
+		_spec.SetField(articletranslation.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := atuo.mutation.Title(); ok {
+		_spec.SetField(articletranslation.FieldTitle, field.TypeString, value)
+	}
+	if atuo.mutation.TitleCleared() {
+		_spec.ClearField(articletranslation.FieldTitle, field.TypeString)
+	}
+	if value, ok := atuo.mutation.Content(); ok {
+		_spec.SetField(articletranslation.FieldContent, field.TypeString, value)
+	}
+	if atuo.mutation.ContentCleared() {
+		_spec.ClearField(articletranslation.FieldContent, field.TypeString)
+	}
+	if value, ok := atuo.mutation.Bilingual(); ok {
+		_spec.SetField(articletranslation.FieldBilingual, field.TypeString, value)
+	}
+	if atuo.mutation.BilingualCleared() {
+		_spec.ClearField(articletranslation.FieldBilingual, field.TypeString)
+	}
+	if value, ok := atuo.mutation.SourceFingerprint(); ok {
+		_spec.SetField(articletranslation.FieldSourceFingerprint, field.TypeUint64, value)
+	}
+	if value, ok := atuo.mutation.AddedSourceFingerprint(); ok {
+		_spec.AddField(articletranslation.FieldSourceFingerprint, field.TypeUint64, value)
+	}
+	if atuo.mutation.SourceFingerprintCleared() {
+		_spec.ClearField(articletranslation.FieldSourceFingerprint, field.TypeUint64)
+	}
+	if value, ok := atuo.mutation.Error(); ok {
+		_spec.SetField(articletranslation.FieldError, field.TypeString, value)
+	}
+	if atuo.mutation.ErrorCleared() {
+		_spec.ClearField(articletranslation.FieldError, field.TypeString)
+	}
+	if value, ok := atuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(articletranslation.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &ArticleTranslation{config: atuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, atuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{articletranslation.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	atuo.mutation.done = true
+	return _node, nil
+}
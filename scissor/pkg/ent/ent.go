@@ -12,7 +12,20 @@ import (
 	"entgo.io/ent"
 	"entgo.io/ent/dialect/sql"
 	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/accesstoken"
 	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articlechunk"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articleenrichment"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articlerevision"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articletranslation"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/export"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/feed"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/highlight"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/image"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/notification"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/prompttemplate"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/snapshot"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/topiccluster"
 	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
 )
 
@@ -74,8 +87,21 @@ var (
 func checkColumn(table, column string) error {
 	initCheck.Do(func() {
 		columnCheck = sql.NewColumnCheck(map[string]func(string) bool{
-			article.Table: article.ValidColumn,
-			user.Table:    user.ValidColumn,
+			accesstoken.Table:        accesstoken.ValidColumn,
+			article.Table:            article.ValidColumn,
+			articlechunk.Table:       articlechunk.ValidColumn,
+			articleenrichment.Table:  articleenrichment.ValidColumn,
+			articlerevision.Table:    articlerevision.ValidColumn,
+			articletranslation.Table: articletranslation.ValidColumn,
+			export.Table:             export.ValidColumn,
+			feed.Table:               feed.ValidColumn,
+			highlight.Table:          highlight.ValidColumn,
+			image.Table:              image.ValidColumn,
+			notification.Table:       notification.ValidColumn,
+			prompttemplate.Table:     prompttemplate.ValidColumn,
+			snapshot.Table:           snapshot.ValidColumn,
+			topiccluster.Table:       topiccluster.ValidColumn,
+			user.Table:               user.ValidColumn,
 		})
 	})
 	return columnCheck(table, column)
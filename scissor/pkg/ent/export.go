@@ -0,0 +1,229 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/export"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
+)
+
+// Export is the model entity for the Export schema.
+type Export struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Status holds the value of the "status" field.
+	Status export.Status `json:"status,omitempty"`
+	// Path holds the value of the "path" field.
+	Path string `json:"path,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// ArticleCount holds the value of the "article_count" field.
+	ArticleCount int `json:"article_count,omitempty"`
+	// Error holds the value of the "error" field.
+	Error string `json:"error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// StartedAt holds the value of the "started_at" field.
+	StartedAt *time.Time `json:"started_at,omitempty"`
+	// FinishedAt holds the value of the "finished_at" field.
+	FinishedAt *time.Time `json:"finished_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ExportQuery when eager-loading is set.
+	Edges        ExportEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ExportEdges holds the relations/edges for other nodes in the graph.
+type ExportEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ExportEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Export) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case export.FieldID, export.FieldUserID, export.FieldSize, export.FieldArticleCount:
+			values[i] = new(sql.NullInt64)
+		case export.FieldStatus, export.FieldPath, export.FieldError:
+			values[i] = new(sql.NullString)
+		case export.FieldCreatedAt, export.FieldStartedAt, export.FieldFinishedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Export fields.
+func (e *Export) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case export.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			e.ID = int(value.Int64)
+		case export.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				e.UserID = int(value.Int64)
+			}
+		case export.FieldStatus:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field status", values[i])
+			} else if value.Valid {
+				e.Status = export.Status(value.String)
+			}
+		case export.FieldPath:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field path", values[i])
+			} else if value.Valid {
+				e.Path = value.String
+			}
+		case export.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				e.Size = value.Int64
+			}
+		case export.FieldArticleCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field article_count", values[i])
+			} else if value.Valid {
+				e.ArticleCount = int(value.Int64)
+			}
+		case export.FieldError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field error", values[i])
+			} else if value.Valid {
+				e.Error = value.String
+			}
+		case export.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				e.CreatedAt = value.Time
+			}
+		case export.FieldStartedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field started_at", values[i])
+			} else if value.Valid {
+				e.StartedAt = new(time.Time)
+				*e.StartedAt = value.Time
+			}
+		case export.FieldFinishedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field finished_at", values[i])
+			} else if value.Valid {
+				e.FinishedAt = new(time.Time)
+				*e.FinishedAt = value.Time
+			}
+		default:
+			e.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Export.
+// This includes values selected through modifiers, order, etc.
+func (e *Export) Value(name string) (ent.Value, error) {
+	return e.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Export entity.
+func (e *Export) QueryUser() *UserQuery {
+	return NewExportClient(e.config).QueryUser(e)
+}
+
+// Update returns a builder for updating this Export.
+// Note that you need to call Export.Unwrap() before calling this method if this Export
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (e *Export) Update() *ExportUpdateOne {
+	return NewExportClient(e.config).UpdateOne(e)
+}
+
+// Unwrap unwraps the Export entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (e *Export) Unwrap() *Export {
+	_tx, ok := e.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Export is not a transactional entity")
+	}
+	e.config.driver = _tx.drv
+	return e
+}
+
+// String implements the fmt.Stringer.
+func (e *Export) String() string {
+	var builder strings.Builder
+	builder.WriteString("Export(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", e.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", e.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("status=")
+	builder.WriteString(fmt.Sprintf("%v", e.Status))
+	builder.WriteString(", ")
+	builder.WriteString("path=")
+	builder.WriteString(e.Path)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", e.Size))
+	builder.WriteString(", ")
+	builder.WriteString("article_count=")
+	builder.WriteString(fmt.Sprintf("%v", e.ArticleCount))
+	builder.WriteString(", ")
+	builder.WriteString("error=")
+	builder.WriteString(e.Error)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(e.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	if v := e.StartedAt; v != nil {
+		builder.WriteString("started_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := e.FinishedAt; v != nil {
+		builder.WriteString("finished_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Exports is a parsable slice of Export.
+type Exports []*Export
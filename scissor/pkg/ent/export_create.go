@@ -0,0 +1,399 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/export"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
+)
+
+// ExportCreate is the builder for creating a Export entity.
+type ExportCreate struct {
+	config
+	mutation *ExportMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (ec *ExportCreate) SetUserID(i int) *ExportCreate {
+	ec.mutation.SetUserID(i)
+	return ec
+}
+
+// SetStatus sets the "status" field.
+func (ec *ExportCreate) SetStatus(e export.Status) *ExportCreate {
+	ec.mutation.SetStatus(e)
+	return ec
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (ec *ExportCreate) SetNillableStatus(e *export.Status) *ExportCreate {
+	if e != nil {
+		ec.SetStatus(*e)
+	}
+	return ec
+}
+
+// SetPath sets the "path" field.
+func (ec *ExportCreate) SetPath(s string) *ExportCreate {
+	ec.mutation.SetPath(s)
+	return ec
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (ec *ExportCreate) SetNillablePath(s *string) *ExportCreate {
+	if s != nil {
+		ec.SetPath(*s)
+	}
+	return ec
+}
+
+// SetSize sets the "size" field.
+func (ec *ExportCreate) SetSize(i int64) *ExportCreate {
+	ec.mutation.SetSize(i)
+	return ec
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (ec *ExportCreate) SetNillableSize(i *int64) *ExportCreate {
+	if i != nil {
+		ec.SetSize(*i)
+	}
+	return ec
+}
+
+// SetArticleCount sets the "article_count" field.
+func (ec *ExportCreate) SetArticleCount(i int) *ExportCreate {
+	ec.mutation.SetArticleCount(i)
+	return ec
+}
+
+// SetNillableArticleCount sets the "article_count" field if the given value is not nil.
+func (ec *ExportCreate) SetNillableArticleCount(i *int) *ExportCreate {
+	if i != nil {
+		ec.SetArticleCount(*i)
+	}
+	return ec
+}
+
+// SetError sets the "error" field.
+func (ec *ExportCreate) SetError(s string) *ExportCreate {
+	ec.mutation.SetError(s)
+	return ec
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (ec *ExportCreate) SetNillableError(s *string) *ExportCreate {
+	if s != nil {
+		ec.SetError(*s)
+	}
+	return ec
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ec *ExportCreate) SetCreatedAt(t time.Time) *ExportCreate {
+	ec.mutation.SetCreatedAt(t)
+	return ec
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ec *ExportCreate) SetNillableCreatedAt(t *time.Time) *ExportCreate {
+	if t != nil {
+		ec.SetCreatedAt(*t)
+	}
+	return ec
+}
+
+// SetStartedAt sets the "started_at" field.
+func (ec *ExportCreate) SetStartedAt(t time.Time) *ExportCreate {
+	ec.mutation.SetStartedAt(t)
+	return ec
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (ec *ExportCreate) SetNillableStartedAt(t *time.Time) *ExportCreate {
+	if t != nil {
+		ec.SetStartedAt(*t)
+	}
+	return ec
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (ec *ExportCreate) SetFinishedAt(t time.Time) *ExportCreate {
+	ec.mutation.SetFinishedAt(t)
+	return ec
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (ec *ExportCreate) SetNillableFinishedAt(t *time.Time) *ExportCreate {
+	if t != nil {
+		ec.SetFinishedAt(*t)
+	}
+	return ec
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ec *ExportCreate) SetUser(u *User) *ExportCreate {
+	return ec.SetUserID(u.ID)
+}
+
+// Mutation returns the ExportMutation object of the builder.
+func (ec *ExportCreate) Mutation() *ExportMutation {
+	return ec.mutation
+}
+
+// Save creates the Export in the database.
+func (ec *ExportCreate) Save(ctx context.Context) (*Export, error) {
+	ec.defaults()
+	return withHooks(ctx, ec.sqlSave, ec.mutation, ec.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ec *ExportCreate) SaveX(ctx context.Context) *Export {
+	v, err := ec.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ec *ExportCreate) Exec(ctx context.Context) error {
+	_, err := ec.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ec *ExportCreate) ExecX(ctx context.Context) {
+	if err := ec.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ec *ExportCreate) defaults() {
+	if _, ok := ec.mutation.Status(); !ok {
+		v := export.DefaultStatus
+		ec.mutation.SetStatus(v)
+	}
+	if _, ok := ec.mutation.Size(); !ok {
+		v := export.DefaultSize
+		ec.mutation.SetSize(v)
+	}
+	if _, ok := ec.mutation.ArticleCount(); !ok {
+		v := export.DefaultArticleCount
+		ec.mutation.SetArticleCount(v)
+	}
+	if _, ok := ec.mutation.CreatedAt(); !ok {
+		v := export.DefaultCreatedAt()
+		ec.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ec *ExportCreate) check() error {
+	if _, ok := ec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Export.user_id"`)}
+	}
+	if _, ok := ec.mutation.Status(); !ok {
+		return &ValidationError{Name: "status", err: errors.New(`ent: missing required field "Export.status"`)}
+	}
+	if v, ok := ec.mutation.Status(); ok {
+		if err := export.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Export.status": %w`, err)}
+		}
+	}
+	if _, ok := ec.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Export.size"`)}
+	}
+	if v, ok := ec.mutation.Size(); ok {
+		if err := export.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Export.size": %w`, err)}
+		}
+	}
+	if _, ok := ec.mutation.ArticleCount(); !ok {
+		return &ValidationError{Name: "article_count", err: errors.New(`ent: missing required field "Export.article_count"`)}
+	}
+	if v, ok := ec.mutation.ArticleCount(); ok {
+		if err := export.ArticleCountValidator(v); err != nil {
+			return &ValidationError{Name: "article_count", err: fmt.Errorf(`ent: validator failed for field "Export.article_count": %w`, err)}
+		}
+	}
+	if _, ok := ec.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Export.created_at"`)}
+	}
+	if _, ok := ec.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Export.user"`)}
+	}
+	return nil
+}
+
+func (ec *ExportCreate) sqlSave(ctx context.Context) (*Export, error) {
+	if err := ec.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ec.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ec.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ec.mutation.id = &_node.ID
+	ec.mutation.done = true
+	return _node, nil
+}
+
+func (ec *ExportCreate) createSpec() (*Export, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Export{config: ec.config}
+		_spec = sqlgraph.NewCreateSpec(export.Table, sqlgraph.NewFieldSpec(export.FieldID, field.TypeInt))
+	)
+	if value, ok := ec.mutation.Status(); ok {
+		_spec.SetField(export.FieldStatus, field.TypeEnum, value)
+		_node.Status = value
+	}
+	if value, ok := ec.mutation.Path(); ok {
+		_spec.SetField(export.FieldPath, field.TypeString, value)
+		_node.Path = value
+	}
+	if value, ok := ec.mutation.Size(); ok {
+		_spec.SetField(export.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := ec.mutation.ArticleCount(); ok {
+		_spec.SetField(export.FieldArticleCount, field.TypeInt, value)
+		_node.ArticleCount = value
+	}
+	if value, ok := ec.mutation.Error(); ok {
+		_spec.SetField(export.FieldError, field.TypeString, value)
+		_node.Error = value
+	}
+	if value, ok := ec.mutation.CreatedAt(); ok {
+		_spec.SetField(export.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ec.mutation.StartedAt(); ok {
+		_spec.SetField(export.FieldStartedAt, field.TypeTime, value)
+		_node.StartedAt = &value
+	}
+	if value, ok := ec.mutation.FinishedAt(); ok {
+		_spec.SetField(export.FieldFinishedAt, field.TypeTime, value)
+		_node.FinishedAt = &value
+	}
+	if nodes := ec.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   export.UserTable,
+			Columns: []string{export.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ExportCreateBulk is the builder for creating many Export entities in bulk.
+type ExportCreateBulk struct {
+	config
+	err      error
+	builders []*ExportCreate
+}
+
+// Save creates the Export entities in the database.
+func (ecb *ExportCreateBulk) Save(ctx context.Context) ([]*Export, error) {
+	if ecb.err != nil {
+		return nil, ecb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ecb.builders))
+	nodes := make([]*Export, len(ecb.builders))
+	mutators := make([]Mutator, len(ecb.builders))
+	for i := range ecb.builders {
+		func(i int, root context.Context) {
+			builder := ecb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ExportMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ecb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ecb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ecb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ecb *ExportCreateBulk) SaveX(ctx context.Context) []*Export {
+	v, err := ecb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ecb *ExportCreateBulk) Exec(ctx context.Context) error {
+	_, err := ecb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ecb *ExportCreateBulk) ExecX(ctx context.Context) {
+	if err := ecb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
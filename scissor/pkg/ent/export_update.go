@@ -0,0 +1,687 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/export"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
+)
+
+// ExportUpdate is the builder for updating Export entities.
+type ExportUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ExportMutation
+}
+
+// Where appends a list predicates to the ExportUpdate builder.
+func (eu *ExportUpdate) Where(ps ...predicate.Export) *ExportUpdate {
+	eu.mutation.Where(ps...)
+	return eu
+}
+
+// SetUserID sets the "user_id" field.
+func (eu *ExportUpdate) SetUserID(i int) *ExportUpdate {
+	eu.mutation.SetUserID(i)
+	return eu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (eu *ExportUpdate) SetNillableUserID(i *int) *ExportUpdate {
+	if i != nil {
+		eu.SetUserID(*i)
+	}
+	return eu
+}
+
+// SetStatus sets the "status" field.
+func (eu *ExportUpdate) SetStatus(e export.Status) *ExportUpdate {
+	eu.mutation.SetStatus(e)
+	return eu
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (eu *ExportUpdate) SetNillableStatus(e *export.Status) *ExportUpdate {
+	if e != nil {
+		eu.SetStatus(*e)
+	}
+	return eu
+}
+
+// SetPath sets the "path" field.
+func (eu *ExportUpdate) SetPath(s string) *ExportUpdate {
+	eu.mutation.SetPath(s)
+	return eu
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (eu *ExportUpdate) SetNillablePath(s *string) *ExportUpdate {
+	if s != nil {
+		eu.SetPath(*s)
+	}
+	return eu
+}
+
+// ClearPath clears the value of the "path" field.
+func (eu *ExportUpdate) ClearPath() *ExportUpdate {
+	eu.mutation.ClearPath()
+	return eu
+}
+
+// SetSize sets the "size" field.
+func (eu *ExportUpdate) SetSize(i int64) *ExportUpdate {
+	eu.mutation.ResetSize()
+	eu.mutation.SetSize(i)
+	return eu
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (eu *ExportUpdate) SetNillableSize(i *int64) *ExportUpdate {
+	if i != nil {
+		eu.SetSize(*i)
+	}
+	return eu
+}
+
+// AddSize adds i to the "size" field.
+func (eu *ExportUpdate) AddSize(i int64) *ExportUpdate {
+	eu.mutation.AddSize(i)
+	return eu
+}
+
+// SetArticleCount sets the "article_count" field.
+func (eu *ExportUpdate) SetArticleCount(i int) *ExportUpdate {
+	eu.mutation.ResetArticleCount()
+	eu.mutation.SetArticleCount(i)
+	return eu
+}
+
+// SetNillableArticleCount sets the "article_count" field if the given value is not nil.
+func (eu *ExportUpdate) SetNillableArticleCount(i *int) *ExportUpdate {
+	if i != nil {
+		eu.SetArticleCount(*i)
+	}
+	return eu
+}
+
+// AddArticleCount adds i to the "article_count" field.
+func (eu *ExportUpdate) AddArticleCount(i int) *ExportUpdate {
+	eu.mutation.AddArticleCount(i)
+	return eu
+}
+
+// SetError sets the "error" field.
+func (eu *ExportUpdate) SetError(s string) *ExportUpdate {
+	eu.mutation.SetError(s)
+	return eu
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (eu *ExportUpdate) SetNillableError(s *string) *ExportUpdate {
+	if s != nil {
+		eu.SetError(*s)
+	}
+	return eu
+}
+
+// ClearError clears the value of the "error" field.
+func (eu *ExportUpdate) ClearError() *ExportUpdate {
+	eu.mutation.ClearError()
+	return eu
+}
+
+// SetStartedAt sets the "started_at" field.
+func (eu *ExportUpdate) SetStartedAt(t time.Time) *ExportUpdate {
+	eu.mutation.SetStartedAt(t)
+	return eu
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (eu *ExportUpdate) SetNillableStartedAt(t *time.Time) *ExportUpdate {
+	if t != nil {
+		eu.SetStartedAt(*t)
+	}
+	return eu
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (eu *ExportUpdate) ClearStartedAt() *ExportUpdate {
+	eu.mutation.ClearStartedAt()
+	return eu
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (eu *ExportUpdate) SetFinishedAt(t time.Time) *ExportUpdate {
+	eu.mutation.SetFinishedAt(t)
+	return eu
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (eu *ExportUpdate) SetNillableFinishedAt(t *time.Time) *ExportUpdate {
+	if t != nil {
+		eu.SetFinishedAt(*t)
+	}
+	return eu
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (eu *ExportUpdate) ClearFinishedAt() *ExportUpdate {
+	eu.mutation.ClearFinishedAt()
+	return eu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (eu *ExportUpdate) SetUser(u *User) *ExportUpdate {
+	return eu.SetUserID(u.ID)
+}
+
+// Mutation returns the ExportMutation object of the builder.
+func (eu *ExportUpdate) Mutation() *ExportMutation {
+	return eu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (eu *ExportUpdate) ClearUser() *ExportUpdate {
+	eu.mutation.ClearUser()
+	return eu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (eu *ExportUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, eu.sqlSave, eu.mutation, eu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (eu *ExportUpdate) SaveX(ctx context.Context) int {
+	affected, err := eu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (eu *ExportUpdate) Exec(ctx context.Context) error {
+	_, err := eu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (eu *ExportUpdate) ExecX(ctx context.Context) {
+	if err := eu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (eu *ExportUpdate) check() error {
+	if v, ok := eu.mutation.Status(); ok {
+		if err := export.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Export.status": %w`, err)}
+		}
+	}
+	if v, ok := eu.mutation.Size(); ok {
+		if err := export.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Export.size": %w`, err)}
+		}
+	}
+	if v, ok := eu.mutation.ArticleCount(); ok {
+		if err := export.ArticleCountValidator(v); err != nil {
+			return &ValidationError{Name: "article_count", err: fmt.Errorf(`ent: validator failed for field "Export.article_count": %w`, err)}
+		}
+	}
+	if _, ok := eu.mutation.UserID(); eu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Export.user"`)
+	}
+	return nil
+}
+
+func (eu *ExportUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := eu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(export.Table, export.Columns, sqlgraph.NewFieldSpec(export.FieldID, field.TypeInt))
+	if ps := eu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := eu.mutation.Status(); ok {
+		_spec.SetField(export.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := eu.mutation.Path(); ok {
+		_spec.SetField(export.FieldPath, field.TypeString, value)
+	}
+	if eu.mutation.PathCleared() {
+		_spec.ClearField(export.FieldPath, field.TypeString)
+	}
+	if value, ok := eu.mutation.Size(); ok {
+		_spec.SetField(export.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := eu.mutation.AddedSize(); ok {
+		_spec.AddField(export.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := eu.mutation.ArticleCount(); ok {
+		_spec.SetField(export.FieldArticleCount, field.TypeInt, value)
+	}
+	if value, ok := eu.mutation.AddedArticleCount(); ok {
+		_spec.AddField(export.FieldArticleCount, field.TypeInt, value)
+	}
+	if value, ok := eu.mutation.Error(); ok {
+		_spec.SetField(export.FieldError, field.TypeString, value)
+	}
+	if eu.mutation.ErrorCleared() {
+		_spec.ClearField(export.FieldError, field.TypeString)
+	}
+	if value, ok := eu.mutation.StartedAt(); ok {
+		_spec.SetField(export.FieldStartedAt, field.TypeTime, value)
+	}
+	if eu.mutation.StartedAtCleared() {
+		_spec.ClearField(export.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := eu.mutation.FinishedAt(); ok {
+		_spec.SetField(export.FieldFinishedAt, field.TypeTime, value)
+	}
+	if eu.mutation.FinishedAtCleared() {
+		_spec.ClearField(export.FieldFinishedAt, field.TypeTime)
+	}
+	if eu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   export.UserTable,
+			Columns: []string{export.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := eu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   export.UserTable,
+			Columns: []string{export.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, eu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{export.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	eu.mutation.done = true
+	return n, nil
+}
+
+// ExportUpdateOne is the builder for updating a single Export entity.
+type ExportUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ExportMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (euo *ExportUpdateOne) SetUserID(i int) *ExportUpdateOne {
+	euo.mutation.SetUserID(i)
+	return euo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (euo *ExportUpdateOne) SetNillableUserID(i *int) *ExportUpdateOne {
+	if i != nil {
+		euo.SetUserID(*i)
+	}
+	return euo
+}
+
+// SetStatus sets the "status" field.
+func (euo *ExportUpdateOne) SetStatus(e export.Status) *ExportUpdateOne {
+	euo.mutation.SetStatus(e)
+	return euo
+}
+
+// SetNillableStatus sets the "status" field if the given value is not nil.
+func (euo *ExportUpdateOne) SetNillableStatus(e *export.Status) *ExportUpdateOne {
+	if e != nil {
+		euo.SetStatus(*e)
+	}
+	return euo
+}
+
+// SetPath sets the "path" field.
+func (euo *ExportUpdateOne) SetPath(s string) *ExportUpdateOne {
+	euo.mutation.SetPath(s)
+	return euo
+}
+
+// SetNillablePath sets the "path" field if the given value is not nil.
+func (euo *ExportUpdateOne) SetNillablePath(s *string) *ExportUpdateOne {
+	if s != nil {
+		euo.SetPath(*s)
+	}
+	return euo
+}
+
+// ClearPath clears the value of the "path" field.
+func (euo *ExportUpdateOne) ClearPath() *ExportUpdateOne {
+	euo.mutation.ClearPath()
+	return euo
+}
+
+// SetSize sets the "size" field.
+func (euo *ExportUpdateOne) SetSize(i int64) *ExportUpdateOne {
+	euo.mutation.ResetSize()
+	euo.mutation.SetSize(i)
+	return euo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (euo *ExportUpdateOne) SetNillableSize(i *int64) *ExportUpdateOne {
+	if i != nil {
+		euo.SetSize(*i)
+	}
+	return euo
+}
+
+// AddSize adds i to the "size" field.
+func (euo *ExportUpdateOne) AddSize(i int64) *ExportUpdateOne {
+	euo.mutation.AddSize(i)
+	return euo
+}
+
+// SetArticleCount sets the "article_count" field.
+func (euo *ExportUpdateOne) SetArticleCount(i int) *ExportUpdateOne {
+	euo.mutation.ResetArticleCount()
+	euo.mutation.SetArticleCount(i)
+	return euo
+}
+
+// SetNillableArticleCount sets the "article_count" field if the given value is not nil.
+func (euo *ExportUpdateOne) SetNillableArticleCount(i *int) *ExportUpdateOne {
+	if i != nil {
+		euo.SetArticleCount(*i)
+	}
+	return euo
+}
+
+// AddArticleCount adds i to the "article_count" field.
+func (euo *ExportUpdateOne) AddArticleCount(i int) *ExportUpdateOne {
+	euo.mutation.AddArticleCount(i)
+	return euo
+}
+
+// SetError sets the "error" field.
+func (euo *ExportUpdateOne) SetError(s string) *ExportUpdateOne {
+	euo.mutation.SetError(s)
+	return euo
+}
+
+// SetNillableError sets the "error" field if the given value is not nil.
+func (euo *ExportUpdateOne) SetNillableError(s *string) *ExportUpdateOne {
+	if s != nil {
+		euo.SetError(*s)
+	}
+	return euo
+}
+
+// ClearError clears the value of the "error" field.
+func (euo *ExportUpdateOne) ClearError() *ExportUpdateOne {
+	euo.mutation.ClearError()
+	return euo
+}
+
+// SetStartedAt sets the "started_at" field.
+func (euo *ExportUpdateOne) SetStartedAt(t time.Time) *ExportUpdateOne {
+	euo.mutation.SetStartedAt(t)
+	return euo
+}
+
+// SetNillableStartedAt sets the "started_at" field if the given value is not nil.
+func (euo *ExportUpdateOne) SetNillableStartedAt(t *time.Time) *ExportUpdateOne {
+	if t != nil {
+		euo.SetStartedAt(*t)
+	}
+	return euo
+}
+
+// ClearStartedAt clears the value of the "started_at" field.
+func (euo *ExportUpdateOne) ClearStartedAt() *ExportUpdateOne {
+	euo.mutation.ClearStartedAt()
+	return euo
+}
+
+// SetFinishedAt sets the "finished_at" field.
+func (euo *ExportUpdateOne) SetFinishedAt(t time.Time) *ExportUpdateOne {
+	euo.mutation.SetFinishedAt(t)
+	return euo
+}
+
+// SetNillableFinishedAt sets the "finished_at" field if the given value is not nil.
+func (euo *ExportUpdateOne) SetNillableFinishedAt(t *time.Time) *ExportUpdateOne {
+	if t != nil {
+		euo.SetFinishedAt(*t)
+	}
+	return euo
+}
+
+// ClearFinishedAt clears the value of the "finished_at" field.
+func (euo *ExportUpdateOne) ClearFinishedAt() *ExportUpdateOne {
+	euo.mutation.ClearFinishedAt()
+	return euo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (euo *ExportUpdateOne) SetUser(u *User) *ExportUpdateOne {
+	return euo.SetUserID(u.ID)
+}
+
+// Mutation returns the ExportMutation object of the builder.
+func (euo *ExportUpdateOne) Mutation() *ExportMutation {
+	return euo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (euo *ExportUpdateOne) ClearUser() *ExportUpdateOne {
+	euo.mutation.ClearUser()
+	return euo
+}
+
+// Where appends a list predicates to the ExportUpdate builder.
+func (euo *ExportUpdateOne) Where(ps ...predicate.Export) *ExportUpdateOne {
+	euo.mutation.Where(ps...)
+	return euo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (euo *ExportUpdateOne) Select(field string, fields ...string) *ExportUpdateOne {
+	euo.fields = append([]string{field}, fields...)
+	return euo
+}
+
+// Save executes the query and returns the updated Export entity.
+func (euo *ExportUpdateOne) Save(ctx context.Context) (*Export, error) {
+	return withHooks(ctx, euo.sqlSave, euo.mutation, euo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (euo *ExportUpdateOne) SaveX(ctx context.Context) *Export {
+	node, err := euo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (euo *ExportUpdateOne) Exec(ctx context.Context) error {
+	_, err := euo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (euo *ExportUpdateOne) ExecX(ctx context.Context) {
+	if err := euo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (euo *ExportUpdateOne) check() error {
+	if v, ok := euo.mutation.Status(); ok {
+		if err := export.StatusValidator(v); err != nil {
+			return &ValidationError{Name: "status", err: fmt.Errorf(`ent: validator failed for field "Export.status": %w`, err)}
+		}
+	}
+	if v, ok := euo.mutation.Size(); ok {
+		if err := export.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Export.size": %w`, err)}
+		}
+	}
+	if v, ok := euo.mutation.ArticleCount(); ok {
+		if err := export.ArticleCountValidator(v); err != nil {
+			return &ValidationError{Name: "article_count", err: fmt.Errorf(`ent: validator failed for field "Export.article_count": %w`, err)}
+		}
+	}
+	if _, ok := euo.mutation.UserID(); euo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Export.user"`)
+	}
+	return nil
+}
+
+func (euo *ExportUpdateOne) sqlSave(ctx context.Context) (_node *Export, err error) {
+	if err := euo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(export.Table, export.Columns, sqlgraph.NewFieldSpec(export.FieldID, field.TypeInt))
+	id, ok := euo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Export.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := euo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, export.FieldID)
+		for _, f := range fields {
+			if !export.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != export.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := euo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := euo.mutation.Status(); ok {
+		_spec.SetField(export.FieldStatus, field.TypeEnum, value)
+	}
+	if value, ok := euo.mutation.Path(); ok {
+		_spec.SetField(export.FieldPath, field.TypeString, value)
+	}
+	if euo.mutation.PathCleared() {
+		_spec.ClearField(export.FieldPath, field.TypeString)
+	}
+	if value, ok := euo.mutation.Size(); ok {
+		_spec.SetField(export.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := euo.mutation.AddedSize(); ok {
+		_spec.AddField(export.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := euo.mutation.ArticleCount(); ok {
+		_spec.SetField(export.FieldArticleCount, field.TypeInt, value)
+	}
+	if value, ok := euo.mutation.AddedArticleCount(); ok {
+		_spec.AddField(export.FieldArticleCount, field.TypeInt, value)
+	}
+	if value, ok := euo.mutation.Error(); ok {
+		_spec.SetField(export.FieldError, field.TypeString, value)
+	}
+	if euo.mutation.ErrorCleared() {
+		_spec.ClearField(export.FieldError, field.TypeString)
+	}
+	if value, ok := euo.mutation.StartedAt(); ok {
+		_spec.SetField(export.FieldStartedAt, field.TypeTime, value)
+	}
+	if euo.mutation.StartedAtCleared() {
+		_spec.ClearField(export.FieldStartedAt, field.TypeTime)
+	}
+	if value, ok := euo.mutation.FinishedAt(); ok {
+		_spec.SetField(export.FieldFinishedAt, field.TypeTime, value)
+	}
+	if euo.mutation.FinishedAtCleared() {
+		_spec.ClearField(export.FieldFinishedAt, field.TypeTime)
+	}
+	if euo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   export.UserTable,
+			Columns: []string{export.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := euo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   export.UserTable,
+			Columns: []string{export.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Export{config: euo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, euo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{export.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	euo.mutation.done = true
+	return _node, nil
+}
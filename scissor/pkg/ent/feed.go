@@ -0,0 +1,318 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/feed"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/schema"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
+)
+
+// Feed is the model entity for the Feed schema.
+type Feed struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// URL holds the value of the "url" field.
+	URL string `json:"url,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// SiteURL holds the value of the "site_url" field.
+	SiteURL string `json:"site_url,omitempty"`
+	// Rules holds the value of the "rules" field.
+	Rules schema.FeedRules `json:"rules,omitempty"`
+	// Tags holds the value of the "tags" field.
+	Tags []string `json:"tags,omitempty"`
+	// Enabled holds the value of the "enabled" field.
+	Enabled bool `json:"enabled,omitempty"`
+	// Etag holds the value of the "etag" field.
+	Etag string `json:"etag,omitempty"`
+	// LastModified holds the value of the "last_modified" field.
+	LastModified string `json:"last_modified,omitempty"`
+	// Seen holds the value of the "seen" field.
+	Seen []string `json:"seen,omitempty"`
+	// LastFetchedAt holds the value of the "last_fetched_at" field.
+	LastFetchedAt *time.Time `json:"last_fetched_at,omitempty"`
+	// LastSuccessAt holds the value of the "last_success_at" field.
+	LastSuccessAt *time.Time `json:"last_success_at,omitempty"`
+	// ErrorCount holds the value of the "error_count" field.
+	ErrorCount int `json:"error_count,omitempty"`
+	// LastError holds the value of the "last_error" field.
+	LastError string `json:"last_error,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the FeedQuery when eager-loading is set.
+	Edges        FeedEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// FeedEdges holds the relations/edges for other nodes in the graph.
+type FeedEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e FeedEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Feed) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case feed.FieldRules, feed.FieldTags, feed.FieldSeen:
+			values[i] = new([]byte)
+		case feed.FieldEnabled:
+			values[i] = new(sql.NullBool)
+		case feed.FieldID, feed.FieldUserID, feed.FieldErrorCount:
+			values[i] = new(sql.NullInt64)
+		case feed.FieldURL, feed.FieldTitle, feed.FieldSiteURL, feed.FieldEtag, feed.FieldLastModified, feed.FieldLastError:
+			values[i] = new(sql.NullString)
+		case feed.FieldLastFetchedAt, feed.FieldLastSuccessAt, feed.FieldCreatedAt, feed.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Feed fields.
+func (f *Feed) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case feed.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			f.ID = int(value.Int64)
+		case feed.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				f.UserID = int(value.Int64)
+			}
+		case feed.FieldURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field url", values[i])
+			} else if value.Valid {
+				f.URL = value.String
+			}
+		case feed.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				f.Title = value.String
+			}
+		case feed.FieldSiteURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field site_url", values[i])
+			} else if value.Valid {
+				f.SiteURL = value.String
+			}
+		case feed.FieldRules:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field rules", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &f.Rules); err != nil {
+					return fmt.Errorf("unmarshal field rules: %w", err)
+				}
+			}
+		case feed.FieldTags:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field tags", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &f.Tags); err != nil {
+					return fmt.Errorf("unmarshal field tags: %w", err)
+				}
+			}
+		case feed.FieldEnabled:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field enabled", values[i])
+			} else if value.Valid {
+				f.Enabled = value.Bool
+			}
+		case feed.FieldEtag:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field etag", values[i])
+			} else if value.Valid {
+				f.Etag = value.String
+			}
+		case feed.FieldLastModified:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_modified", values[i])
+			} else if value.Valid {
+				f.LastModified = value.String
+			}
+		case feed.FieldSeen:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field seen", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &f.Seen); err != nil {
+					return fmt.Errorf("unmarshal field seen: %w", err)
+				}
+			}
+		case feed.FieldLastFetchedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_fetched_at", values[i])
+			} else if value.Valid {
+				f.LastFetchedAt = new(time.Time)
+				*f.LastFetchedAt = value.Time
+			}
+		case feed.FieldLastSuccessAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field last_success_at", values[i])
+			} else if value.Valid {
+				f.LastSuccessAt = new(time.Time)
+				*f.LastSuccessAt = value.Time
+			}
+		case feed.FieldErrorCount:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field error_count", values[i])
+			} else if value.Valid {
+				f.ErrorCount = int(value.Int64)
+			}
+		case feed.FieldLastError:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field last_error", values[i])
+			} else if value.Valid {
+				f.LastError = value.String
+			}
+		case feed.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				f.CreatedAt = value.Time
+			}
+		case feed.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				f.UpdatedAt = value.Time
+			}
+		default:
+			f.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Feed.
+// This includes values selected through modifiers, order, etc.
+func (f *Feed) Value(name string) (ent.Value, error) {
+	return f.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Feed entity.
+func (f *Feed) QueryUser() *UserQuery {
+	return NewFeedClient(f.config).QueryUser(f)
+}
+
+// Update returns a builder for updating this Feed.
+// Note that you need to call Feed.Unwrap() before calling this method if this Feed
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (f *Feed) Update() *FeedUpdateOne {
+	return NewFeedClient(f.config).UpdateOne(f)
+}
+
+// Unwrap unwraps the Feed entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (f *Feed) Unwrap() *Feed {
+	_tx, ok := f.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Feed is not a transactional entity")
+	}
+	f.config.driver = _tx.drv
+	return f
+}
+
+// String implements the fmt.Stringer.
+func (f *Feed) String() string {
+	var builder strings.Builder
+	builder.WriteString("Feed(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", f.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", f.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("url=")
+	builder.WriteString(f.URL)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(f.Title)
+	builder.WriteString(", ")
+	builder.WriteString("site_url=")
+	builder.WriteString(f.SiteURL)
+	builder.WriteString(", ")
+	builder.WriteString("rules=")
+	builder.WriteString(fmt.Sprintf("%v", f.Rules))
+	builder.WriteString(", ")
+	builder.WriteString("tags=")
+	builder.WriteString(fmt.Sprintf("%v", f.Tags))
+	builder.WriteString(", ")
+	builder.WriteString("enabled=")
+	builder.WriteString(fmt.Sprintf("%v", f.Enabled))
+	builder.WriteString(", ")
+	builder.WriteString("etag=")
+	builder.WriteString(f.Etag)
+	builder.WriteString(", ")
+	builder.WriteString("last_modified=")
+	builder.WriteString(f.LastModified)
+	builder.WriteString(", ")
+	builder.WriteString("seen=")
+	builder.WriteString(fmt.Sprintf("%v", f.Seen))
+	builder.WriteString(", ")
+	if v := f.LastFetchedAt; v != nil {
+		builder.WriteString("last_fetched_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	if v := f.LastSuccessAt; v != nil {
+		builder.WriteString("last_success_at=")
+		builder.WriteString(v.Format(time.ANSIC))
+	}
+	builder.WriteString(", ")
+	builder.WriteString("error_count=")
+	builder.WriteString(fmt.Sprintf("%v", f.ErrorCount))
+	builder.WriteString(", ")
+	builder.WriteString("last_error=")
+	builder.WriteString(f.LastError)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(f.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(f.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Feeds is a parsable slice of Feed.
+type Feeds []*Feed
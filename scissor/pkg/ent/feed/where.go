@@ -0,0 +1,879 @@
+// Code generated by ent, DO NOT EDIT.
+
+package feed
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldUserID, v))
+}
+
+// URL applies equality check predicate on the "url" field. It's identical to URLEQ.
+func URL(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldURL, v))
+}
+
+// Title applies equality check predicate on the "title" field. It's identical to TitleEQ.
+func Title(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldTitle, v))
+}
+
+// SiteURL applies equality check predicate on the "site_url" field. It's identical to SiteURLEQ.
+func SiteURL(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldSiteURL, v))
+}
+
+// Enabled applies equality check predicate on the "enabled" field. It's identical to EnabledEQ.
+func Enabled(v bool) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldEnabled, v))
+}
+
+// Etag applies equality check predicate on the "etag" field. It's identical to EtagEQ.
+func Etag(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldEtag, v))
+}
+
+// LastModified applies equality check predicate on the "last_modified" field. It's identical to LastModifiedEQ.
+func LastModified(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldLastModified, v))
+}
+
+// LastFetchedAt applies equality check predicate on the "last_fetched_at" field. It's identical to LastFetchedAtEQ.
+func LastFetchedAt(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldLastFetchedAt, v))
+}
+
+// LastSuccessAt applies equality check predicate on the "last_success_at" field. It's identical to LastSuccessAtEQ.
+func LastSuccessAt(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldLastSuccessAt, v))
+}
+
+// ErrorCount applies equality check predicate on the "error_count" field. It's identical to ErrorCountEQ.
+func ErrorCount(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldErrorCount, v))
+}
+
+// LastError applies equality check predicate on the "last_error" field. It's identical to LastErrorEQ.
+func LastError(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldLastError, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// URLEQ applies the EQ predicate on the "url" field.
+func URLEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldURL, v))
+}
+
+// URLNEQ applies the NEQ predicate on the "url" field.
+func URLNEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldURL, v))
+}
+
+// URLIn applies the In predicate on the "url" field.
+func URLIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldURL, vs...))
+}
+
+// URLNotIn applies the NotIn predicate on the "url" field.
+func URLNotIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldURL, vs...))
+}
+
+// URLGT applies the GT predicate on the "url" field.
+func URLGT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldURL, v))
+}
+
+// URLGTE applies the GTE predicate on the "url" field.
+func URLGTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldURL, v))
+}
+
+// URLLT applies the LT predicate on the "url" field.
+func URLLT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldURL, v))
+}
+
+// URLLTE applies the LTE predicate on the "url" field.
+func URLLTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldURL, v))
+}
+
+// URLContains applies the Contains predicate on the "url" field.
+func URLContains(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContains(FieldURL, v))
+}
+
+// URLHasPrefix applies the HasPrefix predicate on the "url" field.
+func URLHasPrefix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasPrefix(FieldURL, v))
+}
+
+// URLHasSuffix applies the HasSuffix predicate on the "url" field.
+func URLHasSuffix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasSuffix(FieldURL, v))
+}
+
+// URLEqualFold applies the EqualFold predicate on the "url" field.
+func URLEqualFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEqualFold(FieldURL, v))
+}
+
+// URLContainsFold applies the ContainsFold predicate on the "url" field.
+func URLContainsFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContainsFold(FieldURL, v))
+}
+
+// TitleEQ applies the EQ predicate on the "title" field.
+func TitleEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldTitle, v))
+}
+
+// TitleNEQ applies the NEQ predicate on the "title" field.
+func TitleNEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldTitle, v))
+}
+
+// TitleIn applies the In predicate on the "title" field.
+func TitleIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldTitle, vs...))
+}
+
+// TitleNotIn applies the NotIn predicate on the "title" field.
+func TitleNotIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldTitle, vs...))
+}
+
+// TitleGT applies the GT predicate on the "title" field.
+func TitleGT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldTitle, v))
+}
+
+// TitleGTE applies the GTE predicate on the "title" field.
+func TitleGTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldTitle, v))
+}
+
+// TitleLT applies the LT predicate on the "title" field.
+func TitleLT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldTitle, v))
+}
+
+// TitleLTE applies the LTE predicate on the "title" field.
+func TitleLTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldTitle, v))
+}
+
+// TitleContains applies the Contains predicate on the "title" field.
+func TitleContains(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContains(FieldTitle, v))
+}
+
+// TitleHasPrefix applies the HasPrefix predicate on the "title" field.
+func TitleHasPrefix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasPrefix(FieldTitle, v))
+}
+
+// TitleHasSuffix applies the HasSuffix predicate on the "title" field.
+func TitleHasSuffix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasSuffix(FieldTitle, v))
+}
+
+// TitleIsNil applies the IsNil predicate on the "title" field.
+func TitleIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldTitle))
+}
+
+// TitleNotNil applies the NotNil predicate on the "title" field.
+func TitleNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldTitle))
+}
+
+// TitleEqualFold applies the EqualFold predicate on the "title" field.
+func TitleEqualFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEqualFold(FieldTitle, v))
+}
+
+// TitleContainsFold applies the ContainsFold predicate on the "title" field.
+func TitleContainsFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContainsFold(FieldTitle, v))
+}
+
+// SiteURLEQ applies the EQ predicate on the "site_url" field.
+func SiteURLEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldSiteURL, v))
+}
+
+// SiteURLNEQ applies the NEQ predicate on the "site_url" field.
+func SiteURLNEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldSiteURL, v))
+}
+
+// SiteURLIn applies the In predicate on the "site_url" field.
+func SiteURLIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldSiteURL, vs...))
+}
+
+// SiteURLNotIn applies the NotIn predicate on the "site_url" field.
+func SiteURLNotIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldSiteURL, vs...))
+}
+
+// SiteURLGT applies the GT predicate on the "site_url" field.
+func SiteURLGT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldSiteURL, v))
+}
+
+// SiteURLGTE applies the GTE predicate on the "site_url" field.
+func SiteURLGTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldSiteURL, v))
+}
+
+// SiteURLLT applies the LT predicate on the "site_url" field.
+func SiteURLLT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldSiteURL, v))
+}
+
+// SiteURLLTE applies the LTE predicate on the "site_url" field.
+func SiteURLLTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldSiteURL, v))
+}
+
+// SiteURLContains applies the Contains predicate on the "site_url" field.
+func SiteURLContains(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContains(FieldSiteURL, v))
+}
+
+// SiteURLHasPrefix applies the HasPrefix predicate on the "site_url" field.
+func SiteURLHasPrefix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasPrefix(FieldSiteURL, v))
+}
+
+// SiteURLHasSuffix applies the HasSuffix predicate on the "site_url" field.
+func SiteURLHasSuffix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasSuffix(FieldSiteURL, v))
+}
+
+// SiteURLIsNil applies the IsNil predicate on the "site_url" field.
+func SiteURLIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldSiteURL))
+}
+
+// SiteURLNotNil applies the NotNil predicate on the "site_url" field.
+func SiteURLNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldSiteURL))
+}
+
+// SiteURLEqualFold applies the EqualFold predicate on the "site_url" field.
+func SiteURLEqualFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEqualFold(FieldSiteURL, v))
+}
+
+// SiteURLContainsFold applies the ContainsFold predicate on the "site_url" field.
+func SiteURLContainsFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContainsFold(FieldSiteURL, v))
+}
+
+// RulesIsNil applies the IsNil predicate on the "rules" field.
+func RulesIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldRules))
+}
+
+// RulesNotNil applies the NotNil predicate on the "rules" field.
+func RulesNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldRules))
+}
+
+// TagsIsNil applies the IsNil predicate on the "tags" field.
+func TagsIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldTags))
+}
+
+// TagsNotNil applies the NotNil predicate on the "tags" field.
+func TagsNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldTags))
+}
+
+// EnabledEQ applies the EQ predicate on the "enabled" field.
+func EnabledEQ(v bool) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldEnabled, v))
+}
+
+// EnabledNEQ applies the NEQ predicate on the "enabled" field.
+func EnabledNEQ(v bool) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldEnabled, v))
+}
+
+// EtagEQ applies the EQ predicate on the "etag" field.
+func EtagEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldEtag, v))
+}
+
+// EtagNEQ applies the NEQ predicate on the "etag" field.
+func EtagNEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldEtag, v))
+}
+
+// EtagIn applies the In predicate on the "etag" field.
+func EtagIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldEtag, vs...))
+}
+
+// EtagNotIn applies the NotIn predicate on the "etag" field.
+func EtagNotIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldEtag, vs...))
+}
+
+// EtagGT applies the GT predicate on the "etag" field.
+func EtagGT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldEtag, v))
+}
+
+// EtagGTE applies the GTE predicate on the "etag" field.
+func EtagGTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldEtag, v))
+}
+
+// EtagLT applies the LT predicate on the "etag" field.
+func EtagLT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldEtag, v))
+}
+
+// EtagLTE applies the LTE predicate on the "etag" field.
+func EtagLTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldEtag, v))
+}
+
+// EtagContains applies the Contains predicate on the "etag" field.
+func EtagContains(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContains(FieldEtag, v))
+}
+
+// EtagHasPrefix applies the HasPrefix predicate on the "etag" field.
+func EtagHasPrefix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasPrefix(FieldEtag, v))
+}
+
+// EtagHasSuffix applies the HasSuffix predicate on the "etag" field.
+func EtagHasSuffix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasSuffix(FieldEtag, v))
+}
+
+// EtagIsNil applies the IsNil predicate on the "etag" field.
+func EtagIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldEtag))
+}
+
+// EtagNotNil applies the NotNil predicate on the "etag" field.
+func EtagNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldEtag))
+}
+
+// EtagEqualFold applies the EqualFold predicate on the "etag" field.
+func EtagEqualFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEqualFold(FieldEtag, v))
+}
+
+// EtagContainsFold applies the ContainsFold predicate on the "etag" field.
+func EtagContainsFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContainsFold(FieldEtag, v))
+}
+
+// LastModifiedEQ applies the EQ predicate on the "last_modified" field.
+func LastModifiedEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldLastModified, v))
+}
+
+// LastModifiedNEQ applies the NEQ predicate on the "last_modified" field.
+func LastModifiedNEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldLastModified, v))
+}
+
+// LastModifiedIn applies the In predicate on the "last_modified" field.
+func LastModifiedIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldLastModified, vs...))
+}
+
+// LastModifiedNotIn applies the NotIn predicate on the "last_modified" field.
+func LastModifiedNotIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldLastModified, vs...))
+}
+
+// LastModifiedGT applies the GT predicate on the "last_modified" field.
+func LastModifiedGT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldLastModified, v))
+}
+
+// LastModifiedGTE applies the GTE predicate on the "last_modified" field.
+func LastModifiedGTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldLastModified, v))
+}
+
+// LastModifiedLT applies the LT predicate on the "last_modified" field.
+func LastModifiedLT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldLastModified, v))
+}
+
+// LastModifiedLTE applies the LTE predicate on the "last_modified" field.
+func LastModifiedLTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldLastModified, v))
+}
+
+// LastModifiedContains applies the Contains predicate on the "last_modified" field.
+func LastModifiedContains(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContains(FieldLastModified, v))
+}
+
+// LastModifiedHasPrefix applies the HasPrefix predicate on the "last_modified" field.
+func LastModifiedHasPrefix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasPrefix(FieldLastModified, v))
+}
+
+// LastModifiedHasSuffix applies the HasSuffix predicate on the "last_modified" field.
+func LastModifiedHasSuffix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasSuffix(FieldLastModified, v))
+}
+
+// LastModifiedIsNil applies the IsNil predicate on the "last_modified" field.
+func LastModifiedIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldLastModified))
+}
+
+// LastModifiedNotNil applies the NotNil predicate on the "last_modified" field.
+func LastModifiedNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldLastModified))
+}
+
+// LastModifiedEqualFold applies the EqualFold predicate on the "last_modified" field.
+func LastModifiedEqualFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEqualFold(FieldLastModified, v))
+}
+
+// LastModifiedContainsFold applies the ContainsFold predicate on the "last_modified" field.
+func LastModifiedContainsFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContainsFold(FieldLastModified, v))
+}
+
+// SeenIsNil applies the IsNil predicate on the "seen" field.
+func SeenIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldSeen))
+}
+
+// SeenNotNil applies the NotNil predicate on the "seen" field.
+func SeenNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldSeen))
+}
+
+// LastFetchedAtEQ applies the EQ predicate on the "last_fetched_at" field.
+func LastFetchedAtEQ(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldLastFetchedAt, v))
+}
+
+// LastFetchedAtNEQ applies the NEQ predicate on the "last_fetched_at" field.
+func LastFetchedAtNEQ(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldLastFetchedAt, v))
+}
+
+// LastFetchedAtIn applies the In predicate on the "last_fetched_at" field.
+func LastFetchedAtIn(vs ...time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldLastFetchedAt, vs...))
+}
+
+// LastFetchedAtNotIn applies the NotIn predicate on the "last_fetched_at" field.
+func LastFetchedAtNotIn(vs ...time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldLastFetchedAt, vs...))
+}
+
+// LastFetchedAtGT applies the GT predicate on the "last_fetched_at" field.
+func LastFetchedAtGT(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldLastFetchedAt, v))
+}
+
+// LastFetchedAtGTE applies the GTE predicate on the "last_fetched_at" field.
+func LastFetchedAtGTE(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldLastFetchedAt, v))
+}
+
+// LastFetchedAtLT applies the LT predicate on the "last_fetched_at" field.
+func LastFetchedAtLT(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldLastFetchedAt, v))
+}
+
+// LastFetchedAtLTE applies the LTE predicate on the "last_fetched_at" field.
+func LastFetchedAtLTE(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldLastFetchedAt, v))
+}
+
+// LastFetchedAtIsNil applies the IsNil predicate on the "last_fetched_at" field.
+func LastFetchedAtIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldLastFetchedAt))
+}
+
+// LastFetchedAtNotNil applies the NotNil predicate on the "last_fetched_at" field.
+func LastFetchedAtNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldLastFetchedAt))
+}
+
+// LastSuccessAtEQ applies the EQ predicate on the "last_success_at" field.
+func LastSuccessAtEQ(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtNEQ applies the NEQ predicate on the "last_success_at" field.
+func LastSuccessAtNEQ(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtIn applies the In predicate on the "last_success_at" field.
+func LastSuccessAtIn(vs ...time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldLastSuccessAt, vs...))
+}
+
+// LastSuccessAtNotIn applies the NotIn predicate on the "last_success_at" field.
+func LastSuccessAtNotIn(vs ...time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldLastSuccessAt, vs...))
+}
+
+// LastSuccessAtGT applies the GT predicate on the "last_success_at" field.
+func LastSuccessAtGT(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtGTE applies the GTE predicate on the "last_success_at" field.
+func LastSuccessAtGTE(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtLT applies the LT predicate on the "last_success_at" field.
+func LastSuccessAtLT(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtLTE applies the LTE predicate on the "last_success_at" field.
+func LastSuccessAtLTE(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldLastSuccessAt, v))
+}
+
+// LastSuccessAtIsNil applies the IsNil predicate on the "last_success_at" field.
+func LastSuccessAtIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldLastSuccessAt))
+}
+
+// LastSuccessAtNotNil applies the NotNil predicate on the "last_success_at" field.
+func LastSuccessAtNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldLastSuccessAt))
+}
+
+// ErrorCountEQ applies the EQ predicate on the "error_count" field.
+func ErrorCountEQ(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldErrorCount, v))
+}
+
+// ErrorCountNEQ applies the NEQ predicate on the "error_count" field.
+func ErrorCountNEQ(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldErrorCount, v))
+}
+
+// ErrorCountIn applies the In predicate on the "error_count" field.
+func ErrorCountIn(vs ...int) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldErrorCount, vs...))
+}
+
+// ErrorCountNotIn applies the NotIn predicate on the "error_count" field.
+func ErrorCountNotIn(vs ...int) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldErrorCount, vs...))
+}
+
+// ErrorCountGT applies the GT predicate on the "error_count" field.
+func ErrorCountGT(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldErrorCount, v))
+}
+
+// ErrorCountGTE applies the GTE predicate on the "error_count" field.
+func ErrorCountGTE(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldErrorCount, v))
+}
+
+// ErrorCountLT applies the LT predicate on the "error_count" field.
+func ErrorCountLT(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldErrorCount, v))
+}
+
+// ErrorCountLTE applies the LTE predicate on the "error_count" field.
+func ErrorCountLTE(v int) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldErrorCount, v))
+}
+
+// LastErrorEQ applies the EQ predicate on the "last_error" field.
+func LastErrorEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldLastError, v))
+}
+
+// LastErrorNEQ applies the NEQ predicate on the "last_error" field.
+func LastErrorNEQ(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldLastError, v))
+}
+
+// LastErrorIn applies the In predicate on the "last_error" field.
+func LastErrorIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldLastError, vs...))
+}
+
+// LastErrorNotIn applies the NotIn predicate on the "last_error" field.
+func LastErrorNotIn(vs ...string) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldLastError, vs...))
+}
+
+// LastErrorGT applies the GT predicate on the "last_error" field.
+func LastErrorGT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldLastError, v))
+}
+
+// LastErrorGTE applies the GTE predicate on the "last_error" field.
+func LastErrorGTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldLastError, v))
+}
+
+// LastErrorLT applies the LT predicate on the "last_error" field.
+func LastErrorLT(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldLastError, v))
+}
+
+// LastErrorLTE applies the LTE predicate on the "last_error" field.
+func LastErrorLTE(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldLastError, v))
+}
+
+// LastErrorContains applies the Contains predicate on the "last_error" field.
+func LastErrorContains(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContains(FieldLastError, v))
+}
+
+// LastErrorHasPrefix applies the HasPrefix predicate on the "last_error" field.
+func LastErrorHasPrefix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasPrefix(FieldLastError, v))
+}
+
+// LastErrorHasSuffix applies the HasSuffix predicate on the "last_error" field.
+func LastErrorHasSuffix(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldHasSuffix(FieldLastError, v))
+}
+
+// LastErrorIsNil applies the IsNil predicate on the "last_error" field.
+func LastErrorIsNil() predicate.Feed {
+	return predicate.Feed(sql.FieldIsNull(FieldLastError))
+}
+
+// LastErrorNotNil applies the NotNil predicate on the "last_error" field.
+func LastErrorNotNil() predicate.Feed {
+	return predicate.Feed(sql.FieldNotNull(FieldLastError))
+}
+
+// LastErrorEqualFold applies the EqualFold predicate on the "last_error" field.
+func LastErrorEqualFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldEqualFold(FieldLastError, v))
+}
+
+// LastErrorContainsFold applies the ContainsFold predicate on the "last_error" field.
+func LastErrorContainsFold(v string) predicate.Feed {
+	return predicate.Feed(sql.FieldContainsFold(FieldLastError, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.Feed {
+	return predicate.Feed(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.Feed {
+	return predicate.Feed(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.Feed {
+	return predicate.Feed(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.Feed) predicate.Feed {
+	return predicate.Feed(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.Feed) predicate.Feed {
+	return predicate.Feed(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.Feed) predicate.Feed {
+	return predicate.Feed(sql.NotPredicates(p))
+}
@@ -0,0 +1,495 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/feed"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/schema"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
+)
+
+// FeedCreate is the builder for creating a Feed entity.
+type FeedCreate struct {
+	config
+	mutation *FeedMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (fc *FeedCreate) SetUserID(i int) *FeedCreate {
+	fc.mutation.SetUserID(i)
+	return fc
+}
+
+// SetURL sets the "url" field.
+func (fc *FeedCreate) SetURL(s string) *FeedCreate {
+	fc.mutation.SetURL(s)
+	return fc
+}
+
+// SetTitle sets the "title" field.
+func (fc *FeedCreate) SetTitle(s string) *FeedCreate {
+	fc.mutation.SetTitle(s)
+	return fc
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableTitle(s *string) *FeedCreate {
+	if s != nil {
+		fc.SetTitle(*s)
+	}
+	return fc
+}
+
+// SetSiteURL sets the "site_url" field.
+func (fc *FeedCreate) SetSiteURL(s string) *FeedCreate {
+	fc.mutation.SetSiteURL(s)
+	return fc
+}
+
+// SetNillableSiteURL sets the "site_url" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableSiteURL(s *string) *FeedCreate {
+	if s != nil {
+		fc.SetSiteURL(*s)
+	}
+	return fc
+}
+
+// SetRules sets the "rules" field.
+func (fc *FeedCreate) SetRules(sr schema.FeedRules) *FeedCreate {
+	fc.mutation.SetRules(sr)
+	return fc
+}
+
+// SetNillableRules sets the "rules" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableRules(sr *schema.FeedRules) *FeedCreate {
+	if sr != nil {
+		fc.SetRules(*sr)
+	}
+	return fc
+}
+
+// SetTags sets the "tags" field.
+func (fc *FeedCreate) SetTags(s []string) *FeedCreate {
+	fc.mutation.SetTags(s)
+	return fc
+}
+
+// SetEnabled sets the "enabled" field.
+func (fc *FeedCreate) SetEnabled(b bool) *FeedCreate {
+	fc.mutation.SetEnabled(b)
+	return fc
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableEnabled(b *bool) *FeedCreate {
+	if b != nil {
+		fc.SetEnabled(*b)
+	}
+	return fc
+}
+
+// SetEtag sets the "etag" field.
+func (fc *FeedCreate) SetEtag(s string) *FeedCreate {
+	fc.mutation.SetEtag(s)
+	return fc
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableEtag(s *string) *FeedCreate {
+	if s != nil {
+		fc.SetEtag(*s)
+	}
+	return fc
+}
+
+// SetLastModified sets the "last_modified" field.
+func (fc *FeedCreate) SetLastModified(s string) *FeedCreate {
+	fc.mutation.SetLastModified(s)
+	return fc
+}
+
+// SetNillableLastModified sets the "last_modified" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableLastModified(s *string) *FeedCreate {
+	if s != nil {
+		fc.SetLastModified(*s)
+	}
+	return fc
+}
+
+// SetSeen sets the "seen" field.
+func (fc *FeedCreate) SetSeen(s []string) *FeedCreate {
+	fc.mutation.SetSeen(s)
+	return fc
+}
+
+// SetLastFetchedAt sets the "last_fetched_at" field.
+func (fc *FeedCreate) SetLastFetchedAt(t time.Time) *FeedCreate {
+	fc.mutation.SetLastFetchedAt(t)
+	return fc
+}
+
+// SetNillableLastFetchedAt sets the "last_fetched_at" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableLastFetchedAt(t *time.Time) *FeedCreate {
+	if t != nil {
+		fc.SetLastFetchedAt(*t)
+	}
+	return fc
+}
+
+// SetLastSuccessAt sets the "last_success_at" field.
+func (fc *FeedCreate) SetLastSuccessAt(t time.Time) *FeedCreate {
+	fc.mutation.SetLastSuccessAt(t)
+	return fc
+}
+
+// SetNillableLastSuccessAt sets the "last_success_at" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableLastSuccessAt(t *time.Time) *FeedCreate {
+	if t != nil {
+		fc.SetLastSuccessAt(*t)
+	}
+	return fc
+}
+
+// SetErrorCount sets the "error_count" field.
+func (fc *FeedCreate) SetErrorCount(i int) *FeedCreate {
+	fc.mutation.SetErrorCount(i)
+	return fc
+}
+
+// SetNillableErrorCount sets the "error_count" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableErrorCount(i *int) *FeedCreate {
+	if i != nil {
+		fc.SetErrorCount(*i)
+	}
+	return fc
+}
+
+// SetLastError sets the "last_error" field.
+func (fc *FeedCreate) SetLastError(s string) *FeedCreate {
+	fc.mutation.SetLastError(s)
+	return fc
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableLastError(s *string) *FeedCreate {
+	if s != nil {
+		fc.SetLastError(*s)
+	}
+	return fc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (fc *FeedCreate) SetCreatedAt(t time.Time) *FeedCreate {
+	fc.mutation.SetCreatedAt(t)
+	return fc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableCreatedAt(t *time.Time) *FeedCreate {
+	if t != nil {
+		fc.SetCreatedAt(*t)
+	}
+	return fc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fc *FeedCreate) SetUpdatedAt(t time.Time) *FeedCreate {
+	fc.mutation.SetUpdatedAt(t)
+	return fc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (fc *FeedCreate) SetNillableUpdatedAt(t *time.Time) *FeedCreate {
+	if t != nil {
+		fc.SetUpdatedAt(*t)
+	}
+	return fc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (fc *FeedCreate) SetUser(u *User) *FeedCreate {
+	return fc.SetUserID(u.ID)
+}
+
+// Mutation returns the FeedMutation object of the builder.
+func (fc *FeedCreate) Mutation() *FeedMutation {
+	return fc.mutation
+}
+
+// Save creates the Feed in the database.
+func (fc *FeedCreate) Save(ctx context.Context) (*Feed, error) {
+	fc.defaults()
+	return withHooks(ctx, fc.sqlSave, fc.mutation, fc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (fc *FeedCreate) SaveX(ctx context.Context) *Feed {
+	v, err := fc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fc *FeedCreate) Exec(ctx context.Context) error {
+	_, err := fc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fc *FeedCreate) ExecX(ctx context.Context) {
+	if err := fc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fc *FeedCreate) defaults() {
+	if _, ok := fc.mutation.Enabled(); !ok {
+		v := feed.DefaultEnabled
+		fc.mutation.SetEnabled(v)
+	}
+	if _, ok := fc.mutation.ErrorCount(); !ok {
+		v := feed.DefaultErrorCount
+		fc.mutation.SetErrorCount(v)
+	}
+	if _, ok := fc.mutation.CreatedAt(); !ok {
+		v := feed.DefaultCreatedAt()
+		fc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := fc.mutation.UpdatedAt(); !ok {
+		v := feed.DefaultUpdatedAt()
+		fc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fc *FeedCreate) check() error {
+	if _, ok := fc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Feed.user_id"`)}
+	}
+	if _, ok := fc.mutation.URL(); !ok {
+		return &ValidationError{Name: "url", err: errors.New(`ent: missing required field "Feed.url"`)}
+	}
+	if _, ok := fc.mutation.Enabled(); !ok {
+		return &ValidationError{Name: "enabled", err: errors.New(`ent: missing required field "Feed.enabled"`)}
+	}
+	if _, ok := fc.mutation.ErrorCount(); !ok {
+		return &ValidationError{Name: "error_count", err: errors.New(`ent: missing required field "Feed.error_count"`)}
+	}
+	if v, ok := fc.mutation.ErrorCount(); ok {
+		if err := feed.ErrorCountValidator(v); err != nil {
+			return &ValidationError{Name: "error_count", err: fmt.Errorf(`ent: validator failed for field "Feed.error_count": %w`, err)}
+		}
+	}
+	if _, ok := fc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Feed.created_at"`)}
+	}
+	if _, ok := fc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Feed.updated_at"`)}
+	}
+	if _, ok := fc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Feed.user"`)}
+	}
+	return nil
+}
+
+func (fc *FeedCreate) sqlSave(ctx context.Context) (*Feed, error) {
+	if err := fc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := fc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, fc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	fc.mutation.id = &_node.ID
+	fc.mutation.done = true
+	return _node, nil
+}
+
+func (fc *FeedCreate) createSpec() (*Feed, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Feed{config: fc.config}
+		_spec = sqlgraph.NewCreateSpec(feed.Table, sqlgraph.NewFieldSpec(feed.FieldID, field.TypeInt))
+	)
+	if value, ok := fc.mutation.URL(); ok {
+		_spec.SetField(feed.FieldURL, field.TypeString, value)
+		_node.URL = value
+	}
+	if value, ok := fc.mutation.Title(); ok {
+		_spec.SetField(feed.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := fc.mutation.SiteURL(); ok {
+		_spec.SetField(feed.FieldSiteURL, field.TypeString, value)
+		_node.SiteURL = value
+	}
+	if value, ok := fc.mutation.Rules(); ok {
+		_spec.SetField(feed.FieldRules, field.TypeJSON, value)
+		_node.Rules = value
+	}
+	if value, ok := fc.mutation.Tags(); ok {
+		_spec.SetField(feed.FieldTags, field.TypeJSON, value)
+		_node.Tags = value
+	}
+	if value, ok := fc.mutation.Enabled(); ok {
+		_spec.SetField(feed.FieldEnabled, field.TypeBool, value)
+		_node.Enabled = value
+	}
+	if value, ok := fc.mutation.Etag(); ok {
+		_spec.SetField(feed.FieldEtag, field.TypeString, value)
+		_node.Etag = value
+	}
+	if value, ok := fc.mutation.LastModified(); ok {
+		_spec.SetField(feed.FieldLastModified, field.TypeString, value)
+		_node.LastModified = value
+	}
+	if value, ok := fc.mutation.Seen(); ok {
+		_spec.SetField(feed.FieldSeen, field.TypeJSON, value)
+		_node.Seen = value
+	}
+	if value, ok := fc.mutation.LastFetchedAt(); ok {
+		_spec.SetField(feed.FieldLastFetchedAt, field.TypeTime, value)
+		_node.LastFetchedAt = &value
+	}
+	if value, ok := fc.mutation.LastSuccessAt(); ok {
+		_spec.SetField(feed.FieldLastSuccessAt, field.TypeTime, value)
+		_node.LastSuccessAt = &value
+	}
+	if value, ok := fc.mutation.ErrorCount(); ok {
+		_spec.SetField(feed.FieldErrorCount, field.TypeInt, value)
+		_node.ErrorCount = value
+	}
+	if value, ok := fc.mutation.LastError(); ok {
+		_spec.SetField(feed.FieldLastError, field.TypeString, value)
+		_node.LastError = value
+	}
+	if value, ok := fc.mutation.CreatedAt(); ok {
+		_spec.SetField(feed.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := fc.mutation.UpdatedAt(); ok {
+		_spec.SetField(feed.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := fc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   feed.UserTable,
+			Columns: []string{feed.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// FeedCreateBulk is the builder for creating many Feed entities in bulk.
+type FeedCreateBulk struct {
+	config
+	err      error
+	builders []*FeedCreate
+}
+
+// Save creates the Feed entities in the database.
+func (fcb *FeedCreateBulk) Save(ctx context.Context) ([]*Feed, error) {
+	if fcb.err != nil {
+		return nil, fcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(fcb.builders))
+	nodes := make([]*Feed, len(fcb.builders))
+	mutators := make([]Mutator, len(fcb.builders))
+	for i := range fcb.builders {
+		func(i int, root context.Context) {
+			builder := fcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*FeedMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, fcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, fcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, fcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fcb *FeedCreateBulk) SaveX(ctx context.Context) []*Feed {
+	v, err := fcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (fcb *FeedCreateBulk) Exec(ctx context.Context) error {
+	_, err := fcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fcb *FeedCreateBulk) ExecX(ctx context.Context) {
+	if err := fcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,1009 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/feed"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/schema"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
+)
+
+// FeedUpdate is the builder for updating Feed entities.
+type FeedUpdate struct {
+	config
+	hooks    []Hook
+	mutation *FeedMutation
+}
+
+// Where appends a list predicates to the FeedUpdate builder.
+func (fu *FeedUpdate) Where(ps ...predicate.Feed) *FeedUpdate {
+	fu.mutation.Where(ps...)
+	return fu
+}
+
+// SetUserID sets the "user_id" field.
+func (fu *FeedUpdate) SetUserID(i int) *FeedUpdate {
+	fu.mutation.SetUserID(i)
+	return fu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableUserID(i *int) *FeedUpdate {
+	if i != nil {
+		fu.SetUserID(*i)
+	}
+	return fu
+}
+
+// SetURL sets the "url" field.
+func (fu *FeedUpdate) SetURL(s string) *FeedUpdate {
+	fu.mutation.SetURL(s)
+	return fu
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableURL(s *string) *FeedUpdate {
+	if s != nil {
+		fu.SetURL(*s)
+	}
+	return fu
+}
+
+// SetTitle sets the "title" field.
+func (fu *FeedUpdate) SetTitle(s string) *FeedUpdate {
+	fu.mutation.SetTitle(s)
+	return fu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableTitle(s *string) *FeedUpdate {
+	if s != nil {
+		fu.SetTitle(*s)
+	}
+	return fu
+}
+
+// ClearTitle clears the value of the "title" field.
+func (fu *FeedUpdate) ClearTitle() *FeedUpdate {
+	fu.mutation.ClearTitle()
+	return fu
+}
+
+// SetSiteURL sets the "site_url" field.
+func (fu *FeedUpdate) SetSiteURL(s string) *FeedUpdate {
+	fu.mutation.SetSiteURL(s)
+	return fu
+}
+
+// SetNillableSiteURL sets the "site_url" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableSiteURL(s *string) *FeedUpdate {
+	if s != nil {
+		fu.SetSiteURL(*s)
+	}
+	return fu
+}
+
+// ClearSiteURL clears the value of the "site_url" field.
+func (fu *FeedUpdate) ClearSiteURL() *FeedUpdate {
+	fu.mutation.ClearSiteURL()
+	return fu
+}
+
+// SetRules sets the "rules" field.
+func (fu *FeedUpdate) SetRules(sr schema.FeedRules) *FeedUpdate {
+	fu.mutation.SetRules(sr)
+	return fu
+}
+
+// SetNillableRules sets the "rules" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableRules(sr *schema.FeedRules) *FeedUpdate {
+	if sr != nil {
+		fu.SetRules(*sr)
+	}
+	return fu
+}
+
+// ClearRules clears the value of the "rules" field.
+func (fu *FeedUpdate) ClearRules() *FeedUpdate {
+	fu.mutation.ClearRules()
+	return fu
+}
+
+// SetTags sets the "tags" field.
+func (fu *FeedUpdate) SetTags(s []string) *FeedUpdate {
+	fu.mutation.SetTags(s)
+	return fu
+}
+
+// AppendTags appends s to the "tags" field.
+func (fu *FeedUpdate) AppendTags(s []string) *FeedUpdate {
+	fu.mutation.AppendTags(s)
+	return fu
+}
+
+// ClearTags clears the value of the "tags" field.
+func (fu *FeedUpdate) ClearTags() *FeedUpdate {
+	fu.mutation.ClearTags()
+	return fu
+}
+
+// SetEnabled sets the "enabled" field.
+func (fu *FeedUpdate) SetEnabled(b bool) *FeedUpdate {
+	fu.mutation.SetEnabled(b)
+	return fu
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableEnabled(b *bool) *FeedUpdate {
+	if b != nil {
+		fu.SetEnabled(*b)
+	}
+	return fu
+}
+
+// SetEtag sets the "etag" field.
+func (fu *FeedUpdate) SetEtag(s string) *FeedUpdate {
+	fu.mutation.SetEtag(s)
+	return fu
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableEtag(s *string) *FeedUpdate {
+	if s != nil {
+		fu.SetEtag(*s)
+	}
+	return fu
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (fu *FeedUpdate) ClearEtag() *FeedUpdate {
+	fu.mutation.ClearEtag()
+	return fu
+}
+
+// SetLastModified sets the "last_modified" field.
+func (fu *FeedUpdate) SetLastModified(s string) *FeedUpdate {
+	fu.mutation.SetLastModified(s)
+	return fu
+}
+
+// SetNillableLastModified sets the "last_modified" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableLastModified(s *string) *FeedUpdate {
+	if s != nil {
+		fu.SetLastModified(*s)
+	}
+	return fu
+}
+
+// ClearLastModified clears the value of the "last_modified" field.
+func (fu *FeedUpdate) ClearLastModified() *FeedUpdate {
+	fu.mutation.ClearLastModified()
+	return fu
+}
+
+// SetSeen sets the "seen" field.
+func (fu *FeedUpdate) SetSeen(s []string) *FeedUpdate {
+	fu.mutation.SetSeen(s)
+	return fu
+}
+
+// AppendSeen appends s to the "seen" field.
+func (fu *FeedUpdate) AppendSeen(s []string) *FeedUpdate {
+	fu.mutation.AppendSeen(s)
+	return fu
+}
+
+// ClearSeen clears the value of the "seen" field.
+func (fu *FeedUpdate) ClearSeen() *FeedUpdate {
+	fu.mutation.ClearSeen()
+	return fu
+}
+
+// SetLastFetchedAt sets the "last_fetched_at" field.
+func (fu *FeedUpdate) SetLastFetchedAt(t time.Time) *FeedUpdate {
+	fu.mutation.SetLastFetchedAt(t)
+	return fu
+}
+
+// SetNillableLastFetchedAt sets the "last_fetched_at" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableLastFetchedAt(t *time.Time) *FeedUpdate {
+	if t != nil {
+		fu.SetLastFetchedAt(*t)
+	}
+	return fu
+}
+
+// ClearLastFetchedAt clears the value of the "last_fetched_at" field.
+func (fu *FeedUpdate) ClearLastFetchedAt() *FeedUpdate {
+	fu.mutation.ClearLastFetchedAt()
+	return fu
+}
+
+// SetLastSuccessAt sets the "last_success_at" field.
+func (fu *FeedUpdate) SetLastSuccessAt(t time.Time) *FeedUpdate {
+	fu.mutation.SetLastSuccessAt(t)
+	return fu
+}
+
+// SetNillableLastSuccessAt sets the "last_success_at" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableLastSuccessAt(t *time.Time) *FeedUpdate {
+	if t != nil {
+		fu.SetLastSuccessAt(*t)
+	}
+	return fu
+}
+
+// ClearLastSuccessAt clears the value of the "last_success_at" field.
+func (fu *FeedUpdate) ClearLastSuccessAt() *FeedUpdate {
+	fu.mutation.ClearLastSuccessAt()
+	return fu
+}
+
+// SetErrorCount sets the "error_count" field.
+func (fu *FeedUpdate) SetErrorCount(i int) *FeedUpdate {
+	fu.mutation.ResetErrorCount()
+	fu.mutation.SetErrorCount(i)
+	return fu
+}
+
+// SetNillableErrorCount sets the "error_count" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableErrorCount(i *int) *FeedUpdate {
+	if i != nil {
+		fu.SetErrorCount(*i)
+	}
+	return fu
+}
+
+// AddErrorCount adds i to the "error_count" field.
+func (fu *FeedUpdate) AddErrorCount(i int) *FeedUpdate {
+	fu.mutation.AddErrorCount(i)
+	return fu
+}
+
+// SetLastError sets the "last_error" field.
+func (fu *FeedUpdate) SetLastError(s string) *FeedUpdate {
+	fu.mutation.SetLastError(s)
+	return fu
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (fu *FeedUpdate) SetNillableLastError(s *string) *FeedUpdate {
+	if s != nil {
+		fu.SetLastError(*s)
+	}
+	return fu
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (fu *FeedUpdate) ClearLastError() *FeedUpdate {
+	fu.mutation.ClearLastError()
+	return fu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fu *FeedUpdate) SetUpdatedAt(t time.Time) *FeedUpdate {
+	fu.mutation.SetUpdatedAt(t)
+	return fu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (fu *FeedUpdate) SetUser(u *User) *FeedUpdate {
+	return fu.SetUserID(u.ID)
+}
+
+// Mutation returns the FeedMutation object of the builder.
+func (fu *FeedUpdate) Mutation() *FeedMutation {
+	return fu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (fu *FeedUpdate) ClearUser() *FeedUpdate {
+	fu.mutation.ClearUser()
+	return fu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (fu *FeedUpdate) Save(ctx context.Context) (int, error) {
+	fu.defaults()
+	return withHooks(ctx, fu.sqlSave, fu.mutation, fu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fu *FeedUpdate) SaveX(ctx context.Context) int {
+	affected, err := fu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (fu *FeedUpdate) Exec(ctx context.Context) error {
+	_, err := fu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fu *FeedUpdate) ExecX(ctx context.Context) {
+	if err := fu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fu *FeedUpdate) defaults() {
+	if _, ok := fu.mutation.UpdatedAt(); !ok {
+		v := feed.UpdateDefaultUpdatedAt()
+		fu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fu *FeedUpdate) check() error {
+	if v, ok := fu.mutation.ErrorCount(); ok {
+		if err := feed.ErrorCountValidator(v); err != nil {
+			return &ValidationError{Name: "error_count", err: fmt.Errorf(`ent: validator failed for field "Feed.error_count": %w`, err)}
+		}
+	}
+	if _, ok := fu.mutation.UserID(); fu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Feed.user"`)
+	}
+	return nil
+}
+
+func (fu *FeedUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := fu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(feed.Table, feed.Columns, sqlgraph.NewFieldSpec(feed.FieldID, field.TypeInt))
+	if ps := fu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fu.mutation.URL(); ok {
+		_spec.SetField(feed.FieldURL, field.TypeString, value)
+	}
+	if value, ok := fu.mutation.Title(); ok {
+		_spec.SetField(feed.FieldTitle, field.TypeString, value)
+	}
+	if fu.mutation.TitleCleared() {
+		_spec.ClearField(feed.FieldTitle, field.TypeString)
+	}
+	if value, ok := fu.mutation.SiteURL(); ok {
+		_spec.SetField(feed.FieldSiteURL, field.TypeString, value)
+	}
+	if fu.mutation.SiteURLCleared() {
+		_spec.ClearField(feed.FieldSiteURL, field.TypeString)
+	}
+	if value, ok := fu.mutation.Rules(); ok {
+		_spec.SetField(feed.FieldRules, field.TypeJSON, value)
+	}
+	if fu.mutation.RulesCleared() {
+		_spec.ClearField(feed.FieldRules, field.TypeJSON)
+	}
+	if value, ok := fu.mutation.Tags(); ok {
+		_spec.SetField(feed.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := fu.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, feed.FieldTags, value)
+		})
+	}
+	if fu.mutation.TagsCleared() {
+		_spec.ClearField(feed.FieldTags, field.TypeJSON)
+	}
+	if value, ok := fu.mutation.Enabled(); ok {
+		_spec.SetField(feed.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := fu.mutation.Etag(); ok {
+		_spec.SetField(feed.FieldEtag, field.TypeString, value)
+	}
+	if fu.mutation.EtagCleared() {
+		_spec.ClearField(feed.FieldEtag, field.TypeString)
+	}
+	if value, ok := fu.mutation.LastModified(); ok {
+		_spec.SetField(feed.FieldLastModified, field.TypeString, value)
+	}
+	if fu.mutation.LastModifiedCleared() {
+		_spec.ClearField(feed.FieldLastModified, field.TypeString)
+	}
+	if value, ok := fu.mutation.Seen(); ok {
+		_spec.SetField(feed.FieldSeen, field.TypeJSON, value)
+	}
+	if value, ok := fu.mutation.AppendedSeen(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, feed.FieldSeen, value)
+		})
+	}
+	if fu.mutation.SeenCleared() {
+		_spec.ClearField(feed.FieldSeen, field.TypeJSON)
+	}
+	if value, ok := fu.mutation.LastFetchedAt(); ok {
+		_spec.SetField(feed.FieldLastFetchedAt, field.TypeTime, value)
+	}
+	if fu.mutation.LastFetchedAtCleared() {
+		_spec.ClearField(feed.FieldLastFetchedAt, field.TypeTime)
+	}
+	if value, ok := fu.mutation.LastSuccessAt(); ok {
+		_spec.SetField(feed.FieldLastSuccessAt, field.TypeTime, value)
+	}
+	if fu.mutation.LastSuccessAtCleared() {
+		_spec.ClearField(feed.FieldLastSuccessAt, field.TypeTime)
+	}
+	if value, ok := fu.mutation.ErrorCount(); ok {
+		_spec.SetField(feed.FieldErrorCount, field.TypeInt, value)
+	}
+	if value, ok := fu.mutation.AddedErrorCount(); ok {
+		_spec.AddField(feed.FieldErrorCount, field.TypeInt, value)
+	}
+	if value, ok := fu.mutation.LastError(); ok {
+		_spec.SetField(feed.FieldLastError, field.TypeString, value)
+	}
+	if fu.mutation.LastErrorCleared() {
+		_spec.ClearField(feed.FieldLastError, field.TypeString)
+	}
+	if value, ok := fu.mutation.UpdatedAt(); ok {
+		_spec.SetField(feed.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if fu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   feed.UserTable,
+			Columns: []string{feed.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   feed.UserTable,
+			Columns: []string{feed.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, fu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{feed.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	fu.mutation.done = true
+	return n, nil
+}
+
+// FeedUpdateOne is the builder for updating a single Feed entity.
+type FeedUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *FeedMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (fuo *FeedUpdateOne) SetUserID(i int) *FeedUpdateOne {
+	fuo.mutation.SetUserID(i)
+	return fuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableUserID(i *int) *FeedUpdateOne {
+	if i != nil {
+		fuo.SetUserID(*i)
+	}
+	return fuo
+}
+
+// SetURL sets the "url" field.
+func (fuo *FeedUpdateOne) SetURL(s string) *FeedUpdateOne {
+	fuo.mutation.SetURL(s)
+	return fuo
+}
+
+// SetNillableURL sets the "url" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableURL(s *string) *FeedUpdateOne {
+	if s != nil {
+		fuo.SetURL(*s)
+	}
+	return fuo
+}
+
+// SetTitle sets the "title" field.
+func (fuo *FeedUpdateOne) SetTitle(s string) *FeedUpdateOne {
+	fuo.mutation.SetTitle(s)
+	return fuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableTitle(s *string) *FeedUpdateOne {
+	if s != nil {
+		fuo.SetTitle(*s)
+	}
+	return fuo
+}
+
+// ClearTitle clears the value of the "title" field.
+func (fuo *FeedUpdateOne) ClearTitle() *FeedUpdateOne {
+	fuo.mutation.ClearTitle()
+	return fuo
+}
+
+// SetSiteURL sets the "site_url" field.
+func (fuo *FeedUpdateOne) SetSiteURL(s string) *FeedUpdateOne {
+	fuo.mutation.SetSiteURL(s)
+	return fuo
+}
+
+// SetNillableSiteURL sets the "site_url" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableSiteURL(s *string) *FeedUpdateOne {
+	if s != nil {
+		fuo.SetSiteURL(*s)
+	}
+	return fuo
+}
+
+// ClearSiteURL clears the value of the "site_url" field.
+func (fuo *FeedUpdateOne) ClearSiteURL() *FeedUpdateOne {
+	fuo.mutation.ClearSiteURL()
+	return fuo
+}
+
+// SetRules sets the "rules" field.
+func (fuo *FeedUpdateOne) SetRules(sr schema.FeedRules) *FeedUpdateOne {
+	fuo.mutation.SetRules(sr)
+	return fuo
+}
+
+// SetNillableRules sets the "rules" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableRules(sr *schema.FeedRules) *FeedUpdateOne {
+	if sr != nil {
+		fuo.SetRules(*sr)
+	}
+	return fuo
+}
+
+// ClearRules clears the value of the "rules" field.
+func (fuo *FeedUpdateOne) ClearRules() *FeedUpdateOne {
+	fuo.mutation.ClearRules()
+	return fuo
+}
+
+// SetTags sets the "tags" field.
+func (fuo *FeedUpdateOne) SetTags(s []string) *FeedUpdateOne {
+	fuo.mutation.SetTags(s)
+	return fuo
+}
+
+// AppendTags appends s to the "tags" field.
+func (fuo *FeedUpdateOne) AppendTags(s []string) *FeedUpdateOne {
+	fuo.mutation.AppendTags(s)
+	return fuo
+}
+
+// ClearTags clears the value of the "tags" field.
+func (fuo *FeedUpdateOne) ClearTags() *FeedUpdateOne {
+	fuo.mutation.ClearTags()
+	return fuo
+}
+
+// SetEnabled sets the "enabled" field.
+func (fuo *FeedUpdateOne) SetEnabled(b bool) *FeedUpdateOne {
+	fuo.mutation.SetEnabled(b)
+	return fuo
+}
+
+// SetNillableEnabled sets the "enabled" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableEnabled(b *bool) *FeedUpdateOne {
+	if b != nil {
+		fuo.SetEnabled(*b)
+	}
+	return fuo
+}
+
+// SetEtag sets the "etag" field.
+func (fuo *FeedUpdateOne) SetEtag(s string) *FeedUpdateOne {
+	fuo.mutation.SetEtag(s)
+	return fuo
+}
+
+// SetNillableEtag sets the "etag" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableEtag(s *string) *FeedUpdateOne {
+	if s != nil {
+		fuo.SetEtag(*s)
+	}
+	return fuo
+}
+
+// ClearEtag clears the value of the "etag" field.
+func (fuo *FeedUpdateOne) ClearEtag() *FeedUpdateOne {
+	fuo.mutation.ClearEtag()
+	return fuo
+}
+
+// SetLastModified sets the "last_modified" field.
+func (fuo *FeedUpdateOne) SetLastModified(s string) *FeedUpdateOne {
+	fuo.mutation.SetLastModified(s)
+	return fuo
+}
+
+// SetNillableLastModified sets the "last_modified" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableLastModified(s *string) *FeedUpdateOne {
+	if s != nil {
+		fuo.SetLastModified(*s)
+	}
+	return fuo
+}
+
+// ClearLastModified clears the value of the "last_modified" field.
+func (fuo *FeedUpdateOne) ClearLastModified() *FeedUpdateOne {
+	fuo.mutation.ClearLastModified()
+	return fuo
+}
+
+// SetSeen sets the "seen" field.
+func (fuo *FeedUpdateOne) SetSeen(s []string) *FeedUpdateOne {
+	fuo.mutation.SetSeen(s)
+	return fuo
+}
+
+// AppendSeen appends s to the "seen" field.
+func (fuo *FeedUpdateOne) AppendSeen(s []string) *FeedUpdateOne {
+	fuo.mutation.AppendSeen(s)
+	return fuo
+}
+
+// ClearSeen clears the value of the "seen" field.
+func (fuo *FeedUpdateOne) ClearSeen() *FeedUpdateOne {
+	fuo.mutation.ClearSeen()
+	return fuo
+}
+
+// SetLastFetchedAt sets the "last_fetched_at" field.
+func (fuo *FeedUpdateOne) SetLastFetchedAt(t time.Time) *FeedUpdateOne {
+	fuo.mutation.SetLastFetchedAt(t)
+	return fuo
+}
+
+// SetNillableLastFetchedAt sets the "last_fetched_at" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableLastFetchedAt(t *time.Time) *FeedUpdateOne {
+	if t != nil {
+		fuo.SetLastFetchedAt(*t)
+	}
+	return fuo
+}
+
+// ClearLastFetchedAt clears the value of the "last_fetched_at" field.
+func (fuo *FeedUpdateOne) ClearLastFetchedAt() *FeedUpdateOne {
+	fuo.mutation.ClearLastFetchedAt()
+	return fuo
+}
+
+// SetLastSuccessAt sets the "last_success_at" field.
+func (fuo *FeedUpdateOne) SetLastSuccessAt(t time.Time) *FeedUpdateOne {
+	fuo.mutation.SetLastSuccessAt(t)
+	return fuo
+}
+
+// SetNillableLastSuccessAt sets the "last_success_at" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableLastSuccessAt(t *time.Time) *FeedUpdateOne {
+	if t != nil {
+		fuo.SetLastSuccessAt(*t)
+	}
+	return fuo
+}
+
+// ClearLastSuccessAt clears the value of the "last_success_at" field.
+func (fuo *FeedUpdateOne) ClearLastSuccessAt() *FeedUpdateOne {
+	fuo.mutation.ClearLastSuccessAt()
+	return fuo
+}
+
+// SetErrorCount sets the "error_count" field.
+func (fuo *FeedUpdateOne) SetErrorCount(i int) *FeedUpdateOne {
+	fuo.mutation.ResetErrorCount()
+	fuo.mutation.SetErrorCount(i)
+	return fuo
+}
+
+// SetNillableErrorCount sets the "error_count" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableErrorCount(i *int) *FeedUpdateOne {
+	if i != nil {
+		fuo.SetErrorCount(*i)
+	}
+	return fuo
+}
+
+// AddErrorCount adds i to the "error_count" field.
+func (fuo *FeedUpdateOne) AddErrorCount(i int) *FeedUpdateOne {
+	fuo.mutation.AddErrorCount(i)
+	return fuo
+}
+
+// SetLastError sets the "last_error" field.
+func (fuo *FeedUpdateOne) SetLastError(s string) *FeedUpdateOne {
+	fuo.mutation.SetLastError(s)
+	return fuo
+}
+
+// SetNillableLastError sets the "last_error" field if the given value is not nil.
+func (fuo *FeedUpdateOne) SetNillableLastError(s *string) *FeedUpdateOne {
+	if s != nil {
+		fuo.SetLastError(*s)
+	}
+	return fuo
+}
+
+// ClearLastError clears the value of the "last_error" field.
+func (fuo *FeedUpdateOne) ClearLastError() *FeedUpdateOne {
+	fuo.mutation.ClearLastError()
+	return fuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (fuo *FeedUpdateOne) SetUpdatedAt(t time.Time) *FeedUpdateOne {
+	fuo.mutation.SetUpdatedAt(t)
+	return fuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (fuo *FeedUpdateOne) SetUser(u *User) *FeedUpdateOne {
+	return fuo.SetUserID(u.ID)
+}
+
+// Mutation returns the FeedMutation object of the builder.
+func (fuo *FeedUpdateOne) Mutation() *FeedMutation {
+	return fuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (fuo *FeedUpdateOne) ClearUser() *FeedUpdateOne {
+	fuo.mutation.ClearUser()
+	return fuo
+}
+
+// Where appends a list predicates to the FeedUpdate builder.
+func (fuo *FeedUpdateOne) Where(ps ...predicate.Feed) *FeedUpdateOne {
+	fuo.mutation.Where(ps...)
+	return fuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (fuo *FeedUpdateOne) Select(field string, fields ...string) *FeedUpdateOne {
+	fuo.fields = append([]string{field}, fields...)
+	return fuo
+}
+
+// Save executes the query and returns the updated Feed entity.
+func (fuo *FeedUpdateOne) Save(ctx context.Context) (*Feed, error) {
+	fuo.defaults()
+	return withHooks(ctx, fuo.sqlSave, fuo.mutation, fuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (fuo *FeedUpdateOne) SaveX(ctx context.Context) *Feed {
+	node, err := fuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (fuo *FeedUpdateOne) Exec(ctx context.Context) error {
+	_, err := fuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (fuo *FeedUpdateOne) ExecX(ctx context.Context) {
+	if err := fuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (fuo *FeedUpdateOne) defaults() {
+	if _, ok := fuo.mutation.UpdatedAt(); !ok {
+		v := feed.UpdateDefaultUpdatedAt()
+		fuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (fuo *FeedUpdateOne) check() error {
+	if v, ok := fuo.mutation.ErrorCount(); ok {
+		if err := feed.ErrorCountValidator(v); err != nil {
+			return &ValidationError{Name: "error_count", err: fmt.Errorf(`ent: validator failed for field "Feed.error_count": %w`, err)}
+		}
+	}
+	if _, ok := fuo.mutation.UserID(); fuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Feed.user"`)
+	}
+	return nil
+}
+
+func (fuo *FeedUpdateOne) sqlSave(ctx context.Context) (_node *Feed, err error) {
+	if err := fuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(feed.Table, feed.Columns, sqlgraph.NewFieldSpec(feed.FieldID, field.TypeInt))
+	id, ok := fuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Feed.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := fuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, feed.FieldID)
+		for _, f := range fields {
+			if !feed.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != feed.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := fuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := fuo.mutation.URL(); ok {
+		_spec.SetField(feed.FieldURL, field.TypeString, value)
+	}
+	if value, ok := fuo.mutation.Title(); ok {
+		_spec.SetField(feed.FieldTitle, field.TypeString, value)
+	}
+	if fuo.mutation.TitleCleared() {
+		_spec.ClearField(feed.FieldTitle, field.TypeString)
+	}
+	if value, ok := fuo.mutation.SiteURL(); ok {
+		_spec.SetField(feed.FieldSiteURL, field.TypeString, value)
+	}
+	if fuo.mutation.SiteURLCleared() {
+		_spec.ClearField(feed.FieldSiteURL, field.TypeString)
+	}
+	if value, ok := fuo.mutation.Rules(); ok {
+		_spec.SetField(feed.FieldRules, field.TypeJSON, value)
+	}
+	if fuo.mutation.RulesCleared() {
+		_spec.ClearField(feed.FieldRules, field.TypeJSON)
+	}
+	if value, ok := fuo.mutation.Tags(); ok {
+		_spec.SetField(feed.FieldTags, field.TypeJSON, value)
+	}
+	if value, ok := fuo.mutation.AppendedTags(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, feed.FieldTags, value)
+		})
+	}
+	if fuo.mutation.TagsCleared() {
+		_spec.ClearField(feed.FieldTags, field.TypeJSON)
+	}
+	if value, ok := fuo.mutation.Enabled(); ok {
+		_spec.SetField(feed.FieldEnabled, field.TypeBool, value)
+	}
+	if value, ok := fuo.mutation.Etag(); ok {
+		_spec.SetField(feed.FieldEtag, field.TypeString, value)
+	}
+	if fuo.mutation.EtagCleared() {
+		_spec.ClearField(feed.FieldEtag, field.TypeString)
+	}
+	if value, ok := fuo.mutation.LastModified(); ok {
+		_spec.SetField(feed.FieldLastModified, field.TypeString, value)
+	}
+	if fuo.mutation.LastModifiedCleared() {
+		_spec.ClearField(feed.FieldLastModified, field.TypeString)
+	}
+	if value, ok := fuo.mutation.Seen(); ok {
+		_spec.SetField(feed.FieldSeen, field.TypeJSON, value)
+	}
+	if value, ok := fuo.mutation.AppendedSeen(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, feed.FieldSeen, value)
+		})
+	}
+	if fuo.mutation.SeenCleared() {
+		_spec.ClearField(feed.FieldSeen, field.TypeJSON)
+	}
+	if value, ok := fuo.mutation.LastFetchedAt(); ok {
+		_spec.SetField(feed.FieldLastFetchedAt, field.TypeTime, value)
+	}
+	if fuo.mutation.LastFetchedAtCleared() {
+		_spec.ClearField(feed.FieldLastFetchedAt, field.TypeTime)
+	}
+	if value, ok := fuo.mutation.LastSuccessAt(); ok {
+		_spec.SetField(feed.FieldLastSuccessAt, field.TypeTime, value)
+	}
+	if fuo.mutation.LastSuccessAtCleared() {
+		_spec.ClearField(feed.FieldLastSuccessAt, field.TypeTime)
+	}
+	if value, ok := fuo.mutation.ErrorCount(); ok {
+		_spec.SetField(feed.FieldErrorCount, field.TypeInt, value)
+	}
+	if value, ok := fuo.mutation.AddedErrorCount(); ok {
+		_spec.AddField(feed.FieldErrorCount, field.TypeInt, value)
+	}
+	if value, ok := fuo.mutation.LastError(); ok {
+		_spec.SetField(feed.FieldLastError, field.TypeString, value)
+	}
+	if fuo.mutation.LastErrorCleared() {
+		_spec.ClearField(feed.FieldLastError, field.TypeString)
+	}
+	if value, ok := fuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(feed.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if fuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   feed.UserTable,
+			Columns: []string{feed.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := fuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   feed.UserTable,
+			Columns: []string{feed.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Feed{config: fuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, fuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{feed.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	fuo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/highlight"
+)
+
+// Highlight is the model entity for the Highlight schema.
+type Highlight struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ArticleID holds the value of the "article_id" field.
+	ArticleID uint `json:"article_id,omitempty"`
+	// Text holds the value of the "text" field.
+	Text string `json:"text,omitempty"`
+	// Note holds the value of the "note" field.
+	Note string `json:"note,omitempty"`
+	// Location holds the value of the "location" field.
+	Location string `json:"location,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the HighlightQuery when eager-loading is set.
+	Edges        HighlightEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// HighlightEdges holds the relations/edges for other nodes in the graph.
+type HighlightEdges struct {
+	// Article holds the value of the article edge.
+	Article *Article `json:"article,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ArticleOrErr returns the Article value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e HighlightEdges) ArticleOrErr() (*Article, error) {
+	if e.Article != nil {
+		return e.Article, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: article.Label}
+	}
+	return nil, &NotLoadedError{edge: "article"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Highlight) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case highlight.FieldID, highlight.FieldArticleID:
+			values[i] = new(sql.NullInt64)
+		case highlight.FieldText, highlight.FieldNote, highlight.FieldLocation:
+			values[i] = new(sql.NullString)
+		case highlight.FieldCreatedAt, highlight.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Highlight fields.
+func (h *Highlight) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case highlight.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			h.ID = int(value.Int64)
+		case highlight.FieldArticleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field article_id", values[i])
+			} else if value.Valid {
+				h.ArticleID = uint(value.Int64)
+			}
+		case highlight.FieldText:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field text", values[i])
+			} else if value.Valid {
+				h.Text = value.String
+			}
+		case highlight.FieldNote:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field note", values[i])
+			} else if value.Valid {
+				h.Note = value.String
+			}
+		case highlight.FieldLocation:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field location", values[i])
+			} else if value.Valid {
+				h.Location = value.String
+			}
+		case highlight.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				h.CreatedAt = value.Time
+			}
+		case highlight.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				h.UpdatedAt = value.Time
+			}
+		default:
+			h.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Highlight.
+// This includes values selected through modifiers, order, etc.
+func (h *Highlight) Value(name string) (ent.Value, error) {
+	return h.selectValues.Get(name)
+}
+
+// QueryArticle queries the "article" edge of the Highlight entity.
+func (h *Highlight) QueryArticle() *ArticleQuery {
+	return NewHighlightClient(h.config).QueryArticle(h)
+}
+
+// Update returns a builder for updating this Highlight.
+// Note that you need to call Highlight.Unwrap() before calling this method if this Highlight
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (h *Highlight) Update() *HighlightUpdateOne {
+	return NewHighlightClient(h.config).UpdateOne(h)
+}
+
+// Unwrap unwraps the Highlight entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (h *Highlight) Unwrap() *Highlight {
+	_tx, ok := h.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Highlight is not a transactional entity")
+	}
+	h.config.driver = _tx.drv
+	return h
+}
+
+// String implements the fmt.Stringer.
+func (h *Highlight) String() string {
+	var builder strings.Builder
+	builder.WriteString("Highlight(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", h.ID))
+	builder.WriteString("article_id=")
+	builder.WriteString(fmt.Sprintf("%v", h.ArticleID))
+	builder.WriteString(", ")
+	builder.WriteString("text=")
+	builder.WriteString(h.Text)
+	builder.WriteString(", ")
+	builder.WriteString("note=")
+	builder.WriteString(h.Note)
+	builder.WriteString(", ")
+	builder.WriteString("location=")
+	builder.WriteString(h.Location)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(h.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(h.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Highlights is a parsable slice of Highlight.
+type Highlights []*Highlight
@@ -0,0 +1,311 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/highlight"
+)
+
+// HighlightCreate is the builder for creating a Highlight entity.
+type HighlightCreate struct {
+	config
+	mutation *HighlightMutation
+	hooks    []Hook
+}
+
+// SetArticleID sets the "article_id" field.
+func (hc *HighlightCreate) SetArticleID(u uint) *HighlightCreate {
+	hc.mutation.SetArticleID(u)
+	return hc
+}
+
+// SetText sets the "text" field.
+func (hc *HighlightCreate) SetText(s string) *HighlightCreate {
+	hc.mutation.SetText(s)
+	return hc
+}
+
+// SetNote sets the "note" field.
+func (hc *HighlightCreate) SetNote(s string) *HighlightCreate {
+	hc.mutation.SetNote(s)
+	return hc
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (hc *HighlightCreate) SetNillableNote(s *string) *HighlightCreate {
+	if s != nil {
+		hc.SetNote(*s)
+	}
+	return hc
+}
+
+// SetLocation sets the "location" field.
+func (hc *HighlightCreate) SetLocation(s string) *HighlightCreate {
+	hc.mutation.SetLocation(s)
+	return hc
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (hc *HighlightCreate) SetNillableLocation(s *string) *HighlightCreate {
+	if s != nil {
+		hc.SetLocation(*s)
+	}
+	return hc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (hc *HighlightCreate) SetCreatedAt(t time.Time) *HighlightCreate {
+	hc.mutation.SetCreatedAt(t)
+	return hc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (hc *HighlightCreate) SetNillableCreatedAt(t *time.Time) *HighlightCreate {
+	if t != nil {
+		hc.SetCreatedAt(*t)
+	}
+	return hc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (hc *HighlightCreate) SetUpdatedAt(t time.Time) *HighlightCreate {
+	hc.mutation.SetUpdatedAt(t)
+	return hc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (hc *HighlightCreate) SetNillableUpdatedAt(t *time.Time) *HighlightCreate {
+	if t != nil {
+		hc.SetUpdatedAt(*t)
+	}
+	return hc
+}
+
+// SetArticle sets the "article" edge to the Article entity.
+func (hc *HighlightCreate) SetArticle(a *Article) *HighlightCreate {
+	return hc.SetArticleID(a.ID)
+}
+
+// Mutation returns the HighlightMutation object of the builder.
+func (hc *HighlightCreate) Mutation() *HighlightMutation {
+	return hc.mutation
+}
+
+// Save creates the Highlight in the database.
+func (hc *HighlightCreate) Save(ctx context.Context) (*Highlight, error) {
+	hc.defaults()
+	return withHooks(ctx, hc.sqlSave, hc.mutation, hc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (hc *HighlightCreate) SaveX(ctx context.Context) *Highlight {
+	v, err := hc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hc *HighlightCreate) Exec(ctx context.Context) error {
+	_, err := hc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hc *HighlightCreate) ExecX(ctx context.Context) {
+	if err := hc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (hc *HighlightCreate) defaults() {
+	if _, ok := hc.mutation.CreatedAt(); !ok {
+		v := highlight.DefaultCreatedAt()
+		hc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := hc.mutation.UpdatedAt(); !ok {
+		v := highlight.DefaultUpdatedAt()
+		hc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hc *HighlightCreate) check() error {
+	if _, ok := hc.mutation.ArticleID(); !ok {
+		return &ValidationError{Name: "article_id", err: errors.New(`ent: missing required field "Highlight.article_id"`)}
+	}
+	if _, ok := hc.mutation.Text(); !ok {
+		return &ValidationError{Name: "text", err: errors.New(`ent: missing required field "Highlight.text"`)}
+	}
+	if _, ok := hc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Highlight.created_at"`)}
+	}
+	if _, ok := hc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "Highlight.updated_at"`)}
+	}
+	if _, ok := hc.mutation.ArticleID(); !ok {
+		return &ValidationError{Name: "article", err: errors.New(`ent: missing required edge "Highlight.article"`)}
+	}
+	return nil
+}
+
+func (hc *HighlightCreate) sqlSave(ctx context.Context) (*Highlight, error) {
+	if err := hc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := hc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, hc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	hc.mutation.id = &_node.ID
+	hc.mutation.done = true
+	return _node, nil
+}
+
+func (hc *HighlightCreate) createSpec() (*Highlight, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Highlight{config: hc.config}
+		_spec = sqlgraph.NewCreateSpec(highlight.Table, sqlgraph.NewFieldSpec(highlight.FieldID, field.TypeInt))
+	)
+	if value, ok := hc.mutation.Text(); ok {
+		_spec.SetField(highlight.FieldText, field.TypeString, value)
+		_node.Text = value
+	}
+	if value, ok := hc.mutation.Note(); ok {
+		_spec.SetField(highlight.FieldNote, field.TypeString, value)
+		_node.Note = value
+	}
+	if value, ok := hc.mutation.Location(); ok {
+		_spec.SetField(highlight.FieldLocation, field.TypeString, value)
+		_node.Location = value
+	}
+	if value, ok := hc.mutation.CreatedAt(); ok {
+		_spec.SetField(highlight.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := hc.mutation.UpdatedAt(); ok {
+		_spec.SetField(highlight.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := hc.mutation.ArticleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   highlight.ArticleTable,
+			Columns: []string{highlight.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ArticleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// HighlightCreateBulk is the builder for creating many Highlight entities in bulk.
+type HighlightCreateBulk struct {
+	config
+	err      error
+	builders []*HighlightCreate
+}
+
+// Save creates the Highlight entities in the database.
+func (hcb *HighlightCreateBulk) Save(ctx context.Context) ([]*Highlight, error) {
+	if hcb.err != nil {
+		return nil, hcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(hcb.builders))
+	nodes := make([]*Highlight, len(hcb.builders))
+	mutators := make([]Mutator, len(hcb.builders))
+	for i := range hcb.builders {
+		func(i int, root context.Context) {
+			builder := hcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*HighlightMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, hcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, hcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, hcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hcb *HighlightCreateBulk) SaveX(ctx context.Context) []*Highlight {
+	v, err := hcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (hcb *HighlightCreateBulk) Exec(ctx context.Context) error {
+	_, err := hcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hcb *HighlightCreateBulk) ExecX(ctx context.Context) {
+	if err := hcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/highlight"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+)
+
+// HighlightDelete is the builder for deleting a Highlight entity.
+type HighlightDelete struct {
+	config
+	hooks    []Hook
+	mutation *HighlightMutation
+}
+
+// Where appends a list predicates to the HighlightDelete builder.
+func (hd *HighlightDelete) Where(ps ...predicate.Highlight) *HighlightDelete {
+	hd.mutation.Where(ps...)
+	return hd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (hd *HighlightDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, hd.sqlExec, hd.mutation, hd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hd *HighlightDelete) ExecX(ctx context.Context) int {
+	n, err := hd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (hd *HighlightDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(highlight.Table, sqlgraph.NewFieldSpec(highlight.FieldID, field.TypeInt))
+	if ps := hd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, hd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	hd.mutation.done = true
+	return affected, err
+}
+
+// HighlightDeleteOne is the builder for deleting a single Highlight entity.
+type HighlightDeleteOne struct {
+	hd *HighlightDelete
+}
+
+// Where appends a list predicates to the HighlightDelete builder.
+func (hdo *HighlightDeleteOne) Where(ps ...predicate.Highlight) *HighlightDeleteOne {
+	hdo.hd.mutation.Where(ps...)
+	return hdo
+}
+
+// Exec executes the deletion query.
+func (hdo *HighlightDeleteOne) Exec(ctx context.Context) error {
+	n, err := hdo.hd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{highlight.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hdo *HighlightDeleteOne) ExecX(ctx context.Context) {
+	if err := hdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
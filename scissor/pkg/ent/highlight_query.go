@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/highlight"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+)
+
+// HighlightQuery is the builder for querying Highlight entities.
+type HighlightQuery struct {
+	config
+	ctx         *QueryContext
+	order       []highlight.OrderOption
+	inters      []Interceptor
+	predicates  []predicate.Highlight
+	withArticle *ArticleQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the HighlightQuery builder.
+func (hq *HighlightQuery) Where(ps ...predicate.Highlight) *HighlightQuery {
+	hq.predicates = append(hq.predicates, ps...)
+	return hq
+}
+
+// Limit the number of records to be returned by this query.
+func (hq *HighlightQuery) Limit(limit int) *HighlightQuery {
+	hq.ctx.Limit = &limit
+	return hq
+}
+
+// Offset to start from.
+func (hq *HighlightQuery) Offset(offset int) *HighlightQuery {
+	hq.ctx.Offset = &offset
+	return hq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (hq *HighlightQuery) Unique(unique bool) *HighlightQuery {
+	hq.ctx.Unique = &unique
+	return hq
+}
+
+// Order specifies how the records should be ordered.
+func (hq *HighlightQuery) Order(o ...highlight.OrderOption) *HighlightQuery {
+	hq.order = append(hq.order, o...)
+	return hq
+}
+
+// QueryArticle chains the current query on the "article" edge.
+func (hq *HighlightQuery) QueryArticle() *ArticleQuery {
+	query := (&ArticleClient{config: hq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := hq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := hq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(highlight.Table, highlight.FieldID, selector),
+			sqlgraph.To(article.Table, article.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, highlight.ArticleTable, highlight.ArticleColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(hq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first Highlight entity from the query.
+// Returns a *NotFoundError when no Highlight was found.
+func (hq *HighlightQuery) First(ctx context.Context) (*Highlight, error) {
+	nodes, err := hq.Limit(1).All(setContextOp(ctx, hq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{highlight.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (hq *HighlightQuery) FirstX(ctx context.Context) *Highlight {
+	node, err := hq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first Highlight ID from the query.
+// Returns a *NotFoundError when no Highlight ID was found.
+func (hq *HighlightQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = hq.Limit(1).IDs(setContextOp(ctx, hq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{highlight.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (hq *HighlightQuery) FirstIDX(ctx context.Context) int {
+	id, err := hq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single Highlight entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one Highlight entity is found.
+// Returns a *NotFoundError when no Highlight entities are found.
+func (hq *HighlightQuery) Only(ctx context.Context) (*Highlight, error) {
+	nodes, err := hq.Limit(2).All(setContextOp(ctx, hq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{highlight.Label}
+	default:
+		return nil, &NotSingularError{highlight.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (hq *HighlightQuery) OnlyX(ctx context.Context) *Highlight {
+	node, err := hq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only Highlight ID in the query.
+// Returns a *NotSingularError when more than one Highlight ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (hq *HighlightQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = hq.Limit(2).IDs(setContextOp(ctx, hq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{highlight.Label}
+	default:
+		err = &NotSingularError{highlight.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (hq *HighlightQuery) OnlyIDX(ctx context.Context) int {
+	id, err := hq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of Highlights.
+func (hq *HighlightQuery) All(ctx context.Context) ([]*Highlight, error) {
+	ctx = setContextOp(ctx, hq.ctx, "All")
+	if err := hq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*Highlight, *HighlightQuery]()
+	return withInterceptors[[]*Highlight](ctx, hq, qr, hq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (hq *HighlightQuery) AllX(ctx context.Context) []*Highlight {
+	nodes, err := hq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of Highlight IDs.
+func (hq *HighlightQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if hq.ctx.Unique == nil && hq.path != nil {
+		hq.Unique(true)
+	}
+	ctx = setContextOp(ctx, hq.ctx, "IDs")
+	if err = hq.Select(highlight.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (hq *HighlightQuery) IDsX(ctx context.Context) []int {
+	ids, err := hq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (hq *HighlightQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, hq.ctx, "Count")
+	if err := hq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, hq, querierCount[*HighlightQuery](), hq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (hq *HighlightQuery) CountX(ctx context.Context) int {
+	count, err := hq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (hq *HighlightQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, hq.ctx, "Exist")
+	switch _, err := hq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (hq *HighlightQuery) ExistX(ctx context.Context) bool {
+	exist, err := hq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the HighlightQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (hq *HighlightQuery) Clone() *HighlightQuery {
+	if hq == nil {
+		return nil
+	}
+	return &HighlightQuery{
+		config:      hq.config,
+		ctx:         hq.ctx.Clone(),
+		order:       append([]highlight.OrderOption{}, hq.order...),
+		inters:      append([]Interceptor{}, hq.inters...),
+		predicates:  append([]predicate.Highlight{}, hq.predicates...),
+		withArticle: hq.withArticle.Clone(),
+		// clone intermediate query.
+		sql:  hq.sql.Clone(),
+		path: hq.path,
+	}
+}
+
+// WithArticle tells the query-builder to eager-load the nodes that are connected to
+// the "article" edge. The optional arguments are used to configure the query builder of the edge.
+func (hq *HighlightQuery) WithArticle(opts ...func(*ArticleQuery)) *HighlightQuery {
+	query := (&ArticleClient{config: hq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	hq.withArticle = query
+	return hq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		ArticleID uint `json:"article_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.Highlight.Query().
+//		GroupBy(highlight.FieldArticleID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (hq *HighlightQuery) GroupBy(field string, fields ...string) *HighlightGroupBy {
+	hq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &HighlightGroupBy{build: hq}
+	grbuild.flds = &hq.ctx.Fields
+	grbuild.label = highlight.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		ArticleID uint `json:"article_id,omitempty"`
+//	}
+//
+//	client.Highlight.Query().
+//		Select(highlight.FieldArticleID).
+//		Scan(ctx, &v)
+func (hq *HighlightQuery) Select(fields ...string) *HighlightSelect {
+	hq.ctx.Fields = append(hq.ctx.Fields, fields...)
+	sbuild := &HighlightSelect{HighlightQuery: hq}
+	sbuild.label = highlight.Label
+	sbuild.flds, sbuild.scan = &hq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a HighlightSelect configured with the given aggregations.
+func (hq *HighlightQuery) Aggregate(fns ...AggregateFunc) *HighlightSelect {
+	return hq.Select().Aggregate(fns...)
+}
+
+func (hq *HighlightQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range hq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, hq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range hq.ctx.Fields {
+		if !highlight.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if hq.path != nil {
+		prev, err := hq.path(ctx)
+		if err != nil {
+			return err
+		}
+		hq.sql = prev
+	}
+	return nil
+}
+
+func (hq *HighlightQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*Highlight, error) {
+	var (
+		nodes       = []*Highlight{}
+		_spec       = hq.querySpec()
+		loadedTypes = [1]bool{
+			hq.withArticle != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*Highlight).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &Highlight{config: hq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, hq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := hq.withArticle; query != nil {
+		if err := hq.loadArticle(ctx, query, nodes, nil,
+			func(n *Highlight, e *Article) { n.Edges.Article = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (hq *HighlightQuery) loadArticle(ctx context.Context, query *ArticleQuery, nodes []*Highlight, init func(*Highlight), assign func(*Highlight, *Article)) error {
+	ids := make([]uint, 0, len(nodes))
+	nodeids := make(map[uint][]*Highlight)
+	for i := range nodes {
+		fk := nodes[i].ArticleID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(article.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "article_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (hq *HighlightQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := hq.querySpec()
+	_spec.Node.Columns = hq.ctx.Fields
+	if len(hq.ctx.Fields) > 0 {
+		_spec.Unique = hq.ctx.Unique != nil && *hq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, hq.driver, _spec)
+}
+
+func (hq *HighlightQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(highlight.Table, highlight.Columns, sqlgraph.NewFieldSpec(highlight.FieldID, field.TypeInt))
+	_spec.From = hq.sql
+	if unique := hq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if hq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := hq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, highlight.FieldID)
+		for i := range fields {
+			if fields[i] != highlight.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if hq.withArticle != nil {
+			_spec.Node.AddColumnOnce(highlight.FieldArticleID)
+		}
+	}
+	if ps := hq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := hq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := hq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := hq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (hq *HighlightQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(hq.driver.Dialect())
+	t1 := builder.Table(highlight.Table)
+	columns := hq.ctx.Fields
+	if len(columns) == 0 {
+		columns = highlight.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if hq.sql != nil {
+		selector = hq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if hq.ctx.Unique != nil && *hq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range hq.predicates {
+		p(selector)
+	}
+	for _, p := range hq.order {
+		p(selector)
+	}
+	if offset := hq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := hq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// HighlightGroupBy is the group-by builder for Highlight entities.
+type HighlightGroupBy struct {
+	selector
+	build *HighlightQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (hgb *HighlightGroupBy) Aggregate(fns ...AggregateFunc) *HighlightGroupBy {
+	hgb.fns = append(hgb.fns, fns...)
+	return hgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (hgb *HighlightGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, hgb.build.ctx, "GroupBy")
+	if err := hgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HighlightQuery, *HighlightGroupBy](ctx, hgb.build, hgb, hgb.build.inters, v)
+}
+
+func (hgb *HighlightGroupBy) sqlScan(ctx context.Context, root *HighlightQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(hgb.fns))
+	for _, fn := range hgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*hgb.flds)+len(hgb.fns))
+		for _, f := range *hgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*hgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := hgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// HighlightSelect is the builder for selecting fields of Highlight entities.
+type HighlightSelect struct {
+	*HighlightQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (hs *HighlightSelect) Aggregate(fns ...AggregateFunc) *HighlightSelect {
+	hs.fns = append(hs.fns, fns...)
+	return hs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (hs *HighlightSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, hs.ctx, "Select")
+	if err := hs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*HighlightQuery, *HighlightSelect](ctx, hs.HighlightQuery, hs, hs.inters, v)
+}
+
+func (hs *HighlightSelect) sqlScan(ctx context.Context, root *HighlightQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(hs.fns))
+	for _, fn := range hs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*hs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := hs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
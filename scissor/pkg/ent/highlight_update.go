@@ -0,0 +1,481 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/highlight"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+)
+
+// HighlightUpdate is the builder for updating Highlight entities.
+type HighlightUpdate struct {
+	config
+	hooks    []Hook
+	mutation *HighlightMutation
+}
+
+// Where appends a list predicates to the HighlightUpdate builder.
+func (hu *HighlightUpdate) Where(ps ...predicate.Highlight) *HighlightUpdate {
+	hu.mutation.Where(ps...)
+	return hu
+}
+
+// SetArticleID sets the "article_id" field.
+func (hu *HighlightUpdate) SetArticleID(u uint) *HighlightUpdate {
+	hu.mutation.SetArticleID(u)
+	return hu
+}
+
+// SetNillableArticleID sets the "article_id" field if the given value is not nil.
+func (hu *HighlightUpdate) SetNillableArticleID(u *uint) *HighlightUpdate {
+	if u != nil {
+		hu.SetArticleID(*u)
+	}
+	return hu
+}
+
+// SetText sets the "text" field.
+func (hu *HighlightUpdate) SetText(s string) *HighlightUpdate {
+	hu.mutation.SetText(s)
+	return hu
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (hu *HighlightUpdate) SetNillableText(s *string) *HighlightUpdate {
+	if s != nil {
+		hu.SetText(*s)
+	}
+	return hu
+}
+
+// SetNote sets the "note" field.
+func (hu *HighlightUpdate) SetNote(s string) *HighlightUpdate {
+	hu.mutation.SetNote(s)
+	return hu
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (hu *HighlightUpdate) SetNillableNote(s *string) *HighlightUpdate {
+	if s != nil {
+		hu.SetNote(*s)
+	}
+	return hu
+}
+
+// ClearNote clears the value of the "note" field.
+func (hu *HighlightUpdate) ClearNote() *HighlightUpdate {
+	hu.mutation.ClearNote()
+	return hu
+}
+
+// SetLocation sets the "location" field.
+func (hu *HighlightUpdate) SetLocation(s string) *HighlightUpdate {
+	hu.mutation.SetLocation(s)
+	return hu
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (hu *HighlightUpdate) SetNillableLocation(s *string) *HighlightUpdate {
+	if s != nil {
+		hu.SetLocation(*s)
+	}
+	return hu
+}
+
+// ClearLocation clears the value of the "location" field.
+func (hu *HighlightUpdate) ClearLocation() *HighlightUpdate {
+	hu.mutation.ClearLocation()
+	return hu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (hu *HighlightUpdate) SetUpdatedAt(t time.Time) *HighlightUpdate {
+	hu.mutation.SetUpdatedAt(t)
+	return hu
+}
+
+// SetArticle sets the "article" edge to the Article entity.
+func (hu *HighlightUpdate) SetArticle(a *Article) *HighlightUpdate {
+	return hu.SetArticleID(a.ID)
+}
+
+// Mutation returns the HighlightMutation object of the builder.
+func (hu *HighlightUpdate) Mutation() *HighlightMutation {
+	return hu.mutation
+}
+
+// ClearArticle clears the "article" edge to the Article entity.
+func (hu *HighlightUpdate) ClearArticle() *HighlightUpdate {
+	hu.mutation.ClearArticle()
+	return hu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (hu *HighlightUpdate) Save(ctx context.Context) (int, error) {
+	hu.defaults()
+	return withHooks(ctx, hu.sqlSave, hu.mutation, hu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (hu *HighlightUpdate) SaveX(ctx context.Context) int {
+	affected, err := hu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (hu *HighlightUpdate) Exec(ctx context.Context) error {
+	_, err := hu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (hu *HighlightUpdate) ExecX(ctx context.Context) {
+	if err := hu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (hu *HighlightUpdate) defaults() {
+	if _, ok := hu.mutation.UpdatedAt(); !ok {
+		v := highlight.UpdateDefaultUpdatedAt()
+		hu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (hu *HighlightUpdate) check() error {
+	if _, ok := hu.mutation.ArticleID(); hu.mutation.ArticleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Highlight.article"`)
+	}
+	return nil
+}
+
+func (hu *HighlightUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := hu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(highlight.Table, highlight.Columns, sqlgraph.NewFieldSpec(highlight.FieldID, field.TypeInt))
+	if ps := hu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := hu.mutation.Text(); ok {
+		_spec.SetField(highlight.FieldText, field.TypeString, value)
+	}
+	if value, ok := hu.mutation.Note(); ok {
+		_spec.SetField(highlight.FieldNote, field.TypeString, value)
+	}
+	if hu.mutation.NoteCleared() {
+		_spec.ClearField(highlight.FieldNote, field.TypeString)
+	}
+	if value, ok := hu.mutation.Location(); ok {
+		_spec.SetField(highlight.FieldLocation, field.TypeString, value)
+	}
+	if hu.mutation.LocationCleared() {
+		_spec.ClearField(highlight.FieldLocation, field.TypeString)
+	}
+	if value, ok := hu.mutation.UpdatedAt(); ok {
+		_spec.SetField(highlight.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if hu.mutation.ArticleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   highlight.ArticleTable,
+			Columns: []string{highlight.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := hu.mutation.ArticleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   highlight.ArticleTable,
+			Columns: []string{highlight.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, hu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{highlight.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	hu.mutation.done = true
+	return n, nil
+}
+
+// HighlightUpdateOne is the builder for updating a single Highlight entity.
+type HighlightUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *HighlightMutation
+}
+
+// SetArticleID sets the "article_id" field.
+func (huo *HighlightUpdateOne) SetArticleID(u uint) *HighlightUpdateOne {
+	huo.mutation.SetArticleID(u)
+	return huo
+}
+
+// SetNillableArticleID sets the "article_id" field if the given value is not nil.
+func (huo *HighlightUpdateOne) SetNillableArticleID(u *uint) *HighlightUpdateOne {
+	if u != nil {
+		huo.SetArticleID(*u)
+	}
+	return huo
+}
+
+// SetText sets the "text" field.
+func (huo *HighlightUpdateOne) SetText(s string) *HighlightUpdateOne {
+	huo.mutation.SetText(s)
+	return huo
+}
+
+// SetNillableText sets the "text" field if the given value is not nil.
+func (huo *HighlightUpdateOne) SetNillableText(s *string) *HighlightUpdateOne {
+	if s != nil {
+		huo.SetText(*s)
+	}
+	return huo
+}
+
+// SetNote sets the "note" field.
+func (huo *HighlightUpdateOne) SetNote(s string) *HighlightUpdateOne {
+	huo.mutation.SetNote(s)
+	return huo
+}
+
+// SetNillableNote sets the "note" field if the given value is not nil.
+func (huo *HighlightUpdateOne) SetNillableNote(s *string) *HighlightUpdateOne {
+	if s != nil {
+		huo.SetNote(*s)
+	}
+	return huo
+}
+
+// ClearNote clears the value of the "note" field.
+func (huo *HighlightUpdateOne) ClearNote() *HighlightUpdateOne {
+	huo.mutation.ClearNote()
+	return huo
+}
+
+// SetLocation sets the "location" field.
+func (huo *HighlightUpdateOne) SetLocation(s string) *HighlightUpdateOne {
+	huo.mutation.SetLocation(s)
+	return huo
+}
+
+// SetNillableLocation sets the "location" field if the given value is not nil.
+func (huo *HighlightUpdateOne) SetNillableLocation(s *string) *HighlightUpdateOne {
+	if s != nil {
+		huo.SetLocation(*s)
+	}
+	return huo
+}
+
+// ClearLocation clears the value of the "location" field.
+func (huo *HighlightUpdateOne) ClearLocation() *HighlightUpdateOne {
+	huo.mutation.ClearLocation()
+	return huo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (huo *HighlightUpdateOne) SetUpdatedAt(t time.Time) *HighlightUpdateOne {
+	huo.mutation.SetUpdatedAt(t)
+	return huo
+}
+
+// SetArticle sets the "article" edge to the Article entity.
+func (huo *HighlightUpdateOne) SetArticle(a *Article) *HighlightUpdateOne {
+	return huo.SetArticleID(a.ID)
+}
+
+// Mutation returns the HighlightMutation object of the builder.
+func (huo *HighlightUpdateOne) Mutation() *HighlightMutation {
+	return huo.mutation
+}
+
+// ClearArticle clears the "article" edge to the Article entity.
+func (huo *HighlightUpdateOne) ClearArticle() *HighlightUpdateOne {
+	huo.mutation.ClearArticle()
+	return huo
+}
+
+// Where appends a list predicates to the HighlightUpdate builder.
+func (huo *HighlightUpdateOne) Where(ps ...predicate.Highlight) *HighlightUpdateOne {
+	huo.mutation.Where(ps...)
+	return huo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (huo *HighlightUpdateOne) Select(field string, fields ...string) *HighlightUpdateOne {
+	huo.fields = append([]string{field}, fields...)
+	return huo
+}
+
+// Save executes the query and returns the updated Highlight entity.
+func (huo *HighlightUpdateOne) Save(ctx context.Context) (*Highlight, error) {
+	huo.defaults()
+	return withHooks(ctx, huo.sqlSave, huo.mutation, huo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (huo *HighlightUpdateOne) SaveX(ctx context.Context) *Highlight {
+	node, err := huo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (huo *HighlightUpdateOne) Exec(ctx context.Context) error {
+	_, err := huo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (huo *HighlightUpdateOne) ExecX(ctx context.Context) {
+	if err := huo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (huo *HighlightUpdateOne) defaults() {
+	if _, ok := huo.mutation.UpdatedAt(); !ok {
+		v := highlight.UpdateDefaultUpdatedAt()
+		huo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (huo *HighlightUpdateOne) check() error {
+	if _, ok := huo.mutation.ArticleID(); huo.mutation.ArticleCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Highlight.article"`)
+	}
+	return nil
+}
+
+func (huo *HighlightUpdateOne) sqlSave(ctx context.Context) (_node *Highlight, err error) {
+	if err := huo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(highlight.Table, highlight.Columns, sqlgraph.NewFieldSpec(highlight.FieldID, field.TypeInt))
+	id, ok := huo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Highlight.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := huo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, highlight.FieldID)
+		for _, f := range fields {
+			if !highlight.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != highlight.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := huo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := huo.mutation.Text(); ok {
+		_spec.SetField(highlight.FieldText, field.TypeString, value)
+	}
+	if value, ok := huo.mutation.Note(); ok {
+		_spec.SetField(highlight.FieldNote, field.TypeString, value)
+	}
+	if huo.mutation.NoteCleared() {
+		_spec.ClearField(highlight.FieldNote, field.TypeString)
+	}
+	if value, ok := huo.mutation.Location(); ok {
+		_spec.SetField(highlight.FieldLocation, field.TypeString, value)
+	}
+	if huo.mutation.LocationCleared() {
+		_spec.ClearField(highlight.FieldLocation, field.TypeString)
+	}
+	if value, ok := huo.mutation.UpdatedAt(); ok {
+		_spec.SetField(highlight.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if huo.mutation.ArticleCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   highlight.ArticleTable,
+			Columns: []string{highlight.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := huo.mutation.ArticleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   highlight.ArticleTable,
+			Columns: []string{highlight.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Highlight{config: huo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, huo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{highlight.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	huo.mutation.done = true
+	return _node, nil
+}
@@ -0,0 +1,190 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/image"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
+)
+
+// Image is the model entity for the Image schema.
+type Image struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Hash holds the value of the "hash" field.
+	Hash string `json:"hash,omitempty"`
+	// ContentType holds the value of the "content_type" field.
+	ContentType string `json:"content_type,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// SourceURL holds the value of the "source_url" field.
+	SourceURL string `json:"source_url,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the ImageQuery when eager-loading is set.
+	Edges        ImageEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// ImageEdges holds the relations/edges for other nodes in the graph.
+type ImageEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e ImageEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Image) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case image.FieldID, image.FieldUserID, image.FieldSize:
+			values[i] = new(sql.NullInt64)
+		case image.FieldHash, image.FieldContentType, image.FieldSourceURL:
+			values[i] = new(sql.NullString)
+		case image.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Image fields.
+func (i *Image) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for j := range columns {
+		switch columns[j] {
+		case image.FieldID:
+			value, ok := values[j].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			i.ID = int(value.Int64)
+		case image.FieldUserID:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[j])
+			} else if value.Valid {
+				i.UserID = int(value.Int64)
+			}
+		case image.FieldHash:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field hash", values[j])
+			} else if value.Valid {
+				i.Hash = value.String
+			}
+		case image.FieldContentType:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field content_type", values[j])
+			} else if value.Valid {
+				i.ContentType = value.String
+			}
+		case image.FieldSize:
+			if value, ok := values[j].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[j])
+			} else if value.Valid {
+				i.Size = value.Int64
+			}
+		case image.FieldSourceURL:
+			if value, ok := values[j].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_url", values[j])
+			} else if value.Valid {
+				i.SourceURL = value.String
+			}
+		case image.FieldCreatedAt:
+			if value, ok := values[j].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[j])
+			} else if value.Valid {
+				i.CreatedAt = value.Time
+			}
+		default:
+			i.selectValues.Set(columns[j], values[j])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Image.
+// This includes values selected through modifiers, order, etc.
+func (i *Image) Value(name string) (ent.Value, error) {
+	return i.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the Image entity.
+func (i *Image) QueryUser() *UserQuery {
+	return NewImageClient(i.config).QueryUser(i)
+}
+
+// Update returns a builder for updating this Image.
+// Note that you need to call Image.Unwrap() before calling this method if this Image
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (i *Image) Update() *ImageUpdateOne {
+	return NewImageClient(i.config).UpdateOne(i)
+}
+
+// Unwrap unwraps the Image entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (i *Image) Unwrap() *Image {
+	_tx, ok := i.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Image is not a transactional entity")
+	}
+	i.config.driver = _tx.drv
+	return i
+}
+
+// String implements the fmt.Stringer.
+func (i *Image) String() string {
+	var builder strings.Builder
+	builder.WriteString("Image(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", i.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", i.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("hash=")
+	builder.WriteString(i.Hash)
+	builder.WriteString(", ")
+	builder.WriteString("content_type=")
+	builder.WriteString(i.ContentType)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", i.Size))
+	builder.WriteString(", ")
+	builder.WriteString("source_url=")
+	builder.WriteString(i.SourceURL)
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(i.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Images is a parsable slice of Image.
+type Images []*Image
@@ -0,0 +1,294 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/image"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
+)
+
+// ImageCreate is the builder for creating a Image entity.
+type ImageCreate struct {
+	config
+	mutation *ImageMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (ic *ImageCreate) SetUserID(i int) *ImageCreate {
+	ic.mutation.SetUserID(i)
+	return ic
+}
+
+// SetHash sets the "hash" field.
+func (ic *ImageCreate) SetHash(s string) *ImageCreate {
+	ic.mutation.SetHash(s)
+	return ic
+}
+
+// SetContentType sets the "content_type" field.
+func (ic *ImageCreate) SetContentType(s string) *ImageCreate {
+	ic.mutation.SetContentType(s)
+	return ic
+}
+
+// SetSize sets the "size" field.
+func (ic *ImageCreate) SetSize(i int64) *ImageCreate {
+	ic.mutation.SetSize(i)
+	return ic
+}
+
+// SetSourceURL sets the "source_url" field.
+func (ic *ImageCreate) SetSourceURL(s string) *ImageCreate {
+	ic.mutation.SetSourceURL(s)
+	return ic
+}
+
+// SetNillableSourceURL sets the "source_url" field if the given value is not nil.
+func (ic *ImageCreate) SetNillableSourceURL(s *string) *ImageCreate {
+	if s != nil {
+		ic.SetSourceURL(*s)
+	}
+	return ic
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ic *ImageCreate) SetCreatedAt(t time.Time) *ImageCreate {
+	ic.mutation.SetCreatedAt(t)
+	return ic
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ic *ImageCreate) SetNillableCreatedAt(t *time.Time) *ImageCreate {
+	if t != nil {
+		ic.SetCreatedAt(*t)
+	}
+	return ic
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ic *ImageCreate) SetUser(u *User) *ImageCreate {
+	return ic.SetUserID(u.ID)
+}
+
+// Mutation returns the ImageMutation object of the builder.
+func (ic *ImageCreate) Mutation() *ImageMutation {
+	return ic.mutation
+}
+
+// Save creates the Image in the database.
+func (ic *ImageCreate) Save(ctx context.Context) (*Image, error) {
+	ic.defaults()
+	return withHooks(ctx, ic.sqlSave, ic.mutation, ic.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ic *ImageCreate) SaveX(ctx context.Context) *Image {
+	v, err := ic.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ic *ImageCreate) Exec(ctx context.Context) error {
+	_, err := ic.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ic *ImageCreate) ExecX(ctx context.Context) {
+	if err := ic.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ic *ImageCreate) defaults() {
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		v := image.DefaultCreatedAt()
+		ic.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ic *ImageCreate) check() error {
+	if _, ok := ic.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Image.user_id"`)}
+	}
+	if _, ok := ic.mutation.Hash(); !ok {
+		return &ValidationError{Name: "hash", err: errors.New(`ent: missing required field "Image.hash"`)}
+	}
+	if _, ok := ic.mutation.ContentType(); !ok {
+		return &ValidationError{Name: "content_type", err: errors.New(`ent: missing required field "Image.content_type"`)}
+	}
+	if _, ok := ic.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Image.size"`)}
+	}
+	if _, ok := ic.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Image.created_at"`)}
+	}
+	if _, ok := ic.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Image.user"`)}
+	}
+	return nil
+}
+
+func (ic *ImageCreate) sqlSave(ctx context.Context) (*Image, error) {
+	if err := ic.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ic.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ic.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ic.mutation.id = &_node.ID
+	ic.mutation.done = true
+	return _node, nil
+}
+
+func (ic *ImageCreate) createSpec() (*Image, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Image{config: ic.config}
+		_spec = sqlgraph.NewCreateSpec(image.Table, sqlgraph.NewFieldSpec(image.FieldID, field.TypeInt))
+	)
+	if value, ok := ic.mutation.Hash(); ok {
+		_spec.SetField(image.FieldHash, field.TypeString, value)
+		_node.Hash = value
+	}
+	if value, ok := ic.mutation.ContentType(); ok {
+		_spec.SetField(image.FieldContentType, field.TypeString, value)
+		_node.ContentType = value
+	}
+	if value, ok := ic.mutation.Size(); ok {
+		_spec.SetField(image.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := ic.mutation.SourceURL(); ok {
+		_spec.SetField(image.FieldSourceURL, field.TypeString, value)
+		_node.SourceURL = value
+	}
+	if value, ok := ic.mutation.CreatedAt(); ok {
+		_spec.SetField(image.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := ic.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   image.UserTable,
+			Columns: []string{image.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// ImageCreateBulk is the builder for creating many Image entities in bulk.
+type ImageCreateBulk struct {
+	config
+	err      error
+	builders []*ImageCreate
+}
+
+// Save creates the Image entities in the database.
+func (icb *ImageCreateBulk) Save(ctx context.Context) ([]*Image, error) {
+	if icb.err != nil {
+		return nil, icb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(icb.builders))
+	nodes := make([]*Image, len(icb.builders))
+	mutators := make([]Mutator, len(icb.builders))
+	for i := range icb.builders {
+		func(i int, root context.Context) {
+			builder := icb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*ImageMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, icb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, icb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, icb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (icb *ImageCreateBulk) SaveX(ctx context.Context) []*Image {
+	v, err := icb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (icb *ImageCreateBulk) Exec(ctx context.Context) error {
+	_, err := icb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (icb *ImageCreateBulk) ExecX(ctx context.Context) {
+	if err := icb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
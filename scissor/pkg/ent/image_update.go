@@ -0,0 +1,480 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/image"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
+)
+
+// ImageUpdate is the builder for updating Image entities.
+type ImageUpdate struct {
+	config
+	hooks    []Hook
+	mutation *ImageMutation
+}
+
+// Where appends a list predicates to the ImageUpdate builder.
+func (iu *ImageUpdate) Where(ps ...predicate.Image) *ImageUpdate {
+	iu.mutation.Where(ps...)
+	return iu
+}
+
+// SetUserID sets the "user_id" field.
+func (iu *ImageUpdate) SetUserID(i int) *ImageUpdate {
+	iu.mutation.SetUserID(i)
+	return iu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (iu *ImageUpdate) SetNillableUserID(i *int) *ImageUpdate {
+	if i != nil {
+		iu.SetUserID(*i)
+	}
+	return iu
+}
+
+// SetHash sets the "hash" field.
+func (iu *ImageUpdate) SetHash(s string) *ImageUpdate {
+	iu.mutation.SetHash(s)
+	return iu
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (iu *ImageUpdate) SetNillableHash(s *string) *ImageUpdate {
+	if s != nil {
+		iu.SetHash(*s)
+	}
+	return iu
+}
+
+// SetContentType sets the "content_type" field.
+func (iu *ImageUpdate) SetContentType(s string) *ImageUpdate {
+	iu.mutation.SetContentType(s)
+	return iu
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (iu *ImageUpdate) SetNillableContentType(s *string) *ImageUpdate {
+	if s != nil {
+		iu.SetContentType(*s)
+	}
+	return iu
+}
+
+// SetSize sets the "size" field.
+func (iu *ImageUpdate) SetSize(i int64) *ImageUpdate {
+	iu.mutation.ResetSize()
+	iu.mutation.SetSize(i)
+	return iu
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (iu *ImageUpdate) SetNillableSize(i *int64) *ImageUpdate {
+	if i != nil {
+		iu.SetSize(*i)
+	}
+	return iu
+}
+
+// AddSize adds i to the "size" field.
+func (iu *ImageUpdate) AddSize(i int64) *ImageUpdate {
+	iu.mutation.AddSize(i)
+	return iu
+}
+
+// SetSourceURL sets the "source_url" field.
+func (iu *ImageUpdate) SetSourceURL(s string) *ImageUpdate {
+	iu.mutation.SetSourceURL(s)
+	return iu
+}
+
+// SetNillableSourceURL sets the "source_url" field if the given value is not nil.
+func (iu *ImageUpdate) SetNillableSourceURL(s *string) *ImageUpdate {
+	if s != nil {
+		iu.SetSourceURL(*s)
+	}
+	return iu
+}
+
+// ClearSourceURL clears the value of the "source_url" field.
+func (iu *ImageUpdate) ClearSourceURL() *ImageUpdate {
+	iu.mutation.ClearSourceURL()
+	return iu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (iu *ImageUpdate) SetUser(u *User) *ImageUpdate {
+	return iu.SetUserID(u.ID)
+}
+
+// Mutation returns the ImageMutation object of the builder.
+func (iu *ImageUpdate) Mutation() *ImageMutation {
+	return iu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (iu *ImageUpdate) ClearUser() *ImageUpdate {
+	iu.mutation.ClearUser()
+	return iu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (iu *ImageUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, iu.sqlSave, iu.mutation, iu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iu *ImageUpdate) SaveX(ctx context.Context) int {
+	affected, err := iu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (iu *ImageUpdate) Exec(ctx context.Context) error {
+	_, err := iu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iu *ImageUpdate) ExecX(ctx context.Context) {
+	if err := iu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iu *ImageUpdate) check() error {
+	if _, ok := iu.mutation.UserID(); iu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Image.user"`)
+	}
+	return nil
+}
+
+func (iu *ImageUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := iu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(image.Table, image.Columns, sqlgraph.NewFieldSpec(image.FieldID, field.TypeInt))
+	if ps := iu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iu.mutation.Hash(); ok {
+		_spec.SetField(image.FieldHash, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.ContentType(); ok {
+		_spec.SetField(image.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := iu.mutation.Size(); ok {
+		_spec.SetField(image.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := iu.mutation.AddedSize(); ok {
+		_spec.AddField(image.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := iu.mutation.SourceURL(); ok {
+		_spec.SetField(image.FieldSourceURL, field.TypeString, value)
+	}
+	if iu.mutation.SourceURLCleared() {
+		_spec.ClearField(image.FieldSourceURL, field.TypeString)
+	}
+	if iu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   image.UserTable,
+			Columns: []string{image.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   image.UserTable,
+			Columns: []string{image.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, iu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{image.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	iu.mutation.done = true
+	return n, nil
+}
+
+// ImageUpdateOne is the builder for updating a single Image entity.
+type ImageUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *ImageMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (iuo *ImageUpdateOne) SetUserID(i int) *ImageUpdateOne {
+	iuo.mutation.SetUserID(i)
+	return iuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (iuo *ImageUpdateOne) SetNillableUserID(i *int) *ImageUpdateOne {
+	if i != nil {
+		iuo.SetUserID(*i)
+	}
+	return iuo
+}
+
+// SetHash sets the "hash" field.
+func (iuo *ImageUpdateOne) SetHash(s string) *ImageUpdateOne {
+	iuo.mutation.SetHash(s)
+	return iuo
+}
+
+// SetNillableHash sets the "hash" field if the given value is not nil.
+func (iuo *ImageUpdateOne) SetNillableHash(s *string) *ImageUpdateOne {
+	if s != nil {
+		iuo.SetHash(*s)
+	}
+	return iuo
+}
+
+// SetContentType sets the "content_type" field.
+func (iuo *ImageUpdateOne) SetContentType(s string) *ImageUpdateOne {
+	iuo.mutation.SetContentType(s)
+	return iuo
+}
+
+// SetNillableContentType sets the "content_type" field if the given value is not nil.
+func (iuo *ImageUpdateOne) SetNillableContentType(s *string) *ImageUpdateOne {
+	if s != nil {
+		iuo.SetContentType(*s)
+	}
+	return iuo
+}
+
+// SetSize sets the "size" field.
+func (iuo *ImageUpdateOne) SetSize(i int64) *ImageUpdateOne {
+	iuo.mutation.ResetSize()
+	iuo.mutation.SetSize(i)
+	return iuo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (iuo *ImageUpdateOne) SetNillableSize(i *int64) *ImageUpdateOne {
+	if i != nil {
+		iuo.SetSize(*i)
+	}
+	return iuo
+}
+
+// AddSize adds i to the "size" field.
+func (iuo *ImageUpdateOne) AddSize(i int64) *ImageUpdateOne {
+	iuo.mutation.AddSize(i)
+	return iuo
+}
+
+// SetSourceURL sets the "source_url" field.
+func (iuo *ImageUpdateOne) SetSourceURL(s string) *ImageUpdateOne {
+	iuo.mutation.SetSourceURL(s)
+	return iuo
+}
+
+// SetNillableSourceURL sets the "source_url" field if the given value is not nil.
+func (iuo *ImageUpdateOne) SetNillableSourceURL(s *string) *ImageUpdateOne {
+	if s != nil {
+		iuo.SetSourceURL(*s)
+	}
+	return iuo
+}
+
+// ClearSourceURL clears the value of the "source_url" field.
+func (iuo *ImageUpdateOne) ClearSourceURL() *ImageUpdateOne {
+	iuo.mutation.ClearSourceURL()
+	return iuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (iuo *ImageUpdateOne) SetUser(u *User) *ImageUpdateOne {
+	return iuo.SetUserID(u.ID)
+}
+
+// Mutation returns the ImageMutation object of the builder.
+func (iuo *ImageUpdateOne) Mutation() *ImageMutation {
+	return iuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (iuo *ImageUpdateOne) ClearUser() *ImageUpdateOne {
+	iuo.mutation.ClearUser()
+	return iuo
+}
+
+// Where appends a list predicates to the ImageUpdate builder.
+func (iuo *ImageUpdateOne) Where(ps ...predicate.Image) *ImageUpdateOne {
+	iuo.mutation.Where(ps...)
+	return iuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (iuo *ImageUpdateOne) Select(field string, fields ...string) *ImageUpdateOne {
+	iuo.fields = append([]string{field}, fields...)
+	return iuo
+}
+
+// Save executes the query and returns the updated Image entity.
+func (iuo *ImageUpdateOne) Save(ctx context.Context) (*Image, error) {
+	return withHooks(ctx, iuo.sqlSave, iuo.mutation, iuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (iuo *ImageUpdateOne) SaveX(ctx context.Context) *Image {
+	node, err := iuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (iuo *ImageUpdateOne) Exec(ctx context.Context) error {
+	_, err := iuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (iuo *ImageUpdateOne) ExecX(ctx context.Context) {
+	if err := iuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (iuo *ImageUpdateOne) check() error {
+	if _, ok := iuo.mutation.UserID(); iuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Image.user"`)
+	}
+	return nil
+}
+
+func (iuo *ImageUpdateOne) sqlSave(ctx context.Context) (_node *Image, err error) {
+	if err := iuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(image.Table, image.Columns, sqlgraph.NewFieldSpec(image.FieldID, field.TypeInt))
+	id, ok := iuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Image.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := iuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, image.FieldID)
+		for _, f := range fields {
+			if !image.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != image.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := iuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := iuo.mutation.Hash(); ok {
+		_spec.SetField(image.FieldHash, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.ContentType(); ok {
+		_spec.SetField(image.FieldContentType, field.TypeString, value)
+	}
+	if value, ok := iuo.mutation.Size(); ok {
+		_spec.SetField(image.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := iuo.mutation.AddedSize(); ok {
+		_spec.AddField(image.FieldSize, field.TypeInt64, value)
+	}
+	if value, ok := iuo.mutation.SourceURL(); ok {
+		_spec.SetField(image.FieldSourceURL, field.TypeString, value)
+	}
+	if iuo.mutation.SourceURLCleared() {
+		_spec.ClearField(image.FieldSourceURL, field.TypeString)
+	}
+	if iuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   image.UserTable,
+			Columns: []string{image.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := iuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   image.UserTable,
+			Columns: []string{image.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Image{config: iuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, iuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{image.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	iuo.mutation.done = true
+	return _node, nil
+}
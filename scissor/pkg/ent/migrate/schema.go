@@ -8,19 +8,67 @@ import (
 )
 
 var (
+	// AccessTokensColumns holds the columns for the "access_tokens" table.
+	AccessTokensColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "token_hash", Type: field.TypeString, Unique: true},
+		{Name: "prefix", Type: field.TypeString},
+		{Name: "last_used_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// AccessTokensTable holds the schema information for the "access_tokens" table.
+	AccessTokensTable = &schema.Table{
+		Name:       "access_tokens",
+		Columns:    AccessTokensColumns,
+		PrimaryKey: []*schema.Column{AccessTokensColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "access_tokens_users_access_tokens",
+				Columns:    []*schema.Column{AccessTokensColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "accesstoken_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{AccessTokensColumns[6]},
+			},
+		},
+	}
 	// ArticlesColumns holds the columns for the "articles" table.
 	ArticlesColumns = []*schema.Column{
 		{Name: "id", Type: field.TypeUint, Increment: true},
 		{Name: "title", Type: field.TypeString},
 		{Name: "content", Type: field.TypeString, Size: 2147483647},
-		{Name: "url", Type: field.TypeString, Unique: true},
+		{Name: "content_text", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "word_count", Type: field.TypeInt, Nullable: true},
+		{Name: "reading_minutes", Type: field.TypeInt, Nullable: true},
+		{Name: "language", Type: field.TypeString, Nullable: true},
+		{Name: "image_count", Type: field.TypeInt, Nullable: true},
+		{Name: "lead_image", Type: field.TypeString, Nullable: true},
+		{Name: "url", Type: field.TypeString},
+		{Name: "canonical_url", Type: field.TypeString, Nullable: true},
+		{Name: "fingerprint", Type: field.TypeUint64, Nullable: true},
 		{Name: "author", Type: field.TypeString},
 		{Name: "source", Type: field.TypeString},
 		{Name: "summary", Type: field.TypeString, Nullable: true},
 		{Name: "tags", Type: field.TypeJSON, Nullable: true},
 		{Name: "published_at", Type: field.TypeTime},
+		{Name: "cluster_checked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "fetch_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"pending", "done", "failed"}},
+		{Name: "fetch_error", Type: field.TypeString, Nullable: true},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "source_status", Type: field.TypeEnum, Nullable: true, Enums: []string{"alive", "deleted", "moved", "changed"}},
+		{Name: "source_moved_to", Type: field.TypeString, Nullable: true},
+		{Name: "source_checked_at", Type: field.TypeTime, Nullable: true},
+		{Name: "source_fingerprint", Type: field.TypeUint64, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "cluster_id", Type: field.TypeInt, Nullable: true},
 		{Name: "user_articles", Type: field.TypeInt},
 	}
 	// ArticlesTable holds the schema information for the "articles" table.
@@ -29,9 +77,15 @@ var (
 		Columns:    ArticlesColumns,
 		PrimaryKey: []*schema.Column{ArticlesColumns[0]},
 		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "articles_topic_clusters_articles",
+				Columns:    []*schema.Column{ArticlesColumns[27]},
+				RefColumns: []*schema.Column{TopicClustersColumns[0]},
+				OnDelete:   schema.SetNull,
+			},
 			{
 				Symbol:     "articles_users_articles",
-				Columns:    []*schema.Column{ArticlesColumns[11]},
+				Columns:    []*schema.Column{ArticlesColumns[28]},
 				RefColumns: []*schema.Column{UsersColumns[0]},
 				OnDelete:   schema.NoAction,
 			},
@@ -43,19 +97,468 @@ var (
 				Columns: []*schema.Column{ArticlesColumns[1]},
 			},
 			{
-				Name:    "article_url",
+				Name:    "article_user_articles_url",
+				Unique:  true,
+				Columns: []*schema.Column{ArticlesColumns[28], ArticlesColumns[9]},
+			},
+			{
+				Name:    "article_canonical_url",
 				Unique:  false,
-				Columns: []*schema.Column{ArticlesColumns[3]},
+				Columns: []*schema.Column{ArticlesColumns[10]},
 			},
 			{
 				Name:    "article_author",
 				Unique:  false,
-				Columns: []*schema.Column{ArticlesColumns[4]},
+				Columns: []*schema.Column{ArticlesColumns[12]},
 			},
 			{
 				Name:    "article_published_at",
 				Unique:  false,
-				Columns: []*schema.Column{ArticlesColumns[8]},
+				Columns: []*schema.Column{ArticlesColumns[16]},
+			},
+			{
+				Name:    "article_fetch_status",
+				Unique:  false,
+				Columns: []*schema.Column{ArticlesColumns[18]},
+			},
+			{
+				Name:    "article_source_status",
+				Unique:  false,
+				Columns: []*schema.Column{ArticlesColumns[21]},
+			},
+			{
+				Name:    "article_source_checked_at",
+				Unique:  false,
+				Columns: []*schema.Column{ArticlesColumns[23]},
+			},
+			{
+				Name:    "article_reading_minutes",
+				Unique:  false,
+				Columns: []*schema.Column{ArticlesColumns[5]},
+			},
+			{
+				Name:    "article_word_count",
+				Unique:  false,
+				Columns: []*schema.Column{ArticlesColumns[4]},
+			},
+			{
+				Name:    "article_language",
+				Unique:  false,
+				Columns: []*schema.Column{ArticlesColumns[6]},
+			},
+		},
+	}
+	// ArticleChunksColumns holds the columns for the "article_chunks" table.
+	ArticleChunksColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "seq", Type: field.TypeInt},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "content_hash", Type: field.TypeString},
+		{Name: "model", Type: field.TypeString},
+		{Name: "embedding", Type: field.TypeJSON},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "article_id", Type: field.TypeUint},
+	}
+	// ArticleChunksTable holds the schema information for the "article_chunks" table.
+	ArticleChunksTable = &schema.Table{
+		Name:       "article_chunks",
+		Columns:    ArticleChunksColumns,
+		PrimaryKey: []*schema.Column{ArticleChunksColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "article_chunks_articles_chunks",
+				Columns:    []*schema.Column{ArticleChunksColumns[7]},
+				RefColumns: []*schema.Column{ArticlesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "articlechunk_article_id_seq",
+				Unique:  false,
+				Columns: []*schema.Column{ArticleChunksColumns[7], ArticleChunksColumns[1]},
+			},
+		},
+	}
+	// ArticleEnrichmentsColumns holds the columns for the "article_enrichments" table.
+	ArticleEnrichmentsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "data", Type: field.TypeJSON, Nullable: true},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"text", "list", "json"}, Default: "text"},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "article_id", Type: field.TypeUint},
+	}
+	// ArticleEnrichmentsTable holds the schema information for the "article_enrichments" table.
+	ArticleEnrichmentsTable = &schema.Table{
+		Name:       "article_enrichments",
+		Columns:    ArticleEnrichmentsColumns,
+		PrimaryKey: []*schema.Column{ArticleEnrichmentsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "article_enrichments_articles_enrichments",
+				Columns:    []*schema.Column{ArticleEnrichmentsColumns[7]},
+				RefColumns: []*schema.Column{ArticlesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "articleenrichment_article_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{ArticleEnrichmentsColumns[7], ArticleEnrichmentsColumns[1]},
+			},
+		},
+	}
+	// ArticleRevisionsColumns holds the columns for the "article_revisions" table.
+	ArticleRevisionsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "title", Type: field.TypeString},
+		{Name: "content", Type: field.TypeString, Size: 2147483647},
+		{Name: "summary", Type: field.TypeString, Nullable: true},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "article_id", Type: field.TypeUint},
+	}
+	// ArticleRevisionsTable holds the schema information for the "article_revisions" table.
+	ArticleRevisionsTable = &schema.Table{
+		Name:       "article_revisions",
+		Columns:    ArticleRevisionsColumns,
+		PrimaryKey: []*schema.Column{ArticleRevisionsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "article_revisions_articles_revisions",
+				Columns:    []*schema.Column{ArticleRevisionsColumns[6]},
+				RefColumns: []*schema.Column{ArticlesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "articlerevision_article_id",
+				Unique:  false,
+				Columns: []*schema.Column{ArticleRevisionsColumns[6]},
+			},
+		},
+	}
+	// ArticleTranslationsColumns holds the columns for the "article_translations" table.
+	ArticleTranslationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "language", Type: field.TypeString},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "done", "failed"}, Default: "pending"},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "content", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "bilingual", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "source_fingerprint", Type: field.TypeUint64, Nullable: true},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "article_id", Type: field.TypeUint},
+	}
+	// ArticleTranslationsTable holds the schema information for the "article_translations" table.
+	ArticleTranslationsTable = &schema.Table{
+		Name:       "article_translations",
+		Columns:    ArticleTranslationsColumns,
+		PrimaryKey: []*schema.Column{ArticleTranslationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "article_translations_articles_translations",
+				Columns:    []*schema.Column{ArticleTranslationsColumns[10]},
+				RefColumns: []*schema.Column{ArticlesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "articletranslation_article_id_language",
+				Unique:  true,
+				Columns: []*schema.Column{ArticleTranslationsColumns[10], ArticleTranslationsColumns[1]},
+			},
+			{
+				Name:    "articletranslation_status",
+				Unique:  false,
+				Columns: []*schema.Column{ArticleTranslationsColumns[2]},
+			},
+		},
+	}
+	// ExportsColumns holds the columns for the "exports" table.
+	ExportsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "status", Type: field.TypeEnum, Enums: []string{"pending", "running", "done", "failed"}, Default: "pending"},
+		{Name: "path", Type: field.TypeString, Nullable: true},
+		{Name: "size", Type: field.TypeInt64, Default: 0},
+		{Name: "article_count", Type: field.TypeInt, Default: 0},
+		{Name: "error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "started_at", Type: field.TypeTime, Nullable: true},
+		{Name: "finished_at", Type: field.TypeTime, Nullable: true},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// ExportsTable holds the schema information for the "exports" table.
+	ExportsTable = &schema.Table{
+		Name:       "exports",
+		Columns:    ExportsColumns,
+		PrimaryKey: []*schema.Column{ExportsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "exports_users_exports",
+				Columns:    []*schema.Column{ExportsColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "export_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{ExportsColumns[9]},
+			},
+			{
+				Name:    "export_status",
+				Unique:  false,
+				Columns: []*schema.Column{ExportsColumns[1]},
+			},
+		},
+	}
+	// FeedsColumns holds the columns for the "feeds" table.
+	FeedsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "url", Type: field.TypeString},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "site_url", Type: field.TypeString, Nullable: true},
+		{Name: "rules", Type: field.TypeJSON, Nullable: true},
+		{Name: "tags", Type: field.TypeJSON, Nullable: true},
+		{Name: "enabled", Type: field.TypeBool, Default: true},
+		{Name: "etag", Type: field.TypeString, Nullable: true},
+		{Name: "last_modified", Type: field.TypeString, Nullable: true},
+		{Name: "seen", Type: field.TypeJSON, Nullable: true},
+		{Name: "last_fetched_at", Type: field.TypeTime, Nullable: true},
+		{Name: "last_success_at", Type: field.TypeTime, Nullable: true},
+		{Name: "error_count", Type: field.TypeInt, Default: 0},
+		{Name: "last_error", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// FeedsTable holds the schema information for the "feeds" table.
+	FeedsTable = &schema.Table{
+		Name:       "feeds",
+		Columns:    FeedsColumns,
+		PrimaryKey: []*schema.Column{FeedsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "feeds_users_feeds",
+				Columns:    []*schema.Column{FeedsColumns[16]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "feed_user_id_url",
+				Unique:  true,
+				Columns: []*schema.Column{FeedsColumns[16], FeedsColumns[1]},
+			},
+		},
+	}
+	// HighlightsColumns holds the columns for the "highlights" table.
+	HighlightsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "text", Type: field.TypeString, Size: 2147483647},
+		{Name: "note", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "location", Type: field.TypeString, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "article_id", Type: field.TypeUint},
+	}
+	// HighlightsTable holds the schema information for the "highlights" table.
+	HighlightsTable = &schema.Table{
+		Name:       "highlights",
+		Columns:    HighlightsColumns,
+		PrimaryKey: []*schema.Column{HighlightsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "highlights_articles_highlights",
+				Columns:    []*schema.Column{HighlightsColumns[6]},
+				RefColumns: []*schema.Column{ArticlesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "highlight_article_id",
+				Unique:  false,
+				Columns: []*schema.Column{HighlightsColumns[6]},
+			},
+		},
+	}
+	// ImagesColumns holds the columns for the "images" table.
+	ImagesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "hash", Type: field.TypeString},
+		{Name: "content_type", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "source_url", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// ImagesTable holds the schema information for the "images" table.
+	ImagesTable = &schema.Table{
+		Name:       "images",
+		Columns:    ImagesColumns,
+		PrimaryKey: []*schema.Column{ImagesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "images_users_images",
+				Columns:    []*schema.Column{ImagesColumns[6]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "image_user_id_hash",
+				Unique:  true,
+				Columns: []*schema.Column{ImagesColumns[6], ImagesColumns[1]},
+			},
+			{
+				Name:    "image_hash",
+				Unique:  false,
+				Columns: []*schema.Column{ImagesColumns[1]},
+			},
+		},
+	}
+	// NotificationsColumns holds the columns for the "notifications" table.
+	NotificationsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "kind", Type: field.TypeString},
+		{Name: "title", Type: field.TypeString},
+		{Name: "body", Type: field.TypeString, Nullable: true, Size: 2147483647},
+		{Name: "article_id", Type: field.TypeUint, Nullable: true},
+		{Name: "read_at", Type: field.TypeTime, Nullable: true},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// NotificationsTable holds the schema information for the "notifications" table.
+	NotificationsTable = &schema.Table{
+		Name:       "notifications",
+		Columns:    NotificationsColumns,
+		PrimaryKey: []*schema.Column{NotificationsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "notifications_users_notifications",
+				Columns:    []*schema.Column{NotificationsColumns[7]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "notification_user_id_read_at",
+				Unique:  false,
+				Columns: []*schema.Column{NotificationsColumns[7], NotificationsColumns[5]},
+			},
+		},
+	}
+	// PromptTemplatesColumns holds the columns for the "prompt_templates" table.
+	PromptTemplatesColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "title", Type: field.TypeString, Nullable: true},
+		{Name: "template", Type: field.TypeString, Size: 2147483647},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"text", "list", "json"}, Default: "text"},
+		{Name: "auto", Type: field.TypeBool, Default: false},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// PromptTemplatesTable holds the schema information for the "prompt_templates" table.
+	PromptTemplatesTable = &schema.Table{
+		Name:       "prompt_templates",
+		Columns:    PromptTemplatesColumns,
+		PrimaryKey: []*schema.Column{PromptTemplatesColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "prompt_templates_users_prompt_templates",
+				Columns:    []*schema.Column{PromptTemplatesColumns[8]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "prompttemplate_user_id_name",
+				Unique:  true,
+				Columns: []*schema.Column{PromptTemplatesColumns[8], PromptTemplatesColumns[1]},
+			},
+		},
+	}
+	// SnapshotsColumns holds the columns for the "snapshots" table.
+	SnapshotsColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "format", Type: field.TypeEnum, Enums: []string{"html", "warc"}},
+		{Name: "storage_key", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt64},
+		{Name: "sha256", Type: field.TypeString},
+		{Name: "source_url", Type: field.TypeString, Size: 2147483647},
+		{Name: "status_code", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "article_id", Type: field.TypeUint},
+	}
+	// SnapshotsTable holds the schema information for the "snapshots" table.
+	SnapshotsTable = &schema.Table{
+		Name:       "snapshots",
+		Columns:    SnapshotsColumns,
+		PrimaryKey: []*schema.Column{SnapshotsColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "snapshots_articles_snapshots",
+				Columns:    []*schema.Column{SnapshotsColumns[8]},
+				RefColumns: []*schema.Column{ArticlesColumns[0]},
+				OnDelete:   schema.Cascade,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "snapshot_article_id",
+				Unique:  false,
+				Columns: []*schema.Column{SnapshotsColumns[8]},
+			},
+		},
+	}
+	// TopicClustersColumns holds the columns for the "topic_clusters" table.
+	TopicClustersColumns = []*schema.Column{
+		{Name: "id", Type: field.TypeInt, Increment: true},
+		{Name: "name", Type: field.TypeString},
+		{Name: "keywords", Type: field.TypeJSON, Nullable: true},
+		{Name: "centroid", Type: field.TypeJSON},
+		{Name: "model", Type: field.TypeString},
+		{Name: "size", Type: field.TypeInt},
+		{Name: "named_size", Type: field.TypeInt},
+		{Name: "created_at", Type: field.TypeTime},
+		{Name: "updated_at", Type: field.TypeTime},
+		{Name: "user_id", Type: field.TypeInt},
+	}
+	// TopicClustersTable holds the schema information for the "topic_clusters" table.
+	TopicClustersTable = &schema.Table{
+		Name:       "topic_clusters",
+		Columns:    TopicClustersColumns,
+		PrimaryKey: []*schema.Column{TopicClustersColumns[0]},
+		ForeignKeys: []*schema.ForeignKey{
+			{
+				Symbol:     "topic_clusters_users_clusters",
+				Columns:    []*schema.Column{TopicClustersColumns[9]},
+				RefColumns: []*schema.Column{UsersColumns[0]},
+				OnDelete:   schema.NoAction,
+			},
+		},
+		Indexes: []*schema.Index{
+			{
+				Name:    "topiccluster_user_id",
+				Unique:  false,
+				Columns: []*schema.Column{TopicClustersColumns[9]},
 			},
 		},
 	}
@@ -67,6 +570,8 @@ var (
 		{Name: "email", Type: field.TypeString, Nullable: true},
 		{Name: "wx_open_id", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "nickname", Type: field.TypeString, Nullable: true},
+		{Name: "feed_token", Type: field.TypeString, Unique: true, Nullable: true},
+		{Name: "mail_token", Type: field.TypeString, Unique: true, Nullable: true},
 		{Name: "created_at", Type: field.TypeTime},
 		{Name: "updated_at", Type: field.TypeTime},
 	}
@@ -95,11 +600,38 @@ var (
 	}
 	// Tables holds all the tables in the schema.
 	Tables = []*schema.Table{
+		AccessTokensTable,
 		ArticlesTable,
+		ArticleChunksTable,
+		ArticleEnrichmentsTable,
+		ArticleRevisionsTable,
+		ArticleTranslationsTable,
+		ExportsTable,
+		FeedsTable,
+		HighlightsTable,
+		ImagesTable,
+		NotificationsTable,
+		PromptTemplatesTable,
+		SnapshotsTable,
+		TopicClustersTable,
 		UsersTable,
 	}
 )
 
 func init() {
-	ArticlesTable.ForeignKeys[0].RefTable = UsersTable
+	AccessTokensTable.ForeignKeys[0].RefTable = UsersTable
+	ArticlesTable.ForeignKeys[0].RefTable = TopicClustersTable
+	ArticlesTable.ForeignKeys[1].RefTable = UsersTable
+	ArticleChunksTable.ForeignKeys[0].RefTable = ArticlesTable
+	ArticleEnrichmentsTable.ForeignKeys[0].RefTable = ArticlesTable
+	ArticleRevisionsTable.ForeignKeys[0].RefTable = ArticlesTable
+	ArticleTranslationsTable.ForeignKeys[0].RefTable = ArticlesTable
+	ExportsTable.ForeignKeys[0].RefTable = UsersTable
+	FeedsTable.ForeignKeys[0].RefTable = UsersTable
+	HighlightsTable.ForeignKeys[0].RefTable = ArticlesTable
+	ImagesTable.ForeignKeys[0].RefTable = UsersTable
+	NotificationsTable.ForeignKeys[0].RefTable = UsersTable
+	PromptTemplatesTable.ForeignKeys[0].RefTable = UsersTable
+	SnapshotsTable.ForeignKeys[0].RefTable = ArticlesTable
+	TopicClustersTable.ForeignKeys[0].RefTable = UsersTable
 }
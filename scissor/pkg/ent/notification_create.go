@@ -0,0 +1,317 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/notification"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
+)
+
+// NotificationCreate is the builder for creating a Notification entity.
+type NotificationCreate struct {
+	config
+	mutation *NotificationMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (nc *NotificationCreate) SetUserID(i int) *NotificationCreate {
+	nc.mutation.SetUserID(i)
+	return nc
+}
+
+// SetKind sets the "kind" field.
+func (nc *NotificationCreate) SetKind(s string) *NotificationCreate {
+	nc.mutation.SetKind(s)
+	return nc
+}
+
+// SetTitle sets the "title" field.
+func (nc *NotificationCreate) SetTitle(s string) *NotificationCreate {
+	nc.mutation.SetTitle(s)
+	return nc
+}
+
+// SetBody sets the "body" field.
+func (nc *NotificationCreate) SetBody(s string) *NotificationCreate {
+	nc.mutation.SetBody(s)
+	return nc
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableBody(s *string) *NotificationCreate {
+	if s != nil {
+		nc.SetBody(*s)
+	}
+	return nc
+}
+
+// SetArticleID sets the "article_id" field.
+func (nc *NotificationCreate) SetArticleID(u uint) *NotificationCreate {
+	nc.mutation.SetArticleID(u)
+	return nc
+}
+
+// SetNillableArticleID sets the "article_id" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableArticleID(u *uint) *NotificationCreate {
+	if u != nil {
+		nc.SetArticleID(*u)
+	}
+	return nc
+}
+
+// SetReadAt sets the "read_at" field.
+func (nc *NotificationCreate) SetReadAt(t time.Time) *NotificationCreate {
+	nc.mutation.SetReadAt(t)
+	return nc
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableReadAt(t *time.Time) *NotificationCreate {
+	if t != nil {
+		nc.SetReadAt(*t)
+	}
+	return nc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (nc *NotificationCreate) SetCreatedAt(t time.Time) *NotificationCreate {
+	nc.mutation.SetCreatedAt(t)
+	return nc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (nc *NotificationCreate) SetNillableCreatedAt(t *time.Time) *NotificationCreate {
+	if t != nil {
+		nc.SetCreatedAt(*t)
+	}
+	return nc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (nc *NotificationCreate) SetUser(u *User) *NotificationCreate {
+	return nc.SetUserID(u.ID)
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nc *NotificationCreate) Mutation() *NotificationMutation {
+	return nc.mutation
+}
+
+// Save creates the Notification in the database.
+func (nc *NotificationCreate) Save(ctx context.Context) (*Notification, error) {
+	nc.defaults()
+	return withHooks(ctx, nc.sqlSave, nc.mutation, nc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (nc *NotificationCreate) SaveX(ctx context.Context) *Notification {
+	v, err := nc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (nc *NotificationCreate) Exec(ctx context.Context) error {
+	_, err := nc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nc *NotificationCreate) ExecX(ctx context.Context) {
+	if err := nc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (nc *NotificationCreate) defaults() {
+	if _, ok := nc.mutation.CreatedAt(); !ok {
+		v := notification.DefaultCreatedAt()
+		nc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nc *NotificationCreate) check() error {
+	if _, ok := nc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "Notification.user_id"`)}
+	}
+	if _, ok := nc.mutation.Kind(); !ok {
+		return &ValidationError{Name: "kind", err: errors.New(`ent: missing required field "Notification.kind"`)}
+	}
+	if _, ok := nc.mutation.Title(); !ok {
+		return &ValidationError{Name: "title", err: errors.New(`ent: missing required field "Notification.title"`)}
+	}
+	if _, ok := nc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Notification.created_at"`)}
+	}
+	if _, ok := nc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "Notification.user"`)}
+	}
+	return nil
+}
+
+func (nc *NotificationCreate) sqlSave(ctx context.Context) (*Notification, error) {
+	if err := nc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := nc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, nc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	nc.mutation.id = &_node.ID
+	nc.mutation.done = true
+	return _node, nil
+}
+
+func (nc *NotificationCreate) createSpec() (*Notification, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Notification{config: nc.config}
+		_spec = sqlgraph.NewCreateSpec(notification.Table, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt))
+	)
+	if value, ok := nc.mutation.Kind(); ok {
+		_spec.SetField(notification.FieldKind, field.TypeString, value)
+		_node.Kind = value
+	}
+	if value, ok := nc.mutation.Title(); ok {
+		_spec.SetField(notification.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := nc.mutation.Body(); ok {
+		_spec.SetField(notification.FieldBody, field.TypeString, value)
+		_node.Body = value
+	}
+	if value, ok := nc.mutation.ArticleID(); ok {
+		_spec.SetField(notification.FieldArticleID, field.TypeUint, value)
+		_node.ArticleID = &value
+	}
+	if value, ok := nc.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+		_node.ReadAt = &value
+	}
+	if value, ok := nc.mutation.CreatedAt(); ok {
+		_spec.SetField(notification.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := nc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.UserTable,
+			Columns: []string{notification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// NotificationCreateBulk is the builder for creating many Notification entities in bulk.
+type NotificationCreateBulk struct {
+	config
+	err      error
+	builders []*NotificationCreate
+}
+
+// Save creates the Notification entities in the database.
+func (ncb *NotificationCreateBulk) Save(ctx context.Context) ([]*Notification, error) {
+	if ncb.err != nil {
+		return nil, ncb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ncb.builders))
+	nodes := make([]*Notification, len(ncb.builders))
+	mutators := make([]Mutator, len(ncb.builders))
+	for i := range ncb.builders {
+		func(i int, root context.Context) {
+			builder := ncb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*NotificationMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ncb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ncb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ncb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ncb *NotificationCreateBulk) SaveX(ctx context.Context) []*Notification {
+	v, err := ncb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ncb *NotificationCreateBulk) Exec(ctx context.Context) error {
+	_, err := ncb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ncb *NotificationCreateBulk) ExecX(ctx context.Context) {
+	if err := ncb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
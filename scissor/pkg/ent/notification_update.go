@@ -0,0 +1,551 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/notification"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
+)
+
+// NotificationUpdate is the builder for updating Notification entities.
+type NotificationUpdate struct {
+	config
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// Where appends a list predicates to the NotificationUpdate builder.
+func (nu *NotificationUpdate) Where(ps ...predicate.Notification) *NotificationUpdate {
+	nu.mutation.Where(ps...)
+	return nu
+}
+
+// SetUserID sets the "user_id" field.
+func (nu *NotificationUpdate) SetUserID(i int) *NotificationUpdate {
+	nu.mutation.SetUserID(i)
+	return nu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableUserID(i *int) *NotificationUpdate {
+	if i != nil {
+		nu.SetUserID(*i)
+	}
+	return nu
+}
+
+// SetKind sets the "kind" field.
+func (nu *NotificationUpdate) SetKind(s string) *NotificationUpdate {
+	nu.mutation.SetKind(s)
+	return nu
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableKind(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetKind(*s)
+	}
+	return nu
+}
+
+// SetTitle sets the "title" field.
+func (nu *NotificationUpdate) SetTitle(s string) *NotificationUpdate {
+	nu.mutation.SetTitle(s)
+	return nu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableTitle(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetTitle(*s)
+	}
+	return nu
+}
+
+// SetBody sets the "body" field.
+func (nu *NotificationUpdate) SetBody(s string) *NotificationUpdate {
+	nu.mutation.SetBody(s)
+	return nu
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableBody(s *string) *NotificationUpdate {
+	if s != nil {
+		nu.SetBody(*s)
+	}
+	return nu
+}
+
+// ClearBody clears the value of the "body" field.
+func (nu *NotificationUpdate) ClearBody() *NotificationUpdate {
+	nu.mutation.ClearBody()
+	return nu
+}
+
+// SetArticleID sets the "article_id" field.
+func (nu *NotificationUpdate) SetArticleID(u uint) *NotificationUpdate {
+	nu.mutation.ResetArticleID()
+	nu.mutation.SetArticleID(u)
+	return nu
+}
+
+// SetNillableArticleID sets the "article_id" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableArticleID(u *uint) *NotificationUpdate {
+	if u != nil {
+		nu.SetArticleID(*u)
+	}
+	return nu
+}
+
+// AddArticleID adds u to the "article_id" field.
+func (nu *NotificationUpdate) AddArticleID(u int) *NotificationUpdate {
+	nu.mutation.AddArticleID(u)
+	return nu
+}
+
+// ClearArticleID clears the value of the "article_id" field.
+func (nu *NotificationUpdate) ClearArticleID() *NotificationUpdate {
+	nu.mutation.ClearArticleID()
+	return nu
+}
+
+// SetReadAt sets the "read_at" field.
+func (nu *NotificationUpdate) SetReadAt(t time.Time) *NotificationUpdate {
+	nu.mutation.SetReadAt(t)
+	return nu
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nu *NotificationUpdate) SetNillableReadAt(t *time.Time) *NotificationUpdate {
+	if t != nil {
+		nu.SetReadAt(*t)
+	}
+	return nu
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (nu *NotificationUpdate) ClearReadAt() *NotificationUpdate {
+	nu.mutation.ClearReadAt()
+	return nu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (nu *NotificationUpdate) SetUser(u *User) *NotificationUpdate {
+	return nu.SetUserID(u.ID)
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nu *NotificationUpdate) Mutation() *NotificationMutation {
+	return nu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (nu *NotificationUpdate) ClearUser() *NotificationUpdate {
+	nu.mutation.ClearUser()
+	return nu
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (nu *NotificationUpdate) Save(ctx context.Context) (int, error) {
+	return withHooks(ctx, nu.sqlSave, nu.mutation, nu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nu *NotificationUpdate) SaveX(ctx context.Context) int {
+	affected, err := nu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (nu *NotificationUpdate) Exec(ctx context.Context) error {
+	_, err := nu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nu *NotificationUpdate) ExecX(ctx context.Context) {
+	if err := nu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nu *NotificationUpdate) check() error {
+	if _, ok := nu.mutation.UserID(); nu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Notification.user"`)
+	}
+	return nil
+}
+
+func (nu *NotificationUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := nu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notification.Table, notification.Columns, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt))
+	if ps := nu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nu.mutation.Kind(); ok {
+		_spec.SetField(notification.FieldKind, field.TypeString, value)
+	}
+	if value, ok := nu.mutation.Title(); ok {
+		_spec.SetField(notification.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := nu.mutation.Body(); ok {
+		_spec.SetField(notification.FieldBody, field.TypeString, value)
+	}
+	if nu.mutation.BodyCleared() {
+		_spec.ClearField(notification.FieldBody, field.TypeString)
+	}
+	if value, ok := nu.mutation.ArticleID(); ok {
+		_spec.SetField(notification.FieldArticleID, field.TypeUint, value)
+	}
+	if value, ok := nu.mutation.AddedArticleID(); ok {
+		_spec.AddField(notification.FieldArticleID, field.TypeUint, value)
+	}
+	if nu.mutation.ArticleIDCleared() {
+		_spec.ClearField(notification.FieldArticleID, field.TypeUint)
+	}
+	if value, ok := nu.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+	}
+	if nu.mutation.ReadAtCleared() {
+		_spec.ClearField(notification.FieldReadAt, field.TypeTime)
+	}
+	if nu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.UserTable,
+			Columns: []string{notification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.UserTable,
+			Columns: []string{notification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, nu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	nu.mutation.done = true
+	return n, nil
+}
+
+// NotificationUpdateOne is the builder for updating a single Notification entity.
+type NotificationUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *NotificationMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (nuo *NotificationUpdateOne) SetUserID(i int) *NotificationUpdateOne {
+	nuo.mutation.SetUserID(i)
+	return nuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableUserID(i *int) *NotificationUpdateOne {
+	if i != nil {
+		nuo.SetUserID(*i)
+	}
+	return nuo
+}
+
+// SetKind sets the "kind" field.
+func (nuo *NotificationUpdateOne) SetKind(s string) *NotificationUpdateOne {
+	nuo.mutation.SetKind(s)
+	return nuo
+}
+
+// SetNillableKind sets the "kind" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableKind(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetKind(*s)
+	}
+	return nuo
+}
+
+// SetTitle sets the "title" field.
+func (nuo *NotificationUpdateOne) SetTitle(s string) *NotificationUpdateOne {
+	nuo.mutation.SetTitle(s)
+	return nuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableTitle(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetTitle(*s)
+	}
+	return nuo
+}
+
+// SetBody sets the "body" field.
+func (nuo *NotificationUpdateOne) SetBody(s string) *NotificationUpdateOne {
+	nuo.mutation.SetBody(s)
+	return nuo
+}
+
+// SetNillableBody sets the "body" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableBody(s *string) *NotificationUpdateOne {
+	if s != nil {
+		nuo.SetBody(*s)
+	}
+	return nuo
+}
+
+// ClearBody clears the value of the "body" field.
+func (nuo *NotificationUpdateOne) ClearBody() *NotificationUpdateOne {
+	nuo.mutation.ClearBody()
+	return nuo
+}
+
+// SetArticleID sets the "article_id" field.
+func (nuo *NotificationUpdateOne) SetArticleID(u uint) *NotificationUpdateOne {
+	nuo.mutation.ResetArticleID()
+	nuo.mutation.SetArticleID(u)
+	return nuo
+}
+
+// SetNillableArticleID sets the "article_id" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableArticleID(u *uint) *NotificationUpdateOne {
+	if u != nil {
+		nuo.SetArticleID(*u)
+	}
+	return nuo
+}
+
+// AddArticleID adds u to the "article_id" field.
+func (nuo *NotificationUpdateOne) AddArticleID(u int) *NotificationUpdateOne {
+	nuo.mutation.AddArticleID(u)
+	return nuo
+}
+
+// ClearArticleID clears the value of the "article_id" field.
+func (nuo *NotificationUpdateOne) ClearArticleID() *NotificationUpdateOne {
+	nuo.mutation.ClearArticleID()
+	return nuo
+}
+
+// SetReadAt sets the "read_at" field.
+func (nuo *NotificationUpdateOne) SetReadAt(t time.Time) *NotificationUpdateOne {
+	nuo.mutation.SetReadAt(t)
+	return nuo
+}
+
+// SetNillableReadAt sets the "read_at" field if the given value is not nil.
+func (nuo *NotificationUpdateOne) SetNillableReadAt(t *time.Time) *NotificationUpdateOne {
+	if t != nil {
+		nuo.SetReadAt(*t)
+	}
+	return nuo
+}
+
+// ClearReadAt clears the value of the "read_at" field.
+func (nuo *NotificationUpdateOne) ClearReadAt() *NotificationUpdateOne {
+	nuo.mutation.ClearReadAt()
+	return nuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (nuo *NotificationUpdateOne) SetUser(u *User) *NotificationUpdateOne {
+	return nuo.SetUserID(u.ID)
+}
+
+// Mutation returns the NotificationMutation object of the builder.
+func (nuo *NotificationUpdateOne) Mutation() *NotificationMutation {
+	return nuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (nuo *NotificationUpdateOne) ClearUser() *NotificationUpdateOne {
+	nuo.mutation.ClearUser()
+	return nuo
+}
+
+// Where appends a list predicates to the NotificationUpdate builder.
+func (nuo *NotificationUpdateOne) Where(ps ...predicate.Notification) *NotificationUpdateOne {
+	nuo.mutation.Where(ps...)
+	return nuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (nuo *NotificationUpdateOne) Select(field string, fields ...string) *NotificationUpdateOne {
+	nuo.fields = append([]string{field}, fields...)
+	return nuo
+}
+
+// Save executes the query and returns the updated Notification entity.
+func (nuo *NotificationUpdateOne) Save(ctx context.Context) (*Notification, error) {
+	return withHooks(ctx, nuo.sqlSave, nuo.mutation, nuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (nuo *NotificationUpdateOne) SaveX(ctx context.Context) *Notification {
+	node, err := nuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (nuo *NotificationUpdateOne) Exec(ctx context.Context) error {
+	_, err := nuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (nuo *NotificationUpdateOne) ExecX(ctx context.Context) {
+	if err := nuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (nuo *NotificationUpdateOne) check() error {
+	if _, ok := nuo.mutation.UserID(); nuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "Notification.user"`)
+	}
+	return nil
+}
+
+func (nuo *NotificationUpdateOne) sqlSave(ctx context.Context) (_node *Notification, err error) {
+	if err := nuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(notification.Table, notification.Columns, sqlgraph.NewFieldSpec(notification.FieldID, field.TypeInt))
+	id, ok := nuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "Notification.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := nuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, notification.FieldID)
+		for _, f := range fields {
+			if !notification.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != notification.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := nuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := nuo.mutation.Kind(); ok {
+		_spec.SetField(notification.FieldKind, field.TypeString, value)
+	}
+	if value, ok := nuo.mutation.Title(); ok {
+		_spec.SetField(notification.FieldTitle, field.TypeString, value)
+	}
+	if value, ok := nuo.mutation.Body(); ok {
+		_spec.SetField(notification.FieldBody, field.TypeString, value)
+	}
+	if nuo.mutation.BodyCleared() {
+		_spec.ClearField(notification.FieldBody, field.TypeString)
+	}
+	if value, ok := nuo.mutation.ArticleID(); ok {
+		_spec.SetField(notification.FieldArticleID, field.TypeUint, value)
+	}
+	if value, ok := nuo.mutation.AddedArticleID(); ok {
+		_spec.AddField(notification.FieldArticleID, field.TypeUint, value)
+	}
+	if nuo.mutation.ArticleIDCleared() {
+		_spec.ClearField(notification.FieldArticleID, field.TypeUint)
+	}
+	if value, ok := nuo.mutation.ReadAt(); ok {
+		_spec.SetField(notification.FieldReadAt, field.TypeTime, value)
+	}
+	if nuo.mutation.ReadAtCleared() {
+		_spec.ClearField(notification.FieldReadAt, field.TypeTime)
+	}
+	if nuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.UserTable,
+			Columns: []string{notification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := nuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   notification.UserTable,
+			Columns: []string{notification.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &Notification{config: nuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, nuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{notification.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	nuo.mutation.done = true
+	return _node, nil
+}
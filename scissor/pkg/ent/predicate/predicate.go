@@ -6,8 +6,47 @@ import (
 	"entgo.io/ent/dialect/sql"
 )
 
+// AccessToken is the predicate function for accesstoken builders.
+type AccessToken func(*sql.Selector)
+
 // Article is the predicate function for article builders.
 type Article func(*sql.Selector)
 
+// ArticleChunk is the predicate function for articlechunk builders.
+type ArticleChunk func(*sql.Selector)
+
+// ArticleEnrichment is the predicate function for articleenrichment builders.
+type ArticleEnrichment func(*sql.Selector)
+
+// ArticleRevision is the predicate function for articlerevision builders.
+type ArticleRevision func(*sql.Selector)
+
+// ArticleTranslation is the predicate function for articletranslation builders.
+type ArticleTranslation func(*sql.Selector)
+
+// Export is the predicate function for export builders.
+type Export func(*sql.Selector)
+
+// Feed is the predicate function for feed builders.
+type Feed func(*sql.Selector)
+
+// Highlight is the predicate function for highlight builders.
+type Highlight func(*sql.Selector)
+
+// Image is the predicate function for image builders.
+type Image func(*sql.Selector)
+
+// Notification is the predicate function for notification builders.
+type Notification func(*sql.Selector)
+
+// PromptTemplate is the predicate function for prompttemplate builders.
+type PromptTemplate func(*sql.Selector)
+
+// Snapshot is the predicate function for snapshot builders.
+type Snapshot func(*sql.Selector)
+
+// TopicCluster is the predicate function for topiccluster builders.
+type TopicCluster func(*sql.Selector)
+
 // User is the predicate function for user builders.
 type User func(*sql.Selector)
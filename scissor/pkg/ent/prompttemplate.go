@@ -0,0 +1,214 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/prompttemplate"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
+)
+
+// PromptTemplate is the model entity for the PromptTemplate schema.
+type PromptTemplate struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Title holds the value of the "title" field.
+	Title string `json:"title,omitempty"`
+	// Template holds the value of the "template" field.
+	Template string `json:"template,omitempty"`
+	// Format holds the value of the "format" field.
+	Format prompttemplate.Format `json:"format,omitempty"`
+	// Auto holds the value of the "auto" field.
+	Auto bool `json:"auto,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the PromptTemplateQuery when eager-loading is set.
+	Edges        PromptTemplateEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// PromptTemplateEdges holds the relations/edges for other nodes in the graph.
+type PromptTemplateEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e PromptTemplateEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*PromptTemplate) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case prompttemplate.FieldAuto:
+			values[i] = new(sql.NullBool)
+		case prompttemplate.FieldID, prompttemplate.FieldUserID:
+			values[i] = new(sql.NullInt64)
+		case prompttemplate.FieldName, prompttemplate.FieldTitle, prompttemplate.FieldTemplate, prompttemplate.FieldFormat:
+			values[i] = new(sql.NullString)
+		case prompttemplate.FieldCreatedAt, prompttemplate.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the PromptTemplate fields.
+func (pt *PromptTemplate) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case prompttemplate.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			pt.ID = int(value.Int64)
+		case prompttemplate.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				pt.UserID = int(value.Int64)
+			}
+		case prompttemplate.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				pt.Name = value.String
+			}
+		case prompttemplate.FieldTitle:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field title", values[i])
+			} else if value.Valid {
+				pt.Title = value.String
+			}
+		case prompttemplate.FieldTemplate:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field template", values[i])
+			} else if value.Valid {
+				pt.Template = value.String
+			}
+		case prompttemplate.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				pt.Format = prompttemplate.Format(value.String)
+			}
+		case prompttemplate.FieldAuto:
+			if value, ok := values[i].(*sql.NullBool); !ok {
+				return fmt.Errorf("unexpected type %T for field auto", values[i])
+			} else if value.Valid {
+				pt.Auto = value.Bool
+			}
+		case prompttemplate.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				pt.CreatedAt = value.Time
+			}
+		case prompttemplate.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				pt.UpdatedAt = value.Time
+			}
+		default:
+			pt.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the PromptTemplate.
+// This includes values selected through modifiers, order, etc.
+func (pt *PromptTemplate) Value(name string) (ent.Value, error) {
+	return pt.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the PromptTemplate entity.
+func (pt *PromptTemplate) QueryUser() *UserQuery {
+	return NewPromptTemplateClient(pt.config).QueryUser(pt)
+}
+
+// Update returns a builder for updating this PromptTemplate.
+// Note that you need to call PromptTemplate.Unwrap() before calling this method if this PromptTemplate
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (pt *PromptTemplate) Update() *PromptTemplateUpdateOne {
+	return NewPromptTemplateClient(pt.config).UpdateOne(pt)
+}
+
+// Unwrap unwraps the PromptTemplate entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (pt *PromptTemplate) Unwrap() *PromptTemplate {
+	_tx, ok := pt.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: PromptTemplate is not a transactional entity")
+	}
+	pt.config.driver = _tx.drv
+	return pt
+}
+
+// String implements the fmt.Stringer.
+func (pt *PromptTemplate) String() string {
+	var builder strings.Builder
+	builder.WriteString("PromptTemplate(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", pt.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", pt.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(pt.Name)
+	builder.WriteString(", ")
+	builder.WriteString("title=")
+	builder.WriteString(pt.Title)
+	builder.WriteString(", ")
+	builder.WriteString("template=")
+	builder.WriteString(pt.Template)
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(fmt.Sprintf("%v", pt.Format))
+	builder.WriteString(", ")
+	builder.WriteString("auto=")
+	builder.WriteString(fmt.Sprintf("%v", pt.Auto))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(pt.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(pt.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// PromptTemplates is a parsable slice of PromptTemplate.
+type PromptTemplates []*PromptTemplate
@@ -0,0 +1,361 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/prompttemplate"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
+)
+
+// PromptTemplateCreate is the builder for creating a PromptTemplate entity.
+type PromptTemplateCreate struct {
+	config
+	mutation *PromptTemplateMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (ptc *PromptTemplateCreate) SetUserID(i int) *PromptTemplateCreate {
+	ptc.mutation.SetUserID(i)
+	return ptc
+}
+
+// SetName sets the "name" field.
+func (ptc *PromptTemplateCreate) SetName(s string) *PromptTemplateCreate {
+	ptc.mutation.SetName(s)
+	return ptc
+}
+
+// SetTitle sets the "title" field.
+func (ptc *PromptTemplateCreate) SetTitle(s string) *PromptTemplateCreate {
+	ptc.mutation.SetTitle(s)
+	return ptc
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (ptc *PromptTemplateCreate) SetNillableTitle(s *string) *PromptTemplateCreate {
+	if s != nil {
+		ptc.SetTitle(*s)
+	}
+	return ptc
+}
+
+// SetTemplate sets the "template" field.
+func (ptc *PromptTemplateCreate) SetTemplate(s string) *PromptTemplateCreate {
+	ptc.mutation.SetTemplate(s)
+	return ptc
+}
+
+// SetFormat sets the "format" field.
+func (ptc *PromptTemplateCreate) SetFormat(pr prompttemplate.Format) *PromptTemplateCreate {
+	ptc.mutation.SetFormat(pr)
+	return ptc
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (ptc *PromptTemplateCreate) SetNillableFormat(pr *prompttemplate.Format) *PromptTemplateCreate {
+	if pr != nil {
+		ptc.SetFormat(*pr)
+	}
+	return ptc
+}
+
+// SetAuto sets the "auto" field.
+func (ptc *PromptTemplateCreate) SetAuto(b bool) *PromptTemplateCreate {
+	ptc.mutation.SetAuto(b)
+	return ptc
+}
+
+// SetNillableAuto sets the "auto" field if the given value is not nil.
+func (ptc *PromptTemplateCreate) SetNillableAuto(b *bool) *PromptTemplateCreate {
+	if b != nil {
+		ptc.SetAuto(*b)
+	}
+	return ptc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (ptc *PromptTemplateCreate) SetCreatedAt(t time.Time) *PromptTemplateCreate {
+	ptc.mutation.SetCreatedAt(t)
+	return ptc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (ptc *PromptTemplateCreate) SetNillableCreatedAt(t *time.Time) *PromptTemplateCreate {
+	if t != nil {
+		ptc.SetCreatedAt(*t)
+	}
+	return ptc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ptc *PromptTemplateCreate) SetUpdatedAt(t time.Time) *PromptTemplateCreate {
+	ptc.mutation.SetUpdatedAt(t)
+	return ptc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (ptc *PromptTemplateCreate) SetNillableUpdatedAt(t *time.Time) *PromptTemplateCreate {
+	if t != nil {
+		ptc.SetUpdatedAt(*t)
+	}
+	return ptc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (ptc *PromptTemplateCreate) SetUser(u *User) *PromptTemplateCreate {
+	return ptc.SetUserID(u.ID)
+}
+
+// Mutation returns the PromptTemplateMutation object of the builder.
+func (ptc *PromptTemplateCreate) Mutation() *PromptTemplateMutation {
+	return ptc.mutation
+}
+
+// Save creates the PromptTemplate in the database.
+func (ptc *PromptTemplateCreate) Save(ctx context.Context) (*PromptTemplate, error) {
+	ptc.defaults()
+	return withHooks(ctx, ptc.sqlSave, ptc.mutation, ptc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (ptc *PromptTemplateCreate) SaveX(ctx context.Context) *PromptTemplate {
+	v, err := ptc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ptc *PromptTemplateCreate) Exec(ctx context.Context) error {
+	_, err := ptc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptc *PromptTemplateCreate) ExecX(ctx context.Context) {
+	if err := ptc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ptc *PromptTemplateCreate) defaults() {
+	if _, ok := ptc.mutation.Format(); !ok {
+		v := prompttemplate.DefaultFormat
+		ptc.mutation.SetFormat(v)
+	}
+	if _, ok := ptc.mutation.Auto(); !ok {
+		v := prompttemplate.DefaultAuto
+		ptc.mutation.SetAuto(v)
+	}
+	if _, ok := ptc.mutation.CreatedAt(); !ok {
+		v := prompttemplate.DefaultCreatedAt()
+		ptc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := ptc.mutation.UpdatedAt(); !ok {
+		v := prompttemplate.DefaultUpdatedAt()
+		ptc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ptc *PromptTemplateCreate) check() error {
+	if _, ok := ptc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "PromptTemplate.user_id"`)}
+	}
+	if _, ok := ptc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "PromptTemplate.name"`)}
+	}
+	if _, ok := ptc.mutation.Template(); !ok {
+		return &ValidationError{Name: "template", err: errors.New(`ent: missing required field "PromptTemplate.template"`)}
+	}
+	if _, ok := ptc.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "PromptTemplate.format"`)}
+	}
+	if v, ok := ptc.mutation.Format(); ok {
+		if err := prompttemplate.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "PromptTemplate.format": %w`, err)}
+		}
+	}
+	if _, ok := ptc.mutation.Auto(); !ok {
+		return &ValidationError{Name: "auto", err: errors.New(`ent: missing required field "PromptTemplate.auto"`)}
+	}
+	if _, ok := ptc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "PromptTemplate.created_at"`)}
+	}
+	if _, ok := ptc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "PromptTemplate.updated_at"`)}
+	}
+	if _, ok := ptc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "PromptTemplate.user"`)}
+	}
+	return nil
+}
+
+func (ptc *PromptTemplateCreate) sqlSave(ctx context.Context) (*PromptTemplate, error) {
+	if err := ptc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := ptc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, ptc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	ptc.mutation.id = &_node.ID
+	ptc.mutation.done = true
+	return _node, nil
+}
+
+func (ptc *PromptTemplateCreate) createSpec() (*PromptTemplate, *sqlgraph.CreateSpec) {
+	var (
+		_node = &PromptTemplate{config: ptc.config}
+		_spec = sqlgraph.NewCreateSpec(prompttemplate.Table, sqlgraph.NewFieldSpec(prompttemplate.FieldID, field.TypeInt))
+	)
+	if value, ok := ptc.mutation.Name(); ok {
+		_spec.SetField(prompttemplate.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := ptc.mutation.Title(); ok {
+		_spec.SetField(prompttemplate.FieldTitle, field.TypeString, value)
+		_node.Title = value
+	}
+	if value, ok := ptc.mutation.Template(); ok {
+		_spec.SetField(prompttemplate.FieldTemplate, field.TypeString, value)
+		_node.Template = value
+	}
+	if value, ok := ptc.mutation.Format(); ok {
+		_spec.SetField(prompttemplate.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := ptc.mutation.Auto(); ok {
+		_spec.SetField(prompttemplate.FieldAuto, field.TypeBool, value)
+		_node.Auto = value
+	}
+	if value, ok := ptc.mutation.CreatedAt(); ok {
+		_spec.SetField(prompttemplate.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := ptc.mutation.UpdatedAt(); ok {
+		_spec.SetField(prompttemplate.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := ptc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   prompttemplate.UserTable,
+			Columns: []string{prompttemplate.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// PromptTemplateCreateBulk is the builder for creating many PromptTemplate entities in bulk.
+type PromptTemplateCreateBulk struct {
+	config
+	err      error
+	builders []*PromptTemplateCreate
+}
+
+// Save creates the PromptTemplate entities in the database.
+func (ptcb *PromptTemplateCreateBulk) Save(ctx context.Context) ([]*PromptTemplate, error) {
+	if ptcb.err != nil {
+		return nil, ptcb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(ptcb.builders))
+	nodes := make([]*PromptTemplate, len(ptcb.builders))
+	mutators := make([]Mutator, len(ptcb.builders))
+	for i := range ptcb.builders {
+		func(i int, root context.Context) {
+			builder := ptcb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*PromptTemplateMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, ptcb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, ptcb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, ptcb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ptcb *PromptTemplateCreateBulk) SaveX(ctx context.Context) []*PromptTemplate {
+	v, err := ptcb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (ptcb *PromptTemplateCreateBulk) Exec(ctx context.Context) error {
+	_, err := ptcb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptcb *PromptTemplateCreateBulk) ExecX(ctx context.Context) {
+	if err := ptcb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
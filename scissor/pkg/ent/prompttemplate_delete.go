@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/prompttemplate"
+)
+
+// PromptTemplateDelete is the builder for deleting a PromptTemplate entity.
+type PromptTemplateDelete struct {
+	config
+	hooks    []Hook
+	mutation *PromptTemplateMutation
+}
+
+// Where appends a list predicates to the PromptTemplateDelete builder.
+func (ptd *PromptTemplateDelete) Where(ps ...predicate.PromptTemplate) *PromptTemplateDelete {
+	ptd.mutation.Where(ps...)
+	return ptd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (ptd *PromptTemplateDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, ptd.sqlExec, ptd.mutation, ptd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptd *PromptTemplateDelete) ExecX(ctx context.Context) int {
+	n, err := ptd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (ptd *PromptTemplateDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(prompttemplate.Table, sqlgraph.NewFieldSpec(prompttemplate.FieldID, field.TypeInt))
+	if ps := ptd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, ptd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	ptd.mutation.done = true
+	return affected, err
+}
+
+// PromptTemplateDeleteOne is the builder for deleting a single PromptTemplate entity.
+type PromptTemplateDeleteOne struct {
+	ptd *PromptTemplateDelete
+}
+
+// Where appends a list predicates to the PromptTemplateDelete builder.
+func (ptdo *PromptTemplateDeleteOne) Where(ps ...predicate.PromptTemplate) *PromptTemplateDeleteOne {
+	ptdo.ptd.mutation.Where(ps...)
+	return ptdo
+}
+
+// Exec executes the deletion query.
+func (ptdo *PromptTemplateDeleteOne) Exec(ctx context.Context) error {
+	n, err := ptdo.ptd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{prompttemplate.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptdo *PromptTemplateDeleteOne) ExecX(ctx context.Context) {
+	if err := ptdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
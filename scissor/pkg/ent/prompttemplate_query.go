@@ -0,0 +1,605 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/prompttemplate"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
+)
+
+// PromptTemplateQuery is the builder for querying PromptTemplate entities.
+type PromptTemplateQuery struct {
+	config
+	ctx        *QueryContext
+	order      []prompttemplate.OrderOption
+	inters     []Interceptor
+	predicates []predicate.PromptTemplate
+	withUser   *UserQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the PromptTemplateQuery builder.
+func (ptq *PromptTemplateQuery) Where(ps ...predicate.PromptTemplate) *PromptTemplateQuery {
+	ptq.predicates = append(ptq.predicates, ps...)
+	return ptq
+}
+
+// Limit the number of records to be returned by this query.
+func (ptq *PromptTemplateQuery) Limit(limit int) *PromptTemplateQuery {
+	ptq.ctx.Limit = &limit
+	return ptq
+}
+
+// Offset to start from.
+func (ptq *PromptTemplateQuery) Offset(offset int) *PromptTemplateQuery {
+	ptq.ctx.Offset = &offset
+	return ptq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (ptq *PromptTemplateQuery) Unique(unique bool) *PromptTemplateQuery {
+	ptq.ctx.Unique = &unique
+	return ptq
+}
+
+// Order specifies how the records should be ordered.
+func (ptq *PromptTemplateQuery) Order(o ...prompttemplate.OrderOption) *PromptTemplateQuery {
+	ptq.order = append(ptq.order, o...)
+	return ptq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (ptq *PromptTemplateQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: ptq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := ptq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := ptq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(prompttemplate.Table, prompttemplate.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, prompttemplate.UserTable, prompttemplate.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(ptq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first PromptTemplate entity from the query.
+// Returns a *NotFoundError when no PromptTemplate was found.
+func (ptq *PromptTemplateQuery) First(ctx context.Context) (*PromptTemplate, error) {
+	nodes, err := ptq.Limit(1).All(setContextOp(ctx, ptq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{prompttemplate.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (ptq *PromptTemplateQuery) FirstX(ctx context.Context) *PromptTemplate {
+	node, err := ptq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first PromptTemplate ID from the query.
+// Returns a *NotFoundError when no PromptTemplate ID was found.
+func (ptq *PromptTemplateQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ptq.Limit(1).IDs(setContextOp(ctx, ptq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{prompttemplate.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (ptq *PromptTemplateQuery) FirstIDX(ctx context.Context) int {
+	id, err := ptq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single PromptTemplate entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one PromptTemplate entity is found.
+// Returns a *NotFoundError when no PromptTemplate entities are found.
+func (ptq *PromptTemplateQuery) Only(ctx context.Context) (*PromptTemplate, error) {
+	nodes, err := ptq.Limit(2).All(setContextOp(ctx, ptq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{prompttemplate.Label}
+	default:
+		return nil, &NotSingularError{prompttemplate.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (ptq *PromptTemplateQuery) OnlyX(ctx context.Context) *PromptTemplate {
+	node, err := ptq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only PromptTemplate ID in the query.
+// Returns a *NotSingularError when more than one PromptTemplate ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (ptq *PromptTemplateQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = ptq.Limit(2).IDs(setContextOp(ctx, ptq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{prompttemplate.Label}
+	default:
+		err = &NotSingularError{prompttemplate.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (ptq *PromptTemplateQuery) OnlyIDX(ctx context.Context) int {
+	id, err := ptq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of PromptTemplates.
+func (ptq *PromptTemplateQuery) All(ctx context.Context) ([]*PromptTemplate, error) {
+	ctx = setContextOp(ctx, ptq.ctx, "All")
+	if err := ptq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*PromptTemplate, *PromptTemplateQuery]()
+	return withInterceptors[[]*PromptTemplate](ctx, ptq, qr, ptq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (ptq *PromptTemplateQuery) AllX(ctx context.Context) []*PromptTemplate {
+	nodes, err := ptq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of PromptTemplate IDs.
+func (ptq *PromptTemplateQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if ptq.ctx.Unique == nil && ptq.path != nil {
+		ptq.Unique(true)
+	}
+	ctx = setContextOp(ctx, ptq.ctx, "IDs")
+	if err = ptq.Select(prompttemplate.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (ptq *PromptTemplateQuery) IDsX(ctx context.Context) []int {
+	ids, err := ptq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (ptq *PromptTemplateQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, ptq.ctx, "Count")
+	if err := ptq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, ptq, querierCount[*PromptTemplateQuery](), ptq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (ptq *PromptTemplateQuery) CountX(ctx context.Context) int {
+	count, err := ptq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (ptq *PromptTemplateQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, ptq.ctx, "Exist")
+	switch _, err := ptq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (ptq *PromptTemplateQuery) ExistX(ctx context.Context) bool {
+	exist, err := ptq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the PromptTemplateQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (ptq *PromptTemplateQuery) Clone() *PromptTemplateQuery {
+	if ptq == nil {
+		return nil
+	}
+	return &PromptTemplateQuery{
+		config:     ptq.config,
+		ctx:        ptq.ctx.Clone(),
+		order:      append([]prompttemplate.OrderOption{}, ptq.order...),
+		inters:     append([]Interceptor{}, ptq.inters...),
+		predicates: append([]predicate.PromptTemplate{}, ptq.predicates...),
+		withUser:   ptq.withUser.Clone(),
+		// clone intermediate query.
+		sql:  ptq.sql.Clone(),
+		path: ptq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (ptq *PromptTemplateQuery) WithUser(opts ...func(*UserQuery)) *PromptTemplateQuery {
+	query := (&UserClient{config: ptq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	ptq.withUser = query
+	return ptq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.PromptTemplate.Query().
+//		GroupBy(prompttemplate.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (ptq *PromptTemplateQuery) GroupBy(field string, fields ...string) *PromptTemplateGroupBy {
+	ptq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &PromptTemplateGroupBy{build: ptq}
+	grbuild.flds = &ptq.ctx.Fields
+	grbuild.label = prompttemplate.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//	}
+//
+//	client.PromptTemplate.Query().
+//		Select(prompttemplate.FieldUserID).
+//		Scan(ctx, &v)
+func (ptq *PromptTemplateQuery) Select(fields ...string) *PromptTemplateSelect {
+	ptq.ctx.Fields = append(ptq.ctx.Fields, fields...)
+	sbuild := &PromptTemplateSelect{PromptTemplateQuery: ptq}
+	sbuild.label = prompttemplate.Label
+	sbuild.flds, sbuild.scan = &ptq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a PromptTemplateSelect configured with the given aggregations.
+func (ptq *PromptTemplateQuery) Aggregate(fns ...AggregateFunc) *PromptTemplateSelect {
+	return ptq.Select().Aggregate(fns...)
+}
+
+func (ptq *PromptTemplateQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range ptq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, ptq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range ptq.ctx.Fields {
+		if !prompttemplate.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if ptq.path != nil {
+		prev, err := ptq.path(ctx)
+		if err != nil {
+			return err
+		}
+		ptq.sql = prev
+	}
+	return nil
+}
+
+func (ptq *PromptTemplateQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*PromptTemplate, error) {
+	var (
+		nodes       = []*PromptTemplate{}
+		_spec       = ptq.querySpec()
+		loadedTypes = [1]bool{
+			ptq.withUser != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*PromptTemplate).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &PromptTemplate{config: ptq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, ptq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := ptq.withUser; query != nil {
+		if err := ptq.loadUser(ctx, query, nodes, nil,
+			func(n *PromptTemplate, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (ptq *PromptTemplateQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*PromptTemplate, init func(*PromptTemplate), assign func(*PromptTemplate, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*PromptTemplate)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+
+func (ptq *PromptTemplateQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := ptq.querySpec()
+	_spec.Node.Columns = ptq.ctx.Fields
+	if len(ptq.ctx.Fields) > 0 {
+		_spec.Unique = ptq.ctx.Unique != nil && *ptq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, ptq.driver, _spec)
+}
+
+func (ptq *PromptTemplateQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(prompttemplate.Table, prompttemplate.Columns, sqlgraph.NewFieldSpec(prompttemplate.FieldID, field.TypeInt))
+	_spec.From = ptq.sql
+	if unique := ptq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if ptq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := ptq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, prompttemplate.FieldID)
+		for i := range fields {
+			if fields[i] != prompttemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if ptq.withUser != nil {
+			_spec.Node.AddColumnOnce(prompttemplate.FieldUserID)
+		}
+	}
+	if ps := ptq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := ptq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := ptq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := ptq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (ptq *PromptTemplateQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(ptq.driver.Dialect())
+	t1 := builder.Table(prompttemplate.Table)
+	columns := ptq.ctx.Fields
+	if len(columns) == 0 {
+		columns = prompttemplate.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if ptq.sql != nil {
+		selector = ptq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if ptq.ctx.Unique != nil && *ptq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range ptq.predicates {
+		p(selector)
+	}
+	for _, p := range ptq.order {
+		p(selector)
+	}
+	if offset := ptq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := ptq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// PromptTemplateGroupBy is the group-by builder for PromptTemplate entities.
+type PromptTemplateGroupBy struct {
+	selector
+	build *PromptTemplateQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (ptgb *PromptTemplateGroupBy) Aggregate(fns ...AggregateFunc) *PromptTemplateGroupBy {
+	ptgb.fns = append(ptgb.fns, fns...)
+	return ptgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (ptgb *PromptTemplateGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, ptgb.build.ctx, "GroupBy")
+	if err := ptgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PromptTemplateQuery, *PromptTemplateGroupBy](ctx, ptgb.build, ptgb, ptgb.build.inters, v)
+}
+
+func (ptgb *PromptTemplateGroupBy) sqlScan(ctx context.Context, root *PromptTemplateQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(ptgb.fns))
+	for _, fn := range ptgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*ptgb.flds)+len(ptgb.fns))
+		for _, f := range *ptgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*ptgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := ptgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// PromptTemplateSelect is the builder for selecting fields of PromptTemplate entities.
+type PromptTemplateSelect struct {
+	*PromptTemplateQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (pts *PromptTemplateSelect) Aggregate(fns ...AggregateFunc) *PromptTemplateSelect {
+	pts.fns = append(pts.fns, fns...)
+	return pts
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (pts *PromptTemplateSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, pts.ctx, "Select")
+	if err := pts.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*PromptTemplateQuery, *PromptTemplateSelect](ctx, pts.PromptTemplateQuery, pts, pts.inters, v)
+}
+
+func (pts *PromptTemplateSelect) sqlScan(ctx context.Context, root *PromptTemplateQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(pts.fns))
+	for _, fn := range pts.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*pts.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := pts.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
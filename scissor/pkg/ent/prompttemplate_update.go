@@ -0,0 +1,398 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/prompttemplate"
+)
+
+// PromptTemplateUpdate is the builder for updating PromptTemplate entities.
+type PromptTemplateUpdate struct {
+	config
+	hooks    []Hook
+	mutation *PromptTemplateMutation
+}
+
+// Where appends a list predicates to the PromptTemplateUpdate builder.
+func (ptu *PromptTemplateUpdate) Where(ps ...predicate.PromptTemplate) *PromptTemplateUpdate {
+	ptu.mutation.Where(ps...)
+	return ptu
+}
+
+// SetTitle sets the "title" field.
+func (ptu *PromptTemplateUpdate) SetTitle(s string) *PromptTemplateUpdate {
+	ptu.mutation.SetTitle(s)
+	return ptu
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (ptu *PromptTemplateUpdate) SetNillableTitle(s *string) *PromptTemplateUpdate {
+	if s != nil {
+		ptu.SetTitle(*s)
+	}
+	return ptu
+}
+
+// ClearTitle clears the value of the "title" field.
+func (ptu *PromptTemplateUpdate) ClearTitle() *PromptTemplateUpdate {
+	ptu.mutation.ClearTitle()
+	return ptu
+}
+
+// SetTemplate sets the "template" field.
+func (ptu *PromptTemplateUpdate) SetTemplate(s string) *PromptTemplateUpdate {
+	ptu.mutation.SetTemplate(s)
+	return ptu
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (ptu *PromptTemplateUpdate) SetNillableTemplate(s *string) *PromptTemplateUpdate {
+	if s != nil {
+		ptu.SetTemplate(*s)
+	}
+	return ptu
+}
+
+// SetFormat sets the "format" field.
+func (ptu *PromptTemplateUpdate) SetFormat(pr prompttemplate.Format) *PromptTemplateUpdate {
+	ptu.mutation.SetFormat(pr)
+	return ptu
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (ptu *PromptTemplateUpdate) SetNillableFormat(pr *prompttemplate.Format) *PromptTemplateUpdate {
+	if pr != nil {
+		ptu.SetFormat(*pr)
+	}
+	return ptu
+}
+
+// SetAuto sets the "auto" field.
+func (ptu *PromptTemplateUpdate) SetAuto(b bool) *PromptTemplateUpdate {
+	ptu.mutation.SetAuto(b)
+	return ptu
+}
+
+// SetNillableAuto sets the "auto" field if the given value is not nil.
+func (ptu *PromptTemplateUpdate) SetNillableAuto(b *bool) *PromptTemplateUpdate {
+	if b != nil {
+		ptu.SetAuto(*b)
+	}
+	return ptu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ptu *PromptTemplateUpdate) SetUpdatedAt(t time.Time) *PromptTemplateUpdate {
+	ptu.mutation.SetUpdatedAt(t)
+	return ptu
+}
+
+// Mutation returns the PromptTemplateMutation object of the builder.
+func (ptu *PromptTemplateUpdate) Mutation() *PromptTemplateMutation {
+	return ptu.mutation
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (ptu *PromptTemplateUpdate) Save(ctx context.Context) (int, error) {
+	ptu.defaults()
+	return withHooks(ctx, ptu.sqlSave, ptu.mutation, ptu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ptu *PromptTemplateUpdate) SaveX(ctx context.Context) int {
+	affected, err := ptu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (ptu *PromptTemplateUpdate) Exec(ctx context.Context) error {
+	_, err := ptu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptu *PromptTemplateUpdate) ExecX(ctx context.Context) {
+	if err := ptu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ptu *PromptTemplateUpdate) defaults() {
+	if _, ok := ptu.mutation.UpdatedAt(); !ok {
+		v := prompttemplate.UpdateDefaultUpdatedAt()
+		ptu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ptu *PromptTemplateUpdate) check() error {
+	if v, ok := ptu.mutation.Format(); ok {
+		if err := prompttemplate.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "PromptTemplate.format": %w`, err)}
+		}
+	}
+	if _, ok := ptu.mutation.UserID(); ptu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PromptTemplate.user"`)
+	}
+	return nil
+}
+
+func (ptu *PromptTemplateUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := ptu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(prompttemplate.Table, prompttemplate.Columns, sqlgraph.NewFieldSpec(prompttemplate.FieldID, field.TypeInt))
+	if ps := ptu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ptu.mutation.Title(); ok {
+		_spec.SetField(prompttemplate.FieldTitle, field.TypeString, value)
+	}
+	if ptu.mutation.TitleCleared() {
+		_spec.ClearField(prompttemplate.FieldTitle, field.TypeString)
+	}
+	if value, ok := ptu.mutation.Template(); ok {
+		_spec.SetField(prompttemplate.FieldTemplate, field.TypeString, value)
+	}
+	if value, ok := ptu.mutation.Format(); ok {
+		_spec.SetField(prompttemplate.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := ptu.mutation.Auto(); ok {
+		_spec.SetField(prompttemplate.FieldAuto, field.TypeBool, value)
+	}
+	if value, ok := ptu.mutation.UpdatedAt(); ok {
+		_spec.SetField(prompttemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, ptu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{prompttemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	ptu.mutation.done = true
+	return n, nil
+}
+
+// PromptTemplateUpdateOne is the builder for updating a single PromptTemplate entity.
+type PromptTemplateUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *PromptTemplateMutation
+}
+
+// SetTitle sets the "title" field.
+func (ptuo *PromptTemplateUpdateOne) SetTitle(s string) *PromptTemplateUpdateOne {
+	ptuo.mutation.SetTitle(s)
+	return ptuo
+}
+
+// SetNillableTitle sets the "title" field if the given value is not nil.
+func (ptuo *PromptTemplateUpdateOne) SetNillableTitle(s *string) *PromptTemplateUpdateOne {
+	if s != nil {
+		ptuo.SetTitle(*s)
+	}
+	return ptuo
+}
+
+// ClearTitle clears the value of the "title" field.
+func (ptuo *PromptTemplateUpdateOne) ClearTitle() *PromptTemplateUpdateOne {
+	ptuo.mutation.ClearTitle()
+	return ptuo
+}
+
+// SetTemplate sets the "template" field.
+func (ptuo *PromptTemplateUpdateOne) SetTemplate(s string) *PromptTemplateUpdateOne {
+	ptuo.mutation.SetTemplate(s)
+	return ptuo
+}
+
+// SetNillableTemplate sets the "template" field if the given value is not nil.
+func (ptuo *PromptTemplateUpdateOne) SetNillableTemplate(s *string) *PromptTemplateUpdateOne {
+	if s != nil {
+		ptuo.SetTemplate(*s)
+	}
+	return ptuo
+}
+
+// SetFormat sets the "format" field.
+func (ptuo *PromptTemplateUpdateOne) SetFormat(pr prompttemplate.Format) *PromptTemplateUpdateOne {
+	ptuo.mutation.SetFormat(pr)
+	return ptuo
+}
+
+// SetNillableFormat sets the "format" field if the given value is not nil.
+func (ptuo *PromptTemplateUpdateOne) SetNillableFormat(pr *prompttemplate.Format) *PromptTemplateUpdateOne {
+	if pr != nil {
+		ptuo.SetFormat(*pr)
+	}
+	return ptuo
+}
+
+// SetAuto sets the "auto" field.
+func (ptuo *PromptTemplateUpdateOne) SetAuto(b bool) *PromptTemplateUpdateOne {
+	ptuo.mutation.SetAuto(b)
+	return ptuo
+}
+
+// SetNillableAuto sets the "auto" field if the given value is not nil.
+func (ptuo *PromptTemplateUpdateOne) SetNillableAuto(b *bool) *PromptTemplateUpdateOne {
+	if b != nil {
+		ptuo.SetAuto(*b)
+	}
+	return ptuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (ptuo *PromptTemplateUpdateOne) SetUpdatedAt(t time.Time) *PromptTemplateUpdateOne {
+	ptuo.mutation.SetUpdatedAt(t)
+	return ptuo
+}
+
+// Mutation returns the PromptTemplateMutation object of the builder.
+func (ptuo *PromptTemplateUpdateOne) Mutation() *PromptTemplateMutation {
+	return ptuo.mutation
+}
+
+// Where appends a list predicates to the PromptTemplateUpdate builder.
+func (ptuo *PromptTemplateUpdateOne) Where(ps ...predicate.PromptTemplate) *PromptTemplateUpdateOne {
+	ptuo.mutation.Where(ps...)
+	return ptuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (ptuo *PromptTemplateUpdateOne) Select(field string, fields ...string) *PromptTemplateUpdateOne {
+	ptuo.fields = append([]string{field}, fields...)
+	return ptuo
+}
+
+// Save executes the query and returns the updated PromptTemplate entity.
+func (ptuo *PromptTemplateUpdateOne) Save(ctx context.Context) (*PromptTemplate, error) {
+	ptuo.defaults()
+	return withHooks(ctx, ptuo.sqlSave, ptuo.mutation, ptuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (ptuo *PromptTemplateUpdateOne) SaveX(ctx context.Context) *PromptTemplate {
+	node, err := ptuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (ptuo *PromptTemplateUpdateOne) Exec(ctx context.Context) error {
+	_, err := ptuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (ptuo *PromptTemplateUpdateOne) ExecX(ctx context.Context) {
+	if err := ptuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (ptuo *PromptTemplateUpdateOne) defaults() {
+	if _, ok := ptuo.mutation.UpdatedAt(); !ok {
+		v := prompttemplate.UpdateDefaultUpdatedAt()
+		ptuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (ptuo *PromptTemplateUpdateOne) check() error {
+	if v, ok := ptuo.mutation.Format(); ok {
+		if err := prompttemplate.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "PromptTemplate.format": %w`, err)}
+		}
+	}
+	if _, ok := ptuo.mutation.UserID(); ptuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "PromptTemplate.user"`)
+	}
+	return nil
+}
+
+func (ptuo *PromptTemplateUpdateOne) sqlSave(ctx context.Context) (_node *PromptTemplate, err error) {
+	if err := ptuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(prompttemplate.Table, prompttemplate.Columns, sqlgraph.NewFieldSpec(prompttemplate.FieldID, field.TypeInt))
+	id, ok := ptuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "PromptTemplate.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := ptuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, prompttemplate.FieldID)
+		for _, f := range fields {
+			if !prompttemplate.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != prompttemplate.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := ptuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := ptuo.mutation.Title(); ok {
+		_spec.SetField(prompttemplate.FieldTitle, field.TypeString, value)
+	}
+	if ptuo.mutation.TitleCleared() {
+		_spec.ClearField(prompttemplate.FieldTitle, field.TypeString)
+	}
+	if value, ok := ptuo.mutation.Template(); ok {
+		_spec.SetField(prompttemplate.FieldTemplate, field.TypeString, value)
+	}
+	if value, ok := ptuo.mutation.Format(); ok {
+		_spec.SetField(prompttemplate.FieldFormat, field.TypeEnum, value)
+	}
+	if value, ok := ptuo.mutation.Auto(); ok {
+		_spec.SetField(prompttemplate.FieldAuto, field.TypeBool, value)
+	}
+	if value, ok := ptuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(prompttemplate.FieldUpdatedAt, field.TypeTime, value)
+	}
+	_node = &PromptTemplate{config: ptuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, ptuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{prompttemplate.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	ptuo.mutation.done = true
+	return _node, nil
+}
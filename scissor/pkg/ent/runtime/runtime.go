@@ -2,7 +2,234 @@
 
 package runtime
 
-// The schema-stitching logic is generated in github.com/gorexlv/cabinet/scissor/pkg/ent/runtime.go
+import (
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/accesstoken"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articlechunk"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articleenrichment"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articlerevision"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/articletranslation"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/export"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/feed"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/highlight"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/image"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/notification"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/prompttemplate"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/schema"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/snapshot"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/topiccluster"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
+)
+
+// The init function reads all schema descriptors with runtime code
+// (default values, validators, hooks and policies) and stitches it
+// to their package variables.
+func init() {
+	accesstokenFields := schema.AccessToken{}.Fields()
+	_ = accesstokenFields
+	// accesstokenDescName is the schema descriptor for name field.
+	accesstokenDescName := accesstokenFields[1].Descriptor()
+	// accesstoken.NameValidator is a validator for the "name" field. It is called by the builders before save.
+	accesstoken.NameValidator = accesstokenDescName.Validators[0].(func(string) error)
+	// accesstokenDescCreatedAt is the schema descriptor for created_at field.
+	accesstokenDescCreatedAt := accesstokenFields[5].Descriptor()
+	// accesstoken.DefaultCreatedAt holds the default value on creation for the created_at field.
+	accesstoken.DefaultCreatedAt = accesstokenDescCreatedAt.Default.(func() time.Time)
+	articleHooks := schema.Article{}.Hooks()
+	article.Hooks[0] = articleHooks[0]
+	articleFields := schema.Article{}.Fields()
+	_ = articleFields
+	// articleDescCreatedAt is the schema descriptor for created_at field.
+	articleDescCreatedAt := articleFields[27].Descriptor()
+	// article.DefaultCreatedAt holds the default value on creation for the created_at field.
+	article.DefaultCreatedAt = articleDescCreatedAt.Default.(func() time.Time)
+	// articleDescUpdatedAt is the schema descriptor for updated_at field.
+	articleDescUpdatedAt := articleFields[28].Descriptor()
+	// article.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	article.DefaultUpdatedAt = articleDescUpdatedAt.Default.(func() time.Time)
+	// article.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	article.UpdateDefaultUpdatedAt = articleDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// articleDescID is the schema descriptor for id field.
+	articleDescID := articleFields[0].Descriptor()
+	// article.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	article.IDValidator = articleDescID.Validators[0].(func(uint) error)
+	articlechunkFields := schema.ArticleChunk{}.Fields()
+	_ = articlechunkFields
+	// articlechunkDescSeq is the schema descriptor for seq field.
+	articlechunkDescSeq := articlechunkFields[1].Descriptor()
+	// articlechunk.SeqValidator is a validator for the "seq" field. It is called by the builders before save.
+	articlechunk.SeqValidator = articlechunkDescSeq.Validators[0].(func(int) error)
+	// articlechunkDescCreatedAt is the schema descriptor for created_at field.
+	articlechunkDescCreatedAt := articlechunkFields[6].Descriptor()
+	// articlechunk.DefaultCreatedAt holds the default value on creation for the created_at field.
+	articlechunk.DefaultCreatedAt = articlechunkDescCreatedAt.Default.(func() time.Time)
+	articleenrichmentFields := schema.ArticleEnrichment{}.Fields()
+	_ = articleenrichmentFields
+	// articleenrichmentDescCreatedAt is the schema descriptor for created_at field.
+	articleenrichmentDescCreatedAt := articleenrichmentFields[5].Descriptor()
+	// articleenrichment.DefaultCreatedAt holds the default value on creation for the created_at field.
+	articleenrichment.DefaultCreatedAt = articleenrichmentDescCreatedAt.Default.(func() time.Time)
+	// articleenrichmentDescUpdatedAt is the schema descriptor for updated_at field.
+	articleenrichmentDescUpdatedAt := articleenrichmentFields[6].Descriptor()
+	// articleenrichment.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	articleenrichment.DefaultUpdatedAt = articleenrichmentDescUpdatedAt.Default.(func() time.Time)
+	// articleenrichment.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	articleenrichment.UpdateDefaultUpdatedAt = articleenrichmentDescUpdatedAt.UpdateDefault.(func() time.Time)
+	articlerevisionFields := schema.ArticleRevision{}.Fields()
+	_ = articlerevisionFields
+	// articlerevisionDescCreatedAt is the schema descriptor for created_at field.
+	articlerevisionDescCreatedAt := articlerevisionFields[5].Descriptor()
+	// articlerevision.DefaultCreatedAt holds the default value on creation for the created_at field.
+	articlerevision.DefaultCreatedAt = articlerevisionDescCreatedAt.Default.(func() time.Time)
+	articletranslationFields := schema.ArticleTranslation{}.Fields()
+	_ = articletranslationFields
+	// articletranslationDescCreatedAt is the schema descriptor for created_at field.
+	articletranslationDescCreatedAt := articletranslationFields[8].Descriptor()
+	// articletranslation.DefaultCreatedAt holds the default value on creation for the created_at field.
+	articletranslation.DefaultCreatedAt = articletranslationDescCreatedAt.Default.(func() time.Time)
+	// articletranslationDescUpdatedAt is the schema descriptor for updated_at field.
+	articletranslationDescUpdatedAt := articletranslationFields[9].Descriptor()
+	// articletranslation.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	articletranslation.DefaultUpdatedAt = articletranslationDescUpdatedAt.Default.(func() time.Time)
+	// articletranslation.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	articletranslation.UpdateDefaultUpdatedAt = articletranslationDescUpdatedAt.UpdateDefault.(func() time.Time)
+	exportFields := schema.Export{}.Fields()
+	_ = exportFields
+	// exportDescSize is the schema descriptor for size field.
+	exportDescSize := exportFields[3].Descriptor()
+	// export.DefaultSize holds the default value on creation for the size field.
+	export.DefaultSize = exportDescSize.Default.(int64)
+	// export.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	export.SizeValidator = exportDescSize.Validators[0].(func(int64) error)
+	// exportDescArticleCount is the schema descriptor for article_count field.
+	exportDescArticleCount := exportFields[4].Descriptor()
+	// export.DefaultArticleCount holds the default value on creation for the article_count field.
+	export.DefaultArticleCount = exportDescArticleCount.Default.(int)
+	// export.ArticleCountValidator is a validator for the "article_count" field. It is called by the builders before save.
+	export.ArticleCountValidator = exportDescArticleCount.Validators[0].(func(int) error)
+	// exportDescCreatedAt is the schema descriptor for created_at field.
+	exportDescCreatedAt := exportFields[6].Descriptor()
+	// export.DefaultCreatedAt holds the default value on creation for the created_at field.
+	export.DefaultCreatedAt = exportDescCreatedAt.Default.(func() time.Time)
+	feedFields := schema.Feed{}.Fields()
+	_ = feedFields
+	// feedDescEnabled is the schema descriptor for enabled field.
+	feedDescEnabled := feedFields[6].Descriptor()
+	// feed.DefaultEnabled holds the default value on creation for the enabled field.
+	feed.DefaultEnabled = feedDescEnabled.Default.(bool)
+	// feedDescErrorCount is the schema descriptor for error_count field.
+	feedDescErrorCount := feedFields[12].Descriptor()
+	// feed.DefaultErrorCount holds the default value on creation for the error_count field.
+	feed.DefaultErrorCount = feedDescErrorCount.Default.(int)
+	// feed.ErrorCountValidator is a validator for the "error_count" field. It is called by the builders before save.
+	feed.ErrorCountValidator = feedDescErrorCount.Validators[0].(func(int) error)
+	// feedDescCreatedAt is the schema descriptor for created_at field.
+	feedDescCreatedAt := feedFields[14].Descriptor()
+	// feed.DefaultCreatedAt holds the default value on creation for the created_at field.
+	feed.DefaultCreatedAt = feedDescCreatedAt.Default.(func() time.Time)
+	// feedDescUpdatedAt is the schema descriptor for updated_at field.
+	feedDescUpdatedAt := feedFields[15].Descriptor()
+	// feed.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	feed.DefaultUpdatedAt = feedDescUpdatedAt.Default.(func() time.Time)
+	// feed.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	feed.UpdateDefaultUpdatedAt = feedDescUpdatedAt.UpdateDefault.(func() time.Time)
+	highlightFields := schema.Highlight{}.Fields()
+	_ = highlightFields
+	// highlightDescCreatedAt is the schema descriptor for created_at field.
+	highlightDescCreatedAt := highlightFields[4].Descriptor()
+	// highlight.DefaultCreatedAt holds the default value on creation for the created_at field.
+	highlight.DefaultCreatedAt = highlightDescCreatedAt.Default.(func() time.Time)
+	// highlightDescUpdatedAt is the schema descriptor for updated_at field.
+	highlightDescUpdatedAt := highlightFields[5].Descriptor()
+	// highlight.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	highlight.DefaultUpdatedAt = highlightDescUpdatedAt.Default.(func() time.Time)
+	// highlight.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	highlight.UpdateDefaultUpdatedAt = highlightDescUpdatedAt.UpdateDefault.(func() time.Time)
+	imageFields := schema.Image{}.Fields()
+	_ = imageFields
+	// imageDescCreatedAt is the schema descriptor for created_at field.
+	imageDescCreatedAt := imageFields[5].Descriptor()
+	// image.DefaultCreatedAt holds the default value on creation for the created_at field.
+	image.DefaultCreatedAt = imageDescCreatedAt.Default.(func() time.Time)
+	notificationFields := schema.Notification{}.Fields()
+	_ = notificationFields
+	// notificationDescCreatedAt is the schema descriptor for created_at field.
+	notificationDescCreatedAt := notificationFields[6].Descriptor()
+	// notification.DefaultCreatedAt holds the default value on creation for the created_at field.
+	notification.DefaultCreatedAt = notificationDescCreatedAt.Default.(func() time.Time)
+	prompttemplateFields := schema.PromptTemplate{}.Fields()
+	_ = prompttemplateFields
+	// prompttemplateDescAuto is the schema descriptor for auto field.
+	prompttemplateDescAuto := prompttemplateFields[5].Descriptor()
+	// prompttemplate.DefaultAuto holds the default value on creation for the auto field.
+	prompttemplate.DefaultAuto = prompttemplateDescAuto.Default.(bool)
+	// prompttemplateDescCreatedAt is the schema descriptor for created_at field.
+	prompttemplateDescCreatedAt := prompttemplateFields[6].Descriptor()
+	// prompttemplate.DefaultCreatedAt holds the default value on creation for the created_at field.
+	prompttemplate.DefaultCreatedAt = prompttemplateDescCreatedAt.Default.(func() time.Time)
+	// prompttemplateDescUpdatedAt is the schema descriptor for updated_at field.
+	prompttemplateDescUpdatedAt := prompttemplateFields[7].Descriptor()
+	// prompttemplate.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	prompttemplate.DefaultUpdatedAt = prompttemplateDescUpdatedAt.Default.(func() time.Time)
+	// prompttemplate.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	prompttemplate.UpdateDefaultUpdatedAt = prompttemplateDescUpdatedAt.UpdateDefault.(func() time.Time)
+	snapshotFields := schema.Snapshot{}.Fields()
+	_ = snapshotFields
+	// snapshotDescSize is the schema descriptor for size field.
+	snapshotDescSize := snapshotFields[3].Descriptor()
+	// snapshot.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	snapshot.SizeValidator = snapshotDescSize.Validators[0].(func(int64) error)
+	// snapshotDescCreatedAt is the schema descriptor for created_at field.
+	snapshotDescCreatedAt := snapshotFields[7].Descriptor()
+	// snapshot.DefaultCreatedAt holds the default value on creation for the created_at field.
+	snapshot.DefaultCreatedAt = snapshotDescCreatedAt.Default.(func() time.Time)
+	topicclusterFields := schema.TopicCluster{}.Fields()
+	_ = topicclusterFields
+	// topicclusterDescSize is the schema descriptor for size field.
+	topicclusterDescSize := topicclusterFields[5].Descriptor()
+	// topiccluster.SizeValidator is a validator for the "size" field. It is called by the builders before save.
+	topiccluster.SizeValidator = topicclusterDescSize.Validators[0].(func(int) error)
+	// topicclusterDescNamedSize is the schema descriptor for named_size field.
+	topicclusterDescNamedSize := topicclusterFields[6].Descriptor()
+	// topiccluster.NamedSizeValidator is a validator for the "named_size" field. It is called by the builders before save.
+	topiccluster.NamedSizeValidator = topicclusterDescNamedSize.Validators[0].(func(int) error)
+	// topicclusterDescCreatedAt is the schema descriptor for created_at field.
+	topicclusterDescCreatedAt := topicclusterFields[7].Descriptor()
+	// topiccluster.DefaultCreatedAt holds the default value on creation for the created_at field.
+	topiccluster.DefaultCreatedAt = topicclusterDescCreatedAt.Default.(func() time.Time)
+	// topicclusterDescUpdatedAt is the schema descriptor for updated_at field.
+	topicclusterDescUpdatedAt := topicclusterFields[8].Descriptor()
+	// topiccluster.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	topiccluster.DefaultUpdatedAt = topicclusterDescUpdatedAt.Default.(func() time.Time)
+	// topiccluster.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	topiccluster.UpdateDefaultUpdatedAt = topicclusterDescUpdatedAt.UpdateDefault.(func() time.Time)
+	userFields := schema.User{}.Fields()
+	_ = userFields
+	// userDescUsername is the schema descriptor for username field.
+	userDescUsername := userFields[1].Descriptor()
+	// user.UsernameValidator is a validator for the "username" field. It is called by the builders before save.
+	user.UsernameValidator = userDescUsername.Validators[0].(func(string) error)
+	// userDescPassword is the schema descriptor for password field.
+	userDescPassword := userFields[2].Descriptor()
+	// user.PasswordValidator is a validator for the "password" field. It is called by the builders before save.
+	user.PasswordValidator = userDescPassword.Validators[0].(func(string) error)
+	// userDescCreatedAt is the schema descriptor for created_at field.
+	userDescCreatedAt := userFields[8].Descriptor()
+	// user.DefaultCreatedAt holds the default value on creation for the created_at field.
+	user.DefaultCreatedAt = userDescCreatedAt.Default.(func() time.Time)
+	// userDescUpdatedAt is the schema descriptor for updated_at field.
+	userDescUpdatedAt := userFields[9].Descriptor()
+	// user.DefaultUpdatedAt holds the default value on creation for the updated_at field.
+	user.DefaultUpdatedAt = userDescUpdatedAt.Default.(func() time.Time)
+	// user.UpdateDefaultUpdatedAt holds the default value on update for the updated_at field.
+	user.UpdateDefaultUpdatedAt = userDescUpdatedAt.UpdateDefault.(func() time.Time)
+	// userDescID is the schema descriptor for id field.
+	userDescID := userFields[0].Descriptor()
+	// user.IDValidator is a validator for the "id" field. It is called by the builders before save.
+	user.IDValidator = userDescID.Validators[0].(func(int) error)
+}
 
 const (
 	Version = "v0.13.1"                                         // Version of ent codegen.
@@ -1,12 +1,17 @@
 package schema
 
 import (
+	"context"
 	"time"
 
 	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
 	"entgo.io/ent/schema/edge"
 	"entgo.io/ent/schema/field"
 	"entgo.io/ent/schema/index"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/sanitize"
+	"github.com/gorexlv/cabinet/scissor/pkg/textutil"
 )
 
 type Article struct {
@@ -17,13 +22,39 @@ func (Article) Fields() []ent.Field {
 	return []ent.Field{
 		field.Uint("id").Positive(),
 		field.String("title"),
+		// content 经过白名单清理的HTML，content_text 为由其派生的纯文本，供检索、摘要和导出使用；
+		// content_text 为空（NULL）表示尚未派生，由后台任务补齐
 		field.Text("content"),
-		field.String("url").Unique(),
+		field.Text("content_text").Optional().Nillable(),
+		// 由正文派生的阅读元数据，word_count 为空（NULL）表示尚未统计
+		field.Int("word_count").Optional().Nillable(),
+		field.Int("reading_minutes").Optional(),
+		field.String("language").Optional(),
+		field.Int("image_count").Optional(),
+		field.String("lead_image").Optional(),
+		// url 在同一用户的文章中唯一，不同用户可以保存同一链接
+		field.String("url"),
+		field.String("canonical_url").Optional(),
+		field.Uint64("fingerprint").Optional(),
 		field.String("author"),
 		field.String("source"),
 		field.String("summary").Optional(),
 		field.JSON("tags", []string{}).Optional(),
 		field.Time("published_at"),
+		field.Int("user_id").StorageKey("user_articles"),
+		field.Int("cluster_id").Optional().Nillable(),
+		// cluster_checked_at 未归类文章最近一次参与聚类的时间，重新向量化后清除
+		field.Time("cluster_checked_at").Optional().Nillable(),
+		// fetch_status 正文抓取状态，导入的书签只有链接，由后台任务抓取正文
+		field.Enum("fetch_status").Values("pending", "done", "failed").Optional(),
+		field.String("fetch_error").Optional(),
+		field.Time("read_at").Optional().Nillable(),
+		// source_status 原文链接的检查结果：仍可访问、已删除、已迁移到新地址或内容已变化
+		field.Enum("source_status").Values("alive", "deleted", "moved", "changed").Optional(),
+		field.String("source_moved_to").Optional(),
+		field.Time("source_checked_at").Optional().Nillable(),
+		// source_fingerprint 首次检查时原文正文的指纹，之后的检查与其比较判断原文是否变化
+		field.Uint64("source_fingerprint").Optional(),
 		field.Time("created_at").Default(time.Now),
 		field.Time("updated_at").Default(time.Now).UpdateDefault(time.Now),
 	}
@@ -32,9 +63,16 @@ func (Article) Fields() []ent.Field {
 func (Article) Indexes() []ent.Index {
 	return []ent.Index{
 		index.Fields("title"),
-		index.Fields("url"),
+		index.Fields("user_id", "url").Unique(),
+		index.Fields("canonical_url"),
 		index.Fields("author"),
 		index.Fields("published_at"),
+		index.Fields("fetch_status"),
+		index.Fields("source_status"),
+		index.Fields("source_checked_at"),
+		index.Fields("reading_minutes"),
+		index.Fields("word_count"),
+		index.Fields("language"),
 	}
 }
 
@@ -42,7 +80,64 @@ func (Article) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.From("user", User.Type).
 			Ref("articles").
+			Field("user_id").
 			Unique().
 			Required(),
+		edge.From("cluster", TopicCluster.Type).
+			Ref("articles").
+			Field("cluster_id").
+			Unique(),
+		edge.To("chunks", ArticleChunk.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("highlights", Highlight.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("snapshots", Snapshot.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("revisions", ArticleRevision.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("translations", ArticleTranslation.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+		edge.To("enrichments", ArticleEnrichment.Type).
+			Annotations(entsql.OnDelete(entsql.Cascade)),
+	}
+}
+
+func (Article) Hooks() []ent.Hook {
+	return []ent.Hook{
+		sanitizeContent,
 	}
 }
+
+// contentMutation 文章变更中与正文相关的方法，避免schema依赖生成的代码
+type contentMutation interface {
+	Content() (string, bool)
+	SetContent(string)
+	SetContentText(string)
+	SetWordCount(int)
+	SetReadingMinutes(int)
+	SetLanguage(string)
+	SetImageCount(int)
+	SetLeadImage(string)
+}
+
+// sanitizeContent 写入正文时以白名单清理HTML并派生纯文本和阅读元数据，无论来自哪个接口都不会保存未经清理的正文
+func sanitizeContent(next ent.Mutator) ent.Mutator {
+	return ent.MutateFunc(func(ctx context.Context, m ent.Mutation) (ent.Value, error) {
+		if cm, ok := m.(contentMutation); ok && m.Op().Is(ent.OpCreate|ent.OpUpdate|ent.OpUpdateOne) {
+			if content, ok := cm.Content(); ok {
+				content = sanitize.HTML(content)
+				text := textutil.PlainText(content)
+				cm.SetContent(content)
+				cm.SetContentText(text)
+
+				stats := textutil.Analyze(content, text)
+				cm.SetWordCount(stats.WordCount)
+				cm.SetReadingMinutes(stats.ReadingMinutes)
+				cm.SetLanguage(stats.Language)
+				cm.SetImageCount(stats.ImageCount)
+				cm.SetLeadImage(stats.LeadImage)
+			}
+		}
+		return next.Mutate(ctx, m)
+	})
+}
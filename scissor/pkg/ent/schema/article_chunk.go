@@ -0,0 +1,49 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ArticleChunk 文章分块及其向量，用于语义检索
+type ArticleChunk struct {
+	ent.Schema
+}
+
+// Fields of the ArticleChunk.
+func (ArticleChunk) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("article_id"),
+		field.Int("seq").
+			NonNegative(),
+		field.Text("content"),
+		field.String("content_hash"),
+		field.String("model"),
+		field.JSON("embedding", []float32{}),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ArticleChunk.
+func (ArticleChunk) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("article", Article.Type).
+			Ref("chunks").
+			Field("article_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the ArticleChunk.
+func (ArticleChunk) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("article_id", "seq"),
+	}
+}
@@ -0,0 +1,60 @@
+package schema
+
+import (
+	"encoding/json"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ArticleEnrichment 附加补全任务（如要点、行动项）对文章的输出，每篇文章每个任务保留最新一份
+type ArticleEnrichment struct {
+	ent.Schema
+}
+
+// Fields of the ArticleEnrichment.
+func (ArticleEnrichment) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("article_id").
+			Immutable(),
+		field.String("name").
+			Immutable(),
+		// 整理后的模型输出文本
+		field.Text("content"),
+		// 结构化输出，list 格式为字符串数组，json 格式为模型输出的JSON，text 格式为空
+		field.JSON("data", json.RawMessage{}).
+			Optional(),
+		field.Enum("format").
+			Values("text", "list", "json").
+			Default("text"),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ArticleEnrichment.
+func (ArticleEnrichment) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("article", Article.Type).
+			Ref("enrichments").
+			Field("article_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the ArticleEnrichment.
+func (ArticleEnrichment) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("article_id", "name").
+			Unique(),
+	}
+}
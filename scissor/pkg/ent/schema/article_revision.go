@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ArticleRevision 文章标题、正文、摘要和标签的历史版本，每次修改这些字段后记录一份
+type ArticleRevision struct {
+	ent.Schema
+}
+
+// Fields of the ArticleRevision.
+func (ArticleRevision) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("article_id").
+			Immutable(),
+		field.String("title").
+			Immutable(),
+		field.Text("content").
+			Immutable(),
+		field.String("summary").
+			Optional().
+			Immutable(),
+		field.JSON("tags", []string{}).
+			Optional().
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the ArticleRevision.
+func (ArticleRevision) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("article", Article.Type).
+			Ref("revisions").
+			Field("article_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the ArticleRevision.
+func (ArticleRevision) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("article_id"),
+	}
+}
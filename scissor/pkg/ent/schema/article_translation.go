@@ -0,0 +1,69 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// ArticleTranslation 文章的另一种语言版本，由后台任务按段落翻译生成
+type ArticleTranslation struct {
+	ent.Schema
+}
+
+// Fields of the ArticleTranslation.
+func (ArticleTranslation) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("article_id").
+			Immutable(),
+		// 目标语言的 ISO 639-1 代码
+		field.String("language").
+			Immutable(),
+		field.Enum("status").
+			Values("pending", "running", "done", "failed").
+			Default("pending"),
+		field.String("title").
+			Optional(),
+		// 译文HTML，已经过白名单清理
+		field.Text("content").
+			Optional(),
+		// 双语对照HTML，每个段落内依次包含原文和译文
+		field.Text("bilingual").
+			Optional(),
+		// 翻译时原文的内容指纹，用于判断原文是否已修改
+		field.Uint64("source_fingerprint").
+			Optional(),
+		field.String("error").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the ArticleTranslation.
+func (ArticleTranslation) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("article", Article.Type).
+			Ref("translations").
+			Field("article_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the ArticleTranslation.
+func (ArticleTranslation) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("article_id", "language").
+			Unique(),
+		index.Fields("status"),
+	}
+}
@@ -0,0 +1,65 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Export 后台生成的文章库导出文件
+type Export struct {
+	ent.Schema
+}
+
+// Fields of the Export.
+func (Export) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.Enum("status").
+			Values("pending", "running", "done", "failed").
+			Default("pending"),
+		// 导出文件的存储路径
+		field.String("path").
+			Optional(),
+		field.Int64("size").
+			NonNegative().
+			Default(0),
+		field.Int("article_count").
+			NonNegative().
+			Default(0),
+		field.String("error").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		// started_at 最近一次开始执行的时间，用于领取进程中断后遗留的任务
+		field.Time("started_at").
+			Optional().
+			Nillable(),
+		field.Time("finished_at").
+			Optional().
+			Nillable(),
+	}
+}
+
+// Edges of the Export.
+func (Export) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("exports").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Export.
+func (Export) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+		index.Fields("status"),
+	}
+}
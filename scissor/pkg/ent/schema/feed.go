@@ -0,0 +1,88 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// FeedRules 决定哪些条目自动保存，各类规则均为空时保存全部条目。
+// 同一类规则满足任意一项即可，不同类规则需同时满足。
+type FeedRules struct {
+	// Keywords 标题或正文包含任一关键词
+	Keywords []string `json:"keywords,omitempty"`
+	// Authors 作者为其中之一
+	Authors []string `json:"authors,omitempty"`
+	// Tags 条目分类包含任一标签
+	Tags []string `json:"tags,omitempty"`
+}
+
+// Feed 用户订阅的 RSS/Atom 源
+type Feed struct {
+	ent.Schema
+}
+
+// Fields of the Feed.
+func (Feed) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("url"),
+		field.String("title").
+			Optional(),
+		field.String("site_url").
+			Optional(),
+		field.JSON("rules", FeedRules{}).
+			Optional(),
+		// tags 自动保存的文章附加的标签
+		field.JSON("tags", []string{}).
+			Optional(),
+		field.Bool("enabled").
+			Default(true),
+		field.String("etag").
+			Optional(),
+		field.String("last_modified").
+			Optional(),
+		// seen 最近处理过的条目标识，避免重复保存用户已删除的文章
+		field.JSON("seen", []string{}).
+			Optional(),
+		field.Time("last_fetched_at").
+			Optional().
+			Nillable(),
+		field.Time("last_success_at").
+			Optional().
+			Nillable(),
+		field.Int("error_count").
+			NonNegative().
+			Default(0),
+		field.String("last_error").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Feed.
+func (Feed) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("feeds").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Feed.
+func (Feed) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "url").
+			Unique(),
+	}
+}
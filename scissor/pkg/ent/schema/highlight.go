@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Highlight 文章中的划线及批注
+type Highlight struct {
+	ent.Schema
+}
+
+// Fields of the Highlight.
+func (Highlight) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("article_id"),
+		field.Text("text"),
+		field.Text("note").
+			Optional(),
+		// location 在原文中的位置，如 Kindle 的位置范围
+		field.String("location").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the Highlight.
+func (Highlight) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("article", Article.Type).
+			Ref("highlights").
+			Field("article_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Highlight.
+func (Highlight) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("article_id"),
+	}
+}
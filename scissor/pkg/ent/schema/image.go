@@ -0,0 +1,52 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Image 从文章中下载归档的图片，同一用户的相同图片只保存一份
+type Image struct {
+	ent.Schema
+}
+
+// Fields of the Image.
+func (Image) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		// hash 图片内容的SHA-256，也是存储中的对象键
+		field.String("hash"),
+		field.String("content_type"),
+		field.Int64("size"),
+		// source_url 首次下载时的原始地址，内嵌的 data URI 图片为空
+		field.Text("source_url").
+			Optional(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Image.
+func (Image) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("images").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Image.
+func (Image) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "hash").
+			Unique(),
+		index.Fields("hash"),
+	}
+}
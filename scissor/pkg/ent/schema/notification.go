@@ -0,0 +1,55 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Notification 站内通知，如收藏文章的原文被删除
+type Notification struct {
+	ent.Schema
+}
+
+// Fields of the Notification.
+func (Notification) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		// kind 通知类型，如 source_deleted、source_moved、source_changed
+		field.String("kind"),
+		field.String("title"),
+		field.Text("body").
+			Optional(),
+		// article_id 相关的文章，文章删除后保留通知
+		field.Uint("article_id").
+			Optional().
+			Nillable(),
+		field.Time("read_at").
+			Optional().
+			Nillable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Notification.
+func (Notification) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("notifications").
+			Field("user_id").
+			Unique().
+			Required(),
+	}
+}
+
+// Indexes of the Notification.
+func (Notification) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "read_at"),
+	}
+}
@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// PromptTemplate 用户自定义的提示词模板，与系统模板同名时覆盖系统模板
+type PromptTemplate struct {
+	ent.Schema
+}
+
+// Fields of the PromptTemplate.
+func (PromptTemplate) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id").
+			Immutable(),
+		// 任务名称，summary 和 tags 为摘要和标签，其他名称为附加补全任务
+		field.String("name").
+			Immutable(),
+		field.String("title").
+			Optional(),
+		// Go text/template 模板，以文章字段为数据
+		field.Text("template"),
+		field.Enum("format").
+			Values("text", "list", "json").
+			Default("text"),
+		// 文章保存后自动执行
+		field.Bool("auto").
+			Default(false),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the PromptTemplate.
+func (PromptTemplate) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("prompt_templates").
+			Field("user_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the PromptTemplate.
+func (PromptTemplate) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id", "name").
+			Unique(),
+	}
+}
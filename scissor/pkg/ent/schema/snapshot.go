@@ -0,0 +1,62 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// Snapshot 文章原网页的不可变快照，保存后不再修改
+type Snapshot struct {
+	ent.Schema
+}
+
+// Fields of the Snapshot.
+func (Snapshot) Fields() []ent.Field {
+	return []ent.Field{
+		field.Uint("article_id").
+			Immutable(),
+		// html 为内联了样式和图片的单文件网页，warc 为原始的请求和响应记录
+		field.Enum("format").
+			Values("html", "warc").
+			Immutable(),
+		// 快照文件的存储路径
+		field.String("storage_key").
+			Immutable(),
+		field.Int64("size").
+			NonNegative().
+			Immutable(),
+		field.String("sha256").
+			Immutable(),
+		// 跟随重定向后实际保存的地址
+		field.Text("source_url").
+			Immutable(),
+		field.Int("status_code").
+			Immutable(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+	}
+}
+
+// Edges of the Snapshot.
+func (Snapshot) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("article", Article.Type).
+			Ref("snapshots").
+			Field("article_id").
+			Unique().
+			Required().
+			Immutable(),
+	}
+}
+
+// Indexes of the Snapshot.
+func (Snapshot) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("article_id"),
+	}
+}
@@ -0,0 +1,59 @@
+package schema
+
+import (
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/entsql"
+	"entgo.io/ent/schema/edge"
+	"entgo.io/ent/schema/field"
+	"entgo.io/ent/schema/index"
+)
+
+// TopicCluster 按内容相似度自动聚合的文章主题
+type TopicCluster struct {
+	ent.Schema
+}
+
+// Fields of the TopicCluster.
+func (TopicCluster) Fields() []ent.Field {
+	return []ent.Field{
+		field.Int("user_id"),
+		field.String("name"),
+		field.JSON("keywords", []string{}).
+			Optional(),
+		field.JSON("centroid", []float32{}),
+		field.String("model"),
+		field.Int("size").
+			NonNegative(),
+		// 上次命名时的成员数，成员数翻倍后重新命名
+		field.Int("named_size").
+			NonNegative(),
+		field.Time("created_at").
+			Default(time.Now).
+			Immutable(),
+		field.Time("updated_at").
+			Default(time.Now).
+			UpdateDefault(time.Now),
+	}
+}
+
+// Edges of the TopicCluster.
+func (TopicCluster) Edges() []ent.Edge {
+	return []ent.Edge{
+		edge.From("user", User.Type).
+			Ref("clusters").
+			Field("user_id").
+			Unique().
+			Required(),
+		edge.To("articles", Article.Type).
+			Annotations(entsql.OnDelete(entsql.SetNull)),
+	}
+}
+
+// Indexes of the TopicCluster.
+func (TopicCluster) Indexes() []ent.Index {
+	return []ent.Index{
+		index.Fields("user_id"),
+	}
+}
@@ -32,6 +32,16 @@ func (User) Fields() []ent.Field {
 			Unique(),
 		field.String("nickname").
 			Optional(),
+		// feed_token 订阅文章库RSS时使用的令牌，代替JWT放在订阅地址中
+		field.String("feed_token").
+			Optional().
+			Unique().
+			Sensitive(),
+		// mail_token 收件地址 u<id>+<token>@域名 中的令牌
+		field.String("mail_token").
+			Optional().
+			Unique().
+			Sensitive(),
 		field.Time("created_at").
 			Default(time.Now).
 			Immutable(),
@@ -45,6 +55,13 @@ func (User) Fields() []ent.Field {
 func (User) Edges() []ent.Edge {
 	return []ent.Edge{
 		edge.To("articles", Article.Type),
+		edge.To("clusters", TopicCluster.Type),
+		edge.To("exports", Export.Type),
+		edge.To("feeds", Feed.Type),
+		edge.To("access_tokens", AccessToken.Type),
+		edge.To("images", Image.Type),
+		edge.To("notifications", Notification.Type),
+		edge.To("prompt_templates", PromptTemplate.Type),
 	}
 }
 
@@ -0,0 +1,212 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/snapshot"
+)
+
+// Snapshot is the model entity for the Snapshot schema.
+type Snapshot struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// ArticleID holds the value of the "article_id" field.
+	ArticleID uint `json:"article_id,omitempty"`
+	// Format holds the value of the "format" field.
+	Format snapshot.Format `json:"format,omitempty"`
+	// StorageKey holds the value of the "storage_key" field.
+	StorageKey string `json:"storage_key,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int64 `json:"size,omitempty"`
+	// Sha256 holds the value of the "sha256" field.
+	Sha256 string `json:"sha256,omitempty"`
+	// SourceURL holds the value of the "source_url" field.
+	SourceURL string `json:"source_url,omitempty"`
+	// StatusCode holds the value of the "status_code" field.
+	StatusCode int `json:"status_code,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the SnapshotQuery when eager-loading is set.
+	Edges        SnapshotEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// SnapshotEdges holds the relations/edges for other nodes in the graph.
+type SnapshotEdges struct {
+	// Article holds the value of the article edge.
+	Article *Article `json:"article,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [1]bool
+}
+
+// ArticleOrErr returns the Article value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e SnapshotEdges) ArticleOrErr() (*Article, error) {
+	if e.Article != nil {
+		return e.Article, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: article.Label}
+	}
+	return nil, &NotLoadedError{edge: "article"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*Snapshot) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case snapshot.FieldID, snapshot.FieldArticleID, snapshot.FieldSize, snapshot.FieldStatusCode:
+			values[i] = new(sql.NullInt64)
+		case snapshot.FieldFormat, snapshot.FieldStorageKey, snapshot.FieldSha256, snapshot.FieldSourceURL:
+			values[i] = new(sql.NullString)
+		case snapshot.FieldCreatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the Snapshot fields.
+func (s *Snapshot) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case snapshot.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			s.ID = int(value.Int64)
+		case snapshot.FieldArticleID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field article_id", values[i])
+			} else if value.Valid {
+				s.ArticleID = uint(value.Int64)
+			}
+		case snapshot.FieldFormat:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field format", values[i])
+			} else if value.Valid {
+				s.Format = snapshot.Format(value.String)
+			}
+		case snapshot.FieldStorageKey:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field storage_key", values[i])
+			} else if value.Valid {
+				s.StorageKey = value.String
+			}
+		case snapshot.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				s.Size = value.Int64
+			}
+		case snapshot.FieldSha256:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field sha256", values[i])
+			} else if value.Valid {
+				s.Sha256 = value.String
+			}
+		case snapshot.FieldSourceURL:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field source_url", values[i])
+			} else if value.Valid {
+				s.SourceURL = value.String
+			}
+		case snapshot.FieldStatusCode:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field status_code", values[i])
+			} else if value.Valid {
+				s.StatusCode = int(value.Int64)
+			}
+		case snapshot.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				s.CreatedAt = value.Time
+			}
+		default:
+			s.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the Snapshot.
+// This includes values selected through modifiers, order, etc.
+func (s *Snapshot) Value(name string) (ent.Value, error) {
+	return s.selectValues.Get(name)
+}
+
+// QueryArticle queries the "article" edge of the Snapshot entity.
+func (s *Snapshot) QueryArticle() *ArticleQuery {
+	return NewSnapshotClient(s.config).QueryArticle(s)
+}
+
+// Update returns a builder for updating this Snapshot.
+// Note that you need to call Snapshot.Unwrap() before calling this method if this Snapshot
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (s *Snapshot) Update() *SnapshotUpdateOne {
+	return NewSnapshotClient(s.config).UpdateOne(s)
+}
+
+// Unwrap unwraps the Snapshot entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (s *Snapshot) Unwrap() *Snapshot {
+	_tx, ok := s.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: Snapshot is not a transactional entity")
+	}
+	s.config.driver = _tx.drv
+	return s
+}
+
+// String implements the fmt.Stringer.
+func (s *Snapshot) String() string {
+	var builder strings.Builder
+	builder.WriteString("Snapshot(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", s.ID))
+	builder.WriteString("article_id=")
+	builder.WriteString(fmt.Sprintf("%v", s.ArticleID))
+	builder.WriteString(", ")
+	builder.WriteString("format=")
+	builder.WriteString(fmt.Sprintf("%v", s.Format))
+	builder.WriteString(", ")
+	builder.WriteString("storage_key=")
+	builder.WriteString(s.StorageKey)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", s.Size))
+	builder.WriteString(", ")
+	builder.WriteString("sha256=")
+	builder.WriteString(s.Sha256)
+	builder.WriteString(", ")
+	builder.WriteString("source_url=")
+	builder.WriteString(s.SourceURL)
+	builder.WriteString(", ")
+	builder.WriteString("status_code=")
+	builder.WriteString(fmt.Sprintf("%v", s.StatusCode))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(s.CreatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// Snapshots is a parsable slice of Snapshot.
+type Snapshots []*Snapshot
@@ -0,0 +1,325 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/snapshot"
+)
+
+// SnapshotCreate is the builder for creating a Snapshot entity.
+type SnapshotCreate struct {
+	config
+	mutation *SnapshotMutation
+	hooks    []Hook
+}
+
+// SetArticleID sets the "article_id" field.
+func (sc *SnapshotCreate) SetArticleID(u uint) *SnapshotCreate {
+	sc.mutation.SetArticleID(u)
+	return sc
+}
+
+// SetFormat sets the "format" field.
+func (sc *SnapshotCreate) SetFormat(s snapshot.Format) *SnapshotCreate {
+	sc.mutation.SetFormat(s)
+	return sc
+}
+
+// SetStorageKey sets the "storage_key" field.
+func (sc *SnapshotCreate) SetStorageKey(s string) *SnapshotCreate {
+	sc.mutation.SetStorageKey(s)
+	return sc
+}
+
+// SetSize sets the "size" field.
+func (sc *SnapshotCreate) SetSize(i int64) *SnapshotCreate {
+	sc.mutation.SetSize(i)
+	return sc
+}
+
+// SetSha256 sets the "sha256" field.
+func (sc *SnapshotCreate) SetSha256(s string) *SnapshotCreate {
+	sc.mutation.SetSha256(s)
+	return sc
+}
+
+// SetSourceURL sets the "source_url" field.
+func (sc *SnapshotCreate) SetSourceURL(s string) *SnapshotCreate {
+	sc.mutation.SetSourceURL(s)
+	return sc
+}
+
+// SetStatusCode sets the "status_code" field.
+func (sc *SnapshotCreate) SetStatusCode(i int) *SnapshotCreate {
+	sc.mutation.SetStatusCode(i)
+	return sc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (sc *SnapshotCreate) SetCreatedAt(t time.Time) *SnapshotCreate {
+	sc.mutation.SetCreatedAt(t)
+	return sc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (sc *SnapshotCreate) SetNillableCreatedAt(t *time.Time) *SnapshotCreate {
+	if t != nil {
+		sc.SetCreatedAt(*t)
+	}
+	return sc
+}
+
+// SetArticle sets the "article" edge to the Article entity.
+func (sc *SnapshotCreate) SetArticle(a *Article) *SnapshotCreate {
+	return sc.SetArticleID(a.ID)
+}
+
+// Mutation returns the SnapshotMutation object of the builder.
+func (sc *SnapshotCreate) Mutation() *SnapshotMutation {
+	return sc.mutation
+}
+
+// Save creates the Snapshot in the database.
+func (sc *SnapshotCreate) Save(ctx context.Context) (*Snapshot, error) {
+	sc.defaults()
+	return withHooks(ctx, sc.sqlSave, sc.mutation, sc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (sc *SnapshotCreate) SaveX(ctx context.Context) *Snapshot {
+	v, err := sc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (sc *SnapshotCreate) Exec(ctx context.Context) error {
+	_, err := sc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (sc *SnapshotCreate) ExecX(ctx context.Context) {
+	if err := sc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (sc *SnapshotCreate) defaults() {
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		v := snapshot.DefaultCreatedAt()
+		sc.mutation.SetCreatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (sc *SnapshotCreate) check() error {
+	if _, ok := sc.mutation.ArticleID(); !ok {
+		return &ValidationError{Name: "article_id", err: errors.New(`ent: missing required field "Snapshot.article_id"`)}
+	}
+	if _, ok := sc.mutation.Format(); !ok {
+		return &ValidationError{Name: "format", err: errors.New(`ent: missing required field "Snapshot.format"`)}
+	}
+	if v, ok := sc.mutation.Format(); ok {
+		if err := snapshot.FormatValidator(v); err != nil {
+			return &ValidationError{Name: "format", err: fmt.Errorf(`ent: validator failed for field "Snapshot.format": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.StorageKey(); !ok {
+		return &ValidationError{Name: "storage_key", err: errors.New(`ent: missing required field "Snapshot.storage_key"`)}
+	}
+	if _, ok := sc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "Snapshot.size"`)}
+	}
+	if v, ok := sc.mutation.Size(); ok {
+		if err := snapshot.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "Snapshot.size": %w`, err)}
+		}
+	}
+	if _, ok := sc.mutation.Sha256(); !ok {
+		return &ValidationError{Name: "sha256", err: errors.New(`ent: missing required field "Snapshot.sha256"`)}
+	}
+	if _, ok := sc.mutation.SourceURL(); !ok {
+		return &ValidationError{Name: "source_url", err: errors.New(`ent: missing required field "Snapshot.source_url"`)}
+	}
+	if _, ok := sc.mutation.StatusCode(); !ok {
+		return &ValidationError{Name: "status_code", err: errors.New(`ent: missing required field "Snapshot.status_code"`)}
+	}
+	if _, ok := sc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "Snapshot.created_at"`)}
+	}
+	if _, ok := sc.mutation.ArticleID(); !ok {
+		return &ValidationError{Name: "article", err: errors.New(`ent: missing required edge "Snapshot.article"`)}
+	}
+	return nil
+}
+
+func (sc *SnapshotCreate) sqlSave(ctx context.Context) (*Snapshot, error) {
+	if err := sc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := sc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, sc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	sc.mutation.id = &_node.ID
+	sc.mutation.done = true
+	return _node, nil
+}
+
+func (sc *SnapshotCreate) createSpec() (*Snapshot, *sqlgraph.CreateSpec) {
+	var (
+		_node = &Snapshot{config: sc.config}
+		_spec = sqlgraph.NewCreateSpec(snapshot.Table, sqlgraph.NewFieldSpec(snapshot.FieldID, field.TypeInt))
+	)
+	if value, ok := sc.mutation.Format(); ok {
+		_spec.SetField(snapshot.FieldFormat, field.TypeEnum, value)
+		_node.Format = value
+	}
+	if value, ok := sc.mutation.StorageKey(); ok {
+		_spec.SetField(snapshot.FieldStorageKey, field.TypeString, value)
+		_node.StorageKey = value
+	}
+	if value, ok := sc.mutation.Size(); ok {
+		_spec.SetField(snapshot.FieldSize, field.TypeInt64, value)
+		_node.Size = value
+	}
+	if value, ok := sc.mutation.Sha256(); ok {
+		_spec.SetField(snapshot.FieldSha256, field.TypeString, value)
+		_node.Sha256 = value
+	}
+	if value, ok := sc.mutation.SourceURL(); ok {
+		_spec.SetField(snapshot.FieldSourceURL, field.TypeString, value)
+		_node.SourceURL = value
+	}
+	if value, ok := sc.mutation.StatusCode(); ok {
+		_spec.SetField(snapshot.FieldStatusCode, field.TypeInt, value)
+		_node.StatusCode = value
+	}
+	if value, ok := sc.mutation.CreatedAt(); ok {
+		_spec.SetField(snapshot.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if nodes := sc.mutation.ArticleIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   snapshot.ArticleTable,
+			Columns: []string{snapshot.ArticleColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.ArticleID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// SnapshotCreateBulk is the builder for creating many Snapshot entities in bulk.
+type SnapshotCreateBulk struct {
+	config
+	err      error
+	builders []*SnapshotCreate
+}
+
+// Save creates the Snapshot entities in the database.
+func (scb *SnapshotCreateBulk) Save(ctx context.Context) ([]*Snapshot, error) {
+	if scb.err != nil {
+		return nil, scb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(scb.builders))
+	nodes := make([]*Snapshot, len(scb.builders))
+	mutators := make([]Mutator, len(scb.builders))
+	for i := range scb.builders {
+		func(i int, root context.Context) {
+			builder := scb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*SnapshotMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, scb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, scb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, scb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (scb *SnapshotCreateBulk) SaveX(ctx context.Context) []*Snapshot {
+	v, err := scb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (scb *SnapshotCreateBulk) Exec(ctx context.Context) error {
+	_, err := scb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (scb *SnapshotCreateBulk) ExecX(ctx context.Context) {
+	if err := scb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
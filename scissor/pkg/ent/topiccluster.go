@@ -0,0 +1,246 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+
+	"entgo.io/ent"
+	"entgo.io/ent/dialect/sql"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/topiccluster"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
+)
+
+// TopicCluster is the model entity for the TopicCluster schema.
+type TopicCluster struct {
+	config `json:"-"`
+	// ID of the ent.
+	ID int `json:"id,omitempty"`
+	// UserID holds the value of the "user_id" field.
+	UserID int `json:"user_id,omitempty"`
+	// Name holds the value of the "name" field.
+	Name string `json:"name,omitempty"`
+	// Keywords holds the value of the "keywords" field.
+	Keywords []string `json:"keywords,omitempty"`
+	// Centroid holds the value of the "centroid" field.
+	Centroid []float32 `json:"centroid,omitempty"`
+	// Model holds the value of the "model" field.
+	Model string `json:"model,omitempty"`
+	// Size holds the value of the "size" field.
+	Size int `json:"size,omitempty"`
+	// NamedSize holds the value of the "named_size" field.
+	NamedSize int `json:"named_size,omitempty"`
+	// CreatedAt holds the value of the "created_at" field.
+	CreatedAt time.Time `json:"created_at,omitempty"`
+	// UpdatedAt holds the value of the "updated_at" field.
+	UpdatedAt time.Time `json:"updated_at,omitempty"`
+	// Edges holds the relations/edges for other nodes in the graph.
+	// The values are being populated by the TopicClusterQuery when eager-loading is set.
+	Edges        TopicClusterEdges `json:"edges"`
+	selectValues sql.SelectValues
+}
+
+// TopicClusterEdges holds the relations/edges for other nodes in the graph.
+type TopicClusterEdges struct {
+	// User holds the value of the user edge.
+	User *User `json:"user,omitempty"`
+	// Articles holds the value of the articles edge.
+	Articles []*Article `json:"articles,omitempty"`
+	// loadedTypes holds the information for reporting if a
+	// type was loaded (or requested) in eager-loading or not.
+	loadedTypes [2]bool
+}
+
+// UserOrErr returns the User value or an error if the edge
+// was not loaded in eager-loading, or loaded but was not found.
+func (e TopicClusterEdges) UserOrErr() (*User, error) {
+	if e.User != nil {
+		return e.User, nil
+	} else if e.loadedTypes[0] {
+		return nil, &NotFoundError{label: user.Label}
+	}
+	return nil, &NotLoadedError{edge: "user"}
+}
+
+// ArticlesOrErr returns the Articles value or an error if the edge
+// was not loaded in eager-loading.
+func (e TopicClusterEdges) ArticlesOrErr() ([]*Article, error) {
+	if e.loadedTypes[1] {
+		return e.Articles, nil
+	}
+	return nil, &NotLoadedError{edge: "articles"}
+}
+
+// scanValues returns the types for scanning values from sql.Rows.
+func (*TopicCluster) scanValues(columns []string) ([]any, error) {
+	values := make([]any, len(columns))
+	for i := range columns {
+		switch columns[i] {
+		case topiccluster.FieldKeywords, topiccluster.FieldCentroid:
+			values[i] = new([]byte)
+		case topiccluster.FieldID, topiccluster.FieldUserID, topiccluster.FieldSize, topiccluster.FieldNamedSize:
+			values[i] = new(sql.NullInt64)
+		case topiccluster.FieldName, topiccluster.FieldModel:
+			values[i] = new(sql.NullString)
+		case topiccluster.FieldCreatedAt, topiccluster.FieldUpdatedAt:
+			values[i] = new(sql.NullTime)
+		default:
+			values[i] = new(sql.UnknownType)
+		}
+	}
+	return values, nil
+}
+
+// assignValues assigns the values that were returned from sql.Rows (after scanning)
+// to the TopicCluster fields.
+func (tc *TopicCluster) assignValues(columns []string, values []any) error {
+	if m, n := len(values), len(columns); m < n {
+		return fmt.Errorf("mismatch number of scan values: %d != %d", m, n)
+	}
+	for i := range columns {
+		switch columns[i] {
+		case topiccluster.FieldID:
+			value, ok := values[i].(*sql.NullInt64)
+			if !ok {
+				return fmt.Errorf("unexpected type %T for field id", value)
+			}
+			tc.ID = int(value.Int64)
+		case topiccluster.FieldUserID:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field user_id", values[i])
+			} else if value.Valid {
+				tc.UserID = int(value.Int64)
+			}
+		case topiccluster.FieldName:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field name", values[i])
+			} else if value.Valid {
+				tc.Name = value.String
+			}
+		case topiccluster.FieldKeywords:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field keywords", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tc.Keywords); err != nil {
+					return fmt.Errorf("unmarshal field keywords: %w", err)
+				}
+			}
+		case topiccluster.FieldCentroid:
+			if value, ok := values[i].(*[]byte); !ok {
+				return fmt.Errorf("unexpected type %T for field centroid", values[i])
+			} else if value != nil && len(*value) > 0 {
+				if err := json.Unmarshal(*value, &tc.Centroid); err != nil {
+					return fmt.Errorf("unmarshal field centroid: %w", err)
+				}
+			}
+		case topiccluster.FieldModel:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field model", values[i])
+			} else if value.Valid {
+				tc.Model = value.String
+			}
+		case topiccluster.FieldSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field size", values[i])
+			} else if value.Valid {
+				tc.Size = int(value.Int64)
+			}
+		case topiccluster.FieldNamedSize:
+			if value, ok := values[i].(*sql.NullInt64); !ok {
+				return fmt.Errorf("unexpected type %T for field named_size", values[i])
+			} else if value.Valid {
+				tc.NamedSize = int(value.Int64)
+			}
+		case topiccluster.FieldCreatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field created_at", values[i])
+			} else if value.Valid {
+				tc.CreatedAt = value.Time
+			}
+		case topiccluster.FieldUpdatedAt:
+			if value, ok := values[i].(*sql.NullTime); !ok {
+				return fmt.Errorf("unexpected type %T for field updated_at", values[i])
+			} else if value.Valid {
+				tc.UpdatedAt = value.Time
+			}
+		default:
+			tc.selectValues.Set(columns[i], values[i])
+		}
+	}
+	return nil
+}
+
+// Value returns the ent.Value that was dynamically selected and assigned to the TopicCluster.
+// This includes values selected through modifiers, order, etc.
+func (tc *TopicCluster) Value(name string) (ent.Value, error) {
+	return tc.selectValues.Get(name)
+}
+
+// QueryUser queries the "user" edge of the TopicCluster entity.
+func (tc *TopicCluster) QueryUser() *UserQuery {
+	return NewTopicClusterClient(tc.config).QueryUser(tc)
+}
+
+// QueryArticles queries the "articles" edge of the TopicCluster entity.
+func (tc *TopicCluster) QueryArticles() *ArticleQuery {
+	return NewTopicClusterClient(tc.config).QueryArticles(tc)
+}
+
+// Update returns a builder for updating this TopicCluster.
+// Note that you need to call TopicCluster.Unwrap() before calling this method if this TopicCluster
+// was returned from a transaction, and the transaction was committed or rolled back.
+func (tc *TopicCluster) Update() *TopicClusterUpdateOne {
+	return NewTopicClusterClient(tc.config).UpdateOne(tc)
+}
+
+// Unwrap unwraps the TopicCluster entity that was returned from a transaction after it was closed,
+// so that all future queries will be executed through the driver which created the transaction.
+func (tc *TopicCluster) Unwrap() *TopicCluster {
+	_tx, ok := tc.config.driver.(*txDriver)
+	if !ok {
+		panic("ent: TopicCluster is not a transactional entity")
+	}
+	tc.config.driver = _tx.drv
+	return tc
+}
+
+// String implements the fmt.Stringer.
+func (tc *TopicCluster) String() string {
+	var builder strings.Builder
+	builder.WriteString("TopicCluster(")
+	builder.WriteString(fmt.Sprintf("id=%v, ", tc.ID))
+	builder.WriteString("user_id=")
+	builder.WriteString(fmt.Sprintf("%v", tc.UserID))
+	builder.WriteString(", ")
+	builder.WriteString("name=")
+	builder.WriteString(tc.Name)
+	builder.WriteString(", ")
+	builder.WriteString("keywords=")
+	builder.WriteString(fmt.Sprintf("%v", tc.Keywords))
+	builder.WriteString(", ")
+	builder.WriteString("centroid=")
+	builder.WriteString(fmt.Sprintf("%v", tc.Centroid))
+	builder.WriteString(", ")
+	builder.WriteString("model=")
+	builder.WriteString(tc.Model)
+	builder.WriteString(", ")
+	builder.WriteString("size=")
+	builder.WriteString(fmt.Sprintf("%v", tc.Size))
+	builder.WriteString(", ")
+	builder.WriteString("named_size=")
+	builder.WriteString(fmt.Sprintf("%v", tc.NamedSize))
+	builder.WriteString(", ")
+	builder.WriteString("created_at=")
+	builder.WriteString(tc.CreatedAt.Format(time.ANSIC))
+	builder.WriteString(", ")
+	builder.WriteString("updated_at=")
+	builder.WriteString(tc.UpdatedAt.Format(time.ANSIC))
+	builder.WriteByte(')')
+	return builder.String()
+}
+
+// TopicClusters is a parsable slice of TopicCluster.
+type TopicClusters []*TopicCluster
@@ -0,0 +1,472 @@
+// Code generated by ent, DO NOT EDIT.
+
+package topiccluster
+
+import (
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+)
+
+// ID filters vertices based on their ID field.
+func ID(id int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldEQ(FieldID, id))
+}
+
+// IDEQ applies the EQ predicate on the ID field.
+func IDEQ(id int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldEQ(FieldID, id))
+}
+
+// IDNEQ applies the NEQ predicate on the ID field.
+func IDNEQ(id int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldNEQ(FieldID, id))
+}
+
+// IDIn applies the In predicate on the ID field.
+func IDIn(ids ...int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldIn(FieldID, ids...))
+}
+
+// IDNotIn applies the NotIn predicate on the ID field.
+func IDNotIn(ids ...int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldNotIn(FieldID, ids...))
+}
+
+// IDGT applies the GT predicate on the ID field.
+func IDGT(id int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldGT(FieldID, id))
+}
+
+// IDGTE applies the GTE predicate on the ID field.
+func IDGTE(id int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldGTE(FieldID, id))
+}
+
+// IDLT applies the LT predicate on the ID field.
+func IDLT(id int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldLT(FieldID, id))
+}
+
+// IDLTE applies the LTE predicate on the ID field.
+func IDLTE(id int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldLTE(FieldID, id))
+}
+
+// UserID applies equality check predicate on the "user_id" field. It's identical to UserIDEQ.
+func UserID(v int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldEQ(FieldUserID, v))
+}
+
+// Name applies equality check predicate on the "name" field. It's identical to NameEQ.
+func Name(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldEQ(FieldName, v))
+}
+
+// Model applies equality check predicate on the "model" field. It's identical to ModelEQ.
+func Model(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldEQ(FieldModel, v))
+}
+
+// Size applies equality check predicate on the "size" field. It's identical to SizeEQ.
+func Size(v int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldEQ(FieldSize, v))
+}
+
+// NamedSize applies equality check predicate on the "named_size" field. It's identical to NamedSizeEQ.
+func NamedSize(v int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldEQ(FieldNamedSize, v))
+}
+
+// CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
+func CreatedAt(v time.Time) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// UpdatedAt applies equality check predicate on the "updated_at" field. It's identical to UpdatedAtEQ.
+func UpdatedAt(v time.Time) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UserIDEQ applies the EQ predicate on the "user_id" field.
+func UserIDEQ(v int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldEQ(FieldUserID, v))
+}
+
+// UserIDNEQ applies the NEQ predicate on the "user_id" field.
+func UserIDNEQ(v int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldNEQ(FieldUserID, v))
+}
+
+// UserIDIn applies the In predicate on the "user_id" field.
+func UserIDIn(vs ...int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldIn(FieldUserID, vs...))
+}
+
+// UserIDNotIn applies the NotIn predicate on the "user_id" field.
+func UserIDNotIn(vs ...int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldNotIn(FieldUserID, vs...))
+}
+
+// NameEQ applies the EQ predicate on the "name" field.
+func NameEQ(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldEQ(FieldName, v))
+}
+
+// NameNEQ applies the NEQ predicate on the "name" field.
+func NameNEQ(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldNEQ(FieldName, v))
+}
+
+// NameIn applies the In predicate on the "name" field.
+func NameIn(vs ...string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldIn(FieldName, vs...))
+}
+
+// NameNotIn applies the NotIn predicate on the "name" field.
+func NameNotIn(vs ...string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldNotIn(FieldName, vs...))
+}
+
+// NameGT applies the GT predicate on the "name" field.
+func NameGT(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldGT(FieldName, v))
+}
+
+// NameGTE applies the GTE predicate on the "name" field.
+func NameGTE(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldGTE(FieldName, v))
+}
+
+// NameLT applies the LT predicate on the "name" field.
+func NameLT(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldLT(FieldName, v))
+}
+
+// NameLTE applies the LTE predicate on the "name" field.
+func NameLTE(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldLTE(FieldName, v))
+}
+
+// NameContains applies the Contains predicate on the "name" field.
+func NameContains(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldContains(FieldName, v))
+}
+
+// NameHasPrefix applies the HasPrefix predicate on the "name" field.
+func NameHasPrefix(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldHasPrefix(FieldName, v))
+}
+
+// NameHasSuffix applies the HasSuffix predicate on the "name" field.
+func NameHasSuffix(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldHasSuffix(FieldName, v))
+}
+
+// NameEqualFold applies the EqualFold predicate on the "name" field.
+func NameEqualFold(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldEqualFold(FieldName, v))
+}
+
+// NameContainsFold applies the ContainsFold predicate on the "name" field.
+func NameContainsFold(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldContainsFold(FieldName, v))
+}
+
+// KeywordsIsNil applies the IsNil predicate on the "keywords" field.
+func KeywordsIsNil() predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldIsNull(FieldKeywords))
+}
+
+// KeywordsNotNil applies the NotNil predicate on the "keywords" field.
+func KeywordsNotNil() predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldNotNull(FieldKeywords))
+}
+
+// ModelEQ applies the EQ predicate on the "model" field.
+func ModelEQ(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldEQ(FieldModel, v))
+}
+
+// ModelNEQ applies the NEQ predicate on the "model" field.
+func ModelNEQ(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldNEQ(FieldModel, v))
+}
+
+// ModelIn applies the In predicate on the "model" field.
+func ModelIn(vs ...string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldIn(FieldModel, vs...))
+}
+
+// ModelNotIn applies the NotIn predicate on the "model" field.
+func ModelNotIn(vs ...string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldNotIn(FieldModel, vs...))
+}
+
+// ModelGT applies the GT predicate on the "model" field.
+func ModelGT(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldGT(FieldModel, v))
+}
+
+// ModelGTE applies the GTE predicate on the "model" field.
+func ModelGTE(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldGTE(FieldModel, v))
+}
+
+// ModelLT applies the LT predicate on the "model" field.
+func ModelLT(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldLT(FieldModel, v))
+}
+
+// ModelLTE applies the LTE predicate on the "model" field.
+func ModelLTE(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldLTE(FieldModel, v))
+}
+
+// ModelContains applies the Contains predicate on the "model" field.
+func ModelContains(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldContains(FieldModel, v))
+}
+
+// ModelHasPrefix applies the HasPrefix predicate on the "model" field.
+func ModelHasPrefix(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldHasPrefix(FieldModel, v))
+}
+
+// ModelHasSuffix applies the HasSuffix predicate on the "model" field.
+func ModelHasSuffix(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldHasSuffix(FieldModel, v))
+}
+
+// ModelEqualFold applies the EqualFold predicate on the "model" field.
+func ModelEqualFold(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldEqualFold(FieldModel, v))
+}
+
+// ModelContainsFold applies the ContainsFold predicate on the "model" field.
+func ModelContainsFold(v string) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldContainsFold(FieldModel, v))
+}
+
+// SizeEQ applies the EQ predicate on the "size" field.
+func SizeEQ(v int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldEQ(FieldSize, v))
+}
+
+// SizeNEQ applies the NEQ predicate on the "size" field.
+func SizeNEQ(v int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldNEQ(FieldSize, v))
+}
+
+// SizeIn applies the In predicate on the "size" field.
+func SizeIn(vs ...int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldIn(FieldSize, vs...))
+}
+
+// SizeNotIn applies the NotIn predicate on the "size" field.
+func SizeNotIn(vs ...int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldNotIn(FieldSize, vs...))
+}
+
+// SizeGT applies the GT predicate on the "size" field.
+func SizeGT(v int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldGT(FieldSize, v))
+}
+
+// SizeGTE applies the GTE predicate on the "size" field.
+func SizeGTE(v int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldGTE(FieldSize, v))
+}
+
+// SizeLT applies the LT predicate on the "size" field.
+func SizeLT(v int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldLT(FieldSize, v))
+}
+
+// SizeLTE applies the LTE predicate on the "size" field.
+func SizeLTE(v int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldLTE(FieldSize, v))
+}
+
+// NamedSizeEQ applies the EQ predicate on the "named_size" field.
+func NamedSizeEQ(v int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldEQ(FieldNamedSize, v))
+}
+
+// NamedSizeNEQ applies the NEQ predicate on the "named_size" field.
+func NamedSizeNEQ(v int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldNEQ(FieldNamedSize, v))
+}
+
+// NamedSizeIn applies the In predicate on the "named_size" field.
+func NamedSizeIn(vs ...int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldIn(FieldNamedSize, vs...))
+}
+
+// NamedSizeNotIn applies the NotIn predicate on the "named_size" field.
+func NamedSizeNotIn(vs ...int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldNotIn(FieldNamedSize, vs...))
+}
+
+// NamedSizeGT applies the GT predicate on the "named_size" field.
+func NamedSizeGT(v int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldGT(FieldNamedSize, v))
+}
+
+// NamedSizeGTE applies the GTE predicate on the "named_size" field.
+func NamedSizeGTE(v int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldGTE(FieldNamedSize, v))
+}
+
+// NamedSizeLT applies the LT predicate on the "named_size" field.
+func NamedSizeLT(v int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldLT(FieldNamedSize, v))
+}
+
+// NamedSizeLTE applies the LTE predicate on the "named_size" field.
+func NamedSizeLTE(v int) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldLTE(FieldNamedSize, v))
+}
+
+// CreatedAtEQ applies the EQ predicate on the "created_at" field.
+func CreatedAtEQ(v time.Time) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtNEQ applies the NEQ predicate on the "created_at" field.
+func CreatedAtNEQ(v time.Time) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldNEQ(FieldCreatedAt, v))
+}
+
+// CreatedAtIn applies the In predicate on the "created_at" field.
+func CreatedAtIn(vs ...time.Time) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtNotIn applies the NotIn predicate on the "created_at" field.
+func CreatedAtNotIn(vs ...time.Time) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldNotIn(FieldCreatedAt, vs...))
+}
+
+// CreatedAtGT applies the GT predicate on the "created_at" field.
+func CreatedAtGT(v time.Time) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldGT(FieldCreatedAt, v))
+}
+
+// CreatedAtGTE applies the GTE predicate on the "created_at" field.
+func CreatedAtGTE(v time.Time) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldGTE(FieldCreatedAt, v))
+}
+
+// CreatedAtLT applies the LT predicate on the "created_at" field.
+func CreatedAtLT(v time.Time) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldLT(FieldCreatedAt, v))
+}
+
+// CreatedAtLTE applies the LTE predicate on the "created_at" field.
+func CreatedAtLTE(v time.Time) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldLTE(FieldCreatedAt, v))
+}
+
+// UpdatedAtEQ applies the EQ predicate on the "updated_at" field.
+func UpdatedAtEQ(v time.Time) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtNEQ applies the NEQ predicate on the "updated_at" field.
+func UpdatedAtNEQ(v time.Time) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldNEQ(FieldUpdatedAt, v))
+}
+
+// UpdatedAtIn applies the In predicate on the "updated_at" field.
+func UpdatedAtIn(vs ...time.Time) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtNotIn applies the NotIn predicate on the "updated_at" field.
+func UpdatedAtNotIn(vs ...time.Time) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldNotIn(FieldUpdatedAt, vs...))
+}
+
+// UpdatedAtGT applies the GT predicate on the "updated_at" field.
+func UpdatedAtGT(v time.Time) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldGT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtGTE applies the GTE predicate on the "updated_at" field.
+func UpdatedAtGTE(v time.Time) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldGTE(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLT applies the LT predicate on the "updated_at" field.
+func UpdatedAtLT(v time.Time) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldLT(FieldUpdatedAt, v))
+}
+
+// UpdatedAtLTE applies the LTE predicate on the "updated_at" field.
+func UpdatedAtLTE(v time.Time) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.FieldLTE(FieldUpdatedAt, v))
+}
+
+// HasUser applies the HasEdge predicate on the "user" edge.
+func HasUser() predicate.TopicCluster {
+	return predicate.TopicCluster(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, UserTable, UserColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasUserWith applies the HasEdge predicate on the "user" edge with a given conditions (other predicates).
+func HasUserWith(preds ...predicate.User) predicate.TopicCluster {
+	return predicate.TopicCluster(func(s *sql.Selector) {
+		step := newUserStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasArticles applies the HasEdge predicate on the "articles" edge.
+func HasArticles() predicate.TopicCluster {
+	return predicate.TopicCluster(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ArticlesTable, ArticlesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasArticlesWith applies the HasEdge predicate on the "articles" edge with a given conditions (other predicates).
+func HasArticlesWith(preds ...predicate.Article) predicate.TopicCluster {
+	return predicate.TopicCluster(func(s *sql.Selector) {
+		step := newArticlesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// And groups predicates with the AND operator between them.
+func And(predicates ...predicate.TopicCluster) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.AndPredicates(predicates...))
+}
+
+// Or groups predicates with the OR operator between them.
+func Or(predicates ...predicate.TopicCluster) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.OrPredicates(predicates...))
+}
+
+// Not applies the not operator on the given predicate.
+func Not(p predicate.TopicCluster) predicate.TopicCluster {
+	return predicate.TopicCluster(sql.NotPredicates(p))
+}
@@ -0,0 +1,379 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/topiccluster"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
+)
+
+// TopicClusterCreate is the builder for creating a TopicCluster entity.
+type TopicClusterCreate struct {
+	config
+	mutation *TopicClusterMutation
+	hooks    []Hook
+}
+
+// SetUserID sets the "user_id" field.
+func (tcc *TopicClusterCreate) SetUserID(i int) *TopicClusterCreate {
+	tcc.mutation.SetUserID(i)
+	return tcc
+}
+
+// SetName sets the "name" field.
+func (tcc *TopicClusterCreate) SetName(s string) *TopicClusterCreate {
+	tcc.mutation.SetName(s)
+	return tcc
+}
+
+// SetKeywords sets the "keywords" field.
+func (tcc *TopicClusterCreate) SetKeywords(s []string) *TopicClusterCreate {
+	tcc.mutation.SetKeywords(s)
+	return tcc
+}
+
+// SetCentroid sets the "centroid" field.
+func (tcc *TopicClusterCreate) SetCentroid(f []float32) *TopicClusterCreate {
+	tcc.mutation.SetCentroid(f)
+	return tcc
+}
+
+// SetModel sets the "model" field.
+func (tcc *TopicClusterCreate) SetModel(s string) *TopicClusterCreate {
+	tcc.mutation.SetModel(s)
+	return tcc
+}
+
+// SetSize sets the "size" field.
+func (tcc *TopicClusterCreate) SetSize(i int) *TopicClusterCreate {
+	tcc.mutation.SetSize(i)
+	return tcc
+}
+
+// SetNamedSize sets the "named_size" field.
+func (tcc *TopicClusterCreate) SetNamedSize(i int) *TopicClusterCreate {
+	tcc.mutation.SetNamedSize(i)
+	return tcc
+}
+
+// SetCreatedAt sets the "created_at" field.
+func (tcc *TopicClusterCreate) SetCreatedAt(t time.Time) *TopicClusterCreate {
+	tcc.mutation.SetCreatedAt(t)
+	return tcc
+}
+
+// SetNillableCreatedAt sets the "created_at" field if the given value is not nil.
+func (tcc *TopicClusterCreate) SetNillableCreatedAt(t *time.Time) *TopicClusterCreate {
+	if t != nil {
+		tcc.SetCreatedAt(*t)
+	}
+	return tcc
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tcc *TopicClusterCreate) SetUpdatedAt(t time.Time) *TopicClusterCreate {
+	tcc.mutation.SetUpdatedAt(t)
+	return tcc
+}
+
+// SetNillableUpdatedAt sets the "updated_at" field if the given value is not nil.
+func (tcc *TopicClusterCreate) SetNillableUpdatedAt(t *time.Time) *TopicClusterCreate {
+	if t != nil {
+		tcc.SetUpdatedAt(*t)
+	}
+	return tcc
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (tcc *TopicClusterCreate) SetUser(u *User) *TopicClusterCreate {
+	return tcc.SetUserID(u.ID)
+}
+
+// AddArticleIDs adds the "articles" edge to the Article entity by IDs.
+func (tcc *TopicClusterCreate) AddArticleIDs(ids ...uint) *TopicClusterCreate {
+	tcc.mutation.AddArticleIDs(ids...)
+	return tcc
+}
+
+// AddArticles adds the "articles" edges to the Article entity.
+func (tcc *TopicClusterCreate) AddArticles(a ...*Article) *TopicClusterCreate {
+	ids := make([]uint, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tcc.AddArticleIDs(ids...)
+}
+
+// Mutation returns the TopicClusterMutation object of the builder.
+func (tcc *TopicClusterCreate) Mutation() *TopicClusterMutation {
+	return tcc.mutation
+}
+
+// Save creates the TopicCluster in the database.
+func (tcc *TopicClusterCreate) Save(ctx context.Context) (*TopicCluster, error) {
+	tcc.defaults()
+	return withHooks(ctx, tcc.sqlSave, tcc.mutation, tcc.hooks)
+}
+
+// SaveX calls Save and panics if Save returns an error.
+func (tcc *TopicClusterCreate) SaveX(ctx context.Context) *TopicCluster {
+	v, err := tcc.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tcc *TopicClusterCreate) Exec(ctx context.Context) error {
+	_, err := tcc.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcc *TopicClusterCreate) ExecX(ctx context.Context) {
+	if err := tcc.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tcc *TopicClusterCreate) defaults() {
+	if _, ok := tcc.mutation.CreatedAt(); !ok {
+		v := topiccluster.DefaultCreatedAt()
+		tcc.mutation.SetCreatedAt(v)
+	}
+	if _, ok := tcc.mutation.UpdatedAt(); !ok {
+		v := topiccluster.DefaultUpdatedAt()
+		tcc.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tcc *TopicClusterCreate) check() error {
+	if _, ok := tcc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user_id", err: errors.New(`ent: missing required field "TopicCluster.user_id"`)}
+	}
+	if _, ok := tcc.mutation.Name(); !ok {
+		return &ValidationError{Name: "name", err: errors.New(`ent: missing required field "TopicCluster.name"`)}
+	}
+	if _, ok := tcc.mutation.Centroid(); !ok {
+		return &ValidationError{Name: "centroid", err: errors.New(`ent: missing required field "TopicCluster.centroid"`)}
+	}
+	if _, ok := tcc.mutation.Model(); !ok {
+		return &ValidationError{Name: "model", err: errors.New(`ent: missing required field "TopicCluster.model"`)}
+	}
+	if _, ok := tcc.mutation.Size(); !ok {
+		return &ValidationError{Name: "size", err: errors.New(`ent: missing required field "TopicCluster.size"`)}
+	}
+	if v, ok := tcc.mutation.Size(); ok {
+		if err := topiccluster.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "TopicCluster.size": %w`, err)}
+		}
+	}
+	if _, ok := tcc.mutation.NamedSize(); !ok {
+		return &ValidationError{Name: "named_size", err: errors.New(`ent: missing required field "TopicCluster.named_size"`)}
+	}
+	if v, ok := tcc.mutation.NamedSize(); ok {
+		if err := topiccluster.NamedSizeValidator(v); err != nil {
+			return &ValidationError{Name: "named_size", err: fmt.Errorf(`ent: validator failed for field "TopicCluster.named_size": %w`, err)}
+		}
+	}
+	if _, ok := tcc.mutation.CreatedAt(); !ok {
+		return &ValidationError{Name: "created_at", err: errors.New(`ent: missing required field "TopicCluster.created_at"`)}
+	}
+	if _, ok := tcc.mutation.UpdatedAt(); !ok {
+		return &ValidationError{Name: "updated_at", err: errors.New(`ent: missing required field "TopicCluster.updated_at"`)}
+	}
+	if _, ok := tcc.mutation.UserID(); !ok {
+		return &ValidationError{Name: "user", err: errors.New(`ent: missing required edge "TopicCluster.user"`)}
+	}
+	return nil
+}
+
+func (tcc *TopicClusterCreate) sqlSave(ctx context.Context) (*TopicCluster, error) {
+	if err := tcc.check(); err != nil {
+		return nil, err
+	}
+	_node, _spec := tcc.createSpec()
+	if err := sqlgraph.CreateNode(ctx, tcc.driver, _spec); err != nil {
+		if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	id := _spec.ID.Value.(int64)
+	_node.ID = int(id)
+	tcc.mutation.id = &_node.ID
+	tcc.mutation.done = true
+	return _node, nil
+}
+
+func (tcc *TopicClusterCreate) createSpec() (*TopicCluster, *sqlgraph.CreateSpec) {
+	var (
+		_node = &TopicCluster{config: tcc.config}
+		_spec = sqlgraph.NewCreateSpec(topiccluster.Table, sqlgraph.NewFieldSpec(topiccluster.FieldID, field.TypeInt))
+	)
+	if value, ok := tcc.mutation.Name(); ok {
+		_spec.SetField(topiccluster.FieldName, field.TypeString, value)
+		_node.Name = value
+	}
+	if value, ok := tcc.mutation.Keywords(); ok {
+		_spec.SetField(topiccluster.FieldKeywords, field.TypeJSON, value)
+		_node.Keywords = value
+	}
+	if value, ok := tcc.mutation.Centroid(); ok {
+		_spec.SetField(topiccluster.FieldCentroid, field.TypeJSON, value)
+		_node.Centroid = value
+	}
+	if value, ok := tcc.mutation.Model(); ok {
+		_spec.SetField(topiccluster.FieldModel, field.TypeString, value)
+		_node.Model = value
+	}
+	if value, ok := tcc.mutation.Size(); ok {
+		_spec.SetField(topiccluster.FieldSize, field.TypeInt, value)
+		_node.Size = value
+	}
+	if value, ok := tcc.mutation.NamedSize(); ok {
+		_spec.SetField(topiccluster.FieldNamedSize, field.TypeInt, value)
+		_node.NamedSize = value
+	}
+	if value, ok := tcc.mutation.CreatedAt(); ok {
+		_spec.SetField(topiccluster.FieldCreatedAt, field.TypeTime, value)
+		_node.CreatedAt = value
+	}
+	if value, ok := tcc.mutation.UpdatedAt(); ok {
+		_spec.SetField(topiccluster.FieldUpdatedAt, field.TypeTime, value)
+		_node.UpdatedAt = value
+	}
+	if nodes := tcc.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   topiccluster.UserTable,
+			Columns: []string{topiccluster.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_node.UserID = nodes[0]
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	if nodes := tcc.mutation.ArticlesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   topiccluster.ArticlesTable,
+			Columns: []string{topiccluster.ArticlesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges = append(_spec.Edges, edge)
+	}
+	return _node, _spec
+}
+
+// TopicClusterCreateBulk is the builder for creating many TopicCluster entities in bulk.
+type TopicClusterCreateBulk struct {
+	config
+	err      error
+	builders []*TopicClusterCreate
+}
+
+// Save creates the TopicCluster entities in the database.
+func (tccb *TopicClusterCreateBulk) Save(ctx context.Context) ([]*TopicCluster, error) {
+	if tccb.err != nil {
+		return nil, tccb.err
+	}
+	specs := make([]*sqlgraph.CreateSpec, len(tccb.builders))
+	nodes := make([]*TopicCluster, len(tccb.builders))
+	mutators := make([]Mutator, len(tccb.builders))
+	for i := range tccb.builders {
+		func(i int, root context.Context) {
+			builder := tccb.builders[i]
+			builder.defaults()
+			var mut Mutator = MutateFunc(func(ctx context.Context, m Mutation) (Value, error) {
+				mutation, ok := m.(*TopicClusterMutation)
+				if !ok {
+					return nil, fmt.Errorf("unexpected mutation type %T", m)
+				}
+				if err := builder.check(); err != nil {
+					return nil, err
+				}
+				builder.mutation = mutation
+				var err error
+				nodes[i], specs[i] = builder.createSpec()
+				if i < len(mutators)-1 {
+					_, err = mutators[i+1].Mutate(root, tccb.builders[i+1].mutation)
+				} else {
+					spec := &sqlgraph.BatchCreateSpec{Nodes: specs}
+					// Invoke the actual operation on the latest mutation in the chain.
+					if err = sqlgraph.BatchCreate(ctx, tccb.driver, spec); err != nil {
+						if sqlgraph.IsConstraintError(err) {
+							err = &ConstraintError{msg: err.Error(), wrap: err}
+						}
+					}
+				}
+				if err != nil {
+					return nil, err
+				}
+				mutation.id = &nodes[i].ID
+				if specs[i].ID.Value != nil {
+					id := specs[i].ID.Value.(int64)
+					nodes[i].ID = int(id)
+				}
+				mutation.done = true
+				return nodes[i], nil
+			})
+			for i := len(builder.hooks) - 1; i >= 0; i-- {
+				mut = builder.hooks[i](mut)
+			}
+			mutators[i] = mut
+		}(i, ctx)
+	}
+	if len(mutators) > 0 {
+		if _, err := mutators[0].Mutate(ctx, tccb.builders[0].mutation); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tccb *TopicClusterCreateBulk) SaveX(ctx context.Context) []*TopicCluster {
+	v, err := tccb.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return v
+}
+
+// Exec executes the query.
+func (tccb *TopicClusterCreateBulk) Exec(ctx context.Context) error {
+	_, err := tccb.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tccb *TopicClusterCreateBulk) ExecX(ctx context.Context) {
+	if err := tccb.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,88 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/topiccluster"
+)
+
+// TopicClusterDelete is the builder for deleting a TopicCluster entity.
+type TopicClusterDelete struct {
+	config
+	hooks    []Hook
+	mutation *TopicClusterMutation
+}
+
+// Where appends a list predicates to the TopicClusterDelete builder.
+func (tcd *TopicClusterDelete) Where(ps ...predicate.TopicCluster) *TopicClusterDelete {
+	tcd.mutation.Where(ps...)
+	return tcd
+}
+
+// Exec executes the deletion query and returns how many vertices were deleted.
+func (tcd *TopicClusterDelete) Exec(ctx context.Context) (int, error) {
+	return withHooks(ctx, tcd.sqlExec, tcd.mutation, tcd.hooks)
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcd *TopicClusterDelete) ExecX(ctx context.Context) int {
+	n, err := tcd.Exec(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return n
+}
+
+func (tcd *TopicClusterDelete) sqlExec(ctx context.Context) (int, error) {
+	_spec := sqlgraph.NewDeleteSpec(topiccluster.Table, sqlgraph.NewFieldSpec(topiccluster.FieldID, field.TypeInt))
+	if ps := tcd.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	affected, err := sqlgraph.DeleteNodes(ctx, tcd.driver, _spec)
+	if err != nil && sqlgraph.IsConstraintError(err) {
+		err = &ConstraintError{msg: err.Error(), wrap: err}
+	}
+	tcd.mutation.done = true
+	return affected, err
+}
+
+// TopicClusterDeleteOne is the builder for deleting a single TopicCluster entity.
+type TopicClusterDeleteOne struct {
+	tcd *TopicClusterDelete
+}
+
+// Where appends a list predicates to the TopicClusterDelete builder.
+func (tcdo *TopicClusterDeleteOne) Where(ps ...predicate.TopicCluster) *TopicClusterDeleteOne {
+	tcdo.tcd.mutation.Where(ps...)
+	return tcdo
+}
+
+// Exec executes the deletion query.
+func (tcdo *TopicClusterDeleteOne) Exec(ctx context.Context) error {
+	n, err := tcdo.tcd.Exec(ctx)
+	switch {
+	case err != nil:
+		return err
+	case n == 0:
+		return &NotFoundError{topiccluster.Label}
+	default:
+		return nil
+	}
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcdo *TopicClusterDeleteOne) ExecX(ctx context.Context) {
+	if err := tcdo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
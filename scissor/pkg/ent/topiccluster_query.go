@@ -0,0 +1,683 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"database/sql/driver"
+	"fmt"
+	"math"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/topiccluster"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
+)
+
+// TopicClusterQuery is the builder for querying TopicCluster entities.
+type TopicClusterQuery struct {
+	config
+	ctx          *QueryContext
+	order        []topiccluster.OrderOption
+	inters       []Interceptor
+	predicates   []predicate.TopicCluster
+	withUser     *UserQuery
+	withArticles *ArticleQuery
+	// intermediate query (i.e. traversal path).
+	sql  *sql.Selector
+	path func(context.Context) (*sql.Selector, error)
+}
+
+// Where adds a new predicate for the TopicClusterQuery builder.
+func (tcq *TopicClusterQuery) Where(ps ...predicate.TopicCluster) *TopicClusterQuery {
+	tcq.predicates = append(tcq.predicates, ps...)
+	return tcq
+}
+
+// Limit the number of records to be returned by this query.
+func (tcq *TopicClusterQuery) Limit(limit int) *TopicClusterQuery {
+	tcq.ctx.Limit = &limit
+	return tcq
+}
+
+// Offset to start from.
+func (tcq *TopicClusterQuery) Offset(offset int) *TopicClusterQuery {
+	tcq.ctx.Offset = &offset
+	return tcq
+}
+
+// Unique configures the query builder to filter duplicate records on query.
+// By default, unique is set to true, and can be disabled using this method.
+func (tcq *TopicClusterQuery) Unique(unique bool) *TopicClusterQuery {
+	tcq.ctx.Unique = &unique
+	return tcq
+}
+
+// Order specifies how the records should be ordered.
+func (tcq *TopicClusterQuery) Order(o ...topiccluster.OrderOption) *TopicClusterQuery {
+	tcq.order = append(tcq.order, o...)
+	return tcq
+}
+
+// QueryUser chains the current query on the "user" edge.
+func (tcq *TopicClusterQuery) QueryUser() *UserQuery {
+	query := (&UserClient{config: tcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(topiccluster.Table, topiccluster.FieldID, selector),
+			sqlgraph.To(user.Table, user.FieldID),
+			sqlgraph.Edge(sqlgraph.M2O, true, topiccluster.UserTable, topiccluster.UserColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// QueryArticles chains the current query on the "articles" edge.
+func (tcq *TopicClusterQuery) QueryArticles() *ArticleQuery {
+	query := (&ArticleClient{config: tcq.config}).Query()
+	query.path = func(ctx context.Context) (fromU *sql.Selector, err error) {
+		if err := tcq.prepareQuery(ctx); err != nil {
+			return nil, err
+		}
+		selector := tcq.sqlQuery(ctx)
+		if err := selector.Err(); err != nil {
+			return nil, err
+		}
+		step := sqlgraph.NewStep(
+			sqlgraph.From(topiccluster.Table, topiccluster.FieldID, selector),
+			sqlgraph.To(article.Table, article.FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, topiccluster.ArticlesTable, topiccluster.ArticlesColumn),
+		)
+		fromU = sqlgraph.SetNeighbors(tcq.driver.Dialect(), step)
+		return fromU, nil
+	}
+	return query
+}
+
+// First returns the first TopicCluster entity from the query.
+// Returns a *NotFoundError when no TopicCluster was found.
+func (tcq *TopicClusterQuery) First(ctx context.Context) (*TopicCluster, error) {
+	nodes, err := tcq.Limit(1).All(setContextOp(ctx, tcq.ctx, "First"))
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nil, &NotFoundError{topiccluster.Label}
+	}
+	return nodes[0], nil
+}
+
+// FirstX is like First, but panics if an error occurs.
+func (tcq *TopicClusterQuery) FirstX(ctx context.Context) *TopicCluster {
+	node, err := tcq.First(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return node
+}
+
+// FirstID returns the first TopicCluster ID from the query.
+// Returns a *NotFoundError when no TopicCluster ID was found.
+func (tcq *TopicClusterQuery) FirstID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tcq.Limit(1).IDs(setContextOp(ctx, tcq.ctx, "FirstID")); err != nil {
+		return
+	}
+	if len(ids) == 0 {
+		err = &NotFoundError{topiccluster.Label}
+		return
+	}
+	return ids[0], nil
+}
+
+// FirstIDX is like FirstID, but panics if an error occurs.
+func (tcq *TopicClusterQuery) FirstIDX(ctx context.Context) int {
+	id, err := tcq.FirstID(ctx)
+	if err != nil && !IsNotFound(err) {
+		panic(err)
+	}
+	return id
+}
+
+// Only returns a single TopicCluster entity found by the query, ensuring it only returns one.
+// Returns a *NotSingularError when more than one TopicCluster entity is found.
+// Returns a *NotFoundError when no TopicCluster entities are found.
+func (tcq *TopicClusterQuery) Only(ctx context.Context) (*TopicCluster, error) {
+	nodes, err := tcq.Limit(2).All(setContextOp(ctx, tcq.ctx, "Only"))
+	if err != nil {
+		return nil, err
+	}
+	switch len(nodes) {
+	case 1:
+		return nodes[0], nil
+	case 0:
+		return nil, &NotFoundError{topiccluster.Label}
+	default:
+		return nil, &NotSingularError{topiccluster.Label}
+	}
+}
+
+// OnlyX is like Only, but panics if an error occurs.
+func (tcq *TopicClusterQuery) OnlyX(ctx context.Context) *TopicCluster {
+	node, err := tcq.Only(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// OnlyID is like Only, but returns the only TopicCluster ID in the query.
+// Returns a *NotSingularError when more than one TopicCluster ID is found.
+// Returns a *NotFoundError when no entities are found.
+func (tcq *TopicClusterQuery) OnlyID(ctx context.Context) (id int, err error) {
+	var ids []int
+	if ids, err = tcq.Limit(2).IDs(setContextOp(ctx, tcq.ctx, "OnlyID")); err != nil {
+		return
+	}
+	switch len(ids) {
+	case 1:
+		id = ids[0]
+	case 0:
+		err = &NotFoundError{topiccluster.Label}
+	default:
+		err = &NotSingularError{topiccluster.Label}
+	}
+	return
+}
+
+// OnlyIDX is like OnlyID, but panics if an error occurs.
+func (tcq *TopicClusterQuery) OnlyIDX(ctx context.Context) int {
+	id, err := tcq.OnlyID(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return id
+}
+
+// All executes the query and returns a list of TopicClusters.
+func (tcq *TopicClusterQuery) All(ctx context.Context) ([]*TopicCluster, error) {
+	ctx = setContextOp(ctx, tcq.ctx, "All")
+	if err := tcq.prepareQuery(ctx); err != nil {
+		return nil, err
+	}
+	qr := querierAll[[]*TopicCluster, *TopicClusterQuery]()
+	return withInterceptors[[]*TopicCluster](ctx, tcq, qr, tcq.inters)
+}
+
+// AllX is like All, but panics if an error occurs.
+func (tcq *TopicClusterQuery) AllX(ctx context.Context) []*TopicCluster {
+	nodes, err := tcq.All(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return nodes
+}
+
+// IDs executes the query and returns a list of TopicCluster IDs.
+func (tcq *TopicClusterQuery) IDs(ctx context.Context) (ids []int, err error) {
+	if tcq.ctx.Unique == nil && tcq.path != nil {
+		tcq.Unique(true)
+	}
+	ctx = setContextOp(ctx, tcq.ctx, "IDs")
+	if err = tcq.Select(topiccluster.FieldID).Scan(ctx, &ids); err != nil {
+		return nil, err
+	}
+	return ids, nil
+}
+
+// IDsX is like IDs, but panics if an error occurs.
+func (tcq *TopicClusterQuery) IDsX(ctx context.Context) []int {
+	ids, err := tcq.IDs(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return ids
+}
+
+// Count returns the count of the given query.
+func (tcq *TopicClusterQuery) Count(ctx context.Context) (int, error) {
+	ctx = setContextOp(ctx, tcq.ctx, "Count")
+	if err := tcq.prepareQuery(ctx); err != nil {
+		return 0, err
+	}
+	return withInterceptors[int](ctx, tcq, querierCount[*TopicClusterQuery](), tcq.inters)
+}
+
+// CountX is like Count, but panics if an error occurs.
+func (tcq *TopicClusterQuery) CountX(ctx context.Context) int {
+	count, err := tcq.Count(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return count
+}
+
+// Exist returns true if the query has elements in the graph.
+func (tcq *TopicClusterQuery) Exist(ctx context.Context) (bool, error) {
+	ctx = setContextOp(ctx, tcq.ctx, "Exist")
+	switch _, err := tcq.FirstID(ctx); {
+	case IsNotFound(err):
+		return false, nil
+	case err != nil:
+		return false, fmt.Errorf("ent: check existence: %w", err)
+	default:
+		return true, nil
+	}
+}
+
+// ExistX is like Exist, but panics if an error occurs.
+func (tcq *TopicClusterQuery) ExistX(ctx context.Context) bool {
+	exist, err := tcq.Exist(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return exist
+}
+
+// Clone returns a duplicate of the TopicClusterQuery builder, including all associated steps. It can be
+// used to prepare common query builders and use them differently after the clone is made.
+func (tcq *TopicClusterQuery) Clone() *TopicClusterQuery {
+	if tcq == nil {
+		return nil
+	}
+	return &TopicClusterQuery{
+		config:       tcq.config,
+		ctx:          tcq.ctx.Clone(),
+		order:        append([]topiccluster.OrderOption{}, tcq.order...),
+		inters:       append([]Interceptor{}, tcq.inters...),
+		predicates:   append([]predicate.TopicCluster{}, tcq.predicates...),
+		withUser:     tcq.withUser.Clone(),
+		withArticles: tcq.withArticles.Clone(),
+		// clone intermediate query.
+		sql:  tcq.sql.Clone(),
+		path: tcq.path,
+	}
+}
+
+// WithUser tells the query-builder to eager-load the nodes that are connected to
+// the "user" edge. The optional arguments are used to configure the query builder of the edge.
+func (tcq *TopicClusterQuery) WithUser(opts ...func(*UserQuery)) *TopicClusterQuery {
+	query := (&UserClient{config: tcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tcq.withUser = query
+	return tcq
+}
+
+// WithArticles tells the query-builder to eager-load the nodes that are connected to
+// the "articles" edge. The optional arguments are used to configure the query builder of the edge.
+func (tcq *TopicClusterQuery) WithArticles(opts ...func(*ArticleQuery)) *TopicClusterQuery {
+	query := (&ArticleClient{config: tcq.config}).Query()
+	for _, opt := range opts {
+		opt(query)
+	}
+	tcq.withArticles = query
+	return tcq
+}
+
+// GroupBy is used to group vertices by one or more fields/columns.
+// It is often used with aggregate functions, like: count, max, mean, min, sum.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//		Count int `json:"count,omitempty"`
+//	}
+//
+//	client.TopicCluster.Query().
+//		GroupBy(topiccluster.FieldUserID).
+//		Aggregate(ent.Count()).
+//		Scan(ctx, &v)
+func (tcq *TopicClusterQuery) GroupBy(field string, fields ...string) *TopicClusterGroupBy {
+	tcq.ctx.Fields = append([]string{field}, fields...)
+	grbuild := &TopicClusterGroupBy{build: tcq}
+	grbuild.flds = &tcq.ctx.Fields
+	grbuild.label = topiccluster.Label
+	grbuild.scan = grbuild.Scan
+	return grbuild
+}
+
+// Select allows the selection one or more fields/columns for the given query,
+// instead of selecting all fields in the entity.
+//
+// Example:
+//
+//	var v []struct {
+//		UserID int `json:"user_id,omitempty"`
+//	}
+//
+//	client.TopicCluster.Query().
+//		Select(topiccluster.FieldUserID).
+//		Scan(ctx, &v)
+func (tcq *TopicClusterQuery) Select(fields ...string) *TopicClusterSelect {
+	tcq.ctx.Fields = append(tcq.ctx.Fields, fields...)
+	sbuild := &TopicClusterSelect{TopicClusterQuery: tcq}
+	sbuild.label = topiccluster.Label
+	sbuild.flds, sbuild.scan = &tcq.ctx.Fields, sbuild.Scan
+	return sbuild
+}
+
+// Aggregate returns a TopicClusterSelect configured with the given aggregations.
+func (tcq *TopicClusterQuery) Aggregate(fns ...AggregateFunc) *TopicClusterSelect {
+	return tcq.Select().Aggregate(fns...)
+}
+
+func (tcq *TopicClusterQuery) prepareQuery(ctx context.Context) error {
+	for _, inter := range tcq.inters {
+		if inter == nil {
+			return fmt.Errorf("ent: uninitialized interceptor (forgotten import ent/runtime?)")
+		}
+		if trv, ok := inter.(Traverser); ok {
+			if err := trv.Traverse(ctx, tcq); err != nil {
+				return err
+			}
+		}
+	}
+	for _, f := range tcq.ctx.Fields {
+		if !topiccluster.ValidColumn(f) {
+			return &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+		}
+	}
+	if tcq.path != nil {
+		prev, err := tcq.path(ctx)
+		if err != nil {
+			return err
+		}
+		tcq.sql = prev
+	}
+	return nil
+}
+
+func (tcq *TopicClusterQuery) sqlAll(ctx context.Context, hooks ...queryHook) ([]*TopicCluster, error) {
+	var (
+		nodes       = []*TopicCluster{}
+		_spec       = tcq.querySpec()
+		loadedTypes = [2]bool{
+			tcq.withUser != nil,
+			tcq.withArticles != nil,
+		}
+	)
+	_spec.ScanValues = func(columns []string) ([]any, error) {
+		return (*TopicCluster).scanValues(nil, columns)
+	}
+	_spec.Assign = func(columns []string, values []any) error {
+		node := &TopicCluster{config: tcq.config}
+		nodes = append(nodes, node)
+		node.Edges.loadedTypes = loadedTypes
+		return node.assignValues(columns, values)
+	}
+	for i := range hooks {
+		hooks[i](ctx, _spec)
+	}
+	if err := sqlgraph.QueryNodes(ctx, tcq.driver, _spec); err != nil {
+		return nil, err
+	}
+	if len(nodes) == 0 {
+		return nodes, nil
+	}
+	if query := tcq.withUser; query != nil {
+		if err := tcq.loadUser(ctx, query, nodes, nil,
+			func(n *TopicCluster, e *User) { n.Edges.User = e }); err != nil {
+			return nil, err
+		}
+	}
+	if query := tcq.withArticles; query != nil {
+		if err := tcq.loadArticles(ctx, query, nodes,
+			func(n *TopicCluster) { n.Edges.Articles = []*Article{} },
+			func(n *TopicCluster, e *Article) { n.Edges.Articles = append(n.Edges.Articles, e) }); err != nil {
+			return nil, err
+		}
+	}
+	return nodes, nil
+}
+
+func (tcq *TopicClusterQuery) loadUser(ctx context.Context, query *UserQuery, nodes []*TopicCluster, init func(*TopicCluster), assign func(*TopicCluster, *User)) error {
+	ids := make([]int, 0, len(nodes))
+	nodeids := make(map[int][]*TopicCluster)
+	for i := range nodes {
+		fk := nodes[i].UserID
+		if _, ok := nodeids[fk]; !ok {
+			ids = append(ids, fk)
+		}
+		nodeids[fk] = append(nodeids[fk], nodes[i])
+	}
+	if len(ids) == 0 {
+		return nil
+	}
+	query.Where(user.IDIn(ids...))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		nodes, ok := nodeids[n.ID]
+		if !ok {
+			return fmt.Errorf(`unexpected foreign-key "user_id" returned %v`, n.ID)
+		}
+		for i := range nodes {
+			assign(nodes[i], n)
+		}
+	}
+	return nil
+}
+func (tcq *TopicClusterQuery) loadArticles(ctx context.Context, query *ArticleQuery, nodes []*TopicCluster, init func(*TopicCluster), assign func(*TopicCluster, *Article)) error {
+	fks := make([]driver.Value, 0, len(nodes))
+	nodeids := make(map[int]*TopicCluster)
+	for i := range nodes {
+		fks = append(fks, nodes[i].ID)
+		nodeids[nodes[i].ID] = nodes[i]
+		if init != nil {
+			init(nodes[i])
+		}
+	}
+	if len(query.ctx.Fields) > 0 {
+		query.ctx.AppendFieldOnce(article.FieldClusterID)
+	}
+	query.Where(predicate.Article(func(s *sql.Selector) {
+		s.Where(sql.InValues(s.C(topiccluster.ArticlesColumn), fks...))
+	}))
+	neighbors, err := query.All(ctx)
+	if err != nil {
+		return err
+	}
+	for _, n := range neighbors {
+		fk := n.ClusterID
+		if fk == nil {
+			return fmt.Errorf(`foreign-key "cluster_id" is nil for node %v`, n.ID)
+		}
+		node, ok := nodeids[*fk]
+		if !ok {
+			return fmt.Errorf(`unexpected referenced foreign-key "cluster_id" returned %v for node %v`, *fk, n.ID)
+		}
+		assign(node, n)
+	}
+	return nil
+}
+
+func (tcq *TopicClusterQuery) sqlCount(ctx context.Context) (int, error) {
+	_spec := tcq.querySpec()
+	_spec.Node.Columns = tcq.ctx.Fields
+	if len(tcq.ctx.Fields) > 0 {
+		_spec.Unique = tcq.ctx.Unique != nil && *tcq.ctx.Unique
+	}
+	return sqlgraph.CountNodes(ctx, tcq.driver, _spec)
+}
+
+func (tcq *TopicClusterQuery) querySpec() *sqlgraph.QuerySpec {
+	_spec := sqlgraph.NewQuerySpec(topiccluster.Table, topiccluster.Columns, sqlgraph.NewFieldSpec(topiccluster.FieldID, field.TypeInt))
+	_spec.From = tcq.sql
+	if unique := tcq.ctx.Unique; unique != nil {
+		_spec.Unique = *unique
+	} else if tcq.path != nil {
+		_spec.Unique = true
+	}
+	if fields := tcq.ctx.Fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, topiccluster.FieldID)
+		for i := range fields {
+			if fields[i] != topiccluster.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, fields[i])
+			}
+		}
+		if tcq.withUser != nil {
+			_spec.Node.AddColumnOnce(topiccluster.FieldUserID)
+		}
+	}
+	if ps := tcq.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if limit := tcq.ctx.Limit; limit != nil {
+		_spec.Limit = *limit
+	}
+	if offset := tcq.ctx.Offset; offset != nil {
+		_spec.Offset = *offset
+	}
+	if ps := tcq.order; len(ps) > 0 {
+		_spec.Order = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	return _spec
+}
+
+func (tcq *TopicClusterQuery) sqlQuery(ctx context.Context) *sql.Selector {
+	builder := sql.Dialect(tcq.driver.Dialect())
+	t1 := builder.Table(topiccluster.Table)
+	columns := tcq.ctx.Fields
+	if len(columns) == 0 {
+		columns = topiccluster.Columns
+	}
+	selector := builder.Select(t1.Columns(columns...)...).From(t1)
+	if tcq.sql != nil {
+		selector = tcq.sql
+		selector.Select(selector.Columns(columns...)...)
+	}
+	if tcq.ctx.Unique != nil && *tcq.ctx.Unique {
+		selector.Distinct()
+	}
+	for _, p := range tcq.predicates {
+		p(selector)
+	}
+	for _, p := range tcq.order {
+		p(selector)
+	}
+	if offset := tcq.ctx.Offset; offset != nil {
+		// limit is mandatory for offset clause. We start
+		// with default value, and override it below if needed.
+		selector.Offset(*offset).Limit(math.MaxInt32)
+	}
+	if limit := tcq.ctx.Limit; limit != nil {
+		selector.Limit(*limit)
+	}
+	return selector
+}
+
+// TopicClusterGroupBy is the group-by builder for TopicCluster entities.
+type TopicClusterGroupBy struct {
+	selector
+	build *TopicClusterQuery
+}
+
+// Aggregate adds the given aggregation functions to the group-by query.
+func (tcgb *TopicClusterGroupBy) Aggregate(fns ...AggregateFunc) *TopicClusterGroupBy {
+	tcgb.fns = append(tcgb.fns, fns...)
+	return tcgb
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tcgb *TopicClusterGroupBy) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tcgb.build.ctx, "GroupBy")
+	if err := tcgb.build.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TopicClusterQuery, *TopicClusterGroupBy](ctx, tcgb.build, tcgb, tcgb.build.inters, v)
+}
+
+func (tcgb *TopicClusterGroupBy) sqlScan(ctx context.Context, root *TopicClusterQuery, v any) error {
+	selector := root.sqlQuery(ctx).Select()
+	aggregation := make([]string, 0, len(tcgb.fns))
+	for _, fn := range tcgb.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	if len(selector.SelectedColumns()) == 0 {
+		columns := make([]string, 0, len(*tcgb.flds)+len(tcgb.fns))
+		for _, f := range *tcgb.flds {
+			columns = append(columns, selector.C(f))
+		}
+		columns = append(columns, aggregation...)
+		selector.Select(columns...)
+	}
+	selector.GroupBy(selector.Columns(*tcgb.flds...)...)
+	if err := selector.Err(); err != nil {
+		return err
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tcgb.build.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
+
+// TopicClusterSelect is the builder for selecting fields of TopicCluster entities.
+type TopicClusterSelect struct {
+	*TopicClusterQuery
+	selector
+}
+
+// Aggregate adds the given aggregation functions to the selector query.
+func (tcs *TopicClusterSelect) Aggregate(fns ...AggregateFunc) *TopicClusterSelect {
+	tcs.fns = append(tcs.fns, fns...)
+	return tcs
+}
+
+// Scan applies the selector query and scans the result into the given value.
+func (tcs *TopicClusterSelect) Scan(ctx context.Context, v any) error {
+	ctx = setContextOp(ctx, tcs.ctx, "Select")
+	if err := tcs.prepareQuery(ctx); err != nil {
+		return err
+	}
+	return scanWithInterceptors[*TopicClusterQuery, *TopicClusterSelect](ctx, tcs.TopicClusterQuery, tcs, tcs.inters, v)
+}
+
+func (tcs *TopicClusterSelect) sqlScan(ctx context.Context, root *TopicClusterQuery, v any) error {
+	selector := root.sqlQuery(ctx)
+	aggregation := make([]string, 0, len(tcs.fns))
+	for _, fn := range tcs.fns {
+		aggregation = append(aggregation, fn(selector))
+	}
+	switch n := len(*tcs.selector.flds); {
+	case n == 0 && len(aggregation) > 0:
+		selector.Select(aggregation...)
+	case n != 0 && len(aggregation) > 0:
+		selector.AppendSelect(aggregation...)
+	}
+	rows := &sql.Rows{}
+	query, args := selector.Query()
+	if err := tcs.driver.Query(ctx, query, args, rows); err != nil {
+		return err
+	}
+	defer rows.Close()
+	return sql.ScanSlice(rows, v)
+}
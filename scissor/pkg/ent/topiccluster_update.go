@@ -0,0 +1,801 @@
+// Code generated by ent, DO NOT EDIT.
+
+package ent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"entgo.io/ent/dialect/sql"
+	"entgo.io/ent/dialect/sql/sqlgraph"
+	"entgo.io/ent/dialect/sql/sqljson"
+	"entgo.io/ent/schema/field"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/article"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/predicate"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/topiccluster"
+	"github.com/gorexlv/cabinet/scissor/pkg/ent/user"
+)
+
+// TopicClusterUpdate is the builder for updating TopicCluster entities.
+type TopicClusterUpdate struct {
+	config
+	hooks    []Hook
+	mutation *TopicClusterMutation
+}
+
+// Where appends a list predicates to the TopicClusterUpdate builder.
+func (tcu *TopicClusterUpdate) Where(ps ...predicate.TopicCluster) *TopicClusterUpdate {
+	tcu.mutation.Where(ps...)
+	return tcu
+}
+
+// SetUserID sets the "user_id" field.
+func (tcu *TopicClusterUpdate) SetUserID(i int) *TopicClusterUpdate {
+	tcu.mutation.SetUserID(i)
+	return tcu
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tcu *TopicClusterUpdate) SetNillableUserID(i *int) *TopicClusterUpdate {
+	if i != nil {
+		tcu.SetUserID(*i)
+	}
+	return tcu
+}
+
+// SetName sets the "name" field.
+func (tcu *TopicClusterUpdate) SetName(s string) *TopicClusterUpdate {
+	tcu.mutation.SetName(s)
+	return tcu
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tcu *TopicClusterUpdate) SetNillableName(s *string) *TopicClusterUpdate {
+	if s != nil {
+		tcu.SetName(*s)
+	}
+	return tcu
+}
+
+// SetKeywords sets the "keywords" field.
+func (tcu *TopicClusterUpdate) SetKeywords(s []string) *TopicClusterUpdate {
+	tcu.mutation.SetKeywords(s)
+	return tcu
+}
+
+// AppendKeywords appends s to the "keywords" field.
+func (tcu *TopicClusterUpdate) AppendKeywords(s []string) *TopicClusterUpdate {
+	tcu.mutation.AppendKeywords(s)
+	return tcu
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (tcu *TopicClusterUpdate) ClearKeywords() *TopicClusterUpdate {
+	tcu.mutation.ClearKeywords()
+	return tcu
+}
+
+// SetCentroid sets the "centroid" field.
+func (tcu *TopicClusterUpdate) SetCentroid(f []float32) *TopicClusterUpdate {
+	tcu.mutation.SetCentroid(f)
+	return tcu
+}
+
+// AppendCentroid appends f to the "centroid" field.
+func (tcu *TopicClusterUpdate) AppendCentroid(f []float32) *TopicClusterUpdate {
+	tcu.mutation.AppendCentroid(f)
+	return tcu
+}
+
+// SetModel sets the "model" field.
+func (tcu *TopicClusterUpdate) SetModel(s string) *TopicClusterUpdate {
+	tcu.mutation.SetModel(s)
+	return tcu
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (tcu *TopicClusterUpdate) SetNillableModel(s *string) *TopicClusterUpdate {
+	if s != nil {
+		tcu.SetModel(*s)
+	}
+	return tcu
+}
+
+// SetSize sets the "size" field.
+func (tcu *TopicClusterUpdate) SetSize(i int) *TopicClusterUpdate {
+	tcu.mutation.ResetSize()
+	tcu.mutation.SetSize(i)
+	return tcu
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (tcu *TopicClusterUpdate) SetNillableSize(i *int) *TopicClusterUpdate {
+	if i != nil {
+		tcu.SetSize(*i)
+	}
+	return tcu
+}
+
+// AddSize adds i to the "size" field.
+func (tcu *TopicClusterUpdate) AddSize(i int) *TopicClusterUpdate {
+	tcu.mutation.AddSize(i)
+	return tcu
+}
+
+// SetNamedSize sets the "named_size" field.
+func (tcu *TopicClusterUpdate) SetNamedSize(i int) *TopicClusterUpdate {
+	tcu.mutation.ResetNamedSize()
+	tcu.mutation.SetNamedSize(i)
+	return tcu
+}
+
+// SetNillableNamedSize sets the "named_size" field if the given value is not nil.
+func (tcu *TopicClusterUpdate) SetNillableNamedSize(i *int) *TopicClusterUpdate {
+	if i != nil {
+		tcu.SetNamedSize(*i)
+	}
+	return tcu
+}
+
+// AddNamedSize adds i to the "named_size" field.
+func (tcu *TopicClusterUpdate) AddNamedSize(i int) *TopicClusterUpdate {
+	tcu.mutation.AddNamedSize(i)
+	return tcu
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tcu *TopicClusterUpdate) SetUpdatedAt(t time.Time) *TopicClusterUpdate {
+	tcu.mutation.SetUpdatedAt(t)
+	return tcu
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (tcu *TopicClusterUpdate) SetUser(u *User) *TopicClusterUpdate {
+	return tcu.SetUserID(u.ID)
+}
+
+// AddArticleIDs adds the "articles" edge to the Article entity by IDs.
+func (tcu *TopicClusterUpdate) AddArticleIDs(ids ...uint) *TopicClusterUpdate {
+	tcu.mutation.AddArticleIDs(ids...)
+	return tcu
+}
+
+// AddArticles adds the "articles" edges to the Article entity.
+func (tcu *TopicClusterUpdate) AddArticles(a ...*Article) *TopicClusterUpdate {
+	ids := make([]uint, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tcu.AddArticleIDs(ids...)
+}
+
+// Mutation returns the TopicClusterMutation object of the builder.
+func (tcu *TopicClusterUpdate) Mutation() *TopicClusterMutation {
+	return tcu.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (tcu *TopicClusterUpdate) ClearUser() *TopicClusterUpdate {
+	tcu.mutation.ClearUser()
+	return tcu
+}
+
+// ClearArticles clears all "articles" edges to the Article entity.
+func (tcu *TopicClusterUpdate) ClearArticles() *TopicClusterUpdate {
+	tcu.mutation.ClearArticles()
+	return tcu
+}
+
+// RemoveArticleIDs removes the "articles" edge to Article entities by IDs.
+func (tcu *TopicClusterUpdate) RemoveArticleIDs(ids ...uint) *TopicClusterUpdate {
+	tcu.mutation.RemoveArticleIDs(ids...)
+	return tcu
+}
+
+// RemoveArticles removes "articles" edges to Article entities.
+func (tcu *TopicClusterUpdate) RemoveArticles(a ...*Article) *TopicClusterUpdate {
+	ids := make([]uint, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tcu.RemoveArticleIDs(ids...)
+}
+
+// Save executes the query and returns the number of nodes affected by the update operation.
+func (tcu *TopicClusterUpdate) Save(ctx context.Context) (int, error) {
+	tcu.defaults()
+	return withHooks(ctx, tcu.sqlSave, tcu.mutation, tcu.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcu *TopicClusterUpdate) SaveX(ctx context.Context) int {
+	affected, err := tcu.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return affected
+}
+
+// Exec executes the query.
+func (tcu *TopicClusterUpdate) Exec(ctx context.Context) error {
+	_, err := tcu.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcu *TopicClusterUpdate) ExecX(ctx context.Context) {
+	if err := tcu.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tcu *TopicClusterUpdate) defaults() {
+	if _, ok := tcu.mutation.UpdatedAt(); !ok {
+		v := topiccluster.UpdateDefaultUpdatedAt()
+		tcu.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tcu *TopicClusterUpdate) check() error {
+	if v, ok := tcu.mutation.Size(); ok {
+		if err := topiccluster.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "TopicCluster.size": %w`, err)}
+		}
+	}
+	if v, ok := tcu.mutation.NamedSize(); ok {
+		if err := topiccluster.NamedSizeValidator(v); err != nil {
+			return &ValidationError{Name: "named_size", err: fmt.Errorf(`ent: validator failed for field "TopicCluster.named_size": %w`, err)}
+		}
+	}
+	if _, ok := tcu.mutation.UserID(); tcu.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "TopicCluster.user"`)
+	}
+	return nil
+}
+
+func (tcu *TopicClusterUpdate) sqlSave(ctx context.Context) (n int, err error) {
+	if err := tcu.check(); err != nil {
+		return n, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(topiccluster.Table, topiccluster.Columns, sqlgraph.NewFieldSpec(topiccluster.FieldID, field.TypeInt))
+	if ps := tcu.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tcu.mutation.Name(); ok {
+		_spec.SetField(topiccluster.FieldName, field.TypeString, value)
+	}
+	if value, ok := tcu.mutation.Keywords(); ok {
+		_spec.SetField(topiccluster.FieldKeywords, field.TypeJSON, value)
+	}
+	if value, ok := tcu.mutation.AppendedKeywords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, topiccluster.FieldKeywords, value)
+		})
+	}
+	if tcu.mutation.KeywordsCleared() {
+		_spec.ClearField(topiccluster.FieldKeywords, field.TypeJSON)
+	}
+	if value, ok := tcu.mutation.Centroid(); ok {
+		_spec.SetField(topiccluster.FieldCentroid, field.TypeJSON, value)
+	}
+	if value, ok := tcu.mutation.AppendedCentroid(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, topiccluster.FieldCentroid, value)
+		})
+	}
+	if value, ok := tcu.mutation.Model(); ok {
+		_spec.SetField(topiccluster.FieldModel, field.TypeString, value)
+	}
+	if value, ok := tcu.mutation.Size(); ok {
+		_spec.SetField(topiccluster.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := tcu.mutation.AddedSize(); ok {
+		_spec.AddField(topiccluster.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := tcu.mutation.NamedSize(); ok {
+		_spec.SetField(topiccluster.FieldNamedSize, field.TypeInt, value)
+	}
+	if value, ok := tcu.mutation.AddedNamedSize(); ok {
+		_spec.AddField(topiccluster.FieldNamedSize, field.TypeInt, value)
+	}
+	if value, ok := tcu.mutation.UpdatedAt(); ok {
+		_spec.SetField(topiccluster.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if tcu.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   topiccluster.UserTable,
+			Columns: []string{topiccluster.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcu.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   topiccluster.UserTable,
+			Columns: []string{topiccluster.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tcu.mutation.ArticlesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   topiccluster.ArticlesTable,
+			Columns: []string{topiccluster.ArticlesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcu.mutation.RemovedArticlesIDs(); len(nodes) > 0 && !tcu.mutation.ArticlesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   topiccluster.ArticlesTable,
+			Columns: []string{topiccluster.ArticlesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcu.mutation.ArticlesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   topiccluster.ArticlesTable,
+			Columns: []string{topiccluster.ArticlesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if n, err = sqlgraph.UpdateNodes(ctx, tcu.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{topiccluster.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return 0, err
+	}
+	tcu.mutation.done = true
+	return n, nil
+}
+
+// TopicClusterUpdateOne is the builder for updating a single TopicCluster entity.
+type TopicClusterUpdateOne struct {
+	config
+	fields   []string
+	hooks    []Hook
+	mutation *TopicClusterMutation
+}
+
+// SetUserID sets the "user_id" field.
+func (tcuo *TopicClusterUpdateOne) SetUserID(i int) *TopicClusterUpdateOne {
+	tcuo.mutation.SetUserID(i)
+	return tcuo
+}
+
+// SetNillableUserID sets the "user_id" field if the given value is not nil.
+func (tcuo *TopicClusterUpdateOne) SetNillableUserID(i *int) *TopicClusterUpdateOne {
+	if i != nil {
+		tcuo.SetUserID(*i)
+	}
+	return tcuo
+}
+
+// SetName sets the "name" field.
+func (tcuo *TopicClusterUpdateOne) SetName(s string) *TopicClusterUpdateOne {
+	tcuo.mutation.SetName(s)
+	return tcuo
+}
+
+// SetNillableName sets the "name" field if the given value is not nil.
+func (tcuo *TopicClusterUpdateOne) SetNillableName(s *string) *TopicClusterUpdateOne {
+	if s != nil {
+		tcuo.SetName(*s)
+	}
+	return tcuo
+}
+
+// SetKeywords sets the "keywords" field.
+func (tcuo *TopicClusterUpdateOne) SetKeywords(s []string) *TopicClusterUpdateOne {
+	tcuo.mutation.SetKeywords(s)
+	return tcuo
+}
+
+// AppendKeywords appends s to the "keywords" field.
+func (tcuo *TopicClusterUpdateOne) AppendKeywords(s []string) *TopicClusterUpdateOne {
+	tcuo.mutation.AppendKeywords(s)
+	return tcuo
+}
+
+// ClearKeywords clears the value of the "keywords" field.
+func (tcuo *TopicClusterUpdateOne) ClearKeywords() *TopicClusterUpdateOne {
+	tcuo.mutation.ClearKeywords()
+	return tcuo
+}
+
+// SetCentroid sets the "centroid" field.
+func (tcuo *TopicClusterUpdateOne) SetCentroid(f []float32) *TopicClusterUpdateOne {
+	tcuo.mutation.SetCentroid(f)
+	return tcuo
+}
+
+// AppendCentroid appends f to the "centroid" field.
+func (tcuo *TopicClusterUpdateOne) AppendCentroid(f []float32) *TopicClusterUpdateOne {
+	tcuo.mutation.AppendCentroid(f)
+	return tcuo
+}
+
+// SetModel sets the "model" field.
+func (tcuo *TopicClusterUpdateOne) SetModel(s string) *TopicClusterUpdateOne {
+	tcuo.mutation.SetModel(s)
+	return tcuo
+}
+
+// SetNillableModel sets the "model" field if the given value is not nil.
+func (tcuo *TopicClusterUpdateOne) SetNillableModel(s *string) *TopicClusterUpdateOne {
+	if s != nil {
+		tcuo.SetModel(*s)
+	}
+	return tcuo
+}
+
+// SetSize sets the "size" field.
+func (tcuo *TopicClusterUpdateOne) SetSize(i int) *TopicClusterUpdateOne {
+	tcuo.mutation.ResetSize()
+	tcuo.mutation.SetSize(i)
+	return tcuo
+}
+
+// SetNillableSize sets the "size" field if the given value is not nil.
+func (tcuo *TopicClusterUpdateOne) SetNillableSize(i *int) *TopicClusterUpdateOne {
+	if i != nil {
+		tcuo.SetSize(*i)
+	}
+	return tcuo
+}
+
+// AddSize adds i to the "size" field.
+func (tcuo *TopicClusterUpdateOne) AddSize(i int) *TopicClusterUpdateOne {
+	tcuo.mutation.AddSize(i)
+	return tcuo
+}
+
+// SetNamedSize sets the "named_size" field.
+func (tcuo *TopicClusterUpdateOne) SetNamedSize(i int) *TopicClusterUpdateOne {
+	tcuo.mutation.ResetNamedSize()
+	tcuo.mutation.SetNamedSize(i)
+	return tcuo
+}
+
+// SetNillableNamedSize sets the "named_size" field if the given value is not nil.
+func (tcuo *TopicClusterUpdateOne) SetNillableNamedSize(i *int) *TopicClusterUpdateOne {
+	if i != nil {
+		tcuo.SetNamedSize(*i)
+	}
+	return tcuo
+}
+
+// AddNamedSize adds i to the "named_size" field.
+func (tcuo *TopicClusterUpdateOne) AddNamedSize(i int) *TopicClusterUpdateOne {
+	tcuo.mutation.AddNamedSize(i)
+	return tcuo
+}
+
+// SetUpdatedAt sets the "updated_at" field.
+func (tcuo *TopicClusterUpdateOne) SetUpdatedAt(t time.Time) *TopicClusterUpdateOne {
+	tcuo.mutation.SetUpdatedAt(t)
+	return tcuo
+}
+
+// SetUser sets the "user" edge to the User entity.
+func (tcuo *TopicClusterUpdateOne) SetUser(u *User) *TopicClusterUpdateOne {
+	return tcuo.SetUserID(u.ID)
+}
+
+// AddArticleIDs adds the "articles" edge to the Article entity by IDs.
+func (tcuo *TopicClusterUpdateOne) AddArticleIDs(ids ...uint) *TopicClusterUpdateOne {
+	tcuo.mutation.AddArticleIDs(ids...)
+	return tcuo
+}
+
+// AddArticles adds the "articles" edges to the Article entity.
+func (tcuo *TopicClusterUpdateOne) AddArticles(a ...*Article) *TopicClusterUpdateOne {
+	ids := make([]uint, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tcuo.AddArticleIDs(ids...)
+}
+
+// Mutation returns the TopicClusterMutation object of the builder.
+func (tcuo *TopicClusterUpdateOne) Mutation() *TopicClusterMutation {
+	return tcuo.mutation
+}
+
+// ClearUser clears the "user" edge to the User entity.
+func (tcuo *TopicClusterUpdateOne) ClearUser() *TopicClusterUpdateOne {
+	tcuo.mutation.ClearUser()
+	return tcuo
+}
+
+// ClearArticles clears all "articles" edges to the Article entity.
+func (tcuo *TopicClusterUpdateOne) ClearArticles() *TopicClusterUpdateOne {
+	tcuo.mutation.ClearArticles()
+	return tcuo
+}
+
+// RemoveArticleIDs removes the "articles" edge to Article entities by IDs.
+func (tcuo *TopicClusterUpdateOne) RemoveArticleIDs(ids ...uint) *TopicClusterUpdateOne {
+	tcuo.mutation.RemoveArticleIDs(ids...)
+	return tcuo
+}
+
+// RemoveArticles removes "articles" edges to Article entities.
+func (tcuo *TopicClusterUpdateOne) RemoveArticles(a ...*Article) *TopicClusterUpdateOne {
+	ids := make([]uint, len(a))
+	for i := range a {
+		ids[i] = a[i].ID
+	}
+	return tcuo.RemoveArticleIDs(ids...)
+}
+
+// Where appends a list predicates to the TopicClusterUpdate builder.
+func (tcuo *TopicClusterUpdateOne) Where(ps ...predicate.TopicCluster) *TopicClusterUpdateOne {
+	tcuo.mutation.Where(ps...)
+	return tcuo
+}
+
+// Select allows selecting one or more fields (columns) of the returned entity.
+// The default is selecting all fields defined in the entity schema.
+func (tcuo *TopicClusterUpdateOne) Select(field string, fields ...string) *TopicClusterUpdateOne {
+	tcuo.fields = append([]string{field}, fields...)
+	return tcuo
+}
+
+// Save executes the query and returns the updated TopicCluster entity.
+func (tcuo *TopicClusterUpdateOne) Save(ctx context.Context) (*TopicCluster, error) {
+	tcuo.defaults()
+	return withHooks(ctx, tcuo.sqlSave, tcuo.mutation, tcuo.hooks)
+}
+
+// SaveX is like Save, but panics if an error occurs.
+func (tcuo *TopicClusterUpdateOne) SaveX(ctx context.Context) *TopicCluster {
+	node, err := tcuo.Save(ctx)
+	if err != nil {
+		panic(err)
+	}
+	return node
+}
+
+// Exec executes the query on the entity.
+func (tcuo *TopicClusterUpdateOne) Exec(ctx context.Context) error {
+	_, err := tcuo.Save(ctx)
+	return err
+}
+
+// ExecX is like Exec, but panics if an error occurs.
+func (tcuo *TopicClusterUpdateOne) ExecX(ctx context.Context) {
+	if err := tcuo.Exec(ctx); err != nil {
+		panic(err)
+	}
+}
+
+// defaults sets the default values of the builder before save.
+func (tcuo *TopicClusterUpdateOne) defaults() {
+	if _, ok := tcuo.mutation.UpdatedAt(); !ok {
+		v := topiccluster.UpdateDefaultUpdatedAt()
+		tcuo.mutation.SetUpdatedAt(v)
+	}
+}
+
+// check runs all checks and user-defined validators on the builder.
+func (tcuo *TopicClusterUpdateOne) check() error {
+	if v, ok := tcuo.mutation.Size(); ok {
+		if err := topiccluster.SizeValidator(v); err != nil {
+			return &ValidationError{Name: "size", err: fmt.Errorf(`ent: validator failed for field "TopicCluster.size": %w`, err)}
+		}
+	}
+	if v, ok := tcuo.mutation.NamedSize(); ok {
+		if err := topiccluster.NamedSizeValidator(v); err != nil {
+			return &ValidationError{Name: "named_size", err: fmt.Errorf(`ent: validator failed for field "TopicCluster.named_size": %w`, err)}
+		}
+	}
+	if _, ok := tcuo.mutation.UserID(); tcuo.mutation.UserCleared() && !ok {
+		return errors.New(`ent: clearing a required unique edge "TopicCluster.user"`)
+	}
+	return nil
+}
+
+func (tcuo *TopicClusterUpdateOne) sqlSave(ctx context.Context) (_node *TopicCluster, err error) {
+	if err := tcuo.check(); err != nil {
+		return _node, err
+	}
+	_spec := sqlgraph.NewUpdateSpec(topiccluster.Table, topiccluster.Columns, sqlgraph.NewFieldSpec(topiccluster.FieldID, field.TypeInt))
+	id, ok := tcuo.mutation.ID()
+	if !ok {
+		return nil, &ValidationError{Name: "id", err: errors.New(`ent: missing "TopicCluster.id" for update`)}
+	}
+	_spec.Node.ID.Value = id
+	if fields := tcuo.fields; len(fields) > 0 {
+		_spec.Node.Columns = make([]string, 0, len(fields))
+		_spec.Node.Columns = append(_spec.Node.Columns, topiccluster.FieldID)
+		for _, f := range fields {
+			if !topiccluster.ValidColumn(f) {
+				return nil, &ValidationError{Name: f, err: fmt.Errorf("ent: invalid field %q for query", f)}
+			}
+			if f != topiccluster.FieldID {
+				_spec.Node.Columns = append(_spec.Node.Columns, f)
+			}
+		}
+	}
+	if ps := tcuo.mutation.predicates; len(ps) > 0 {
+		_spec.Predicate = func(selector *sql.Selector) {
+			for i := range ps {
+				ps[i](selector)
+			}
+		}
+	}
+	if value, ok := tcuo.mutation.Name(); ok {
+		_spec.SetField(topiccluster.FieldName, field.TypeString, value)
+	}
+	if value, ok := tcuo.mutation.Keywords(); ok {
+		_spec.SetField(topiccluster.FieldKeywords, field.TypeJSON, value)
+	}
+	if value, ok := tcuo.mutation.AppendedKeywords(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, topiccluster.FieldKeywords, value)
+		})
+	}
+	if tcuo.mutation.KeywordsCleared() {
+		_spec.ClearField(topiccluster.FieldKeywords, field.TypeJSON)
+	}
+	if value, ok := tcuo.mutation.Centroid(); ok {
+		_spec.SetField(topiccluster.FieldCentroid, field.TypeJSON, value)
+	}
+	if value, ok := tcuo.mutation.AppendedCentroid(); ok {
+		_spec.AddModifier(func(u *sql.UpdateBuilder) {
+			sqljson.Append(u, topiccluster.FieldCentroid, value)
+		})
+	}
+	if value, ok := tcuo.mutation.Model(); ok {
+		_spec.SetField(topiccluster.FieldModel, field.TypeString, value)
+	}
+	if value, ok := tcuo.mutation.Size(); ok {
+		_spec.SetField(topiccluster.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := tcuo.mutation.AddedSize(); ok {
+		_spec.AddField(topiccluster.FieldSize, field.TypeInt, value)
+	}
+	if value, ok := tcuo.mutation.NamedSize(); ok {
+		_spec.SetField(topiccluster.FieldNamedSize, field.TypeInt, value)
+	}
+	if value, ok := tcuo.mutation.AddedNamedSize(); ok {
+		_spec.AddField(topiccluster.FieldNamedSize, field.TypeInt, value)
+	}
+	if value, ok := tcuo.mutation.UpdatedAt(); ok {
+		_spec.SetField(topiccluster.FieldUpdatedAt, field.TypeTime, value)
+	}
+	if tcuo.mutation.UserCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   topiccluster.UserTable,
+			Columns: []string{topiccluster.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcuo.mutation.UserIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.M2O,
+			Inverse: true,
+			Table:   topiccluster.UserTable,
+			Columns: []string{topiccluster.UserColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(user.FieldID, field.TypeInt),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	if tcuo.mutation.ArticlesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   topiccluster.ArticlesTable,
+			Columns: []string{topiccluster.ArticlesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint),
+			},
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcuo.mutation.RemovedArticlesIDs(); len(nodes) > 0 && !tcuo.mutation.ArticlesCleared() {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   topiccluster.ArticlesTable,
+			Columns: []string{topiccluster.ArticlesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Clear = append(_spec.Edges.Clear, edge)
+	}
+	if nodes := tcuo.mutation.ArticlesIDs(); len(nodes) > 0 {
+		edge := &sqlgraph.EdgeSpec{
+			Rel:     sqlgraph.O2M,
+			Inverse: false,
+			Table:   topiccluster.ArticlesTable,
+			Columns: []string{topiccluster.ArticlesColumn},
+			Bidi:    false,
+			Target: &sqlgraph.EdgeTarget{
+				IDSpec: sqlgraph.NewFieldSpec(article.FieldID, field.TypeUint),
+			},
+		}
+		for _, k := range nodes {
+			edge.Target.Nodes = append(edge.Target.Nodes, k)
+		}
+		_spec.Edges.Add = append(_spec.Edges.Add, edge)
+	}
+	_node = &TopicCluster{config: tcuo.config}
+	_spec.Assign = _node.assignValues
+	_spec.ScanValues = _node.scanValues
+	if err = sqlgraph.UpdateNode(ctx, tcuo.driver, _spec); err != nil {
+		if _, ok := err.(*sqlgraph.NotFoundError); ok {
+			err = &NotFoundError{topiccluster.Label}
+		} else if sqlgraph.IsConstraintError(err) {
+			err = &ConstraintError{msg: err.Error(), wrap: err}
+		}
+		return nil, err
+	}
+	tcuo.mutation.done = true
+	return _node, nil
+}
@@ -12,8 +12,34 @@ import (
 // Tx is a transactional client that is created by calling Client.Tx().
 type Tx struct {
 	config
+	// AccessToken is the client for interacting with the AccessToken builders.
+	AccessToken *AccessTokenClient
 	// Article is the client for interacting with the Article builders.
 	Article *ArticleClient
+	// ArticleChunk is the client for interacting with the ArticleChunk builders.
+	ArticleChunk *ArticleChunkClient
+	// ArticleEnrichment is the client for interacting with the ArticleEnrichment builders.
+	ArticleEnrichment *ArticleEnrichmentClient
+	// ArticleRevision is the client for interacting with the ArticleRevision builders.
+	ArticleRevision *ArticleRevisionClient
+	// ArticleTranslation is the client for interacting with the ArticleTranslation builders.
+	ArticleTranslation *ArticleTranslationClient
+	// Export is the client for interacting with the Export builders.
+	Export *ExportClient
+	// Feed is the client for interacting with the Feed builders.
+	Feed *FeedClient
+	// Highlight is the client for interacting with the Highlight builders.
+	Highlight *HighlightClient
+	// Image is the client for interacting with the Image builders.
+	Image *ImageClient
+	// Notification is the client for interacting with the Notification builders.
+	Notification *NotificationClient
+	// PromptTemplate is the client for interacting with the PromptTemplate builders.
+	PromptTemplate *PromptTemplateClient
+	// Snapshot is the client for interacting with the Snapshot builders.
+	Snapshot *SnapshotClient
+	// TopicCluster is the client for interacting with the TopicCluster builders.
+	TopicCluster *TopicClusterClient
 	// User is the client for interacting with the User builders.
 	User *UserClient
 
@@ -147,7 +173,20 @@ func (tx *Tx) Client() *Client {
 }
 
 func (tx *Tx) init() {
+	tx.AccessToken = NewAccessTokenClient(tx.config)
 	tx.Article = NewArticleClient(tx.config)
+	tx.ArticleChunk = NewArticleChunkClient(tx.config)
+	tx.ArticleEnrichment = NewArticleEnrichmentClient(tx.config)
+	tx.ArticleRevision = NewArticleRevisionClient(tx.config)
+	tx.ArticleTranslation = NewArticleTranslationClient(tx.config)
+	tx.Export = NewExportClient(tx.config)
+	tx.Feed = NewFeedClient(tx.config)
+	tx.Highlight = NewHighlightClient(tx.config)
+	tx.Image = NewImageClient(tx.config)
+	tx.Notification = NewNotificationClient(tx.config)
+	tx.PromptTemplate = NewPromptTemplateClient(tx.config)
+	tx.Snapshot = NewSnapshotClient(tx.config)
+	tx.TopicCluster = NewTopicClusterClient(tx.config)
 	tx.User = NewUserClient(tx.config)
 }
 
@@ -158,7 +197,7 @@ func (tx *Tx) init() {
 // of them in order to commit or rollback the transaction.
 //
 // If a closed transaction is embedded in one of the generated entities, and the entity
-// applies a query, for example: Article.QueryXXX(), the query will be executed
+// applies a query, for example: AccessToken.QueryXXX(), the query will be executed
 // through the driver which created this transaction.
 //
 // Note that txDriver is not goroutine safe.
@@ -27,6 +27,10 @@ type User struct {
 	WxOpenID string `json:"wx_open_id,omitempty"`
 	// Nickname holds the value of the "nickname" field.
 	Nickname string `json:"nickname,omitempty"`
+	// FeedToken holds the value of the "feed_token" field.
+	FeedToken string `json:"-"`
+	// MailToken holds the value of the "mail_token" field.
+	MailToken string `json:"-"`
 	// CreatedAt holds the value of the "created_at" field.
 	CreatedAt time.Time `json:"created_at,omitempty"`
 	// UpdatedAt holds the value of the "updated_at" field.
@@ -41,9 +45,23 @@ type User struct {
 type UserEdges struct {
 	// Articles holds the value of the articles edge.
 	Articles []*Article `json:"articles,omitempty"`
+	// Clusters holds the value of the clusters edge.
+	Clusters []*TopicCluster `json:"clusters,omitempty"`
+	// Exports holds the value of the exports edge.
+	Exports []*Export `json:"exports,omitempty"`
+	// Feeds holds the value of the feeds edge.
+	Feeds []*Feed `json:"feeds,omitempty"`
+	// AccessTokens holds the value of the access_tokens edge.
+	AccessTokens []*AccessToken `json:"access_tokens,omitempty"`
+	// Images holds the value of the images edge.
+	Images []*Image `json:"images,omitempty"`
+	// Notifications holds the value of the notifications edge.
+	Notifications []*Notification `json:"notifications,omitempty"`
+	// PromptTemplates holds the value of the prompt_templates edge.
+	PromptTemplates []*PromptTemplate `json:"prompt_templates,omitempty"`
 	// loadedTypes holds the information for reporting if a
 	// type was loaded (or requested) in eager-loading or not.
-	loadedTypes [1]bool
+	loadedTypes [8]bool
 }
 
 // ArticlesOrErr returns the Articles value or an error if the edge
@@ -55,6 +73,69 @@ func (e UserEdges) ArticlesOrErr() ([]*Article, error) {
 	return nil, &NotLoadedError{edge: "articles"}
 }
 
+// ClustersOrErr returns the Clusters value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ClustersOrErr() ([]*TopicCluster, error) {
+	if e.loadedTypes[1] {
+		return e.Clusters, nil
+	}
+	return nil, &NotLoadedError{edge: "clusters"}
+}
+
+// ExportsOrErr returns the Exports value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ExportsOrErr() ([]*Export, error) {
+	if e.loadedTypes[2] {
+		return e.Exports, nil
+	}
+	return nil, &NotLoadedError{edge: "exports"}
+}
+
+// FeedsOrErr returns the Feeds value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) FeedsOrErr() ([]*Feed, error) {
+	if e.loadedTypes[3] {
+		return e.Feeds, nil
+	}
+	return nil, &NotLoadedError{edge: "feeds"}
+}
+
+// AccessTokensOrErr returns the AccessTokens value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) AccessTokensOrErr() ([]*AccessToken, error) {
+	if e.loadedTypes[4] {
+		return e.AccessTokens, nil
+	}
+	return nil, &NotLoadedError{edge: "access_tokens"}
+}
+
+// ImagesOrErr returns the Images value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) ImagesOrErr() ([]*Image, error) {
+	if e.loadedTypes[5] {
+		return e.Images, nil
+	}
+	return nil, &NotLoadedError{edge: "images"}
+}
+
+// NotificationsOrErr returns the Notifications value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) NotificationsOrErr() ([]*Notification, error) {
+	if e.loadedTypes[6] {
+		return e.Notifications, nil
+	}
+	return nil, &NotLoadedError{edge: "notifications"}
+}
+
+// PromptTemplatesOrErr returns the PromptTemplates value or an error if the edge
+// was not loaded in eager-loading.
+func (e UserEdges) PromptTemplatesOrErr() ([]*PromptTemplate, error) {
+	if e.loadedTypes[7] {
+		return e.PromptTemplates, nil
+	}
+	return nil, &NotLoadedError{edge: "prompt_templates"}
+}
+
 // scanValues returns the types for scanning values from sql.Rows.
 func (*User) scanValues(columns []string) ([]any, error) {
 	values := make([]any, len(columns))
@@ -62,7 +143,7 @@ func (*User) scanValues(columns []string) ([]any, error) {
 		switch columns[i] {
 		case user.FieldID:
 			values[i] = new(sql.NullInt64)
-		case user.FieldUsername, user.FieldPassword, user.FieldEmail, user.FieldWxOpenID, user.FieldNickname:
+		case user.FieldUsername, user.FieldPassword, user.FieldEmail, user.FieldWxOpenID, user.FieldNickname, user.FieldFeedToken, user.FieldMailToken:
 			values[i] = new(sql.NullString)
 		case user.FieldCreatedAt, user.FieldUpdatedAt:
 			values[i] = new(sql.NullTime)
@@ -117,6 +198,18 @@ func (u *User) assignValues(columns []string, values []any) error {
 			} else if value.Valid {
 				u.Nickname = value.String
 			}
+		case user.FieldFeedToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field feed_token", values[i])
+			} else if value.Valid {
+				u.FeedToken = value.String
+			}
+		case user.FieldMailToken:
+			if value, ok := values[i].(*sql.NullString); !ok {
+				return fmt.Errorf("unexpected type %T for field mail_token", values[i])
+			} else if value.Valid {
+				u.MailToken = value.String
+			}
 		case user.FieldCreatedAt:
 			if value, ok := values[i].(*sql.NullTime); !ok {
 				return fmt.Errorf("unexpected type %T for field created_at", values[i])
@@ -147,6 +240,41 @@ func (u *User) QueryArticles() *ArticleQuery {
 	return NewUserClient(u.config).QueryArticles(u)
 }
 
+// QueryClusters queries the "clusters" edge of the User entity.
+func (u *User) QueryClusters() *TopicClusterQuery {
+	return NewUserClient(u.config).QueryClusters(u)
+}
+
+// QueryExports queries the "exports" edge of the User entity.
+func (u *User) QueryExports() *ExportQuery {
+	return NewUserClient(u.config).QueryExports(u)
+}
+
+// QueryFeeds queries the "feeds" edge of the User entity.
+func (u *User) QueryFeeds() *FeedQuery {
+	return NewUserClient(u.config).QueryFeeds(u)
+}
+
+// QueryAccessTokens queries the "access_tokens" edge of the User entity.
+func (u *User) QueryAccessTokens() *AccessTokenQuery {
+	return NewUserClient(u.config).QueryAccessTokens(u)
+}
+
+// QueryImages queries the "images" edge of the User entity.
+func (u *User) QueryImages() *ImageQuery {
+	return NewUserClient(u.config).QueryImages(u)
+}
+
+// QueryNotifications queries the "notifications" edge of the User entity.
+func (u *User) QueryNotifications() *NotificationQuery {
+	return NewUserClient(u.config).QueryNotifications(u)
+}
+
+// QueryPromptTemplates queries the "prompt_templates" edge of the User entity.
+func (u *User) QueryPromptTemplates() *PromptTemplateQuery {
+	return NewUserClient(u.config).QueryPromptTemplates(u)
+}
+
 // Update returns a builder for updating this User.
 // Note that you need to call User.Unwrap() before calling this method if this User
 // was returned from a transaction, and the transaction was committed or rolled back.
@@ -185,6 +313,10 @@ func (u *User) String() string {
 	builder.WriteString("nickname=")
 	builder.WriteString(u.Nickname)
 	builder.WriteString(", ")
+	builder.WriteString("feed_token=<sensitive>")
+	builder.WriteString(", ")
+	builder.WriteString("mail_token=<sensitive>")
+	builder.WriteString(", ")
 	builder.WriteString("created_at=")
 	builder.WriteString(u.CreatedAt.Format(time.ANSIC))
 	builder.WriteString(", ")
@@ -80,6 +80,16 @@ func Nickname(v string) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldNickname, v))
 }
 
+// FeedToken applies equality check predicate on the "feed_token" field. It's identical to FeedTokenEQ.
+func FeedToken(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldFeedToken, v))
+}
+
+// MailToken applies equality check predicate on the "mail_token" field. It's identical to MailTokenEQ.
+func MailToken(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMailToken, v))
+}
+
 // CreatedAt applies equality check predicate on the "created_at" field. It's identical to CreatedAtEQ.
 func CreatedAt(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -445,6 +455,156 @@ func NicknameContainsFold(v string) predicate.User {
 	return predicate.User(sql.FieldContainsFold(FieldNickname, v))
 }
 
+// FeedTokenEQ applies the EQ predicate on the "feed_token" field.
+func FeedTokenEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldFeedToken, v))
+}
+
+// FeedTokenNEQ applies the NEQ predicate on the "feed_token" field.
+func FeedTokenNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldFeedToken, v))
+}
+
+// FeedTokenIn applies the In predicate on the "feed_token" field.
+func FeedTokenIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldFeedToken, vs...))
+}
+
+// FeedTokenNotIn applies the NotIn predicate on the "feed_token" field.
+func FeedTokenNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldFeedToken, vs...))
+}
+
+// FeedTokenGT applies the GT predicate on the "feed_token" field.
+func FeedTokenGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldFeedToken, v))
+}
+
+// FeedTokenGTE applies the GTE predicate on the "feed_token" field.
+func FeedTokenGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldFeedToken, v))
+}
+
+// FeedTokenLT applies the LT predicate on the "feed_token" field.
+func FeedTokenLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldFeedToken, v))
+}
+
+// FeedTokenLTE applies the LTE predicate on the "feed_token" field.
+func FeedTokenLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldFeedToken, v))
+}
+
+// FeedTokenContains applies the Contains predicate on the "feed_token" field.
+func FeedTokenContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldFeedToken, v))
+}
+
+// FeedTokenHasPrefix applies the HasPrefix predicate on the "feed_token" field.
+func FeedTokenHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldFeedToken, v))
+}
+
+// FeedTokenHasSuffix applies the HasSuffix predicate on the "feed_token" field.
+func FeedTokenHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldFeedToken, v))
+}
+
+// FeedTokenIsNil applies the IsNil predicate on the "feed_token" field.
+func FeedTokenIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldFeedToken))
+}
+
+// FeedTokenNotNil applies the NotNil predicate on the "feed_token" field.
+func FeedTokenNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldFeedToken))
+}
+
+// FeedTokenEqualFold applies the EqualFold predicate on the "feed_token" field.
+func FeedTokenEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldFeedToken, v))
+}
+
+// FeedTokenContainsFold applies the ContainsFold predicate on the "feed_token" field.
+func FeedTokenContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldFeedToken, v))
+}
+
+// MailTokenEQ applies the EQ predicate on the "mail_token" field.
+func MailTokenEQ(v string) predicate.User {
+	return predicate.User(sql.FieldEQ(FieldMailToken, v))
+}
+
+// MailTokenNEQ applies the NEQ predicate on the "mail_token" field.
+func MailTokenNEQ(v string) predicate.User {
+	return predicate.User(sql.FieldNEQ(FieldMailToken, v))
+}
+
+// MailTokenIn applies the In predicate on the "mail_token" field.
+func MailTokenIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldIn(FieldMailToken, vs...))
+}
+
+// MailTokenNotIn applies the NotIn predicate on the "mail_token" field.
+func MailTokenNotIn(vs ...string) predicate.User {
+	return predicate.User(sql.FieldNotIn(FieldMailToken, vs...))
+}
+
+// MailTokenGT applies the GT predicate on the "mail_token" field.
+func MailTokenGT(v string) predicate.User {
+	return predicate.User(sql.FieldGT(FieldMailToken, v))
+}
+
+// MailTokenGTE applies the GTE predicate on the "mail_token" field.
+func MailTokenGTE(v string) predicate.User {
+	return predicate.User(sql.FieldGTE(FieldMailToken, v))
+}
+
+// MailTokenLT applies the LT predicate on the "mail_token" field.
+func MailTokenLT(v string) predicate.User {
+	return predicate.User(sql.FieldLT(FieldMailToken, v))
+}
+
+// MailTokenLTE applies the LTE predicate on the "mail_token" field.
+func MailTokenLTE(v string) predicate.User {
+	return predicate.User(sql.FieldLTE(FieldMailToken, v))
+}
+
+// MailTokenContains applies the Contains predicate on the "mail_token" field.
+func MailTokenContains(v string) predicate.User {
+	return predicate.User(sql.FieldContains(FieldMailToken, v))
+}
+
+// MailTokenHasPrefix applies the HasPrefix predicate on the "mail_token" field.
+func MailTokenHasPrefix(v string) predicate.User {
+	return predicate.User(sql.FieldHasPrefix(FieldMailToken, v))
+}
+
+// MailTokenHasSuffix applies the HasSuffix predicate on the "mail_token" field.
+func MailTokenHasSuffix(v string) predicate.User {
+	return predicate.User(sql.FieldHasSuffix(FieldMailToken, v))
+}
+
+// MailTokenIsNil applies the IsNil predicate on the "mail_token" field.
+func MailTokenIsNil() predicate.User {
+	return predicate.User(sql.FieldIsNull(FieldMailToken))
+}
+
+// MailTokenNotNil applies the NotNil predicate on the "mail_token" field.
+func MailTokenNotNil() predicate.User {
+	return predicate.User(sql.FieldNotNull(FieldMailToken))
+}
+
+// MailTokenEqualFold applies the EqualFold predicate on the "mail_token" field.
+func MailTokenEqualFold(v string) predicate.User {
+	return predicate.User(sql.FieldEqualFold(FieldMailToken, v))
+}
+
+// MailTokenContainsFold applies the ContainsFold predicate on the "mail_token" field.
+func MailTokenContainsFold(v string) predicate.User {
+	return predicate.User(sql.FieldContainsFold(FieldMailToken, v))
+}
+
 // CreatedAtEQ applies the EQ predicate on the "created_at" field.
 func CreatedAtEQ(v time.Time) predicate.User {
 	return predicate.User(sql.FieldEQ(FieldCreatedAt, v))
@@ -548,6 +708,167 @@ func HasArticlesWith(preds ...predicate.Article) predicate.User {
 	})
 }
 
+// HasClusters applies the HasEdge predicate on the "clusters" edge.
+func HasClusters() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ClustersTable, ClustersColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasClustersWith applies the HasEdge predicate on the "clusters" edge with a given conditions (other predicates).
+func HasClustersWith(preds ...predicate.TopicCluster) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newClustersStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasExports applies the HasEdge predicate on the "exports" edge.
+func HasExports() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ExportsTable, ExportsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasExportsWith applies the HasEdge predicate on the "exports" edge with a given conditions (other predicates).
+func HasExportsWith(preds ...predicate.Export) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newExportsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasFeeds applies the HasEdge predicate on the "feeds" edge.
+func HasFeeds() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, FeedsTable, FeedsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasFeedsWith applies the HasEdge predicate on the "feeds" edge with a given conditions (other predicates).
+func HasFeedsWith(preds ...predicate.Feed) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newFeedsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasAccessTokens applies the HasEdge predicate on the "access_tokens" edge.
+func HasAccessTokens() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, AccessTokensTable, AccessTokensColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasAccessTokensWith applies the HasEdge predicate on the "access_tokens" edge with a given conditions (other predicates).
+func HasAccessTokensWith(preds ...predicate.AccessToken) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newAccessTokensStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasImages applies the HasEdge predicate on the "images" edge.
+func HasImages() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, ImagesTable, ImagesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasImagesWith applies the HasEdge predicate on the "images" edge with a given conditions (other predicates).
+func HasImagesWith(preds ...predicate.Image) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newImagesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasNotifications applies the HasEdge predicate on the "notifications" edge.
+func HasNotifications() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, NotificationsTable, NotificationsColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasNotificationsWith applies the HasEdge predicate on the "notifications" edge with a given conditions (other predicates).
+func HasNotificationsWith(preds ...predicate.Notification) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newNotificationsStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
+// HasPromptTemplates applies the HasEdge predicate on the "prompt_templates" edge.
+func HasPromptTemplates() predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := sqlgraph.NewStep(
+			sqlgraph.From(Table, FieldID),
+			sqlgraph.Edge(sqlgraph.O2M, false, PromptTemplatesTable, PromptTemplatesColumn),
+		)
+		sqlgraph.HasNeighbors(s, step)
+	})
+}
+
+// HasPromptTemplatesWith applies the HasEdge predicate on the "prompt_templates" edge with a given conditions (other predicates).
+func HasPromptTemplatesWith(preds ...predicate.PromptTemplate) predicate.User {
+	return predicate.User(func(s *sql.Selector) {
+		step := newPromptTemplatesStep()
+		sqlgraph.HasNeighborsWith(s, step, func(s *sql.Selector) {
+			for _, p := range preds {
+				p(s)
+			}
+		})
+	})
+}
+
 // And groups predicates with the AND operator between them.
 func And(predicates ...predicate.User) predicate.User {
 	return predicate.User(sql.AndPredicates(predicates...))
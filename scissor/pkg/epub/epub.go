@@ -0,0 +1,293 @@
+// Package epub 生成包含目录、章节和内嵌图片的 EPUB 3 电子书
+package epub
+
+import (
+	"archive/zip"
+	"bytes"
+	"context"
+	"crypto/sha1"
+	"encoding/hex"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// ImageFetcher 下载图片，返回图片数据和MIME类型
+type ImageFetcher func(ctx context.Context, url string) ([]byte, string, error)
+
+// Book 一本电子书
+type Book struct {
+	Title      string
+	Author     string
+	Language   string
+	Identifier string
+	// Description 书籍简介
+	Description string
+	Modified    time.Time
+	// Fetcher 用于内嵌图片，为nil时删除正文中的远程图片
+	Fetcher ImageFetcher
+	// MaxImageBytes 内嵌图片的总大小上限，超出后的图片会被删除，0表示不限制
+	MaxImageBytes int64
+
+	chapters   []*chapter
+	images     []*image
+	imageByURL map[string]*image
+	imageBytes int64
+}
+
+type chapter struct {
+	ID    string
+	Title string
+	Body  string
+}
+
+type image struct {
+	ID        string
+	Name      string
+	MediaType string
+	data      []byte
+}
+
+// New 创建电子书
+func New(title string) *Book {
+	return &Book{
+		Title:      title,
+		Language:   "zh-CN",
+		Identifier: fmt.Sprintf("urn:scissor:%d", time.Now().UnixNano()),
+		Modified:   time.Now(),
+		imageByURL: make(map[string]*image),
+	}
+}
+
+// AddChapter 添加一章，content为HTML片段，会被转换为XHTML并内嵌其中的图片
+func (b *Book) AddChapter(ctx context.Context, title, content string) error {
+	nodes, err := html.ParseFragment(strings.NewReader(content), &html.Node{
+		Type:     html.ElementNode,
+		Data:     "body",
+		DataAtom: atom.Body,
+	})
+	if err != nil {
+		return fmt.Errorf("解析章节内容失败: %w", err)
+	}
+
+	root := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	for _, n := range nodes {
+		root.AppendChild(n)
+	}
+	b.clean(ctx, root)
+
+	var body bytes.Buffer
+	for n := root.FirstChild; n != nil; n = n.NextSibling {
+		if err := html.Render(&body, n); err != nil {
+			return err
+		}
+	}
+
+	b.chapters = append(b.chapters, &chapter{
+		ID:    fmt.Sprintf("chapter-%d", len(b.chapters)+1),
+		Title: title,
+		Body:  body.String(),
+	})
+	return nil
+}
+
+// EPUB中不允许或无法使用的元素
+var disallowed = map[atom.Atom]bool{
+	atom.Script: true, atom.Style: true, atom.Noscript: true, atom.Iframe: true,
+	atom.Form: true, atom.Input: true, atom.Button: true, atom.Select: true,
+	atom.Textarea: true, atom.Object: true, atom.Embed: true, atom.Video: true,
+	atom.Audio: true, atom.Link: true, atom.Meta: true, atom.Template: true,
+}
+
+// XML中合法的属性名
+var validAttr = regexp.MustCompile(`^[a-zA-Z_][-a-zA-Z0-9_.]*$`)
+
+// clean 删除不允许的元素和属性，并将图片替换为内嵌资源
+func (b *Book) clean(ctx context.Context, n *html.Node) {
+	for c := n.FirstChild; c != nil; {
+		next := c.NextSibling
+		switch {
+		case c.Type == html.CommentNode:
+			n.RemoveChild(c)
+		case c.Type == html.ElementNode && disallowed[c.DataAtom]:
+			n.RemoveChild(c)
+		case c.Type == html.ElementNode && c.DataAtom == atom.Img && !b.embed(ctx, c):
+			n.RemoveChild(c)
+		default:
+			b.clean(ctx, c)
+		}
+		c = next
+	}
+
+	if n.Type != html.ElementNode {
+		return
+	}
+	attrs := n.Attr[:0]
+	for _, a := range n.Attr {
+		key := strings.ToLower(a.Key)
+		if a.Namespace != "" || !validAttr.MatchString(a.Key) || strings.HasPrefix(key, "on") || key == "style" {
+			continue
+		}
+		if key == "href" && strings.HasPrefix(strings.TrimSpace(strings.ToLower(a.Val)), "javascript:") {
+			continue
+		}
+		attrs = append(attrs, a)
+	}
+	n.Attr = attrs
+}
+
+// embed 下载图片并改写为书内路径，无法内嵌时返回false
+func (b *Book) embed(ctx context.Context, n *html.Node) bool {
+	src := ""
+	alt := ""
+	for _, a := range n.Attr {
+		switch a.Key {
+		case "data-src":
+			src = a.Val
+		case "src":
+			if src == "" {
+				src = a.Val
+			}
+		case "alt":
+			alt = a.Val
+		}
+	}
+	if b.Fetcher == nil || !(strings.HasPrefix(src, "http://") || strings.HasPrefix(src, "https://")) {
+		return false
+	}
+
+	img, ok := b.imageByURL[src]
+	if !ok {
+		data, mediaType, err := b.Fetcher(ctx, src)
+		if err == nil && b.MaxImageBytes > 0 && b.imageBytes+int64(len(data)) > b.MaxImageBytes {
+			err = fmt.Errorf("图片总大小超过限制")
+		}
+		ext := imageExt(mediaType)
+		if err != nil || ext == "" {
+			b.imageByURL[src] = nil
+			return false
+		}
+		sum := sha1.Sum(data)
+		name := "images/" + hex.EncodeToString(sum[:]) + ext
+		// 不同链接指向同一张图片时只保存一份
+		for _, existing := range b.images {
+			if existing.Name == name {
+				img = existing
+			}
+		}
+		if img == nil {
+			img = &image{
+				ID:        fmt.Sprintf("image-%d", len(b.images)+1),
+				Name:      name,
+				MediaType: mediaType,
+				data:      data,
+			}
+			b.images = append(b.images, img)
+			b.imageBytes += int64(len(data))
+		}
+		b.imageByURL[src] = img
+	}
+	if img == nil {
+		return false
+	}
+
+	n.Attr = []html.Attribute{{Key: "src", Val: "../" + img.Name}, {Key: "alt", Val: alt}}
+	return true
+}
+
+func imageExt(mediaType string) string {
+	switch strings.ToLower(strings.TrimSpace(strings.Split(mediaType, ";")[0])) {
+	case "image/jpeg":
+		return ".jpg"
+	case "image/png":
+		return ".png"
+	case "image/gif":
+		return ".gif"
+	case "image/svg+xml":
+		return ".svg"
+	case "image/webp":
+		return ".webp"
+	}
+	return ""
+}
+
+// Write 将电子书写入w
+func (b *Book) Write(w io.Writer) error {
+	zw := zip.NewWriter(w)
+
+	// mimetype 必须是第一个文件，不压缩且不带数据描述符
+	mimetype := []byte("application/epub+zip")
+	mw, err := zw.CreateRaw(&zip.FileHeader{
+		Name:               "mimetype",
+		Method:             zip.Store,
+		CRC32:              crc32.ChecksumIEEE(mimetype),
+		CompressedSize64:   uint64(len(mimetype)),
+		UncompressedSize64: uint64(len(mimetype)),
+	})
+	if err != nil {
+		return err
+	}
+	if _, err := mw.Write(mimetype); err != nil {
+		return err
+	}
+
+	// 模板只能访问导出字段
+	view := struct {
+		*Book
+		Chapters []*chapter
+		Images   []*image
+	}{b, b.chapters, b.images}
+
+	files := []struct {
+		name string
+		tmpl *template.Template
+		data interface{}
+	}{
+		{"META-INF/container.xml", containerTemplate, nil},
+		{"OEBPS/content.opf", packageTemplate, view},
+		{"OEBPS/nav.xhtml", navTemplate, view},
+		{"OEBPS/toc.ncx", ncxTemplate, view},
+		{"OEBPS/style.css", styleTemplate, nil},
+	}
+	for _, f := range files {
+		fw, err := zw.Create(f.name)
+		if err != nil {
+			return err
+		}
+		if err := f.tmpl.Execute(fw, f.data); err != nil {
+			return fmt.Errorf("生成 %s 失败: %w", f.name, err)
+		}
+	}
+
+	for _, c := range b.chapters {
+		fw, err := zw.Create("OEBPS/text/" + c.ID + ".xhtml")
+		if err != nil {
+			return err
+		}
+		if err := chapterTemplate.Execute(fw, struct {
+			Language string
+			*chapter
+		}{b.Language, c}); err != nil {
+			return err
+		}
+	}
+
+	for _, img := range b.images {
+		fw, err := zw.CreateHeader(&zip.FileHeader{Name: "OEBPS/" + img.Name, Method: zip.Store})
+		if err != nil {
+			return err
+		}
+		if _, err := fw.Write(img.data); err != nil {
+			return err
+		}
+	}
+
+	return zw.Close()
+}
@@ -0,0 +1,45 @@
+package epub
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+)
+
+// HTTPFetcher 返回通过HTTP下载图片的ImageFetcher，超过maxSize字节的图片视为失败。
+// 请求不带Referer，以绕过微信图片的防盗链。图片地址来自用户的文章，client 应使用 safehttp.NewClient 创建。
+func HTTPFetcher(client *http.Client, maxSize int64) ImageFetcher {
+	return func(ctx context.Context, url string) ([]byte, string, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, "", err
+		}
+		req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; scissor)")
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, "", err
+		}
+		defer resp.Body.Close()
+
+		if resp.StatusCode != http.StatusOK {
+			return nil, "", fmt.Errorf("下载图片失败，状态码: %d", resp.StatusCode)
+		}
+
+		data, err := io.ReadAll(io.LimitReader(resp.Body, maxSize+1))
+		if err != nil {
+			return nil, "", err
+		}
+		if int64(len(data)) > maxSize {
+			return nil, "", fmt.Errorf("图片超过 %d 字节", maxSize)
+		}
+
+		mediaType := resp.Header.Get("Content-Type")
+		if !strings.HasPrefix(mediaType, "image/") {
+			mediaType = http.DetectContentType(data)
+		}
+		return data, mediaType, nil
+	}
+}
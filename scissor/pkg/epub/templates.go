@@ -0,0 +1,127 @@
+package epub
+
+import (
+	"encoding/xml"
+	"strings"
+	"text/template"
+	"time"
+)
+
+var funcs = template.FuncMap{
+	"xml": func(s string) string {
+		var b strings.Builder
+		xml.EscapeText(&b, []byte(s))
+		return b.String()
+	},
+	"modified": func(t time.Time) string {
+		return t.UTC().Format("2006-01-02T15:04:05Z")
+	},
+	"inc": func(i int) int { return i + 1 },
+	"raw": func(s string) string { return s },
+}
+
+func mustParse(name, text string) *template.Template {
+	return template.Must(template.New(name).Funcs(funcs).Parse(text))
+}
+
+var containerTemplate = mustParse("container", `<?xml version="1.0" encoding="UTF-8"?>
+<container version="1.0" xmlns="urn:oasis:names:tc:opendocument:xmlns:container">
+  <rootfiles>
+    <rootfile full-path="OEBPS/content.opf" media-type="application/oebps-package+xml"/>
+  </rootfiles>
+</container>
+`)
+
+var packageTemplate = mustParse("package", `<?xml version="1.0" encoding="UTF-8"?>
+<package xmlns="http://www.idpf.org/2007/opf" version="3.0" unique-identifier="book-id" xml:lang="{{xml .Language}}">
+  <metadata xmlns:dc="http://purl.org/dc/elements/1.1/">
+    <dc:identifier id="book-id">{{xml .Identifier}}</dc:identifier>
+    <dc:title>{{xml .Title}}</dc:title>
+    <dc:language>{{xml .Language}}</dc:language>
+{{- if .Author}}
+    <dc:creator>{{xml .Author}}</dc:creator>
+{{- end}}
+{{- if .Description}}
+    <dc:description>{{xml .Description}}</dc:description>
+{{- end}}
+    <dc:date>{{modified .Modified}}</dc:date>
+    <meta property="dcterms:modified">{{modified .Modified}}</meta>
+  </metadata>
+  <manifest>
+    <item id="nav" href="nav.xhtml" media-type="application/xhtml+xml" properties="nav"/>
+    <item id="ncx" href="toc.ncx" media-type="application/x-dtbncx+xml"/>
+    <item id="style" href="style.css" media-type="text/css"/>
+{{- range .Chapters}}
+    <item id="{{.ID}}" href="text/{{.ID}}.xhtml" media-type="application/xhtml+xml"/>
+{{- end}}
+{{- range .Images}}
+    <item id="{{.ID}}" href="{{.Name}}" media-type="{{.MediaType}}"/>
+{{- end}}
+  </manifest>
+  <spine toc="ncx">
+    <itemref idref="nav"/>
+{{- range .Chapters}}
+    <itemref idref="{{.ID}}"/>
+{{- end}}
+  </spine>
+</package>
+`)
+
+var navTemplate = mustParse("nav", `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xmlns:epub="http://www.idpf.org/2007/ops" xml:lang="{{xml .Language}}" lang="{{xml .Language}}">
+<head>
+  <title>{{xml .Title}}</title>
+  <link rel="stylesheet" type="text/css" href="style.css"/>
+</head>
+<body>
+  <nav epub:type="toc" id="toc">
+    <h1>{{xml .Title}}</h1>
+    <ol>
+{{- range .Chapters}}
+      <li><a href="text/{{.ID}}.xhtml">{{xml .Title}}</a></li>
+{{- end}}
+    </ol>
+  </nav>
+</body>
+</html>
+`)
+
+// toc.ncx 供仅支持 EPUB 2 的阅读器使用
+var ncxTemplate = mustParse("ncx", `<?xml version="1.0" encoding="UTF-8"?>
+<ncx xmlns="http://www.daisy.org/z3986/2005/ncx/" version="2005-1">
+  <head>
+    <meta name="dtb:uid" content="{{xml .Identifier}}"/>
+  </head>
+  <docTitle><text>{{xml .Title}}</text></docTitle>
+  <navMap>
+{{- range $i, $c := .Chapters}}
+    <navPoint id="nav-{{$c.ID}}" playOrder="{{inc $i}}">
+      <navLabel><text>{{xml $c.Title}}</text></navLabel>
+      <content src="text/{{$c.ID}}.xhtml"/>
+    </navPoint>
+{{- end}}
+  </navMap>
+</ncx>
+`)
+
+var chapterTemplate = mustParse("chapter", `<?xml version="1.0" encoding="UTF-8"?>
+<!DOCTYPE html>
+<html xmlns="http://www.w3.org/1999/xhtml" xml:lang="{{xml .Language}}" lang="{{xml .Language}}">
+<head>
+  <title>{{xml .Title}}</title>
+  <link rel="stylesheet" type="text/css" href="../style.css"/>
+</head>
+<body>
+{{raw .Body}}
+</body>
+</html>
+`)
+
+var styleTemplate = mustParse("style", `body { line-height: 1.6; }
+h1 { font-size: 1.4em; margin-bottom: .3em; }
+img { max-width: 100%; height: auto; }
+pre { white-space: pre-wrap; font-size: .85em; }
+blockquote { margin: 1em 0; padding-left: 1em; border-left: 3px solid #ccc; color: #555; }
+.meta { color: #888; font-size: .85em; }
+`)
@@ -0,0 +1,407 @@
+// Package extract 抓取网页并提取文章标题、作者、来源、发布时间和正文
+package extract
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"golang.org/x/net/html/charset"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/safehttp"
+)
+
+const (
+	// 浏览器UA，部分站点会拦截默认的Go客户端
+	userAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0 Safari/537.36"
+	// 最多读取的页面大小
+	maxPageSize = 10 << 20
+)
+
+// Document 从网页中提取出的文章
+type Document struct {
+	// URL 跟随跳转后的最终地址
+	URL         string
+	Title       string
+	Author      string
+	Source      string
+	Content     string
+	PublishedAt time.Time
+}
+
+// Client 网页抓取客户端
+type Client struct {
+	httpClient *http.Client
+}
+
+// NewClient 创建网页抓取客户端。网址由用户提交，只允许访问公网地址。
+func NewClient() *Client {
+	return &Client{
+		httpClient: safehttp.NewClient(30 * time.Second),
+	}
+}
+
+// Fetch 抓取网页并提取文章
+func (c *Client) Fetch(ctx context.Context, rawURL string) (*Document, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, rawURL, nil)
+	if err != nil {
+		return nil, fmt.Errorf("创建请求失败: %w", err)
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml")
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("请求网页失败: %w", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("请求网页失败，状态码: %d", resp.StatusCode)
+	}
+
+	body, err := charset.NewReader(io.LimitReader(resp.Body, maxPageSize), resp.Header.Get("Content-Type"))
+	if err != nil {
+		return nil, fmt.Errorf("识别网页编码失败: %w", err)
+	}
+	return Parse(resp.Request.URL.String(), body)
+}
+
+// Parse 从已获取的HTML中提取文章，pageURL用于识别站点和补全相对链接
+func Parse(pageURL string, r io.Reader) (*Document, error) {
+	root, err := html.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("解析网页失败: %w", err)
+	}
+
+	base, _ := url.Parse(pageURL)
+	doc := &Document{URL: pageURL}
+	meta := collectMeta(root)
+
+	doc.Title = firstNonEmpty(meta["og:title"], meta["twitter:title"], textOf(findByID(root, "activity-name")), textOf(findFirst(root, atom.Title)))
+	doc.Author = firstNonEmpty(meta["author"], meta["article:author"])
+	doc.Source = firstNonEmpty(textOf(findByID(root, "js_name")), meta["og:site_name"])
+	if base != nil && doc.Source == "" {
+		doc.Source = base.Hostname()
+	}
+	doc.PublishedAt = publishedAt(root, meta)
+
+	content := findByID(root, "js_content")
+	if content == nil {
+		content = mainContent(root)
+	}
+	if content != nil {
+		cleanup(content, base)
+		doc.Content = innerHTML(content)
+	}
+
+	return doc, nil
+}
+
+// Clean 清理一段HTML片段（如网页中选中的部分），与正文使用相同的规则，pageURL用于补全相对链接
+func Clean(pageURL, fragment string) (string, error) {
+	container := &html.Node{Type: html.ElementNode, Data: "div", DataAtom: atom.Div}
+	nodes, err := html.ParseFragment(strings.NewReader(fragment), &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body})
+	if err != nil {
+		return "", fmt.Errorf("解析网页失败: %w", err)
+	}
+	for _, n := range nodes {
+		container.AppendChild(n)
+	}
+
+	base, _ := url.Parse(pageURL)
+	cleanup(container, base)
+	return innerHTML(container), nil
+}
+
+// collectMeta 收集 <meta> 标签，键为 name 或 property 的小写值
+func collectMeta(root *html.Node) map[string]string {
+	meta := make(map[string]string)
+	walk(root, func(n *html.Node) bool {
+		if n.Type == html.ElementNode && n.DataAtom == atom.Meta {
+			key := strings.ToLower(firstNonEmpty(attr(n, "property"), attr(n, "name"), attr(n, "itemprop")))
+			if key != "" && meta[key] == "" {
+				meta[key] = strings.TrimSpace(attr(n, "content"))
+			}
+		}
+		return true
+	})
+	return meta
+}
+
+var (
+	// 公众号页面脚本中的发布时间戳，如 var ct = "1712345678";
+	wechatTimestamp = regexp.MustCompile(`\bct\s*=\s*"(\d{10})"`)
+	dateLayouts     = []string{time.RFC3339, "2006-01-02T15:04:05", "2006-01-02 15:04:05", "2006-01-02 15:04", "2006-01-02"}
+)
+
+func publishedAt(root *html.Node, meta map[string]string) time.Time {
+	for _, key := range []string{"article:published_time", "og:article:published_time", "datepublished", "pubdate"} {
+		if t, ok := parseDate(meta[key]); ok {
+			return t
+		}
+	}
+
+	var found time.Time
+	walk(root, func(n *html.Node) bool {
+		if !found.IsZero() {
+			return false
+		}
+		if n.Type == html.ElementNode && n.DataAtom == atom.Script && n.FirstChild != nil {
+			if m := wechatTimestamp.FindStringSubmatch(n.FirstChild.Data); m != nil {
+				if sec, err := strconv.ParseInt(m[1], 10, 64); err == nil {
+					found = time.Unix(sec, 0)
+				}
+			}
+		}
+		if n.Type == html.ElementNode && n.DataAtom == atom.Time {
+			if t, ok := parseDate(attr(n, "datetime")); ok {
+				found = t
+			}
+		}
+		return true
+	})
+	return found
+}
+
+func parseDate(s string) (time.Time, bool) {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return time.Time{}, false
+	}
+	for _, layout := range dateLayouts {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// 不属于正文的元素
+var boilerplate = map[atom.Atom]bool{
+	atom.Script: true, atom.Style: true, atom.Noscript: true, atom.Nav: true,
+	atom.Footer: true, atom.Header: true, atom.Aside: true, atom.Form: true,
+	atom.Iframe: true, atom.Button: true, atom.Input: true, atom.Select: true,
+	atom.Textarea: true, atom.Template: true, atom.Svg: true,
+}
+
+// mainContent 选出正文所在的元素：优先使用 <article>，否则按段落文本量打分，扣除链接文本
+func mainContent(root *html.Node) *html.Node {
+	if article := largest(findAll(root, atom.Article)); article != nil {
+		return article
+	}
+
+	var best *html.Node
+	bestScore := 0
+	walk(root, func(n *html.Node) bool {
+		if n.Type != html.ElementNode {
+			return true
+		}
+		if boilerplate[n.DataAtom] {
+			return false
+		}
+		switch n.DataAtom {
+		case atom.Div, atom.Section, atom.Main, atom.Td, atom.Body:
+		default:
+			return true
+		}
+
+		score := 0
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type == html.ElementNode && (c.DataAtom == atom.P || c.DataAtom == atom.Pre || c.DataAtom == atom.Blockquote) {
+				score += len([]rune(textOf(c)))
+			}
+		}
+		score -= linkTextLength(n) / 2
+		if score > bestScore {
+			best, bestScore = n, score
+		}
+		return true
+	})
+	if best == nil {
+		best = findFirst(root, atom.Body)
+	}
+	return best
+}
+
+func largest(nodes []*html.Node) *html.Node {
+	var best *html.Node
+	bestLen := 0
+	for _, n := range nodes {
+		if l := len(textOf(n)); l > bestLen {
+			best, bestLen = n, l
+		}
+	}
+	return best
+}
+
+func linkTextLength(n *html.Node) int {
+	total := 0
+	for _, a := range findAll(n, atom.A) {
+		total += len([]rune(textOf(a)))
+	}
+	return total
+}
+
+// cleanup 移除正文中的无关元素、事件属性和隐藏样式，补全相对链接，并将懒加载图片的 data-src 写回 src
+func cleanup(n *html.Node, base *url.URL) {
+	var remove []*html.Node
+	walk(n, func(c *html.Node) bool {
+		if c.Type == html.CommentNode {
+			remove = append(remove, c)
+			return false
+		}
+		if c.Type != html.ElementNode {
+			return true
+		}
+		if boilerplate[c.DataAtom] && c != n {
+			remove = append(remove, c)
+			return false
+		}
+
+		attrs := c.Attr[:0]
+		for _, a := range c.Attr {
+			key := strings.ToLower(a.Key)
+			if strings.HasPrefix(key, "on") {
+				continue
+			}
+			// 公众号正文默认以 visibility:hidden 隐藏，由脚本显示
+			if key == "style" && strings.Contains(strings.ReplaceAll(a.Val, " ", ""), "visibility:hidden") {
+				continue
+			}
+			if (key == "href" || key == "src") && base != nil {
+				a.Val = resolve(base, a.Val)
+			}
+			attrs = append(attrs, a)
+		}
+		c.Attr = attrs
+
+		if c.DataAtom == atom.Img {
+			if src := attr(c, "data-src"); src != "" {
+				setAttr(c, "src", resolve(base, src))
+			}
+		}
+		return true
+	})
+	for _, c := range remove {
+		if c.Parent != nil {
+			c.Parent.RemoveChild(c)
+		}
+	}
+}
+
+func resolve(base *url.URL, ref string) string {
+	if base == nil {
+		return ref
+	}
+	u, err := base.Parse(strings.TrimSpace(ref))
+	if err != nil {
+		return ref
+	}
+	return u.String()
+}
+
+func innerHTML(n *html.Node) string {
+	var buf bytes.Buffer
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		html.Render(&buf, c)
+	}
+	return strings.TrimSpace(buf.String())
+}
+
+// walk 深度优先遍历，fn返回false时不再进入该节点的子节点
+func walk(n *html.Node, fn func(*html.Node) bool) {
+	if !fn(n) {
+		return
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		walk(c, fn)
+	}
+}
+
+func findAll(root *html.Node, a atom.Atom) []*html.Node {
+	var nodes []*html.Node
+	walk(root, func(n *html.Node) bool {
+		if n.Type == html.ElementNode && n.DataAtom == a {
+			nodes = append(nodes, n)
+		}
+		return true
+	})
+	return nodes
+}
+
+func findFirst(root *html.Node, a atom.Atom) *html.Node {
+	if nodes := findAll(root, a); len(nodes) > 0 {
+		return nodes[0]
+	}
+	return nil
+}
+
+func findByID(root *html.Node, id string) *html.Node {
+	var found *html.Node
+	walk(root, func(n *html.Node) bool {
+		if found != nil {
+			return false
+		}
+		if n.Type == html.ElementNode && attr(n, "id") == id {
+			found = n
+			return false
+		}
+		return true
+	})
+	return found
+}
+
+func textOf(n *html.Node) string {
+	if n == nil {
+		return ""
+	}
+	var b strings.Builder
+	walk(n, func(c *html.Node) bool {
+		if c.Type == html.ElementNode && (c.DataAtom == atom.Script || c.DataAtom == atom.Style) {
+			return false
+		}
+		if c.Type == html.TextNode {
+			b.WriteString(c.Data)
+		}
+		return true
+	})
+	return strings.Join(strings.Fields(b.String()), " ")
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if strings.EqualFold(a.Key, key) {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func setAttr(n *html.Node, key, val string) {
+	for i, a := range n.Attr {
+		if strings.EqualFold(a.Key, key) {
+			n.Attr[i].Val = val
+			return
+		}
+	}
+	n.Attr = append(n.Attr, html.Attribute{Key: key, Val: val})
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
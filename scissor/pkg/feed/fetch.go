@@ -0,0 +1,78 @@
+package feed
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/safehttp"
+)
+
+// 订阅源内容的大小上限
+const maxFeedSize = 10 << 20
+
+// FetchResult 一次条件请求的结果
+type FetchResult struct {
+	// NotModified 服务端返回304，Feed为nil
+	NotModified  bool
+	Feed         *Feed
+	ETag         string
+	LastModified string
+}
+
+// Client 订阅源抓取客户端
+type Client struct {
+	httpClient *http.Client
+}
+
+// NewClient 创建订阅源抓取客户端，订阅地址由用户提交，只允许访问公网地址
+func NewClient() *Client {
+	return &Client{
+		httpClient: safehttp.NewClient(30 * time.Second),
+	}
+}
+
+// Fetch 抓取订阅源，etag和lastModified为上次响应中的值，用于条件请求
+func (c *Client) Fetch(ctx context.Context, feedURL, etag, lastModified string) (*FetchResult, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, feedURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	req.Header.Set("User-Agent", "Mozilla/5.0 (compatible; scissor feed reader)")
+	req.Header.Set("Accept", "application/rss+xml, application/atom+xml, application/xml;q=0.9, text/xml;q=0.8, */*;q=0.5")
+	if etag != "" {
+		req.Header.Set("If-None-Match", etag)
+	}
+	if lastModified != "" {
+		req.Header.Set("If-Modified-Since", lastModified)
+	}
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+
+	result := &FetchResult{
+		ETag:         resp.Header.Get("ETag"),
+		LastModified: resp.Header.Get("Last-Modified"),
+	}
+	switch resp.StatusCode {
+	case http.StatusNotModified:
+		result.NotModified = true
+		result.ETag, result.LastModified = etag, lastModified
+		return result, nil
+	case http.StatusOK:
+	default:
+		return nil, fmt.Errorf("请求订阅源失败，状态码: %d", resp.StatusCode)
+	}
+
+	feed, err := Parse(resp.Request.URL.String(), io.LimitReader(resp.Body, maxFeedSize))
+	if err != nil {
+		return nil, err
+	}
+	result.Feed = feed
+	return result, nil
+}
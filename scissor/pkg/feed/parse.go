@@ -0,0 +1,290 @@
+// Package feed 抓取并解析 RSS 2.0、RSS 1.0 和 Atom 订阅源
+package feed
+
+import (
+	"bytes"
+	"encoding/xml"
+	"errors"
+	"fmt"
+	"io"
+	"net/url"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html/charset"
+)
+
+// Feed 订阅源，解析和生成共用
+type Feed struct {
+	Title       string
+	Description string
+	SiteURL     string
+	// FeedURL 订阅源自身的地址，仅生成时使用
+	FeedURL string
+	Updated time.Time
+	Items   []*Item
+}
+
+// Item 订阅源中的一个条目
+type Item struct {
+	// GUID 条目的唯一标识，源中未提供时使用链接
+	GUID   string
+	Title  string
+	Link   string
+	Author string
+	// Summary 条目摘要，源只提供一种描述时与Content相同
+	Summary    string
+	Content    string
+	Categories []string
+	Published  time.Time
+	Updated    time.Time
+}
+
+// ErrUnknownFormat 内容不是 RSS 或 Atom
+var ErrUnknownFormat = errors.New("无法识别的订阅源格式")
+
+// Parse 解析订阅源，feedURL用于补全相对链接
+func Parse(feedURL string, r io.Reader) (*Feed, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, err
+	}
+
+	dec := xml.NewDecoder(bytes.NewReader(data))
+	dec.CharsetReader = charset.NewReaderLabel
+	dec.Strict = false
+	dec.Entity = xml.HTMLEntity
+
+	// 根据根元素判断格式
+	var root string
+	for root == "" {
+		tok, err := dec.Token()
+		if err != nil {
+			return nil, ErrUnknownFormat
+		}
+		if start, ok := tok.(xml.StartElement); ok {
+			root = strings.ToLower(start.Name.Local)
+		}
+	}
+
+	dec = xml.NewDecoder(bytes.NewReader(data))
+	dec.CharsetReader = charset.NewReaderLabel
+	dec.Strict = false
+	dec.Entity = xml.HTMLEntity
+
+	var feed *Feed
+	switch root {
+	case "rss", "rdf":
+		var doc rssDocument
+		if err := dec.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("解析RSS失败: %w", err)
+		}
+		feed = doc.toFeed()
+	case "feed":
+		var doc atomFeed
+		if err := dec.Decode(&doc); err != nil {
+			return nil, fmt.Errorf("解析Atom失败: %w", err)
+		}
+		feed = doc.toFeed()
+	default:
+		return nil, ErrUnknownFormat
+	}
+
+	base, _ := url.Parse(feedURL)
+	for _, item := range feed.Items {
+		item.Link = resolve(base, item.Link)
+		if item.GUID == "" {
+			item.GUID = item.Link
+		}
+	}
+	feed.SiteURL = resolve(base, feed.SiteURL)
+	return feed, nil
+}
+
+type rssDocument struct {
+	Channel rssChannel `xml:"channel"`
+	// RSS 1.0 的条目与 channel 同级
+	Items []rssItem `xml:"item"`
+}
+
+type rssChannel struct {
+	Title       string    `xml:"title"`
+	Links       []rssLink `xml:"link"`
+	Description string    `xml:"description"`
+	Items       []rssItem `xml:"item"`
+}
+
+// rssLink 频道中常同时出现 RSS 的 link 与 atom:link，只有前者是网站地址
+type rssLink struct {
+	XMLName xml.Name
+	Value   string `xml:",chardata"`
+}
+
+type rssItem struct {
+	GUID           string   `xml:"guid"`
+	Title          string   `xml:"title"`
+	Link           string   `xml:"link"`
+	Author         string   `xml:"author"`
+	Creator        string   `xml:"http://purl.org/dc/elements/1.1/ creator"`
+	Description    string   `xml:"description"`
+	ContentEncoded string   `xml:"http://purl.org/rss/1.0/modules/content/ encoded"`
+	Categories     []string `xml:"category"`
+	Subjects       []string `xml:"http://purl.org/dc/elements/1.1/ subject"`
+	PubDate        string   `xml:"pubDate"`
+	Date           string   `xml:"http://purl.org/dc/elements/1.1/ date"`
+}
+
+func (d *rssDocument) toFeed() *Feed {
+	feed := &Feed{
+		Title:       strings.TrimSpace(d.Channel.Title),
+		Description: strings.TrimSpace(d.Channel.Description),
+		SiteURL:     rssSiteLink(d.Channel.Links),
+	}
+	for _, it := range append(d.Channel.Items, d.Items...) {
+		feed.Items = append(feed.Items, &Item{
+			GUID:       strings.TrimSpace(it.GUID),
+			Title:      strings.TrimSpace(it.Title),
+			Link:       strings.TrimSpace(it.Link),
+			Author:     firstNonEmpty(it.Creator, it.Author),
+			Summary:    strings.TrimSpace(it.Description),
+			Content:    firstNonEmpty(it.ContentEncoded, it.Description),
+			Categories: trimAll(append(it.Categories, it.Subjects...)),
+			Published:  parseTime(firstNonEmpty(it.PubDate, it.Date)),
+		})
+	}
+	return feed
+}
+
+func rssSiteLink(links []rssLink) string {
+	for _, l := range links {
+		if l.XMLName.Space != "http://www.w3.org/2005/Atom" {
+			return strings.TrimSpace(l.Value)
+		}
+	}
+	return ""
+}
+
+type atomFeed struct {
+	Title    string      `xml:"title"`
+	Subtitle string      `xml:"subtitle"`
+	Links    []atomLink  `xml:"link"`
+	Entries  []atomEntry `xml:"entry"`
+}
+
+type atomLink struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr"`
+}
+
+type atomEntry struct {
+	ID         string         `xml:"id"`
+	Title      string         `xml:"title"`
+	Links      []atomLink     `xml:"link"`
+	Authors    []atomPerson   `xml:"author"`
+	Content    string         `xml:"content"`
+	Summary    string         `xml:"summary"`
+	Categories []atomCategory `xml:"category"`
+	Published  string         `xml:"published"`
+	Updated    string         `xml:"updated"`
+}
+
+type atomPerson struct {
+	Name string `xml:"name"`
+}
+
+type atomCategory struct {
+	Term  string `xml:"term,attr"`
+	Label string `xml:"label,attr"`
+}
+
+func (f *atomFeed) toFeed() *Feed {
+	feed := &Feed{
+		Title:       strings.TrimSpace(f.Title),
+		Description: strings.TrimSpace(f.Subtitle),
+		SiteURL:     alternateLink(f.Links),
+	}
+	for _, e := range f.Entries {
+		var categories []string
+		for _, c := range e.Categories {
+			categories = append(categories, firstNonEmpty(c.Label, c.Term))
+		}
+		var author string
+		if len(e.Authors) > 0 {
+			author = strings.TrimSpace(e.Authors[0].Name)
+		}
+		feed.Items = append(feed.Items, &Item{
+			GUID:       strings.TrimSpace(e.ID),
+			Title:      strings.TrimSpace(e.Title),
+			Link:       alternateLink(e.Links),
+			Author:     author,
+			Summary:    firstNonEmpty(e.Summary, e.Content),
+			Content:    firstNonEmpty(e.Content, e.Summary),
+			Categories: trimAll(categories),
+			Published:  parseTime(firstNonEmpty(e.Published, e.Updated)),
+			Updated:    parseTime(e.Updated),
+		})
+	}
+	return feed
+}
+
+func alternateLink(links []atomLink) string {
+	for _, l := range links {
+		if l.Rel == "" || l.Rel == "alternate" {
+			return strings.TrimSpace(l.Href)
+		}
+	}
+	return ""
+}
+
+var timeLayouts = []string{
+	time.RFC1123Z,
+	time.RFC1123,
+	time.RFC3339,
+	"Mon, 2 Jan 2006 15:04:05 -0700",
+	"Mon, 2 Jan 2006 15:04:05 MST",
+	"2 Jan 2006 15:04:05 -0700",
+	"Mon, 02 Jan 2006 15:04 -0700",
+	"2006-01-02T15:04:05",
+	"2006-01-02 15:04:05",
+	"2006-01-02",
+}
+
+func parseTime(s string) time.Time {
+	s = strings.TrimSpace(s)
+	for _, layout := range timeLayouts {
+		if t, err := time.Parse(layout, s); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+func resolve(base *url.URL, ref string) string {
+	if base == nil || ref == "" {
+		return ref
+	}
+	u, err := base.Parse(ref)
+	if err != nil {
+		return ref
+	}
+	return u.String()
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			return v
+		}
+	}
+	return ""
+}
+
+func trimAll(values []string) []string {
+	var result []string
+	for _, v := range values {
+		if v = strings.TrimSpace(v); v != "" {
+			result = append(result, v)
+		}
+	}
+	return result
+}
@@ -0,0 +1,275 @@
+package feed
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"io"
+	"time"
+)
+
+// 生成订阅源时使用的内容类型
+const (
+	MimeRSS  = "application/rss+xml"
+	MimeAtom = "application/atom+xml"
+	MimeJSON = "application/feed+json"
+)
+
+// WriteRSS 以 RSS 2.0 格式输出订阅源，Summary作为description，Content作为content:encoded
+func WriteRSS(w io.Writer, f *Feed) error {
+	doc := rssOut{
+		Version:   "2.0",
+		ContentNS: "http://purl.org/rss/1.0/modules/content/",
+		AtomNS:    "http://www.w3.org/2005/Atom",
+		DCNS:      "http://purl.org/dc/elements/1.1/",
+		Channel: rssChannelOut{
+			Title:       f.Title,
+			Link:        f.SiteURL,
+			Description: f.Description,
+			Generator:   "scissor",
+		},
+	}
+	if f.FeedURL != "" {
+		doc.Channel.AtomLink = &atomLinkOut{Href: f.FeedURL, Rel: "self", Type: MimeRSS}
+	}
+	if !f.Updated.IsZero() {
+		doc.Channel.LastBuildDate = f.Updated.Format(time.RFC1123Z)
+	}
+	for _, item := range f.Items {
+		out := rssItemOut{
+			Title:      item.Title,
+			Link:       item.Link,
+			GUID:       rssGUIDOut{Value: item.GUID, IsPermaLink: "false"},
+			Creator:    item.Author,
+			Categories: item.Categories,
+		}
+		if item.Summary != "" {
+			out.Description = &cdata{item.Summary}
+		}
+		if item.Content != "" {
+			out.Content = &cdata{item.Content}
+		}
+		if !item.Published.IsZero() {
+			out.PubDate = item.Published.Format(time.RFC1123Z)
+		}
+		doc.Channel.Items = append(doc.Channel.Items, out)
+	}
+	return writeXML(w, doc)
+}
+
+// WriteAtom 以 Atom 1.0 格式输出订阅源
+func WriteAtom(w io.Writer, f *Feed) error {
+	doc := atomFeedOut{
+		XMLNS:    "http://www.w3.org/2005/Atom",
+		ID:       firstNonEmpty(f.FeedURL, f.SiteURL),
+		Title:    f.Title,
+		Subtitle: f.Description,
+		Updated:  atomTime(f.Updated),
+	}
+	if f.SiteURL != "" {
+		doc.Links = append(doc.Links, atomLinkOut{Href: f.SiteURL, Rel: "alternate", Type: "text/html"})
+	}
+	if f.FeedURL != "" {
+		doc.Links = append(doc.Links, atomLinkOut{Href: f.FeedURL, Rel: "self", Type: MimeAtom})
+	}
+	for _, item := range f.Items {
+		out := atomEntryOut{
+			ID:        item.GUID,
+			Title:     item.Title,
+			Updated:   atomTime(firstTime(item.Updated, item.Published)),
+			Published: atomTime(item.Published),
+		}
+		if item.Link != "" {
+			out.Links = []atomLinkOut{{Href: item.Link, Rel: "alternate", Type: "text/html"}}
+		}
+		if item.Author != "" {
+			out.Author = &atomPersonOut{Name: item.Author}
+		}
+		if item.Summary != "" {
+			out.Summary = &atomTextOut{Type: "html", Value: item.Summary}
+		}
+		if item.Content != "" {
+			out.Content = &atomTextOut{Type: "html", Value: item.Content}
+		}
+		for _, c := range item.Categories {
+			out.Categories = append(out.Categories, atomCategoryOut{Term: c})
+		}
+		doc.Entries = append(doc.Entries, out)
+	}
+	return writeXML(w, doc)
+}
+
+// WriteJSON 以 JSON Feed 1.1 格式输出订阅源
+func WriteJSON(w io.Writer, f *Feed) error {
+	doc := jsonFeedOut{
+		Version:     "https://jsonfeed.org/version/1.1",
+		Title:       f.Title,
+		HomePageURL: f.SiteURL,
+		FeedURL:     f.FeedURL,
+		Description: f.Description,
+		Items:       make([]jsonItemOut, 0, len(f.Items)),
+	}
+	for _, item := range f.Items {
+		out := jsonItemOut{
+			ID:          item.GUID,
+			URL:         item.Link,
+			Title:       item.Title,
+			ContentHTML: item.Content,
+			Summary:     item.Summary,
+			Tags:        item.Categories,
+		}
+		if !item.Published.IsZero() {
+			out.DatePublished = item.Published.Format(time.RFC3339)
+		}
+		if !item.Updated.IsZero() {
+			out.DateModified = item.Updated.Format(time.RFC3339)
+		}
+		// JSON Feed 要求每个条目至少包含一种正文
+		if out.ContentHTML == "" {
+			out.ContentText = item.Summary
+		}
+		if item.Author != "" {
+			out.Authors = []jsonAuthorOut{{Name: item.Author}}
+		}
+		doc.Items = append(doc.Items, out)
+	}
+	enc := json.NewEncoder(w)
+	enc.SetEscapeHTML(false)
+	return enc.Encode(doc)
+}
+
+func writeXML(w io.Writer, v interface{}) error {
+	if _, err := io.WriteString(w, xml.Header); err != nil {
+		return err
+	}
+	enc := xml.NewEncoder(w)
+	enc.Indent("", "  ")
+	if err := enc.Encode(v); err != nil {
+		return err
+	}
+	return enc.Flush()
+}
+
+// atomTime Atom 要求必须有更新时间，缺失时使用当前时间
+func atomTime(t time.Time) string {
+	if t.IsZero() {
+		t = time.Now()
+	}
+	return t.UTC().Format(time.RFC3339)
+}
+
+func firstTime(values ...time.Time) time.Time {
+	for _, t := range values {
+		if !t.IsZero() {
+			return t
+		}
+	}
+	return time.Time{}
+}
+
+// cdata 以CDATA输出HTML，阅读器中比实体转义更易读
+type cdata struct {
+	Value string `xml:",cdata"`
+}
+
+type rssOut struct {
+	XMLName   xml.Name      `xml:"rss"`
+	Version   string        `xml:"version,attr"`
+	ContentNS string        `xml:"xmlns:content,attr"`
+	AtomNS    string        `xml:"xmlns:atom,attr"`
+	DCNS      string        `xml:"xmlns:dc,attr"`
+	Channel   rssChannelOut `xml:"channel"`
+}
+
+type rssChannelOut struct {
+	Title         string       `xml:"title"`
+	Link          string       `xml:"link"`
+	Description   string       `xml:"description"`
+	AtomLink      *atomLinkOut `xml:"atom:link,omitempty"`
+	Generator     string       `xml:"generator,omitempty"`
+	LastBuildDate string       `xml:"lastBuildDate,omitempty"`
+	Items         []rssItemOut `xml:"item"`
+}
+
+type rssItemOut struct {
+	Title       string     `xml:"title"`
+	Link        string     `xml:"link,omitempty"`
+	GUID        rssGUIDOut `xml:"guid"`
+	Creator     string     `xml:"dc:creator,omitempty"`
+	Categories  []string   `xml:"category"`
+	PubDate     string     `xml:"pubDate,omitempty"`
+	Description *cdata     `xml:"description,omitempty"`
+	Content     *cdata     `xml:"content:encoded,omitempty"`
+}
+
+type rssGUIDOut struct {
+	Value       string `xml:",chardata"`
+	IsPermaLink string `xml:"isPermaLink,attr"`
+}
+
+type atomFeedOut struct {
+	XMLName  xml.Name       `xml:"feed"`
+	XMLNS    string         `xml:"xmlns,attr"`
+	ID       string         `xml:"id"`
+	Title    string         `xml:"title"`
+	Subtitle string         `xml:"subtitle,omitempty"`
+	Updated  string         `xml:"updated"`
+	Links    []atomLinkOut  `xml:"link"`
+	Entries  []atomEntryOut `xml:"entry"`
+}
+
+type atomLinkOut struct {
+	Href string `xml:"href,attr"`
+	Rel  string `xml:"rel,attr,omitempty"`
+	Type string `xml:"type,attr,omitempty"`
+}
+
+type atomEntryOut struct {
+	ID         string            `xml:"id"`
+	Title      string            `xml:"title"`
+	Updated    string            `xml:"updated"`
+	Published  string            `xml:"published,omitempty"`
+	Links      []atomLinkOut     `xml:"link"`
+	Author     *atomPersonOut    `xml:"author,omitempty"`
+	Categories []atomCategoryOut `xml:"category"`
+	Summary    *atomTextOut      `xml:"summary,omitempty"`
+	Content    *atomTextOut      `xml:"content,omitempty"`
+}
+
+type atomPersonOut struct {
+	Name string `xml:"name"`
+}
+
+type atomCategoryOut struct {
+	Term string `xml:"term,attr"`
+}
+
+type atomTextOut struct {
+	Type  string `xml:"type,attr"`
+	Value string `xml:",chardata"`
+}
+
+type jsonFeedOut struct {
+	Version     string        `json:"version"`
+	Title       string        `json:"title"`
+	HomePageURL string        `json:"home_page_url,omitempty"`
+	FeedURL     string        `json:"feed_url,omitempty"`
+	Description string        `json:"description,omitempty"`
+	Items       []jsonItemOut `json:"items"`
+}
+
+type jsonItemOut struct {
+	ID            string          `json:"id"`
+	URL           string          `json:"url,omitempty"`
+	Title         string          `json:"title,omitempty"`
+	ContentHTML   string          `json:"content_html,omitempty"`
+	ContentText   string          `json:"content_text,omitempty"`
+	Summary       string          `json:"summary,omitempty"`
+	DatePublished string          `json:"date_published,omitempty"`
+	DateModified  string          `json:"date_modified,omitempty"`
+	Authors       []jsonAuthorOut `json:"authors,omitempty"`
+	Tags          []string        `json:"tags,omitempty"`
+}
+
+type jsonAuthorOut struct {
+	Name string `json:"name"`
+}
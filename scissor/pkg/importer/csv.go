@@ -0,0 +1,98 @@
+package importer
+
+import (
+	"bytes"
+	"encoding/csv"
+	"fmt"
+	"io"
+	"strings"
+)
+
+// csvColumns 描述CSV导出文件的列名及标签分隔方式
+type csvColumns struct {
+	url     string
+	title   string
+	savedAt string
+	tags    string
+	tagSep  func(rune) bool
+	folder  string
+}
+
+var (
+	// Pocket: title,url,time_added,cursor,tags,status，标签以 | 分隔
+	pocketColumns = csvColumns{
+		url:     "url",
+		title:   "title",
+		savedAt: "time_added",
+		tags:    "tags",
+		tagSep:  func(r rune) bool { return r == '|' },
+	}
+	// Instapaper: URL,Title,Selection,Folder,Timestamp，较新的导出还包含 Tags 列
+	instapaperColumns = csvColumns{
+		url:     "url",
+		title:   "title",
+		savedAt: "timestamp",
+		tags:    "tags",
+		tagSep:  func(r rune) bool { return r == ',' },
+		folder:  "folder",
+	}
+)
+
+// Instapaper 的内置文件夹，不作为标签
+var instapaperBuiltinFolders = map[string]bool{
+	"unread":  true,
+	"archive": true,
+	"starred": true,
+}
+
+func parseCSV(data []byte, cols csvColumns) ([]*Bookmark, error) {
+	r := csv.NewReader(bytes.NewReader(data))
+	r.FieldsPerRecord = -1
+	r.LazyQuotes = true
+
+	header, err := r.Read()
+	if err != nil {
+		return nil, fmt.Errorf("读取CSV表头失败: %w", err)
+	}
+	index := make(map[string]int, len(header))
+	for i, name := range header {
+		index[strings.ToLower(strings.TrimSpace(name))] = i
+	}
+	if _, ok := index[cols.url]; !ok {
+		return nil, fmt.Errorf("CSV缺少 %s 列", cols.url)
+	}
+
+	get := func(record []string, column string) string {
+		i, ok := index[column]
+		if !ok || column == "" || i >= len(record) {
+			return ""
+		}
+		return strings.TrimSpace(record[i])
+	}
+
+	var bookmarks []*Bookmark
+	for {
+		record, err := r.Read()
+		if err == io.EOF {
+			return bookmarks, nil
+		}
+		if err != nil {
+			return nil, fmt.Errorf("读取CSV失败: %w", err)
+		}
+
+		url := get(record, cols.url)
+		if url == "" {
+			continue
+		}
+		b := &Bookmark{
+			URL:     url,
+			Title:   get(record, cols.title),
+			Tags:    splitTags(strings.Trim(get(record, cols.tags), "[]"), cols.tagSep),
+			SavedAt: unixTime(get(record, cols.savedAt)),
+		}
+		if folder := get(record, cols.folder); folder != "" && !instapaperBuiltinFolders[strings.ToLower(folder)] {
+			b.Tags = appendTag(b.Tags, folder)
+		}
+		bookmarks = append(bookmarks, b)
+	}
+}
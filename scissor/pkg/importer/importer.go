@@ -0,0 +1,119 @@
+// Package importer 解析常见书签服务的导出文件
+package importer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strings"
+	"time"
+)
+
+// 支持的导出格式
+const (
+	FormatNetscape   = "netscape"
+	FormatPocket     = "pocket"
+	FormatInstapaper = "instapaper"
+	FormatPinboard   = "pinboard"
+)
+
+// Bookmark 导出文件中的一条书签
+type Bookmark struct {
+	URL   string
+	Title string
+	// Tags 书签标签，文件夹名也会作为标签
+	Tags []string
+	// SavedAt 原始收藏时间，文件中没有时为零值
+	SavedAt time.Time
+}
+
+// Parse 按格式解析导出文件，format为空时根据内容自动识别
+func Parse(format string, r io.Reader) ([]*Bookmark, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return nil, fmt.Errorf("读取导出文件失败: %w", err)
+	}
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+
+	if format == "" {
+		format = Detect(data)
+	}
+
+	switch format {
+	case FormatNetscape:
+		return parseNetscape(data)
+	case FormatPocket:
+		// Pocket 早期导出为HTML，现在导出为CSV
+		if isHTML(data) {
+			return parseNetscape(data)
+		}
+		return parseCSV(data, pocketColumns)
+	case FormatInstapaper:
+		return parseCSV(data, instapaperColumns)
+	case FormatPinboard:
+		return parsePinboard(data)
+	case FormatKindle:
+		return nil, fmt.Errorf("Kindle 标注请使用 ParseKindle 解析")
+	default:
+		return nil, fmt.Errorf("不支持的导入格式: %q", format)
+	}
+}
+
+// Detect 根据文件内容识别导出格式，无法识别时返回空字符串
+func Detect(data []byte) string {
+	trimmed := bytes.TrimSpace(data)
+	switch {
+	case bytes.HasPrefix(trimmed, []byte("[")):
+		return FormatPinboard
+	case isHTML(trimmed):
+		return FormatNetscape
+	}
+
+	if bytes.Contains(trimmed, []byte("\n"+kindleSeparator)) {
+		return FormatKindle
+	}
+
+	header := strings.ToLower(string(firstLine(trimmed)))
+	switch {
+	case strings.Contains(header, "time_added"):
+		return FormatPocket
+	case strings.Contains(header, "folder") && strings.Contains(header, "url"):
+		return FormatInstapaper
+	}
+	return ""
+}
+
+func isHTML(data []byte) bool {
+	return bytes.HasPrefix(bytes.TrimSpace(data), []byte("<"))
+}
+
+func firstLine(data []byte) []byte {
+	if i := bytes.IndexByte(data, '\n'); i >= 0 {
+		return data[:i]
+	}
+	return data
+}
+
+// splitTags 按分隔符拆分标签，去除空白和重复项
+func splitTags(s string, sep func(rune) bool) []string {
+	var tags []string
+	seen := make(map[string]bool)
+	for _, tag := range strings.FieldsFunc(s, sep) {
+		tag = strings.TrimSpace(tag)
+		if tag == "" || seen[tag] {
+			continue
+		}
+		seen[tag] = true
+		tags = append(tags, tag)
+	}
+	return tags
+}
+
+func appendTag(tags []string, tag string) []string {
+	for _, t := range tags {
+		if t == tag {
+			return tags
+		}
+	}
+	return append(tags, tag)
+}
@@ -0,0 +1,249 @@
+package importer
+
+import (
+	"bytes"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// FormatKindle Kindle 的 My Clippings.txt
+const FormatKindle = "kindle"
+
+// 标注类型
+const (
+	ClippingHighlight = "highlight"
+	ClippingNote      = "note"
+	ClippingBookmark  = "bookmark"
+)
+
+// kindleSeparator 条目之间的分隔行
+const kindleSeparator = "=========="
+
+// Book Kindle 中的一本书及其标注
+type Book struct {
+	Title     string
+	Author    string
+	Clippings []*Clipping
+}
+
+// Clipping 一条标注、笔记或书签
+type Clipping struct {
+	Kind string
+	Text string
+	// Note 附在标注上的笔记
+	Note string
+	// Start/End 位置范围，没有位置信息时为0
+	Start   int
+	End     int
+	Page    int
+	AddedAt time.Time
+}
+
+// Location 可读的位置描述
+func (c *Clipping) Location() string {
+	switch {
+	case c.Start > 0 && c.End > c.Start:
+		return "位置 " + strconv.Itoa(c.Start) + "-" + strconv.Itoa(c.End)
+	case c.Start > 0:
+		return "位置 " + strconv.Itoa(c.Start)
+	case c.Page > 0:
+		return "第 " + strconv.Itoa(c.Page) + " 页"
+	}
+	return ""
+}
+
+var (
+	kindleLocation = regexp.MustCompile(`(?i)(?:location|loc\.|位置)\s*#?\s*(\d+)(?:\s*-\s*(\d+))?`)
+	kindlePage     = regexp.MustCompile(`(?i)(?:page\s*(\d+)|第\s*(\d+)\s*页)`)
+	kindleZhDate   = regexp.MustCompile(`(\d{4})年(\d{1,2})月(\d{1,2})日.*?(上午|下午)?\s*(\d{1,2}):(\d{2})(?::(\d{2}))?`)
+	kindleEnDates  = []string{
+		"Monday, January 2, 2006 3:04:05 PM",
+		"Monday, 2 January 2006 15:04:05",
+		"Monday, January 2, 2006, 3:04 PM",
+	}
+)
+
+// ParseKindle 解析 Kindle 的 My Clippings.txt，支持中文和英文界面。
+// 书签被忽略；同一本书中被延长或修改的标注只保留最后一次。
+func ParseKindle(data []byte) ([]*Book, error) {
+	data = bytes.TrimPrefix(data, []byte("\xef\xbb\xbf"))
+	data = bytes.ReplaceAll(data, []byte("\r\n"), []byte("\n"))
+
+	var (
+		books  []*Book
+		byName = make(map[string]*Book)
+	)
+	for _, entry := range strings.Split(string(data), kindleSeparator) {
+		lines := strings.Split(strings.Trim(entry, "\n\ufeff "), "\n")
+		if len(lines) < 2 {
+			continue
+		}
+
+		title, author := splitTitleAuthor(strings.Trim(lines[0], "\ufeff "))
+		clipping := parseClippingMeta(lines[1])
+		if clipping == nil || clipping.Kind == ClippingBookmark {
+			continue
+		}
+		clipping.Text = strings.TrimSpace(strings.Join(lines[2:], "\n"))
+		if clipping.Text == "" {
+			continue
+		}
+
+		key := title + "\x00" + author
+		book, ok := byName[key]
+		if !ok {
+			book = &Book{Title: title, Author: author}
+			byName[key] = book
+			books = append(books, book)
+		}
+		book.add(clipping)
+	}
+
+	for _, book := range books {
+		book.attachNotes()
+	}
+	return books, nil
+}
+
+// attachNotes 将笔记附到所在位置的标注上，找不到对应标注的笔记单独保留
+func (b *Book) attachNotes() {
+	kept := b.Clippings[:0]
+	for _, c := range b.Clippings {
+		if c.Kind == ClippingNote && c.Start > 0 {
+			if h := b.highlightAt(c.Start); h != nil {
+				if h.Note != "" {
+					h.Note += "\n"
+				}
+				h.Note += c.Text
+				continue
+			}
+		}
+		kept = append(kept, c)
+	}
+	b.Clippings = kept
+}
+
+func (b *Book) highlightAt(location int) *Clipping {
+	for _, c := range b.Clippings {
+		if c.Kind == ClippingHighlight && c.Start > 0 && overlaps(c, &Clipping{Start: location}) {
+			return c
+		}
+	}
+	return nil
+}
+
+// add 添加标注，与已有标注位置重叠时视为修改后的版本并替换
+func (b *Book) add(c *Clipping) {
+	if c.Kind == ClippingHighlight && c.Start > 0 {
+		for i, existing := range b.Clippings {
+			if existing.Kind == ClippingHighlight && existing.Start > 0 && overlaps(existing, c) {
+				b.Clippings[i] = c
+				return
+			}
+		}
+	}
+	b.Clippings = append(b.Clippings, c)
+}
+
+func overlaps(a, b *Clipping) bool {
+	aEnd, bEnd := a.End, b.End
+	if aEnd == 0 {
+		aEnd = a.Start
+	}
+	if bEnd == 0 {
+		bEnd = b.Start
+	}
+	return a.Start <= bEnd && b.Start <= aEnd
+}
+
+// splitTitleAuthor 拆分 "书名 (作者)"，作者为最后一对括号中的内容
+func splitTitleAuthor(line string) (string, string) {
+	line = strings.TrimSpace(line)
+	if !strings.HasSuffix(line, ")") && !strings.HasSuffix(line, "）") {
+		return line, ""
+	}
+
+	depth := 0
+	runes := []rune(line)
+	for i := len(runes) - 1; i >= 0; i-- {
+		switch runes[i] {
+		case ')', '）':
+			depth++
+		case '(', '（':
+			depth--
+			if depth == 0 {
+				title := strings.TrimSpace(string(runes[:i]))
+				author := strings.TrimSpace(string(runes[i+1 : len(runes)-1]))
+				if title == "" {
+					return line, ""
+				}
+				return title, author
+			}
+		}
+	}
+	return line, ""
+}
+
+// parseClippingMeta 解析形如 "- Your Highlight on page 12 | Location 123-125 | Added on ..." 的信息行
+func parseClippingMeta(line string) *Clipping {
+	line = strings.TrimSpace(strings.TrimPrefix(strings.TrimSpace(line), "-"))
+	lower := strings.ToLower(line)
+
+	c := &Clipping{}
+	switch {
+	case strings.Contains(lower, "highlight") || strings.Contains(line, "标注"):
+		c.Kind = ClippingHighlight
+	case strings.Contains(lower, "note") || strings.Contains(line, "笔记"):
+		c.Kind = ClippingNote
+	case strings.Contains(lower, "bookmark") || strings.Contains(line, "书签"):
+		c.Kind = ClippingBookmark
+	default:
+		return nil
+	}
+
+	if m := kindleLocation.FindStringSubmatch(line); m != nil {
+		c.Start, _ = strconv.Atoi(m[1])
+		c.End, _ = strconv.Atoi(m[2])
+	}
+	if m := kindlePage.FindStringSubmatch(line); m != nil {
+		page := m[1]
+		if page == "" {
+			page = m[2]
+		}
+		c.Page, _ = strconv.Atoi(page)
+	}
+
+	parts := strings.Split(line, "|")
+	c.AddedAt = parseKindleDate(parts[len(parts)-1])
+	return c
+}
+
+func parseKindleDate(s string) time.Time {
+	s = strings.TrimSpace(s)
+	if m := kindleZhDate.FindStringSubmatch(s); m != nil {
+		n := make([]int, 8)
+		for _, i := range []int{1, 2, 3, 5, 6, 7} {
+			n[i], _ = strconv.Atoi(m[i])
+		}
+		hour := n[5]
+		if m[4] == "下午" && hour < 12 {
+			hour += 12
+		}
+		if m[4] == "上午" && hour == 12 {
+			hour = 0
+		}
+		return time.Date(n[1], time.Month(n[2]), n[3], hour, n[6], n[7], 0, time.Local)
+	}
+
+	for _, prefix := range []string{"Added on ", "added on "} {
+		s = strings.TrimPrefix(s, prefix)
+	}
+	for _, layout := range kindleEnDates {
+		if t, err := time.ParseInLocation(layout, s, time.Local); err == nil {
+			return t
+		}
+	}
+	return time.Time{}
+}
@@ -0,0 +1,122 @@
+package importer
+
+import (
+	"bytes"
+	"fmt"
+	"io"
+	"strconv"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// parseNetscape 解析浏览器导出的 Netscape 书签HTML，也兼容 Pocket 的HTML导出。
+// 书签所在的文件夹路径会作为标签，浏览器自带的书签栏等根文件夹除外。
+func parseNetscape(data []byte) ([]*Bookmark, error) {
+	z := html.NewTokenizer(bytes.NewReader(data))
+
+	var (
+		bookmarks []*Bookmark
+		// folders 当前所在的文件夹路径，空字符串表示不作为标签的文件夹
+		folders []string
+		// pendingFolder 最近读到的文件夹名，在其后的 <DL> 处入栈
+		pendingFolder string
+		inFolderName  bool
+		current       *Bookmark
+	)
+
+	for {
+		tt := z.Next()
+		switch tt {
+		case html.ErrorToken:
+			if z.Err() == io.EOF {
+				return bookmarks, nil
+			}
+			return nil, fmt.Errorf("解析书签HTML失败: %w", z.Err())
+
+		case html.StartTagToken:
+			name, _ := z.TagName()
+			attrs := tokenAttrs(z)
+			switch atom.Lookup(name) {
+			case atom.H3:
+				inFolderName = true
+				pendingFolder = ""
+				if attrs["personal_toolbar_folder"] == "true" || attrs["unfiled_bookmarks_folder"] == "true" {
+					inFolderName = false
+				}
+			case atom.Dl:
+				folders = append(folders, pendingFolder)
+				pendingFolder = ""
+			case atom.A:
+				href := strings.TrimSpace(attrs["href"])
+				if href == "" {
+					continue
+				}
+				current = &Bookmark{URL: href}
+				for _, folder := range folders {
+					if folder != "" {
+						current.Tags = appendTag(current.Tags, folder)
+					}
+				}
+				for _, tag := range splitTags(attrs["tags"], func(r rune) bool { return r == ',' }) {
+					current.Tags = appendTag(current.Tags, tag)
+				}
+				current.SavedAt = unixTime(attrs["add_date"])
+				if current.SavedAt.IsZero() {
+					current.SavedAt = unixTime(attrs["time_added"])
+				}
+			}
+
+		case html.TextToken:
+			text := strings.TrimSpace(string(z.Text()))
+			if inFolderName {
+				pendingFolder += text
+			} else if current != nil {
+				current.Title += text
+			}
+
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			switch atom.Lookup(name) {
+			case atom.H3:
+				inFolderName = false
+			case atom.Dl:
+				if len(folders) > 0 {
+					folders = folders[:len(folders)-1]
+				}
+			case atom.A:
+				if current != nil {
+					bookmarks = append(bookmarks, current)
+					current = nil
+				}
+			}
+		}
+	}
+}
+
+func tokenAttrs(z *html.Tokenizer) map[string]string {
+	attrs := make(map[string]string)
+	for {
+		key, val, more := z.TagAttr()
+		if len(key) > 0 {
+			attrs[strings.ToLower(string(key))] = string(val)
+		}
+		if !more {
+			return attrs
+		}
+	}
+}
+
+// unixTime 解析秒级时间戳，Firefox 等浏览器有时导出微秒级时间戳
+func unixTime(s string) time.Time {
+	sec, err := strconv.ParseInt(strings.TrimSpace(s), 10, 64)
+	if err != nil || sec <= 0 {
+		return time.Time{}
+	}
+	for sec > 1e11 {
+		sec /= 1000
+	}
+	return time.Unix(sec, 0)
+}
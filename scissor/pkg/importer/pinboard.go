@@ -0,0 +1,49 @@
+package importer
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+	"time"
+	"unicode"
+)
+
+// pinboardPost Pinboard JSON导出中的一条书签
+type pinboardPost struct {
+	Href        string `json:"href"`
+	Description string `json:"description"`
+	Time        string `json:"time"`
+	Tags        string `json:"tags"`
+}
+
+// parsePinboard 解析 Pinboard 的JSON导出，标签以空格分隔
+func parsePinboard(data []byte) ([]*Bookmark, error) {
+	var posts []pinboardPost
+	if err := json.Unmarshal(data, &posts); err != nil {
+		return nil, fmt.Errorf("解析Pinboard JSON失败: %w", err)
+	}
+
+	bookmarks := make([]*Bookmark, 0, len(posts))
+	for _, p := range posts {
+		href := strings.TrimSpace(p.Href)
+		if href == "" {
+			continue
+		}
+		bookmarks = append(bookmarks, &Bookmark{
+			URL:     href,
+			Title:   strings.TrimSpace(p.Description),
+			Tags:    splitTags(p.Tags, unicode.IsSpace),
+			SavedAt: parsePinboardTime(p.Time),
+		})
+	}
+	return bookmarks, nil
+}
+
+// parsePinboardTime 解析 Pinboard 的 ISO 8601 时间
+func parsePinboardTime(s string) time.Time {
+	t, err := time.Parse(time.RFC3339, s)
+	if err != nil {
+		return time.Time{}
+	}
+	return t
+}
@@ -2,15 +2,23 @@ package kimi
 
 import (
 	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
+	"net"
 	"net/http"
 	"os"
+	"strings"
+	"time"
 )
 
+const defaultModel = "moonshot-v1-8k"
+
 type Client struct {
 	apiKey     string
 	apiBaseURL string
+	model      string
+	httpClient *http.Client
 }
 
 type Message struct {
@@ -19,7 +27,9 @@ type Message struct {
 }
 
 type Request struct {
+	Model    string    `json:"model"`
 	Messages []Message `json:"messages"`
+	Stream   bool      `json:"stream,omitempty"`
 }
 
 type Response struct {
@@ -39,27 +49,55 @@ func NewClient() (*Client, error) {
 	return &Client{
 		apiKey:     apiKey,
 		apiBaseURL: "https://api.moonshot.cn/v1/chat/completions",
+		model:      defaultModel,
+		httpClient: newHTTPClient(),
 	}, nil
 }
 
-func (c *Client) GenerateSummary(content string) (string, error) {
-	prompt := fmt.Sprintf("请为以下文章生成一个简洁的摘要（不超过200字）：\n\n%s", content)
-	return c.callAPI(prompt)
+// newHTTPClient 创建调用接口的客户端。流式回复可能持续数分钟，不设置总超时，
+// 只限制建立连接和等待响应头的时间（非流式调用生成完才返回响应头，因此留得较宽），
+// 整体时限由调用方的ctx控制。
+func newHTTPClient() *http.Client {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+	}
+	return &http.Client{
+		Transport: &http.Transport{
+			Proxy:                 http.ProxyFromEnvironment,
+			DialContext:           dialer.DialContext,
+			ForceAttemptHTTP2:     true,
+			MaxIdleConns:          10,
+			IdleConnTimeout:       90 * time.Second,
+			TLSHandshakeTimeout:   10 * time.Second,
+			ResponseHeaderTimeout: 5 * time.Minute,
+		},
+	}
 }
 
-func (c *Client) GenerateTags(content string) (string, error) {
-	prompt := fmt.Sprintf("请为以下文章提取5-8个关键词标签，用逗号分隔：\n\n%s", content)
-	return c.callAPI(prompt)
+func (c *Client) GenerateTopicName(titles []string) (string, error) {
+	prompt := fmt.Sprintf("以下文章属于同一主题，请用不超过10个字概括这个主题，只输出主题名称：\n\n%s", strings.Join(titles, "\n"))
+	name, err := c.callAPI(prompt)
+	if err != nil {
+		return "", err
+	}
+	return strings.TrimSpace(name), nil
 }
 
 func (c *Client) callAPI(prompt string) (string, error) {
-	reqBody := Request{
-		Messages: []Message{
-			{
-				Role:    "user",
-				Content: prompt,
-			},
+	return c.Chat(context.Background(), []Message{
+		{
+			Role:    "user",
+			Content: prompt,
 		},
+	})
+}
+
+// Chat 调用对话接口并返回完整回复，ctx取消时中断请求
+func (c *Client) Chat(ctx context.Context, messages []Message) (string, error) {
+	reqBody := Request{
+		Model:    c.model,
+		Messages: messages,
 	}
 
 	jsonData, err := json.Marshal(reqBody)
@@ -67,7 +105,7 @@ func (c *Client) callAPI(prompt string) (string, error) {
 		return "", fmt.Errorf("failed to marshal request: %v", err)
 	}
 
-	req, err := http.NewRequest("POST", c.apiBaseURL, bytes.NewBuffer(jsonData))
+	req, err := http.NewRequestWithContext(ctx, "POST", c.apiBaseURL, bytes.NewBuffer(jsonData))
 	if err != nil {
 		return "", fmt.Errorf("failed to create request: %v", err)
 	}
@@ -75,8 +113,7 @@ func (c *Client) callAPI(prompt string) (string, error) {
 	req.Header.Set("Content-Type", "application/json")
 	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
 
-	client := &http.Client{}
-	resp, err := client.Do(req)
+	resp, err := c.httpClient.Do(req)
 	if err != nil {
 		return "", fmt.Errorf("failed to send request: %v", err)
 	}
@@ -0,0 +1,96 @@
+package kimi
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+)
+
+// ErrStreamIncomplete 流式回复在结束标记之前中断，已收到的内容不完整
+var ErrStreamIncomplete = errors.New("stream ended before completion")
+
+type streamChunk struct {
+	Choices []struct {
+		Delta struct {
+			Content string `json:"content"`
+		} `json:"delta"`
+		FinishReason string `json:"finish_reason"`
+	} `json:"choices"`
+}
+
+// ChatStream 以流式方式调用对话接口，每收到一段增量文本就回调onDelta，返回完整回复。
+// ctx取消时会中断上游请求；onDelta返回错误时停止读取并返回该错误。
+// 只有收到 [DONE] 或带有 finish_reason 的数据块才算完成，否则返回 ErrStreamIncomplete。
+func (c *Client) ChatStream(ctx context.Context, messages []Message, onDelta func(string) error) (string, error) {
+	jsonData, err := json.Marshal(Request{
+		Model:    c.model,
+		Messages: messages,
+		Stream:   true,
+	})
+	if err != nil {
+		return "", fmt.Errorf("failed to marshal request: %v", err)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, c.apiBaseURL, bytes.NewBuffer(jsonData))
+	if err != nil {
+		return "", fmt.Errorf("failed to create request: %v", err)
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "text/event-stream")
+	req.Header.Set("Authorization", fmt.Sprintf("Bearer %s", c.apiKey))
+
+	resp, err := c.httpClient.Do(req)
+	if err != nil {
+		return "", fmt.Errorf("failed to send request: %v", err)
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		return "", fmt.Errorf("API request failed with status: %d", resp.StatusCode)
+	}
+
+	var full strings.Builder
+	finished := false
+	scanner := bufio.NewScanner(resp.Body)
+	scanner.Buffer(make([]byte, 64*1024), 1024*1024)
+	for scanner.Scan() {
+		line := scanner.Text()
+		if !strings.HasPrefix(line, "data:") {
+			continue
+		}
+		data := strings.TrimSpace(strings.TrimPrefix(line, "data:"))
+		if data == "[DONE]" {
+			return full.String(), nil
+		}
+
+		var chunk streamChunk
+		if err := json.Unmarshal([]byte(data), &chunk); err != nil {
+			return "", fmt.Errorf("failed to decode stream chunk: %v", err)
+		}
+		for _, choice := range chunk.Choices {
+			if choice.FinishReason != "" {
+				finished = true
+			}
+			if choice.Delta.Content == "" {
+				continue
+			}
+			full.WriteString(choice.Delta.Content)
+			if err := onDelta(choice.Delta.Content); err != nil {
+				return full.String(), err
+			}
+		}
+	}
+	if err := scanner.Err(); err != nil {
+		return "", fmt.Errorf("failed to read stream: %v", err)
+	}
+	if !finished {
+		return "", ErrStreamIncomplete
+	}
+
+	return full.String(), nil
+}
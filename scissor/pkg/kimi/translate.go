@@ -0,0 +1,43 @@
+package kimi
+
+import (
+	"context"
+	"fmt"
+	"strings"
+)
+
+// TranslateHTML 将HTML片段翻译为目标语言，保留标签和属性，只翻译文字。
+// language 为目标语言的名称，如"简体中文"。
+func (c *Client) TranslateHTML(ctx context.Context, fragment, language string) (string, error) {
+	out, err := c.Chat(ctx, []Message{
+		{
+			Role: "system",
+			Content: fmt.Sprintf("你是专业的翻译。请将用户发送的HTML片段翻译为%s，"+
+				"保留所有HTML标签及其属性不变，只翻译其中的文字；代码、网址和没有通用译名的专有名词保留原文。"+
+				"只输出翻译后的HTML片段，不要添加任何解释。", language),
+		},
+		{
+			Role:    "user",
+			Content: fragment,
+		},
+	})
+	if err != nil {
+		return "", err
+	}
+	return trimCodeFence(out), nil
+}
+
+// trimCodeFence 去掉模型有时给回复加上的Markdown代码块标记
+func trimCodeFence(s string) string {
+	s = strings.TrimSpace(s)
+	if !strings.HasPrefix(s, "```") || !strings.HasSuffix(s, "```") || len(s) < 6 {
+		return s
+	}
+	s = strings.TrimSuffix(s, "```")
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		s = s[i+1:]
+	} else {
+		s = strings.TrimPrefix(s, "```")
+	}
+	return strings.TrimSpace(s)
+}
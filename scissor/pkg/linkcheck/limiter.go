@@ -0,0 +1,56 @@
+package linkcheck
+
+import (
+	"context"
+	"sync"
+	"time"
+)
+
+// HostLimiter 限制对同一主机的请求间隔，可被多个goroutine共用
+type HostLimiter struct {
+	interval time.Duration
+
+	mu   sync.Mutex
+	next map[string]time.Time
+}
+
+// NewHostLimiter 创建主机限速器，interval为同一主机两次请求之间的最小间隔
+func NewHostLimiter(interval time.Duration) *HostLimiter {
+	return &HostLimiter{
+		interval: interval,
+		next:     make(map[string]time.Time),
+	}
+}
+
+// Wait 预约对host的下一次请求时间并等待到该时间，ctx取消时返回错误
+func (l *HostLimiter) Wait(ctx context.Context, host string) error {
+	l.mu.Lock()
+	now := time.Now()
+	at := l.next[host]
+	if at.Before(now) {
+		at = now
+	}
+	l.next[host] = at.Add(l.interval)
+	// 清理已过期的主机，避免长期运行时无限增长
+	if len(l.next) > 1000 {
+		for h, t := range l.next {
+			if t.Before(now) {
+				delete(l.next, h)
+			}
+		}
+	}
+	l.mu.Unlock()
+
+	wait := time.Until(at)
+	if wait <= 0 {
+		return nil
+	}
+	timer := time.NewTimer(wait)
+	defer timer.Stop()
+	select {
+	case <-ctx.Done():
+		return ctx.Err()
+	case <-timer.C:
+		return nil
+	}
+}
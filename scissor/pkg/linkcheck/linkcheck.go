@@ -0,0 +1,182 @@
+// Package linkcheck 检查已保存文章的原文链接是否仍然有效，识别删除、迁移和公众号的违规下架页面
+package linkcheck
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strings"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/safehttp"
+	"github.com/gorexlv/cabinet/scissor/pkg/urlcanon"
+)
+
+// Status 原文链接的状态
+type Status string
+
+const (
+	// StatusAlive 原文仍可访问
+	StatusAlive Status = "alive"
+	// StatusDeleted 原文已删除或被下架
+	StatusDeleted Status = "deleted"
+	// StatusMoved 原文重定向到了其他地址
+	StatusMoved Status = "moved"
+	// StatusChanged 原文仍可访问但内容已变化，由调用方比较正文后判断
+	StatusChanged Status = "changed"
+)
+
+const (
+	userAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0 Safari/537.36"
+	// 最多跟随的重定向次数
+	maxRedirects = 5
+	// 读取网页的大小上限
+	maxBodySize = 2 << 20
+)
+
+// ErrInconclusive 无法判断原文状态，如网络错误、服务器错误或被反爬拦截，应稍后重试
+var ErrInconclusive = errors.New("无法判断原文状态")
+
+// 公众号文章被删除或下架后仍返回200，页面中显示以下提示
+var wechatRemovedMarkers = []string{
+	"该内容已被发布者删除",
+	"此内容因违规无法查看",
+	"此内容被多人投诉，相关的内容无法进行查看",
+	"此内容发送失败无法查看",
+	"该内容暂时无法查看",
+	"接相关投诉，此内容违反",
+	"涉嫌违反相关法律法规和政策",
+	"此帐号已被屏蔽",
+	"此帐号已自主注销",
+	"该公众号已迁移",
+}
+
+// Result 检查结果
+type Result struct {
+	Status     Status
+	StatusCode int
+	// URL 跟随重定向后的最终地址
+	URL string
+	// Reason 判断为删除或迁移的原因
+	Reason string
+	// Body 原文仍可访问时的网页内容，供调用方判断内容是否变化
+	Body []byte
+}
+
+// Checker 检查原文链接，对同一主机的请求按间隔限速
+type Checker struct {
+	client  *http.Client
+	limiter *HostLimiter
+}
+
+// NewChecker 创建链接检查器，hostInterval为同一主机两次请求之间的最小间隔
+func NewChecker(timeout, hostInterval time.Duration) *Checker {
+	return &Checker{
+		client: &http.Client{
+			// 文章链接由用户提交，只允许访问公网地址，手动跟随的每一跳都会检查
+			Transport: safehttp.NewTransport(),
+			Timeout:   timeout,
+			// 手动跟随重定向，以便判断迁移和对每一跳限速
+			CheckRedirect: func(*http.Request, []*http.Request) error {
+				return http.ErrUseLastResponse
+			},
+		},
+		limiter: NewHostLimiter(hostInterval),
+	}
+}
+
+// Check 请求原文并判断其状态，无法判断时返回 ErrInconclusive
+func (c *Checker) Check(ctx context.Context, rawURL string) (*Result, error) {
+	origin, err := url.Parse(rawURL)
+	if err != nil || (origin.Scheme != "http" && origin.Scheme != "https") {
+		return nil, fmt.Errorf("%w: 无效的链接 %q", ErrInconclusive, rawURL)
+	}
+
+	current := origin
+	for hop := 0; ; hop++ {
+		if err := c.limiter.Wait(ctx, current.Host); err != nil {
+			return nil, err
+		}
+		code, location, body, err := c.get(ctx, current.String())
+		if err != nil {
+			if ctx.Err() != nil {
+				return nil, ctx.Err()
+			}
+			return nil, fmt.Errorf("%w: %v", ErrInconclusive, err)
+		}
+
+		switch {
+		case code >= 300 && code < 400 && location != "":
+			if hop >= maxRedirects {
+				return nil, fmt.Errorf("%w: 重定向次数过多", ErrInconclusive)
+			}
+			next, err := current.Parse(location)
+			if err != nil {
+				return nil, fmt.Errorf("%w: 无效的重定向地址 %q", ErrInconclusive, location)
+			}
+			current = next
+			continue
+		case code == http.StatusNotFound || code == http.StatusGone:
+			return &Result{Status: StatusDeleted, StatusCode: code, URL: current.String(), Reason: http.StatusText(code)}, nil
+		case code != http.StatusOK:
+			return nil, fmt.Errorf("%w: 状态码 %d", ErrInconclusive, code)
+		}
+
+		result := &Result{Status: StatusAlive, StatusCode: code, URL: current.String(), Body: body}
+		if marker := removedMarker(current, body); marker != "" {
+			result.Status, result.Reason, result.Body = StatusDeleted, marker, nil
+		} else if current != origin && urlcanon.Canonicalize(current.String()) != urlcanon.Canonicalize(origin.String()) {
+			if isHomepage(current) && !isHomepage(origin) {
+				// 删除的文章常被重定向到网站首页
+				result.Status, result.Reason, result.Body = StatusDeleted, "重定向到首页", nil
+			} else {
+				result.Status, result.Reason = StatusMoved, "重定向到 "+current.String()
+			}
+		}
+		return result, nil
+	}
+}
+
+func (c *Checker) get(ctx context.Context, u string) (int, string, []byte, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, u, nil)
+	if err != nil {
+		return 0, "", nil, err
+	}
+	req.Header.Set("User-Agent", userAgent)
+	req.Header.Set("Accept", "text/html,application/xhtml+xml;q=0.9,*/*;q=0.8")
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return 0, "", nil, err
+	}
+	defer resp.Body.Close()
+
+	var body []byte
+	if resp.StatusCode == http.StatusOK {
+		if body, err = io.ReadAll(io.LimitReader(resp.Body, maxBodySize)); err != nil {
+			return 0, "", nil, err
+		}
+	}
+	return resp.StatusCode, resp.Header.Get("Location"), body, nil
+}
+
+// removedMarker 返回公众号删除或违规页面中的提示，其他站点返回空
+func removedMarker(u *url.URL, body []byte) string {
+	if !strings.HasSuffix(u.Hostname(), "weixin.qq.com") {
+		return ""
+	}
+	for _, marker := range wechatRemovedMarkers {
+		if bytes.Contains(body, []byte(marker)) {
+			return marker
+		}
+	}
+	return ""
+}
+
+func isHomepage(u *url.URL) bool {
+	return strings.Trim(u.Path, "/") == "" && u.RawQuery == ""
+}
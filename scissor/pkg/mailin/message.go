@@ -0,0 +1,240 @@
+// Package mailin 解析投递到收件地址的邮件，并提供只用于接收邮件的最小SMTP服务器
+package mailin
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"mime/quotedprintable"
+	"net/mail"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html/charset"
+)
+
+// 单个附件或内嵌图片的大小上限，超出的部分被丢弃
+const maxPartSize = 10 << 20
+
+// 嵌套multipart和转发邮件的最大深度
+const maxDepth = 10
+
+// Message 解析后的邮件
+type Message struct {
+	From      *mail.Address
+	Subject   string
+	Date      time.Time
+	MessageID string
+	// HTML 和 Text 为第一个非附件的HTML和纯文本正文
+	HTML string
+	Text string
+	// Parts 内嵌图片和附件
+	Parts []*Part
+}
+
+// Part 邮件中的内嵌资源或附件
+type Part struct {
+	ContentType string
+	// ContentID 内嵌资源的标识，正文中以 cid:<ContentID> 引用
+	ContentID string
+	Filename  string
+	// Inline 为true时是正文内嵌的资源，否则是附件
+	Inline bool
+	Data   []byte
+	// Truncated 数据超出大小上限被截断
+	Truncated bool
+}
+
+var wordDecoder = &mime.WordDecoder{CharsetReader: charset.NewReaderLabel}
+
+// Parse 解析RFC 5322邮件，支持multipart、quoted-printable/base64编码和非UTF-8字符集
+func Parse(r io.Reader) (*Message, error) {
+	m, err := mail.ReadMessage(r)
+	if err != nil {
+		return nil, fmt.Errorf("解析邮件失败: %w", err)
+	}
+
+	msg := &Message{
+		Subject:   decodeHeader(m.Header.Get("Subject")),
+		MessageID: strings.Trim(strings.TrimSpace(m.Header.Get("Message-Id")), "<>"),
+	}
+	if date, err := m.Header.Date(); err == nil {
+		msg.Date = date
+	}
+	parser := &mail.AddressParser{WordDecoder: wordDecoder}
+	if from, err := parser.Parse(m.Header.Get("From")); err == nil {
+		msg.From = from
+	}
+
+	if err := msg.walk(textproto.MIMEHeader(m.Header), m.Body, 0); err != nil {
+		return nil, err
+	}
+	return msg, nil
+}
+
+// walk 递归处理邮件的各个部分
+func (msg *Message) walk(header textproto.MIMEHeader, body io.Reader, depth int) error {
+	if depth > maxDepth {
+		return errors.New("邮件嵌套层数过多")
+	}
+
+	mediaType, params, err := mime.ParseMediaType(header.Get("Content-Type"))
+	if err != nil {
+		mediaType, params = "text/plain", map[string]string{}
+	}
+	body = decodeTransfer(header.Get("Content-Transfer-Encoding"), body)
+
+	switch {
+	case strings.HasPrefix(mediaType, "multipart/"):
+		mr := multipart.NewReader(body, params["boundary"])
+		for {
+			p, err := mr.NextPart()
+			if err == io.EOF {
+				return nil
+			}
+			if err != nil {
+				return fmt.Errorf("解析邮件正文失败: %w", err)
+			}
+			if err := msg.walk(p.Header, p, depth+1); err != nil {
+				return err
+			}
+		}
+	case mediaType == "message/rfc822" && msg.HTML == "" && msg.Text == "":
+		// 以附件形式转发的邮件，正文取自被转发的邮件
+		inner, err := mail.ReadMessage(body)
+		if err != nil {
+			return nil
+		}
+		if msg.Subject == "" {
+			msg.Subject = decodeHeader(inner.Header.Get("Subject"))
+		}
+		return msg.walk(textproto.MIMEHeader(inner.Header), inner.Body, depth+1)
+	}
+
+	disposition, dparams, _ := mime.ParseMediaType(header.Get("Content-Disposition"))
+	filename := decodeHeader(firstNonEmpty(dparams["filename"], params["name"]))
+	attachment := disposition == "attachment" || (filename != "" && !strings.HasPrefix(mediaType, "image/"))
+
+	if !attachment && (mediaType == "text/html" || mediaType == "text/plain") {
+		text, err := readText(body, params["charset"])
+		if err != nil {
+			return fmt.Errorf("读取邮件正文失败: %w", err)
+		}
+		if mediaType == "text/html" && msg.HTML == "" {
+			msg.HTML = text
+		} else if mediaType == "text/plain" && msg.Text == "" {
+			msg.Text = text
+		}
+		return nil
+	}
+
+	data, err := io.ReadAll(io.LimitReader(body, maxPartSize+1))
+	if err != nil {
+		return fmt.Errorf("读取邮件附件失败: %w", err)
+	}
+	part := &Part{
+		ContentType: mediaType,
+		ContentID:   strings.Trim(strings.TrimSpace(header.Get("Content-Id")), "<>"),
+		Filename:    filename,
+		Inline:      !attachment,
+		Data:        data,
+	}
+	if len(data) > maxPartSize {
+		part.Data, part.Truncated = data[:maxPartSize], true
+	}
+	msg.Parts = append(msg.Parts, part)
+	return nil
+}
+
+// Inline 返回以Content-ID引用的内嵌资源
+func (msg *Message) Inline(contentID string) *Part {
+	for _, p := range msg.Parts {
+		if p.ContentID != "" && p.ContentID == contentID {
+			return p
+		}
+	}
+	return nil
+}
+
+// Attachments 返回附件
+func (msg *Message) Attachments() []*Part {
+	var result []*Part
+	for _, p := range msg.Parts {
+		if !p.Inline {
+			result = append(result, p)
+		}
+	}
+	return result
+}
+
+func decodeTransfer(encoding string, r io.Reader) io.Reader {
+	switch strings.ToLower(strings.TrimSpace(encoding)) {
+	case "base64":
+		return base64.NewDecoder(base64.StdEncoding, &base64Cleaner{r: r})
+	case "quoted-printable":
+		return quotedprintable.NewReader(r)
+	}
+	return r
+}
+
+// base64Cleaner 去掉base64正文中的换行和空白，部分邮件客户端会在行尾留下空格
+type base64Cleaner struct {
+	r io.Reader
+}
+
+func (c *base64Cleaner) Read(p []byte) (int, error) {
+	for {
+		n, err := c.r.Read(p)
+		out := p[:0]
+		for _, b := range p[:n] {
+			if b != ' ' && b != '\t' && b != '\r' && b != '\n' {
+				out = append(out, b)
+			}
+		}
+		if len(out) > 0 || err != nil {
+			return len(out), err
+		}
+	}
+}
+
+func readText(r io.Reader, label string) (string, error) {
+	data, err := io.ReadAll(r)
+	if err != nil {
+		return "", err
+	}
+	if label == "" || strings.EqualFold(label, "utf-8") || strings.EqualFold(label, "us-ascii") {
+		return string(data), nil
+	}
+	cr, err := charset.NewReaderLabel(label, bytes.NewReader(data))
+	if err != nil {
+		// 未知字符集按原样返回
+		return string(data), nil
+	}
+	decoded, err := io.ReadAll(cr)
+	if err != nil {
+		return string(data), nil
+	}
+	return string(decoded), nil
+}
+
+func decodeHeader(s string) string {
+	decoded, err := wordDecoder.DecodeHeader(s)
+	if err != nil {
+		return strings.TrimSpace(s)
+	}
+	return strings.TrimSpace(decoded)
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
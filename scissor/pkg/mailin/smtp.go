@@ -0,0 +1,325 @@
+package mailin
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"log"
+	"net"
+	"net/textproto"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+const (
+	// 默认的邮件大小上限
+	defaultMaxSize = 25 << 20
+	// 默认的并发会话上限
+	defaultMaxConns = 100
+	// 命令行的长度上限，包括结尾的CRLF（RFC 5321 4.5.3.1.4）
+	maxLineLength = 1000
+	// 单封邮件的收件人上限
+	maxRecipients = 100
+	// 等待客户端命令的超时时间
+	commandTimeout = 5 * time.Minute
+)
+
+// Server 只接收投递的最小SMTP服务器，不支持认证、STARTTLS和中继。
+// 通常部署在MX之后，或直接作为收件域名的MX。
+type Server struct {
+	Addr string
+	// Domain 问候语中的主机名
+	Domain string
+	// MaxSize 邮件大小上限，为0时使用25MB
+	MaxSize int64
+	// MaxConns 并发会话上限，超出时以421拒绝新连接，为0时使用100
+	MaxConns int
+	// Accept 校验收件人地址，返回false时以550拒绝该收件人
+	Accept func(ctx context.Context, rcpt string) bool
+	// Deliver 处理一封邮件，rcpts只包含已通过校验的收件人；返回错误时以451告知发件方稍后重试
+	Deliver func(ctx context.Context, from string, rcpts []string, data []byte) error
+
+	mu       sync.Mutex
+	listener net.Listener
+	wg       sync.WaitGroup
+}
+
+// ListenAndServe 监听Addr并处理连接，直到ctx取消或调用Close
+func (s *Server) ListenAndServe(ctx context.Context) error {
+	l, err := net.Listen("tcp", s.Addr)
+	if err != nil {
+		return err
+	}
+	return s.Serve(ctx, l)
+}
+
+// Serve 在已有的监听上处理连接
+func (s *Server) Serve(ctx context.Context, l net.Listener) error {
+	s.mu.Lock()
+	s.listener = l
+	s.mu.Unlock()
+
+	go func() {
+		<-ctx.Done()
+		l.Close()
+	}()
+
+	sem := make(chan struct{}, s.maxConns())
+	for {
+		conn, err := l.Accept()
+		if err != nil {
+			if ctx.Err() != nil || errors.Is(err, net.ErrClosed) {
+				s.wg.Wait()
+				return nil
+			}
+			return err
+		}
+		select {
+		case sem <- struct{}{}:
+		default:
+			conn.SetWriteDeadline(time.Now().Add(time.Second))
+			fmt.Fprintf(conn, "421 4.3.2 Too many connections, try again later\r\n")
+			conn.Close()
+			continue
+		}
+		s.wg.Add(1)
+		go func() {
+			defer func() {
+				<-sem
+				s.wg.Done()
+			}()
+			s.handle(ctx, conn)
+		}()
+	}
+}
+
+// Close 停止接受新连接并等待进行中的会话结束
+func (s *Server) Close() error {
+	s.mu.Lock()
+	l := s.listener
+	s.mu.Unlock()
+	if l == nil {
+		return nil
+	}
+	err := l.Close()
+	s.wg.Wait()
+	return err
+}
+
+func (s *Server) maxConns() int {
+	if s.MaxConns > 0 {
+		return s.MaxConns
+	}
+	return defaultMaxConns
+}
+
+func (s *Server) maxSize() int64 {
+	if s.MaxSize > 0 {
+		return s.MaxSize
+	}
+	return defaultMaxSize
+}
+
+// session 一次SMTP会话中的信封状态
+type session struct {
+	helo  bool
+	from  string
+	mail  bool
+	rcpts []string
+}
+
+func (s *Server) handle(ctx context.Context, conn net.Conn) {
+	defer conn.Close()
+	tp := textproto.NewConn(conn)
+
+	reply := func(code int, msg string) bool {
+		conn.SetWriteDeadline(time.Now().Add(commandTimeout))
+		return tp.PrintfLine("%d %s", code, msg) == nil
+	}
+
+	domain := s.Domain
+	if domain == "" {
+		domain = "localhost"
+	}
+	if !reply(220, domain+" ESMTP scissor") {
+		return
+	}
+
+	var sess session
+	for {
+		conn.SetReadDeadline(time.Now().Add(commandTimeout))
+		line, err := readLine(tp.R)
+		if errors.Is(err, errLineTooLong) {
+			reply(500, "5.5.2 Line too long")
+			continue
+		}
+		if err != nil {
+			return
+		}
+		verb, arg, _ := strings.Cut(line, " ")
+		arg = strings.TrimSpace(arg)
+
+		switch strings.ToUpper(verb) {
+		case "HELO":
+			sess = session{helo: true}
+			reply(250, domain)
+		case "EHLO":
+			sess = session{helo: true}
+			conn.SetWriteDeadline(time.Now().Add(commandTimeout))
+			tp.PrintfLine("250-%s", domain)
+			tp.PrintfLine("250-SIZE %d", s.maxSize())
+			tp.PrintfLine("250-8BITMIME")
+			tp.PrintfLine("250 SMTPUTF8")
+		case "MAIL":
+			if !sess.helo {
+				reply(503, "5.5.1 Send HELO/EHLO first")
+				continue
+			}
+			from, params, ok := parsePath(arg, "FROM:")
+			if !ok {
+				reply(501, "5.5.4 Syntax: MAIL FROM:<address>")
+				continue
+			}
+			if size, err := strconv.ParseInt(params["SIZE"], 10, 64); err == nil && size > s.maxSize() {
+				reply(552, "5.3.4 Message size exceeds fixed limit")
+				continue
+			}
+			sess.from, sess.mail, sess.rcpts = from, true, nil
+			reply(250, "2.1.0 OK")
+		case "RCPT":
+			if !sess.mail {
+				reply(503, "5.5.1 Need MAIL command")
+				continue
+			}
+			rcpt, _, ok := parsePath(arg, "TO:")
+			if !ok || rcpt == "" {
+				reply(501, "5.5.4 Syntax: RCPT TO:<address>")
+				continue
+			}
+			if len(sess.rcpts) >= maxRecipients {
+				reply(452, "4.5.3 Too many recipients")
+				continue
+			}
+			if s.Accept != nil && !s.Accept(ctx, rcpt) {
+				reply(550, "5.1.1 No such user")
+				continue
+			}
+			sess.rcpts = append(sess.rcpts, rcpt)
+			reply(250, "2.1.5 OK")
+		case "DATA":
+			if len(sess.rcpts) == 0 {
+				reply(503, "5.5.1 Need RCPT command")
+				continue
+			}
+			if !reply(354, "End data with <CR><LF>.<CR><LF>") {
+				return
+			}
+			data, err := s.readData(conn, tp)
+			if err != nil {
+				if errors.Is(err, errTooLarge) {
+					reply(552, "5.3.4 Message size exceeds fixed limit")
+					sess = session{helo: true}
+					continue
+				}
+				return
+			}
+			if err := s.deliver(ctx, sess.from, sess.rcpts, data); err != nil {
+				log.Printf("Failed to deliver mail from %s: %v", sess.from, err)
+				reply(451, "4.3.0 Temporary failure, try again later")
+			} else {
+				reply(250, "2.0.0 OK: queued")
+			}
+			sess = session{helo: true}
+		case "RSET":
+			sess = session{helo: sess.helo}
+			reply(250, "2.0.0 OK")
+		case "NOOP":
+			reply(250, "2.0.0 OK")
+		case "VRFY":
+			reply(252, "2.5.0 Cannot VRFY user")
+		case "QUIT":
+			reply(221, "2.0.0 Bye")
+			return
+		default:
+			reply(502, "5.5.2 Command not recognized")
+		}
+	}
+}
+
+var (
+	errTooLarge    = errors.New("邮件超出大小上限")
+	errLineTooLong = errors.New("命令行超出长度上限")
+)
+
+// readLine 读取一行命令并去掉结尾的换行，只占用bufio.Reader自身的缓冲。
+// 超出长度上限时丢弃该行剩余内容并返回errLineTooLong。
+func readLine(r *bufio.Reader) (string, error) {
+	line, err := r.ReadSlice('\n')
+	if err == nil && len(line) <= maxLineLength {
+		return strings.TrimRight(string(line), "\r\n"), nil
+	}
+	for errors.Is(err, bufio.ErrBufferFull) {
+		_, err = r.ReadSlice('\n')
+	}
+	if err != nil {
+		return "", err
+	}
+	return "", errLineTooLong
+}
+
+// readData 读取DATA内容并还原点号转义，超出大小上限时读完剩余内容后返回errTooLarge
+func (s *Server) readData(conn net.Conn, tp *textproto.Conn) ([]byte, error) {
+	conn.SetReadDeadline(time.Now().Add(commandTimeout))
+	r := tp.DotReader()
+	var buf bytes.Buffer
+	n, err := io.Copy(&buf, io.LimitReader(r, s.maxSize()+1))
+	if err != nil {
+		return nil, err
+	}
+	if n > s.maxSize() {
+		if _, err := io.Copy(io.Discard, r); err != nil {
+			return nil, err
+		}
+		return nil, errTooLarge
+	}
+	return buf.Bytes(), nil
+}
+
+func (s *Server) deliver(ctx context.Context, from string, rcpts []string, data []byte) (err error) {
+	if s.Deliver == nil {
+		return nil
+	}
+	defer func() {
+		if r := recover(); r != nil {
+			err = fmt.Errorf("panic: %v", r)
+		}
+	}()
+	return s.Deliver(ctx, from, rcpts, data)
+}
+
+// parsePath 解析 "FROM:<addr> SIZE=123" 形式的参数，空地址 <> 是合法的退信发件人
+func parsePath(arg, prefix string) (string, map[string]string, bool) {
+	if len(arg) < len(prefix) || !strings.EqualFold(arg[:len(prefix)], prefix) {
+		return "", nil, false
+	}
+	rest := strings.TrimSpace(arg[len(prefix):])
+	if !strings.HasPrefix(rest, "<") {
+		return "", nil, false
+	}
+	end := strings.Index(rest, ">")
+	if end < 0 {
+		return "", nil, false
+	}
+	addr := rest[1:end]
+	params := make(map[string]string)
+	for _, p := range strings.Fields(rest[end+1:]) {
+		k, v, _ := strings.Cut(p, "=")
+		params[strings.ToUpper(k)] = v
+	}
+	return addr, params, true
+}
@@ -0,0 +1,290 @@
+// Package markdown 将文章HTML转换为Markdown
+package markdown
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// FromHTML 将HTML片段转换为Markdown，无法表示的元素只保留文本
+func FromHTML(s string) string {
+	nodes, err := html.ParseFragment(strings.NewReader(s), &html.Node{
+		Type:     html.ElementNode,
+		Data:     "body",
+		DataAtom: atom.Body,
+	})
+	if err != nil {
+		return s
+	}
+
+	root := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	for _, n := range nodes {
+		root.AppendChild(n)
+	}
+	return strings.Join(blocks(root), "\n\n") + "\n"
+}
+
+var (
+	spaces = regexp.MustCompile(`[ \t\r\n]+`)
+	// 会被解析为Markdown语法的字符
+	escaper = strings.NewReplacer(`\`, `\\`, "`", "\\`", "*", `\*`, "_", `\_`, "[", `\[`, "]", `\]`)
+)
+
+// 作为容器展开子节点的块级元素
+var containers = map[atom.Atom]bool{
+	atom.P: true, atom.Div: true, atom.Section: true, atom.Article: true, atom.Main: true,
+	atom.Header: true, atom.Footer: true, atom.Figure: true, atom.Figcaption: true,
+	atom.Body: true, atom.Center: true, atom.Dl: true, atom.Dd: true, atom.Dt: true,
+}
+
+func isBlock(n *html.Node) bool {
+	if n.Type != html.ElementNode {
+		return false
+	}
+	if containers[n.DataAtom] {
+		return true
+	}
+	switch n.DataAtom {
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6, atom.Hr, atom.Blockquote,
+		atom.Ul, atom.Ol, atom.Pre, atom.Table:
+		return true
+	}
+	return false
+}
+
+// blocks 将元素的子节点转换为Markdown块，连续的行内内容合并为一个段落
+func blocks(n *html.Node) []string {
+	var (
+		result []string
+		inline strings.Builder
+	)
+	flush := func() {
+		if text := strings.TrimSpace(inline.String()); text != "" {
+			result = append(result, text)
+		}
+		inline.Reset()
+	}
+
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if !isBlock(c) {
+			inline.WriteString(inlineMarkdown(c))
+			continue
+		}
+		flush()
+		result = append(result, block(c)...)
+	}
+	flush()
+	return result
+}
+
+func block(n *html.Node) []string {
+	switch n.DataAtom {
+	case atom.H1, atom.H2, atom.H3, atom.H4, atom.H5, atom.H6:
+		text := strings.TrimSpace(spaces.ReplaceAllString(children(n), " "))
+		if text == "" {
+			return nil
+		}
+		return []string{strings.Repeat("#", int(n.Data[1]-'0')) + " " + text}
+	case atom.Hr:
+		return []string{"---"}
+	case atom.Blockquote:
+		inner := strings.Join(blocks(n), "\n\n")
+		if inner == "" {
+			return nil
+		}
+		return []string{indent(inner, "> ", "> ")}
+	case atom.Ul, atom.Ol:
+		return []string{list(n)}
+	case atom.Pre:
+		return []string{"```\n" + strings.TrimRight(rawText(n), "\n") + "\n```"}
+	case atom.Table:
+		if t := table(n); t != "" {
+			return []string{t}
+		}
+		return nil
+	default:
+		return blocks(n)
+	}
+}
+
+func list(n *html.Node) string {
+	var items []string
+	i := 1
+	for li := n.FirstChild; li != nil; li = li.NextSibling {
+		if li.Type != html.ElementNode || li.DataAtom != atom.Li {
+			continue
+		}
+		marker := "- "
+		if n.DataAtom == atom.Ol {
+			marker = fmt.Sprintf("%d. ", i)
+			i++
+		}
+		inner := strings.Join(blocks(li), "\n")
+		items = append(items, indent(inner, marker, strings.Repeat(" ", len(marker))))
+	}
+	return strings.Join(items, "\n")
+}
+
+// indent 为首行添加first前缀，其余非空行添加rest前缀
+func indent(s, first, rest string) string {
+	lines := strings.Split(s, "\n")
+	for i, line := range lines {
+		prefix := rest
+		if i == 0 {
+			prefix = first
+		}
+		if line == "" {
+			lines[i] = strings.TrimRight(prefix, " ")
+			continue
+		}
+		lines[i] = prefix + line
+	}
+	return strings.Join(lines, "\n")
+}
+
+// inlineMarkdown 转换行内节点
+func inlineMarkdown(n *html.Node) string {
+	switch n.Type {
+	case html.TextNode:
+		return escaper.Replace(spaces.ReplaceAllString(n.Data, " "))
+	case html.ElementNode:
+	default:
+		return ""
+	}
+
+	switch n.DataAtom {
+	case atom.Script, atom.Style, atom.Noscript:
+		return ""
+	case atom.Br:
+		return "  \n"
+	case atom.Strong, atom.B:
+		return wrap(n, "**")
+	case atom.Em, atom.I:
+		return wrap(n, "*")
+	case atom.Del, atom.S:
+		return wrap(n, "~~")
+	case atom.Code:
+		return "`" + rawText(n) + "`"
+	case atom.A:
+		text := strings.TrimSpace(children(n))
+		href := attr(n, "href")
+		if href == "" || strings.HasPrefix(href, "javascript:") {
+			return text
+		}
+		if text == "" {
+			text = href
+		}
+		return "[" + text + "](" + escapeURL(href) + ")"
+	case atom.Img:
+		src := attr(n, "src")
+		if src == "" {
+			src = attr(n, "data-src")
+		}
+		if src == "" {
+			return ""
+		}
+		return "![" + escaper.Replace(attr(n, "alt")) + "](" + escapeURL(src) + ")"
+	case atom.Li:
+		return strings.Join(blocks(n), " ")
+	default:
+		if isBlock(n) {
+			return " " + strings.Join(block(n), " ") + " "
+		}
+		return children(n)
+	}
+}
+
+func children(n *html.Node) string {
+	var b strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		b.WriteString(inlineMarkdown(c))
+	}
+	return b.String()
+}
+
+func wrap(n *html.Node, mark string) string {
+	text := strings.TrimSpace(children(n))
+	if text == "" {
+		return ""
+	}
+	return mark + text + mark
+}
+
+func table(n *html.Node) string {
+	var rows [][]string
+	var walk func(*html.Node)
+	walk = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type != html.ElementNode {
+				continue
+			}
+			if c.DataAtom != atom.Tr {
+				walk(c)
+				continue
+			}
+			var row []string
+			for cell := c.FirstChild; cell != nil; cell = cell.NextSibling {
+				if cell.Type == html.ElementNode && (cell.DataAtom == atom.Td || cell.DataAtom == atom.Th) {
+					text := strings.TrimSpace(spaces.ReplaceAllString(children(cell), " "))
+					row = append(row, strings.ReplaceAll(text, "|", `\|`))
+				}
+			}
+			rows = append(rows, row)
+		}
+	}
+	walk(n)
+
+	width := 0
+	for _, row := range rows {
+		if len(row) > width {
+			width = len(row)
+		}
+	}
+	if width == 0 {
+		return ""
+	}
+
+	lines := make([]string, 0, len(rows)+1)
+	for i, row := range rows {
+		for len(row) < width {
+			row = append(row, "")
+		}
+		lines = append(lines, "| "+strings.Join(row, " | ")+" |")
+		if i == 0 {
+			lines = append(lines, strings.Repeat("| --- ", width)+"|")
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+func rawText(n *html.Node) string {
+	if n.Type == html.TextNode {
+		return n.Data
+	}
+	var b strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && c.DataAtom == atom.Br {
+			b.WriteString("\n")
+			continue
+		}
+		b.WriteString(rawText(c))
+	}
+	return b.String()
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if a.Key == key {
+			return strings.TrimSpace(a.Val)
+		}
+	}
+	return ""
+}
+
+func escapeURL(u string) string {
+	return strings.NewReplacer(" ", "%20", "(", "%28", ")", "%29").Replace(u)
+}
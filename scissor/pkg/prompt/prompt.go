@@ -0,0 +1,269 @@
+// Package prompt 渲染大模型提示词模板并解析结构化输出。
+// 模板使用Go的text/template语法，以文章字段为数据，如 {{.Title}}、{{.Content}}。
+package prompt
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+	"text/template"
+	"time"
+	"unicode/utf8"
+
+	"github.com/gorexlv/cabinet/scissor/internal/config"
+)
+
+// 内置任务名称，摘要和标签的结果保存在文章上，其他任务的结果单独保存
+const (
+	Summary = "summary"
+	Tags    = "tags"
+)
+
+// 输出格式
+const (
+	// FormatText 原样保存模型输出的文本
+	FormatText = "text"
+	// FormatList 按行拆分为列表，去掉行首的列表符号和序号
+	FormatList = "list"
+	// FormatJSON 输出必须是JSON，允许包裹在Markdown代码块中
+	FormatJSON = "json"
+)
+
+// 模板正文的长度上限（字符）
+const maxTemplateLength = 8000
+
+var (
+	// ErrInvalidName 任务名称只能包含小写字母、数字、下划线和连字符，并以字母开头
+	ErrInvalidName = errors.New("无效的任务名称")
+	// ErrInvalidFormat 不支持的输出格式
+	ErrInvalidFormat = errors.New("无效的输出格式")
+	// ErrInvalidOutput 模型输出不符合要求的格式
+	ErrInvalidOutput = errors.New("模型输出格式无效")
+
+	namePattern = regexp.MustCompile(`^[a-z][a-z0-9_-]{0,31}$`)
+	// 列表项行首的符号或序号，如 "- "、"1. "、"（1）"
+	listMarker = regexp.MustCompile(`^(?:[-*•·]\s*|\d+[.、)）]\s*|[（(]\d+[)）]\s*)`)
+)
+
+// Template 提示词模板
+type Template struct {
+	// Name 任务名称，summary 和 tags 为内置任务
+	Name  string `json:"name"`
+	Title string `json:"title"`
+	Text  string `json:"template"`
+	// Format 输出格式：text、list 或 json。摘要固定为 text，标签固定为 list
+	Format string `json:"format"`
+	// Auto 文章保存后自动执行，否则只在请求时生成。摘要和标签总是自动生成
+	Auto bool `json:"auto"`
+}
+
+// Article 模板可以使用的文章字段
+type Article struct {
+	Title  string
+	Author string
+	Source string
+	URL    string
+	// Content 正文纯文本
+	Content     string
+	Summary     string
+	Tags        []string
+	Language    string
+	PublishedAt time.Time
+}
+
+// 模板中可用的函数
+var funcs = template.FuncMap{
+	// truncate 按字符截断，如 {{truncate 4000 .Content}}
+	"truncate": func(n int, s string) string {
+		if utf8.RuneCountInString(s) <= n {
+			return s
+		}
+		return string([]rune(s)[:n])
+	},
+	"join": func(sep string, items []string) string {
+		return strings.Join(items, sep)
+	},
+}
+
+// 校验模板时使用的示例文章
+var sampleArticle = &Article{
+	Title:       "示例文章",
+	Author:      "作者",
+	Source:      "来源",
+	URL:         "https://example.com/article",
+	Content:     "正文",
+	Summary:     "摘要",
+	Tags:        []string{"标签"},
+	Language:    "zh",
+	PublishedAt: time.Now(),
+}
+
+// Validate 检查名称、输出格式和模板语法，并用示例文章试渲染一次
+func (t *Template) Validate() error {
+	if !namePattern.MatchString(t.Name) {
+		return ErrInvalidName
+	}
+	switch {
+	case t.Name == Summary && t.Format != FormatText,
+		t.Name == Tags && t.Format != FormatList,
+		t.Format != FormatText && t.Format != FormatList && t.Format != FormatJSON:
+		return ErrInvalidFormat
+	}
+	if strings.TrimSpace(t.Text) == "" || utf8.RuneCountInString(t.Text) > maxTemplateLength {
+		return fmt.Errorf("模板不能为空且不超过%d字", maxTemplateLength)
+	}
+	_, err := t.Render(sampleArticle)
+	return err
+}
+
+// Render 以文章字段渲染模板
+func (t *Template) Render(a *Article) (string, error) {
+	tmpl, err := template.New(t.Name).Funcs(funcs).Option("missingkey=error").Parse(t.Text)
+	if err != nil {
+		return "", fmt.Errorf("模板语法错误: %w", err)
+	}
+	var b bytes.Buffer
+	if err := tmpl.Execute(&b, a); err != nil {
+		return "", fmt.Errorf("模板渲染失败: %w", err)
+	}
+	return b.String(), nil
+}
+
+// Parse 按输出格式解析模型输出，返回整理后的文本和结构化数据。
+// text 格式没有结构化数据，list 格式的数据为字符串数组。
+func Parse(format, output string) (string, json.RawMessage, error) {
+	output = strings.TrimSpace(output)
+	switch format {
+	case FormatList:
+		items := ListItems(output)
+		if len(items) == 0 {
+			return "", nil, ErrInvalidOutput
+		}
+		data, err := json.Marshal(items)
+		return strings.Join(items, "\n"), data, err
+	case FormatJSON:
+		output = trimCodeFence(output)
+		if !json.Valid([]byte(output)) {
+			return "", nil, ErrInvalidOutput
+		}
+		return output, json.RawMessage(output), nil
+	default:
+		return output, nil, nil
+	}
+}
+
+// ListItems 将模型输出拆分为列表项：按行拆分，去掉列表符号和序号，忽略空行
+func ListItems(output string) []string {
+	var items []string
+	for _, line := range strings.Split(output, "\n") {
+		line = strings.TrimSpace(listMarker.ReplaceAllString(strings.TrimSpace(line), ""))
+		if line != "" {
+			items = append(items, line)
+		}
+	}
+	return items
+}
+
+// trimCodeFence 去掉包裹输出的Markdown代码块标记
+func trimCodeFence(s string) string {
+	if !strings.HasPrefix(s, "```") || !strings.HasSuffix(s, "```") || len(s) < 6 {
+		return s
+	}
+	s = strings.TrimSuffix(s, "```")
+	if i := strings.IndexByte(s, '\n'); i >= 0 {
+		s = s[i+1:]
+	} else {
+		s = strings.TrimPrefix(s, "```")
+	}
+	return strings.TrimSpace(s)
+}
+
+// 内置的系统模板，摘要和标签沿用原有的提示词
+var builtins = []*Template{
+	{
+		Name:   Summary,
+		Title:  "摘要",
+		Text:   "请为以下文章生成一个简洁的摘要（不超过200字）：\n\n{{.Content}}",
+		Format: FormatText,
+		Auto:   true,
+	},
+	{
+		Name:   Tags,
+		Title:  "标签",
+		Text:   "请为以下文章提取5-8个关键词标签，用逗号分隔：\n\n{{.Content}}",
+		Format: FormatList,
+		Auto:   true,
+	},
+	{
+		Name:   "tldr",
+		Title:  "一句话总结",
+		Text:   "用一句话（不超过50字）概括以下文章的核心观点，只输出这句话：\n\n标题：{{.Title}}\n\n{{.Content}}",
+		Format: FormatText,
+	},
+	{
+		Name:   "takeaways",
+		Title:  "要点",
+		Text:   "列出以下文章的3-7个关键要点，每行一条，不要输出其他内容：\n\n标题：{{.Title}}\n\n{{.Content}}",
+		Format: FormatList,
+	},
+	{
+		Name:   "action_items",
+		Title:  "行动项",
+		Text:   "根据以下文章，列出读者可以采取的具体行动，每行一条；没有可行动的内容时输出“无”：\n\n标题：{{.Title}}\n\n{{.Content}}",
+		Format: FormatList,
+	},
+	{
+		Name:   "outline",
+		Title:  "思维导图大纲",
+		Text:   "将以下文章整理为思维导图大纲，使用Markdown多级列表，根节点为文章主题，不超过三级，只输出大纲：\n\n标题：{{.Title}}\n\n{{.Content}}",
+		Format: FormatText,
+	},
+}
+
+// System 返回系统模板：内置模板，加上配置文件中定义的模板，同名时配置覆盖内置
+func System(cfg config.PromptsConfig) ([]*Template, error) {
+	byName := make(map[string]*Template)
+	var names []string
+	add := func(t *Template) {
+		if _, ok := byName[t.Name]; !ok {
+			names = append(names, t.Name)
+		}
+		byName[t.Name] = t
+	}
+	for _, t := range builtins {
+		copied := *t
+		add(&copied)
+	}
+
+	for _, c := range cfg.Templates {
+		t := &Template{
+			Name:   c.Name,
+			Title:  c.Title,
+			Text:   c.Template,
+			Format: c.Format,
+			Auto:   c.Auto,
+		}
+		if t.Format == "" {
+			t.Format = FormatText
+			if t.Name == Tags {
+				t.Format = FormatList
+			}
+		}
+		if t.Name == Summary || t.Name == Tags {
+			t.Auto = true
+		}
+		if err := t.Validate(); err != nil {
+			return nil, fmt.Errorf("提示词模板 %q 无效: %w", c.Name, err)
+		}
+		add(t)
+	}
+
+	result := make([]*Template, len(names))
+	for i, name := range names {
+		result[i] = byName[name]
+	}
+	return result, nil
+}
@@ -0,0 +1,130 @@
+// Package safehttp 提供抓取用户提交的地址时使用的HTTP客户端，拒绝访问内网、本机和元数据服务等地址。
+// 检查在DNS解析之后、建立连接之前进行，每次重定向都会重新检查，可以防止DNS重绑定。
+package safehttp
+
+import (
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"net/netip"
+	"net/url"
+	"syscall"
+	"time"
+)
+
+// 最多跟随的重定向次数
+const maxRedirects = 10
+
+var (
+	// ErrBlockedAddress 目标地址是内网、本机或其他不允许访问的地址
+	ErrBlockedAddress = errors.New("不允许访问的地址")
+	// ErrBlockedScheme 只允许 http 和 https 协议
+	ErrBlockedScheme = errors.New("不支持的协议")
+)
+
+// 不允许访问的地址段，除 netip 能直接判断的回环、私有、链路本地、组播和未指定地址之外
+var blockedPrefixes = []netip.Prefix{
+	netip.MustParsePrefix("0.0.0.0/8"),      // 本网络
+	netip.MustParsePrefix("100.64.0.0/10"),  // 运营商级NAT
+	netip.MustParsePrefix("192.0.0.0/24"),   // IETF协议分配
+	netip.MustParsePrefix("198.18.0.0/15"),  // 基准测试
+	netip.MustParsePrefix("240.0.0.0/4"),    // 保留及广播
+	netip.MustParsePrefix("64:ff9b::/96"),   // NAT64，可映射到任意IPv4地址
+	netip.MustParsePrefix("64:ff9b:1::/48"), // 本地NAT64
+	netip.MustParsePrefix("2002::/16"),      // 6to4，可映射到任意IPv4地址
+	netip.MustParsePrefix("2001::/32"),      // Teredo
+	netip.MustParsePrefix("fec0::/10"),      // 已废弃的站点本地地址
+}
+
+// NewClient 创建只能访问公网 http/https 地址的客户端，timeout为0时不限制总时长。
+// 不使用环境变量中的代理，否则连接目标是代理而无法检查。
+func NewClient(timeout time.Duration) *http.Client {
+	return &http.Client{
+		Transport:     NewTransport(),
+		Timeout:       timeout,
+		CheckRedirect: checkRedirect,
+	}
+}
+
+// NewTransport 创建在建立连接前检查目标地址的Transport，并拒绝 http/https 以外的协议
+func NewTransport() http.RoundTripper {
+	dialer := &net.Dialer{
+		Timeout:   30 * time.Second,
+		KeepAlive: 30 * time.Second,
+		Control:   control,
+	}
+	return &schemeGuard{next: &http.Transport{
+		DialContext:           dialer.DialContext,
+		ForceAttemptHTTP2:     true,
+		MaxIdleConns:          100,
+		IdleConnTimeout:       90 * time.Second,
+		TLSHandshakeTimeout:   10 * time.Second,
+		ResponseHeaderTimeout: 30 * time.Second,
+		ExpectContinueTimeout: time.Second,
+	}}
+}
+
+// Allowed 判断是否允许连接该IP地址
+func Allowed(ip netip.Addr) bool {
+	ip = ip.Unmap()
+	if !ip.IsValid() || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+		ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() || ip.IsInterfaceLocalMulticast() || ip.IsMulticast() {
+		return false
+	}
+	for _, p := range blockedPrefixes {
+		if p.Contains(ip) {
+			return false
+		}
+	}
+	return true
+}
+
+// CheckURL 在发起请求前检查地址的协议，以及以IP表示的主机
+func CheckURL(u *url.URL) error {
+	if u.Scheme != "http" && u.Scheme != "https" {
+		return fmt.Errorf("%w: %s", ErrBlockedScheme, u.Scheme)
+	}
+	if ip, err := netip.ParseAddr(u.Hostname()); err == nil && !Allowed(ip) {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, ip)
+	}
+	return nil
+}
+
+// control 在DNS解析之后、连接之前检查实际连接的地址
+func control(network, address string, _ syscall.RawConn) error {
+	host, _, err := net.SplitHostPort(address)
+	if err != nil {
+		return err
+	}
+	ip, err := netip.ParseAddr(host)
+	if err != nil {
+		return err
+	}
+	if !Allowed(ip) {
+		return fmt.Errorf("%w: %s", ErrBlockedAddress, ip)
+	}
+	return nil
+}
+
+func checkRedirect(req *http.Request, via []*http.Request) error {
+	if len(via) >= maxRedirects {
+		return fmt.Errorf("重定向次数过多")
+	}
+	return CheckURL(req.URL)
+}
+
+// schemeGuard 拒绝 http/https 以外的协议，覆盖首次请求和每次重定向
+type schemeGuard struct {
+	next http.RoundTripper
+}
+
+func (g *schemeGuard) RoundTrip(req *http.Request) (*http.Response, error) {
+	if err := CheckURL(req.URL); err != nil {
+		if req.Body != nil {
+			req.Body.Close()
+		}
+		return nil, err
+	}
+	return g.next.RoundTrip(req)
+}
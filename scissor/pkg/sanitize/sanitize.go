@@ -0,0 +1,228 @@
+// Package sanitize 以白名单清理文章HTML，只保留排版所需的元素和属性，去掉脚本、样式和事件属性
+package sanitize
+
+import (
+	"regexp"
+	"strings"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+)
+
+// 连同内容一起移除的元素
+var droppedElements = map[atom.Atom]bool{
+	atom.Script: true, atom.Style: true, atom.Noscript: true, atom.Template: true,
+	atom.Iframe: true, atom.Frame: true, atom.Frameset: true, atom.Object: true,
+	atom.Embed: true, atom.Applet: true, atom.Svg: true, atom.Math: true,
+	atom.Form: true, atom.Input: true, atom.Button: true, atom.Select: true,
+	atom.Textarea: true, atom.Option: true, atom.Head: true, atom.Title: true,
+	atom.Meta: true, atom.Link: true, atom.Base: true, atom.Video: true,
+	atom.Audio: true, atom.Source: true, atom.Track: true, atom.Canvas: true,
+}
+
+// 保留的元素及其允许的属性，不在此列表中的元素去掉标签、保留内容
+var allowedElements = map[atom.Atom][]string{
+	atom.P: nil, atom.Br: nil, atom.Hr: nil, atom.Div: nil, atom.Span: nil,
+	atom.Section: nil, atom.Article: nil, atom.Blockquote: {"cite"},
+	atom.H1: nil, atom.H2: nil, atom.H3: nil, atom.H4: nil, atom.H5: nil, atom.H6: nil,
+	atom.Pre: nil, atom.Code: {"class"}, atom.Kbd: nil, atom.Samp: nil, atom.Var: nil,
+	atom.Em: nil, atom.Strong: nil, atom.B: nil, atom.I: nil, atom.U: nil, atom.S: nil,
+	atom.Del: nil, atom.Ins: nil, atom.Sub: nil, atom.Sup: nil, atom.Mark: nil, atom.Small: nil,
+	atom.Abbr: nil, atom.Cite: nil, atom.Q: nil, atom.Time: {"datetime"},
+	atom.Ruby: nil, atom.Rt: nil, atom.Rp: nil,
+	atom.Ul: nil, atom.Ol: {"start", "type", "reversed"}, atom.Li: {"value"},
+	atom.Dl: nil, atom.Dt: nil, atom.Dd: nil,
+	atom.Figure: nil, atom.Figcaption: nil, atom.Details: nil, atom.Summary: nil,
+	atom.Table: nil, atom.Caption: nil, atom.Thead: nil, atom.Tbody: nil, atom.Tfoot: nil,
+	atom.Tr: nil, atom.Th: {"colspan", "rowspan", "align", "scope"}, atom.Td: {"colspan", "rowspan", "align"},
+	atom.Colgroup: {"span"}, atom.Col: {"span"},
+	atom.A:   {"href"},
+	atom.Img: {"src", "alt", "width", "height", "data-original-src"},
+}
+
+// 所有保留元素都允许的属性
+var globalAttrs = []string{"title", "lang", "dir"}
+
+var (
+	// 代码块只保留表示语言的类名
+	codeClassPattern = regexp.MustCompile(`^language-[A-Za-z0-9_+-]+$`)
+	// 可以内嵌的图片格式，SVG可能包含脚本
+	dataImagePattern = regexp.MustCompile(`^data:image/(png|jpeg|gif|webp|avif);base64,[A-Za-z0-9+/=\s]+$`)
+)
+
+// HTML 清理HTML片段，返回只含白名单元素和属性的HTML。
+// 链接只保留 http、https、mailto 和相对地址，图片只保留 http、https、相对地址和位图的 data URI，
+// 懒加载图片的 data-src 会作为 src 保留。
+func HTML(s string) string {
+	if strings.TrimSpace(s) == "" {
+		return ""
+	}
+	body := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := html.ParseFragment(strings.NewReader(s), body)
+	if err != nil {
+		return html.EscapeString(s)
+	}
+
+	container := &html.Node{Type: html.ElementNode, Data: "div", DataAtom: atom.Div}
+	for _, n := range nodes {
+		container.AppendChild(n)
+	}
+	clean(container)
+
+	var b strings.Builder
+	for c := container.FirstChild; c != nil; c = c.NextSibling {
+		html.Render(&b, c)
+	}
+	return b.String()
+}
+
+// clean 清理n的子节点
+func clean(n *html.Node) {
+	for c := n.FirstChild; c != nil; {
+		next := c.NextSibling
+		switch c.Type {
+		case html.TextNode:
+		case html.ElementNode:
+			switch {
+			case droppedElements[c.DataAtom]:
+				n.RemoveChild(c)
+			case allowed(c.DataAtom):
+				clean(c)
+				if c.DataAtom == atom.Img && !filterImg(c) {
+					n.RemoveChild(c)
+					break
+				}
+				filterAttrs(c)
+			default:
+				// 去掉标签、保留内容，子节点在后续循环中处理
+				clean(c)
+				for gc := c.FirstChild; gc != nil; {
+					gcNext := gc.NextSibling
+					c.RemoveChild(gc)
+					n.InsertBefore(gc, c)
+					gc = gcNext
+				}
+				n.RemoveChild(c)
+			}
+		default:
+			// 注释、文档类型等
+			n.RemoveChild(c)
+		}
+		c = next
+	}
+}
+
+func allowed(a atom.Atom) bool {
+	_, ok := allowedElements[a]
+	return ok
+}
+
+// filterImg 确定图片地址，没有可用地址时返回false
+func filterImg(n *html.Node) bool {
+	src := strings.TrimSpace(attr(n, "src"))
+	if lazy := strings.TrimSpace(attr(n, "data-src")); lazy != "" && (src == "" || strings.HasPrefix(src, "data:")) {
+		src = lazy
+	}
+	if !safeURL(src, true) {
+		return false
+	}
+	setAttr(n, "src", src)
+	return true
+}
+
+func filterAttrs(n *html.Node) {
+	allowed := allowedElements[n.DataAtom]
+	attrs := n.Attr[:0]
+	for _, a := range n.Attr {
+		key := strings.ToLower(a.Key)
+		if a.Namespace != "" || !(contains(allowed, key) || contains(globalAttrs, key)) {
+			continue
+		}
+		switch key {
+		case "href", "cite", "data-original-src":
+			if !safeURL(a.Val, false) {
+				continue
+			}
+		case "class":
+			var classes []string
+			for _, c := range strings.Fields(a.Val) {
+				if codeClassPattern.MatchString(c) {
+					classes = append(classes, c)
+				}
+			}
+			if len(classes) == 0 {
+				continue
+			}
+			a.Val = strings.Join(classes, " ")
+		}
+		a.Key = key
+		attrs = append(attrs, a)
+	}
+	n.Attr = attrs
+
+	if n.DataAtom == atom.A && attr(n, "href") != "" {
+		n.Attr = append(n.Attr, html.Attribute{Key: "rel", Val: "noopener noreferrer nofollow"})
+	}
+}
+
+// safeURL 判断链接或图片地址是否安全，image为true时允许位图的 data URI
+func safeURL(s string, image bool) bool {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return false
+	}
+	lower := strings.ToLower(s)
+	if strings.HasPrefix(lower, "data:") {
+		return image && dataImagePattern.MatchString(s)
+	}
+	// 去掉浏览器会忽略的控制字符和空白后判断协议，避免 java\tscript: 之类的写法
+	scheme := strings.Map(func(r rune) rune {
+		if r <= ' ' {
+			return -1
+		}
+		return r
+	}, lower)
+	colon := strings.IndexByte(scheme, ':')
+	if colon < 0 {
+		return true
+	}
+	// 冒号出现在路径、查询或片段中时是相对地址
+	if slash := strings.IndexAny(scheme, "/?#"); slash >= 0 && slash < colon {
+		return true
+	}
+	switch scheme[:colon] {
+	case "http", "https":
+		return true
+	case "mailto":
+		return !image
+	}
+	return false
+}
+
+func contains(list []string, s string) bool {
+	for _, v := range list {
+		if v == s {
+			return true
+		}
+	}
+	return false
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if strings.EqualFold(a.Key, key) {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func setAttr(n *html.Node, key, val string) {
+	for i, a := range n.Attr {
+		if strings.EqualFold(a.Key, key) {
+			n.Attr[i].Val = val
+			return
+		}
+	}
+	n.Attr = append(n.Attr, html.Attribute{Key: key, Val: val})
+}
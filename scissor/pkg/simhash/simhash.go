@@ -0,0 +1,56 @@
+// Package simhash 计算文本的SimHash指纹，用于发现内容近似的文章
+package simhash
+
+import (
+	"hash/fnv"
+	"math/bits"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/textutil"
+)
+
+// Fingerprint 计算文本的64位SimHash指纹，特征为拉丁词和中日韩二元组，按词频加权
+func Fingerprint(text string) uint64 {
+	weights := make(map[string]int)
+	for _, token := range textutil.Tokens(text) {
+		// 单个汉字区分度太低，只使用二元组
+		if len([]rune(token)) == 1 && textutil.IsCJK([]rune(token)[0]) {
+			continue
+		}
+		weights[token]++
+	}
+	if len(weights) == 0 {
+		return 0
+	}
+
+	var v [64]int
+	for token, w := range weights {
+		h := fnv.New64a()
+		h.Write([]byte(token))
+		sum := h.Sum64()
+		for i := 0; i < 64; i++ {
+			if sum&(1<<uint(i)) != 0 {
+				v[i] += w
+			} else {
+				v[i] -= w
+			}
+		}
+	}
+
+	var fp uint64
+	for i := 0; i < 64; i++ {
+		if v[i] > 0 {
+			fp |= 1 << uint(i)
+		}
+	}
+	return fp
+}
+
+// Distance 返回两个指纹的汉明距离
+func Distance(a, b uint64) int {
+	return bits.OnesCount64(a ^ b)
+}
+
+// Similarity 将汉明距离换算为0到1之间的相似度
+func Similarity(a, b uint64) float64 {
+	return 1 - float64(Distance(a, b))/64
+}
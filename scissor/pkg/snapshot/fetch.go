@@ -0,0 +1,116 @@
+// Package snapshot 保存网页的不可变快照，可以生成内联了样式和图片的单文件HTML，或记录为WARC
+package snapshot
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+const (
+	// 浏览器UA，部分站点会拦截默认的Go客户端
+	userAgent = "Mozilla/5.0 (Windows NT 10.0; Win64; x64) AppleWebKit/537.36 (KHTML, like Gecko) Chrome/124.0 Safari/537.36"
+	// 单个资源的大小上限
+	maxResourceSize = 10 << 20
+)
+
+// ErrTooLarge 资源超过大小上限
+var ErrTooLarge = errors.New("资源过大")
+
+// Resource 抓取到的网页或资源，保留原始的请求和响应头以便写入WARC
+type Resource struct {
+	// URL 跟随重定向后的最终地址，RequestURL 为最初请求的地址
+	URL           string
+	RequestURL    string
+	RequestHeader http.Header
+	StatusCode    int
+	Proto         string
+	Header        http.Header
+	Body          []byte
+	FetchedAt     time.Time
+}
+
+// ContentType 返回响应的 Content-Type
+func (r *Resource) ContentType() string {
+	return r.Header.Get("Content-Type")
+}
+
+// Fetcher 按地址获取资源，可以是实时抓取，也可以从已保存的WARC中读取
+type Fetcher func(ctx context.Context, url string) (*Resource, error)
+
+// HTTPFetcher 返回通过HTTP抓取资源的Fetcher。请求不带Referer，以绕过微信图片的防盗链。
+// 地址来自用户提交的文章，client 应使用 safehttp.NewClient 创建，避免访问内网地址。
+func HTTPFetcher(client *http.Client) Fetcher {
+	return func(ctx context.Context, url string) (*Resource, error) {
+		req, err := http.NewRequestWithContext(ctx, http.MethodGet, url, nil)
+		if err != nil {
+			return nil, err
+		}
+		req.Header.Set("User-Agent", userAgent)
+		req.Header.Set("Accept", "*/*")
+
+		resp, err := client.Do(req)
+		if err != nil {
+			return nil, err
+		}
+		defer resp.Body.Close()
+
+		body, err := io.ReadAll(io.LimitReader(resp.Body, maxResourceSize+1))
+		if err != nil {
+			return nil, err
+		}
+		if len(body) > maxResourceSize {
+			return nil, ErrTooLarge
+		}
+		if resp.StatusCode != http.StatusOK {
+			return nil, fmt.Errorf("请求失败，状态码: %d", resp.StatusCode)
+		}
+
+		return &Resource{
+			URL:           resp.Request.URL.String(),
+			RequestURL:    url,
+			RequestHeader: req.Header.Clone(),
+			StatusCode:    resp.StatusCode,
+			Proto:         resp.Proto,
+			Header:        resp.Header,
+			Body:          body,
+			FetchedAt:     time.Now().UTC(),
+		}, nil
+	}
+}
+
+// Recorder 记录经过它抓取的资源，用于写入WARC
+type Recorder struct {
+	fetch Fetcher
+
+	mu        sync.Mutex
+	resources []*Resource
+}
+
+// NewRecorder 创建记录资源的Fetcher包装
+func NewRecorder(fetch Fetcher) *Recorder {
+	return &Recorder{fetch: fetch}
+}
+
+// Fetch 抓取资源并记录
+func (r *Recorder) Fetch(ctx context.Context, url string) (*Resource, error) {
+	res, err := r.fetch(ctx, url)
+	if err != nil {
+		return nil, err
+	}
+	r.mu.Lock()
+	r.resources = append(r.resources, res)
+	r.mu.Unlock()
+	return res, nil
+}
+
+// Resources 返回已记录的资源，按抓取顺序排列
+func (r *Recorder) Resources() []*Resource {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return append([]*Resource(nil), r.resources...)
+}
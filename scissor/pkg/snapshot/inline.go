@@ -0,0 +1,342 @@
+package snapshot
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"fmt"
+	"mime"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"time"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+	"golang.org/x/net/html/charset"
+)
+
+const (
+	// 单个快照内联资源的总大小上限和数量上限，超出后的资源保留原地址
+	maxInlineTotal     = 50 << 20
+	maxInlineResources = 500
+	// 样式表 @import 的最大嵌套层数
+	maxImportDepth = 3
+)
+
+// ContentSecurityPolicy 快照网页的内容安全策略：禁止脚本和一切外部请求，只允许内联的样式和资源。
+// Inline 会将其写入网页的 <meta>，以便下载后直接打开或通过 srcdoc 显示时同样生效。
+const ContentSecurityPolicy = "default-src 'none'; img-src data:; style-src 'unsafe-inline' data:; font-src data:; media-src data:"
+
+var (
+	cssURLPattern    = regexp.MustCompile(`url\(\s*(['"]?)([^'")]+)['"]?\s*\)`)
+	cssImportPattern = regexp.MustCompile(`@import\s+(?:url\(\s*)?['"]?([^'")\s;]+)['"]?\s*\)?[^;]*;`)
+)
+
+// 快照中不保留的元素：脚本以及会加载外部内容的框架和插件
+var removedElements = map[atom.Atom]bool{
+	atom.Script:   true,
+	atom.Iframe:   true,
+	atom.Frame:    true,
+	atom.Frameset: true,
+	atom.Object:   true,
+	atom.Embed:    true,
+	atom.Applet:   true,
+	atom.Base:     true,
+	atom.Source:   true,
+	atom.Template: true,
+}
+
+// Inline 将网页及其样式表、图片和字体内联为单个HTML文件，移除脚本、框架和事件属性。
+// 资源通过fetch获取，获取失败的资源保留为绝对地址。
+func Inline(ctx context.Context, page *Resource, fetch Fetcher) ([]byte, error) {
+	r, err := charset.NewReader(bytes.NewReader(page.Body), page.ContentType())
+	if err != nil {
+		return nil, fmt.Errorf("识别网页编码失败: %w", err)
+	}
+	root, err := html.Parse(r)
+	if err != nil {
+		return nil, fmt.Errorf("解析网页失败: %w", err)
+	}
+
+	base, err := url.Parse(page.URL)
+	if err != nil {
+		return nil, err
+	}
+	if b := findElement(root, atom.Base); b != nil {
+		if href := attr(b, "href"); href != "" {
+			if u, err := base.Parse(href); err == nil {
+				base = u
+			}
+		}
+	}
+
+	in := &inliner{
+		ctx:    ctx,
+		fetch:  fetch,
+		images: make(map[string]string),
+		sheets: make(map[string]string),
+	}
+	in.node(root, base)
+
+	if head := findElement(root, atom.Head); head != nil {
+		meta := []*html.Node{
+			element(atom.Meta, "charset", "utf-8"),
+			element(atom.Meta, "http-equiv", "Content-Security-Policy", "content", ContentSecurityPolicy),
+			element(atom.Meta, "name", "scissor-snapshot-source", "content", page.URL),
+			element(atom.Meta, "name", "scissor-snapshot-date", "content", page.FetchedAt.UTC().Format(time.RFC3339)),
+		}
+		for i := len(meta) - 1; i >= 0; i-- {
+			head.InsertBefore(meta[i], head.FirstChild)
+		}
+	}
+
+	var buf bytes.Buffer
+	if err := html.Render(&buf, root); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+type inliner struct {
+	ctx   context.Context
+	fetch Fetcher
+	total int64
+	count int
+	// 资源地址 -> data URI 或处理后的样式表，获取失败的记为空
+	images map[string]string
+	sheets map[string]string
+}
+
+// node 处理元素及其子节点
+func (in *inliner) node(n *html.Node, base *url.URL) {
+	var remove []*html.Node
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		switch {
+		case c.Type == html.CommentNode:
+			remove = append(remove, c)
+			continue
+		case c.Type != html.ElementNode:
+			continue
+		case removedElements[c.DataAtom]:
+			remove = append(remove, c)
+			continue
+		}
+
+		switch c.DataAtom {
+		case atom.Meta:
+			// 编码统一为UTF-8，并且不允许自动跳转
+			if attr(c, "charset") != "" || attr(c, "http-equiv") != "" {
+				remove = append(remove, c)
+				continue
+			}
+		case atom.Link:
+			rel := strings.ToLower(attr(c, "rel"))
+			switch {
+			case strings.Contains(rel, "stylesheet"):
+				css, ok := in.stylesheet(resolve(base, attr(c, "href")), 0)
+				if !ok {
+					remove = append(remove, c)
+					continue
+				}
+				style := element(atom.Style)
+				if media := attr(c, "media"); media != "" {
+					style.Attr = append(style.Attr, html.Attribute{Key: "media", Val: media})
+				}
+				style.AppendChild(&html.Node{Type: html.TextNode, Data: css})
+				n.InsertBefore(style, c)
+				remove = append(remove, c)
+				continue
+			case strings.Contains(rel, "icon"):
+				setAttr(c, "href", in.image(resolve(base, attr(c, "href"))))
+			default:
+				remove = append(remove, c)
+				continue
+			}
+		case atom.Style:
+			if t := c.FirstChild; t != nil && t.Type == html.TextNode {
+				t.Data = in.css(t.Data, base, 0)
+			}
+		}
+
+		in.attrs(c, base)
+		in.node(c, base)
+	}
+	for _, c := range remove {
+		n.RemoveChild(c)
+	}
+}
+
+// attrs 移除事件属性和脚本链接，补全相对链接，内联图片和样式中的资源
+func (in *inliner) attrs(n *html.Node, base *url.URL) {
+	if n.DataAtom == atom.Img {
+		if src := attr(n, "data-src"); src != "" {
+			setAttr(n, "src", src)
+		}
+	}
+
+	attrs := n.Attr[:0]
+	for _, a := range n.Attr {
+		key := strings.ToLower(a.Key)
+		switch {
+		case strings.HasPrefix(key, "on"), key == "srcset", key == "data-srcset", key == "data-src", key == "srcdoc":
+			continue
+		case key == "href" || key == "src" || key == "action" || key == "poster":
+			if strings.HasPrefix(strings.ToLower(strings.TrimSpace(a.Val)), "javascript:") {
+				continue
+			}
+			a.Val = resolve(base, a.Val)
+			if (key == "src" && n.DataAtom == atom.Img) || key == "poster" {
+				a.Val = in.image(a.Val)
+			}
+		case key == "style":
+			// 公众号正文默认以 visibility:hidden 隐藏，由脚本显示
+			if strings.Contains(strings.ReplaceAll(a.Val, " ", ""), "visibility:hidden") {
+				continue
+			}
+			a.Val = in.css(a.Val, base, 0)
+		}
+		attrs = append(attrs, a)
+	}
+	n.Attr = attrs
+}
+
+// css 内联样式中 @import 的样式表和 url() 引用的资源
+func (in *inliner) css(text string, base *url.URL, depth int) string {
+	text = cssImportPattern.ReplaceAllStringFunc(text, func(m string) string {
+		ref := cssImportPattern.FindStringSubmatch(m)[1]
+		if css, ok := in.stylesheet(resolve(base, ref), depth+1); ok {
+			return css
+		}
+		return ""
+	})
+	return cssURLPattern.ReplaceAllStringFunc(text, func(m string) string {
+		ref := strings.TrimSpace(cssURLPattern.FindStringSubmatch(m)[2])
+		if ref == "" || strings.HasPrefix(ref, "data:") || strings.HasPrefix(ref, "#") {
+			return m
+		}
+		return `url("` + in.image(resolve(base, ref)) + `")`
+	})
+}
+
+// stylesheet 获取并处理外部样式表
+func (in *inliner) stylesheet(ref string, depth int) (string, bool) {
+	if css, ok := in.sheets[ref]; ok {
+		return css, css != ""
+	}
+	in.sheets[ref] = ""
+	if depth > maxImportDepth {
+		return "", false
+	}
+	res := in.get(ref)
+	if res == nil {
+		return "", false
+	}
+	base, err := url.Parse(res.URL)
+	if err != nil {
+		return "", false
+	}
+
+	css := in.css(strings.TrimPrefix(string(res.Body), "\ufeff"), base, depth)
+	// 样式内容放在 <style> 中，避免提前结束元素
+	css = strings.ReplaceAll(css, "</style", `<\/style`)
+	in.sheets[ref] = css
+	return css, css != ""
+}
+
+// image 将图片、字体等资源转为 data URI，获取失败时返回原地址
+func (in *inliner) image(ref string) string {
+	if ref == "" || strings.HasPrefix(ref, "data:") {
+		return ref
+	}
+	if uri, ok := in.images[ref]; ok {
+		if uri == "" {
+			return ref
+		}
+		return uri
+	}
+	in.images[ref] = ""
+
+	res := in.get(ref)
+	if res == nil {
+		return ref
+	}
+	mediaType, _, _ := mime.ParseMediaType(res.ContentType())
+	if mediaType == "" || mediaType == "application/octet-stream" {
+		mediaType = http.DetectContentType(res.Body)
+	}
+	uri := "data:" + mediaType + ";base64," + base64.StdEncoding.EncodeToString(res.Body)
+	in.images[ref] = uri
+	return uri
+}
+
+// get 在数量和总大小限制内获取资源
+func (in *inliner) get(ref string) *Resource {
+	u, err := url.Parse(ref)
+	if err != nil || (u.Scheme != "http" && u.Scheme != "https") {
+		return nil
+	}
+	if in.count >= maxInlineResources || in.total >= maxInlineTotal || in.ctx.Err() != nil {
+		return nil
+	}
+	in.count++
+
+	res, err := in.fetch(in.ctx, ref)
+	if err != nil || in.total+int64(len(res.Body)) > maxInlineTotal {
+		return nil
+	}
+	in.total += int64(len(res.Body))
+	return res
+}
+
+func resolve(base *url.URL, ref string) string {
+	ref = strings.TrimSpace(ref)
+	if ref == "" {
+		return ""
+	}
+	u, err := base.Parse(ref)
+	if err != nil {
+		return ref
+	}
+	return u.String()
+}
+
+func element(a atom.Atom, attrs ...string) *html.Node {
+	n := &html.Node{Type: html.ElementNode, Data: a.String(), DataAtom: a}
+	for i := 0; i+1 < len(attrs); i += 2 {
+		n.Attr = append(n.Attr, html.Attribute{Key: attrs[i], Val: attrs[i+1]})
+	}
+	return n
+}
+
+func findElement(n *html.Node, a atom.Atom) *html.Node {
+	if n.Type == html.ElementNode && n.DataAtom == a {
+		return n
+	}
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if found := findElement(c, a); found != nil {
+			return found
+		}
+	}
+	return nil
+}
+
+func attr(n *html.Node, key string) string {
+	for _, a := range n.Attr {
+		if strings.EqualFold(a.Key, key) {
+			return a.Val
+		}
+	}
+	return ""
+}
+
+func setAttr(n *html.Node, key, val string) {
+	for i, a := range n.Attr {
+		if strings.EqualFold(a.Key, key) {
+			n.Attr[i].Val = val
+			return
+		}
+	}
+	n.Attr = append(n.Attr, html.Attribute{Key: key, Val: val})
+}
@@ -0,0 +1,259 @@
+package snapshot
+
+import (
+	"bufio"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/rand"
+	"crypto/sha1"
+	"encoding/base32"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/textproto"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// MimeWARC WARC文件的媒体类型
+const MimeWARC = "application/warc"
+
+// 写入WARC时由重建的响应决定的头，不保留原值
+var warcSkippedHeaders = map[string]bool{
+	"Content-Encoding":  true,
+	"Content-Length":    true,
+	"Transfer-Encoding": true,
+}
+
+// ErrNotArchived WARC中没有该地址的资源
+var ErrNotArchived = errors.New("快照中没有该资源")
+
+// WriteWARC 将资源写为 WARC/1.1 文件，第一个资源应为网页本身。
+// 每个资源写入一条 request 和一条 response 记录，每条记录单独压缩为一个gzip成员（.warc.gz）。
+func WriteWARC(w io.Writer, resources []*Resource) error {
+	info := "software: scissor\r\nformat: WARC File Format 1.1\r\n"
+	if len(resources) > 0 {
+		info += "x-scissor-page: " + resources[0].URL + "\r\n"
+	}
+	if err := writeRecord(w, warcHeader{
+		{"WARC-Type", "warcinfo"},
+		{"WARC-Record-ID", newRecordID()},
+		{"WARC-Date", time.Now().UTC().Format(time.RFC3339)},
+		{"Content-Type", "application/warc-fields"},
+	}, []byte(info)); err != nil {
+		return err
+	}
+
+	for _, res := range resources {
+		date := res.FetchedAt.UTC().Format(time.RFC3339)
+		responseID := newRecordID()
+		body := responseBlock(res)
+		header := warcHeader{
+			{"WARC-Type", "response"},
+			{"WARC-Record-ID", responseID},
+			{"WARC-Date", date},
+			{"WARC-Target-URI", res.URL},
+			{"Content-Type", "application/http;msgtype=response"},
+			{"WARC-Payload-Digest", payloadDigest(res.Body)},
+		}
+		// 重定向前的地址，回放时同样可以按原地址查找
+		if res.RequestURL != "" && res.RequestURL != res.URL {
+			header = append(header, [2]string{"WARC-Scissor-Request-URI", res.RequestURL})
+		}
+		if err := writeRecord(w, header, body); err != nil {
+			return err
+		}
+
+		if err := writeRecord(w, warcHeader{
+			{"WARC-Type", "request"},
+			{"WARC-Record-ID", newRecordID()},
+			{"WARC-Date", date},
+			{"WARC-Target-URI", res.URL},
+			{"WARC-Concurrent-To", responseID},
+			{"Content-Type", "application/http;msgtype=request"},
+		}, requestBlock(res)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// ReadWARC 读取 WriteWARC 写入的文件，返回其中的响应，顺序与写入时一致
+func ReadWARC(r io.Reader) ([]*Resource, error) {
+	zr, err := gzip.NewReader(r)
+	if err != nil {
+		return nil, err
+	}
+	defer zr.Close()
+	br := bufio.NewReader(zr)
+	tp := textproto.NewReader(br)
+
+	var resources []*Resource
+	for {
+		version, err := tp.ReadLine()
+		if err == io.EOF {
+			return resources, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if version == "" {
+			// 记录之间的空行
+			continue
+		}
+		if !strings.HasPrefix(version, "WARC/") {
+			return nil, fmt.Errorf("无效的WARC记录: %q", version)
+		}
+		header, err := tp.ReadMIMEHeader()
+		if err != nil {
+			return nil, err
+		}
+		length, err := strconv.ParseInt(header.Get("Content-Length"), 10, 64)
+		if err != nil || length < 0 {
+			return nil, fmt.Errorf("无效的WARC记录长度: %q", header.Get("Content-Length"))
+		}
+		block := make([]byte, length)
+		if _, err := io.ReadFull(br, block); err != nil {
+			return nil, err
+		}
+
+		if header.Get("WARC-Type") != "response" {
+			continue
+		}
+		res, err := parseResponseBlock(block)
+		if err != nil {
+			return nil, err
+		}
+		res.URL = header.Get("WARC-Target-URI")
+		res.RequestURL = firstNonEmpty(header.Get("WARC-Scissor-Request-URI"), res.URL)
+		if t, err := time.Parse(time.RFC3339, header.Get("WARC-Date")); err == nil {
+			res.FetchedAt = t
+		}
+		resources = append(resources, res)
+	}
+}
+
+// Replay 返回从已保存的资源中读取的Fetcher，按最初请求的地址或最终地址查找
+func Replay(resources []*Resource) Fetcher {
+	index := make(map[string]*Resource, len(resources)*2)
+	for _, res := range resources {
+		for _, u := range []string{res.RequestURL, res.URL} {
+			if _, ok := index[u]; u != "" && !ok {
+				index[u] = res
+			}
+		}
+	}
+	return func(ctx context.Context, u string) (*Resource, error) {
+		if res, ok := index[u]; ok {
+			return res, nil
+		}
+		return nil, ErrNotArchived
+	}
+}
+
+type warcHeader [][2]string
+
+func writeRecord(w io.Writer, header warcHeader, block []byte) error {
+	var buf bytes.Buffer
+	buf.WriteString("WARC/1.1\r\n")
+	for _, h := range header {
+		buf.WriteString(h[0] + ": " + h[1] + "\r\n")
+	}
+	buf.WriteString("Content-Length: " + strconv.Itoa(len(block)) + "\r\n\r\n")
+	buf.Write(block)
+	buf.WriteString("\r\n\r\n")
+
+	zw := gzip.NewWriter(w)
+	if _, err := zw.Write(buf.Bytes()); err != nil {
+		return err
+	}
+	return zw.Close()
+}
+
+// responseBlock 重建HTTP响应。抓取时已解压正文，因此去掉原有的编码和长度头。
+func responseBlock(res *Resource) []byte {
+	var buf bytes.Buffer
+	proto := res.Proto
+	if proto == "" {
+		proto = "HTTP/1.1"
+	}
+	fmt.Fprintf(&buf, "%s %d %s\r\n", proto, res.StatusCode, http.StatusText(res.StatusCode))
+	writeHTTPHeader(&buf, res.Header)
+	fmt.Fprintf(&buf, "Content-Length: %d\r\n\r\n", len(res.Body))
+	buf.Write(res.Body)
+	return buf.Bytes()
+}
+
+func requestBlock(res *Resource) []byte {
+	var buf bytes.Buffer
+	u, err := url.Parse(res.URL)
+	if err != nil {
+		u = &url.URL{Path: "/"}
+	}
+	fmt.Fprintf(&buf, "GET %s HTTP/1.1\r\nHost: %s\r\n", u.RequestURI(), u.Host)
+	writeHTTPHeader(&buf, res.RequestHeader)
+	buf.WriteString("\r\n")
+	return buf.Bytes()
+}
+
+func writeHTTPHeader(buf *bytes.Buffer, header http.Header) {
+	keys := make([]string, 0, len(header))
+	for k := range header {
+		if !warcSkippedHeaders[http.CanonicalHeaderKey(k)] {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		for _, v := range header[k] {
+			buf.WriteString(k + ": " + strings.NewReplacer("\r", " ", "\n", " ").Replace(v) + "\r\n")
+		}
+	}
+}
+
+func parseResponseBlock(block []byte) (*Resource, error) {
+	resp, err := http.ReadResponse(bufio.NewReader(bytes.NewReader(block)), nil)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	return &Resource{
+		StatusCode: resp.StatusCode,
+		Proto:      resp.Proto,
+		Header:     resp.Header,
+		Body:       body,
+	}, nil
+}
+
+// payloadDigest 按WARC惯例以Base32编码的SHA-1表示正文摘要
+func payloadDigest(body []byte) string {
+	sum := sha1.Sum(body)
+	return "sha1:" + base32.StdEncoding.EncodeToString(sum[:])
+}
+
+func newRecordID() string {
+	b := make([]byte, 16)
+	rand.Read(b)
+	// 版本4的UUID
+	b[6] = b[6]&0x0f | 0x40
+	b[8] = b[8]&0x3f | 0x80
+	return fmt.Sprintf("<urn:uuid:%x-%x-%x-%x-%x>", b[0:4], b[4:6], b[6:8], b[8:10], b[10:])
+}
+
+func firstNonEmpty(values ...string) string {
+	for _, v := range values {
+		if v != "" {
+			return v
+		}
+	}
+	return ""
+}
@@ -0,0 +1,70 @@
+package storage
+
+import (
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// BlobPathPrefix 本服务提供签名下载的路径前缀
+const BlobPathPrefix = "/api/blobs/"
+
+// ErrInvalidSignature 签名不匹配或已过期
+var ErrInvalidSignature = errors.New("下载地址无效或已过期")
+
+// URLSigner 为本地和内存存储生成由本服务校验的限时下载地址
+type URLSigner struct {
+	baseURL string
+	key     []byte
+}
+
+// NewURLSigner 创建签名器，baseURL为服务的外部地址，为空时生成相对地址
+func NewURLSigner(baseURL, key string) *URLSigner {
+	return &URLSigner{
+		baseURL: strings.TrimRight(baseURL, "/"),
+		key:     []byte(key),
+	}
+}
+
+// DeriveSigningKey 从其他用途的密钥派生下载地址的签名密钥，两者泄露或轮换时互不影响
+func DeriveSigningKey(secret string) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte("blob-url"))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// Sign 返回对象在expiresAt之前有效的下载地址
+func (s *URLSigner) Sign(key string, expiresAt time.Time) string {
+	expires := strconv.FormatInt(expiresAt.Unix(), 10)
+	segments := strings.Split(key, "/")
+	for i, seg := range segments {
+		segments[i] = url.PathEscape(seg)
+	}
+	q := url.Values{}
+	q.Set("expires", expires)
+	q.Set("signature", s.signature(key, expires))
+	return s.baseURL + BlobPathPrefix + strings.Join(segments, "/") + "?" + q.Encode()
+}
+
+// Verify 校验下载地址中的过期时间和签名
+func (s *URLSigner) Verify(key, expires, signature string) error {
+	exp, err := strconv.ParseInt(expires, 10, 64)
+	if err != nil || time.Now().Unix() > exp {
+		return ErrInvalidSignature
+	}
+	if !hmac.Equal([]byte(signature), []byte(s.signature(key, expires))) {
+		return ErrInvalidSignature
+	}
+	return nil
+}
+
+func (s *URLSigner) signature(key, expires string) string {
+	mac := hmac.New(sha256.New, s.key)
+	mac.Write([]byte(key + "\n" + expires))
+	return hex.EncodeToString(mac.Sum(nil))
+}
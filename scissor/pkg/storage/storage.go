@@ -0,0 +1,68 @@
+// Package storage 保存图片、导出文件等二进制对象，支持本地目录、S3兼容服务和内存三种后端
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"path"
+	"strings"
+	"time"
+
+	"github.com/gorexlv/cabinet/scissor/internal/config"
+)
+
+var (
+	// ErrNotFound 对象不存在
+	ErrNotFound = errors.New("对象不存在")
+	// ErrInvalidKey 对象键为空或包含 .. 等路径成分
+	ErrInvalidKey = errors.New("无效的对象键")
+	// ErrNotSupported 后端不支持生成签名地址
+	ErrNotSupported = errors.New("存储不支持签名地址")
+)
+
+// Storage 以键存取二进制对象，键为 / 分隔的相对路径
+type Storage interface {
+	// Put 写入对象，已存在时覆盖
+	Put(ctx context.Context, key string, r io.Reader, contentType string) error
+	// Get 读取对象，不存在时返回 ErrNotFound
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	// Delete 删除对象，对象不存在时不报错
+	Delete(ctx context.Context, key string) error
+	// SignedURL 返回在expires时间内无需认证即可下载对象的地址
+	SignedURL(ctx context.Context, key string, expires time.Duration) (string, error)
+}
+
+// New 根据配置创建存储，signer用于本地和内存存储的签名地址，为nil时这两种后端不支持签名地址
+func New(cfg config.StorageConfig, signer *URLSigner) (Storage, error) {
+	switch cfg.Backend {
+	case "", "local":
+		return NewLocal(cfg.Dir, signer), nil
+	case "s3":
+		return NewS3(S3Options{
+			Endpoint:  cfg.S3.Endpoint,
+			Region:    cfg.S3.Region,
+			Bucket:    cfg.S3.Bucket,
+			AccessKey: cfg.S3.AccessKey,
+			SecretKey: cfg.S3.SecretKey,
+			PathStyle: cfg.S3.PathStyle,
+		})
+	case "memory":
+		return NewMemory(signer), nil
+	default:
+		return nil, fmt.Errorf("unknown storage backend: %s", cfg.Backend)
+	}
+}
+
+// cleanKey 校验并规范化对象键
+func cleanKey(key string) (string, error) {
+	if key == "" || strings.HasPrefix(key, "/") || strings.Contains(key, "\\") {
+		return "", ErrInvalidKey
+	}
+	cleaned := path.Clean(key)
+	if cleaned != key || cleaned == "." || strings.HasPrefix(cleaned, "../") || cleaned == ".." {
+		return "", ErrInvalidKey
+	}
+	return cleaned, nil
+}
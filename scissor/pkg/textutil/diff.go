@@ -0,0 +1,97 @@
+package textutil
+
+// DiffOp 差异中一行的操作
+type DiffOp string
+
+const (
+	DiffEqual  DiffOp = "equal"
+	DiffInsert DiffOp = "insert"
+	DiffDelete DiffOp = "delete"
+)
+
+// DiffLine 差异中的一行
+type DiffLine struct {
+	Op   DiffOp `json:"op"`
+	Text string `json:"text"`
+}
+
+// 逐行比较时最长公共子序列表格的最大单元数，超出时中间部分整体视为删除后插入
+const maxDiffCells = 4 << 20
+
+// Diff 逐行比较a和b，返回将a变为b的差异，未变化的行以 DiffEqual 保留
+func Diff(a, b []string) []DiffLine {
+	// 去掉相同的开头和结尾，只比较中间变化的部分
+	prefix := 0
+	for prefix < len(a) && prefix < len(b) && a[prefix] == b[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(a)-prefix && suffix < len(b)-prefix && a[len(a)-1-suffix] == b[len(b)-1-suffix] {
+		suffix++
+	}
+
+	var lines []DiffLine
+	for _, s := range a[:prefix] {
+		lines = append(lines, DiffLine{Op: DiffEqual, Text: s})
+	}
+	lines = append(lines, diffMiddle(a[prefix:len(a)-suffix], b[prefix:len(b)-suffix])...)
+	for _, s := range a[len(a)-suffix:] {
+		lines = append(lines, DiffLine{Op: DiffEqual, Text: s})
+	}
+	return lines
+}
+
+// diffMiddle 以最长公共子序列比较
+func diffMiddle(a, b []string) []DiffLine {
+	n, m := len(a), len(b)
+	var lines []DiffLine
+	if n == 0 || m == 0 || (n+1)*(m+1) > maxDiffCells {
+		for _, s := range a {
+			lines = append(lines, DiffLine{Op: DiffDelete, Text: s})
+		}
+		for _, s := range b {
+			lines = append(lines, DiffLine{Op: DiffInsert, Text: s})
+		}
+		return lines
+	}
+
+	// lcs[i][j] 为 a[i:] 与 b[j:] 的最长公共子序列长度
+	lcs := make([][]int32, n+1)
+	for i := range lcs {
+		lcs[i] = make([]int32, m+1)
+	}
+	for i := n - 1; i >= 0; i-- {
+		for j := m - 1; j >= 0; j-- {
+			if a[i] == b[j] {
+				lcs[i][j] = lcs[i+1][j+1] + 1
+			} else if lcs[i+1][j] >= lcs[i][j+1] {
+				lcs[i][j] = lcs[i+1][j]
+			} else {
+				lcs[i][j] = lcs[i][j+1]
+			}
+		}
+	}
+
+	i, j := 0, 0
+	for i < n && j < m {
+		switch {
+		case a[i] == b[j]:
+			lines = append(lines, DiffLine{Op: DiffEqual, Text: a[i]})
+			i++
+			j++
+		case lcs[i+1][j] >= lcs[i][j+1]:
+			lines = append(lines, DiffLine{Op: DiffDelete, Text: a[i]})
+			i++
+		default:
+			lines = append(lines, DiffLine{Op: DiffInsert, Text: b[j]})
+			j++
+		}
+	}
+	for ; i < n; i++ {
+		lines = append(lines, DiffLine{Op: DiffDelete, Text: a[i]})
+	}
+	for ; j < m; j++ {
+		lines = append(lines, DiffLine{Op: DiffInsert, Text: b[j]})
+	}
+	return lines
+}
@@ -0,0 +1,168 @@
+package textutil
+
+import (
+	"strconv"
+	"strings"
+	"unicode"
+
+	"golang.org/x/net/html"
+)
+
+const (
+	// 中日韩文字每分钟阅读的字数
+	cjkCharsPerMinute = 400
+	// 拉丁字母等按词计数的文字每分钟阅读的词数
+	wordsPerMinute = 230
+	// 宽或高小于此值的图片视为图标，不作为题图
+	minLeadImageSize = 100
+)
+
+// Stats 文章正文的阅读元数据
+type Stats struct {
+	// WordCount 字数：中日韩文字按字计数，其他文字按词计数
+	WordCount int
+	// ReadingMinutes 预计阅读分钟数，有正文时至少为1
+	ReadingMinutes int
+	// Language 正文的主要语言（ISO 639-1），无法判断时为空
+	Language   string
+	ImageCount int
+	// LeadImage 正文中第一张非图标的图片地址
+	LeadImage string
+}
+
+// Analyze 统计HTML正文的阅读元数据，text为正文的纯文本
+func Analyze(content, text string) Stats {
+	cjk, words := countWords(text)
+	stats := Stats{
+		WordCount: cjk + words,
+		Language:  Language(text),
+	}
+	if stats.WordCount > 0 {
+		// 向上取整
+		stats.ReadingMinutes = (cjk*wordsPerMinute + words*cjkCharsPerMinute + cjkCharsPerMinute*wordsPerMinute - 1) /
+			(cjkCharsPerMinute * wordsPerMinute)
+	}
+	stats.ImageCount, stats.LeadImage = images(content)
+	return stats
+}
+
+// countWords 分别统计中日韩文字的字数和其他文字的词数
+func countWords(text string) (cjk, words int) {
+	inWord := false
+	for _, r := range text {
+		switch {
+		case IsCJK(r):
+			cjk++
+			inWord = false
+		case unicode.IsLetter(r) || unicode.IsDigit(r) || (inWord && (r == '\'' || r == '’' || r == '-')):
+			if !inWord {
+				words++
+				inWord = true
+			}
+		default:
+			inWord = false
+		}
+	}
+	return cjk, words
+}
+
+// 常见虚词，用于区分使用拉丁字母的语言
+var stopwords = map[string][]string{
+	"en": {"the", "and", "of", "to", "is", "in", "that", "it", "for", "with", "this", "are", "was"},
+	"de": {"der", "die", "und", "das", "ist", "nicht", "mit", "ein", "eine", "auch", "sich", "den"},
+	"fr": {"le", "la", "les", "et", "des", "est", "une", "dans", "que", "pour", "pas", "du"},
+	"es": {"el", "los", "las", "y", "que", "es", "por", "una", "con", "para", "del", "se"},
+}
+
+// Language 按文字类别和常见虚词粗略判断文本的主要语言，返回 ISO 639-1 代码，无法判断时返回空字符串。
+// 中文夹杂英文术语的文章判断为中文。
+func Language(text string) string {
+	var han, kana, hangul, cyrillic, latin int
+	for _, r := range text {
+		switch {
+		case unicode.Is(unicode.Han, r):
+			han++
+		case unicode.Is(unicode.Hiragana, r) || unicode.Is(unicode.Katakana, r):
+			kana++
+		case unicode.Is(unicode.Hangul, r):
+			hangul++
+		case unicode.Is(unicode.Cyrillic, r):
+			cyrillic++
+		case unicode.Is(unicode.Latin, r):
+			latin++
+		}
+	}
+
+	// 一个汉字大致相当于一个拉丁字母单词，按平均词长5折算
+	if cjk := han + kana + hangul; cjk > 0 && cjk*5 >= latin {
+		switch {
+		case kana*10 >= cjk:
+			return "ja"
+		case hangul >= han:
+			return "ko"
+		default:
+			return "zh"
+		}
+	}
+	if cyrillic > latin {
+		return "ru"
+	}
+	if latin == 0 {
+		return ""
+	}
+
+	counts := make(map[string]int)
+	for _, w := range strings.FieldsFunc(strings.ToLower(text), func(r rune) bool {
+		return !unicode.IsLetter(r)
+	}) {
+		counts[w]++
+	}
+	best, bestScore := "", 0
+	for _, lang := range []string{"en", "de", "fr", "es"} {
+		score := 0
+		for _, w := range stopwords[lang] {
+			score += counts[w]
+		}
+		if score > bestScore {
+			best, bestScore = lang, score
+		}
+	}
+	return best
+}
+
+// images 统计HTML中的图片数并返回第一张非图标图片的地址
+func images(content string) (count int, lead string) {
+	z := html.NewTokenizer(strings.NewReader(content))
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return count, lead
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, hasAttr := z.TagName()
+			if string(name) != "img" {
+				continue
+			}
+			var src string
+			small := false
+			for hasAttr {
+				var key, val []byte
+				key, val, hasAttr = z.TagAttr()
+				switch string(key) {
+				case "src":
+					src = strings.TrimSpace(string(val))
+				case "width", "height":
+					if n, err := strconv.Atoi(strings.TrimSuffix(string(val), "px")); err == nil && n < minLeadImageSize {
+						small = true
+					}
+				}
+			}
+			if src == "" {
+				continue
+			}
+			count++
+			if lead == "" && !small && !strings.HasPrefix(src, "data:") {
+				lead = src
+			}
+		}
+	}
+}
@@ -0,0 +1,167 @@
+// Package textutil 提供文章正文的纯文本处理工具
+package textutil
+
+import (
+	"strings"
+	"unicode"
+	"unicode/utf8"
+
+	"golang.org/x/net/html"
+)
+
+// 块级元素，遇到时插入换行以保留段落结构
+var blockTags = map[string]bool{
+	"p": true, "div": true, "section": true, "article": true, "br": true,
+	"h1": true, "h2": true, "h3": true, "h4": true, "h5": true, "h6": true,
+	"li": true, "ul": true, "ol": true, "blockquote": true, "pre": true,
+	"tr": true, "table": true, "hr": true, "figure": true, "figcaption": true,
+}
+
+// PlainText 将HTML转换为按段落分行的纯文本
+func PlainText(s string) string {
+	if !strings.ContainsAny(s, "<&") {
+		return normalizeLines(s)
+	}
+
+	var b strings.Builder
+	z := html.NewTokenizer(strings.NewReader(s))
+	skip := 0
+	for {
+		switch z.Next() {
+		case html.ErrorToken:
+			return normalizeLines(b.String())
+		case html.TextToken:
+			if skip == 0 {
+				b.Write(z.Text())
+			}
+		case html.StartTagToken, html.SelfClosingTagToken:
+			name, _ := z.TagName()
+			tag := string(name)
+			if tag == "script" || tag == "style" || tag == "noscript" {
+				skip++
+			}
+			if blockTags[tag] {
+				b.WriteByte('\n')
+			}
+		case html.EndTagToken:
+			name, _ := z.TagName()
+			tag := string(name)
+			if (tag == "script" || tag == "style" || tag == "noscript") && skip > 0 {
+				skip--
+			}
+			if blockTags[tag] {
+				b.WriteByte('\n')
+			}
+		}
+	}
+}
+
+// normalizeLines 合并行内空白并去掉空行
+func normalizeLines(s string) string {
+	lines := strings.Split(s, "\n")
+	out := lines[:0]
+	for _, line := range lines {
+		line = strings.Join(strings.Fields(line), " ")
+		if line != "" {
+			out = append(out, line)
+		}
+	}
+	return strings.Join(out, "\n")
+}
+
+// Chunk 按段落将文本切分为不超过size个字符的片段，相邻片段保留overlap个字符的重叠
+func Chunk(text string, size, overlap int) []string {
+	if overlap < 0 || overlap >= size-1 {
+		overlap = 0
+	}
+	step := size - overlap - 1
+	if step <= 0 {
+		return nil
+	}
+
+	// 超长段落先按固定长度硬切
+	var units [][]rune
+	for _, para := range strings.Split(text, "\n") {
+		r := []rune(strings.TrimSpace(para))
+		for len(r) > step {
+			units = append(units, r[:step])
+			r = r[step:]
+		}
+		if len(r) > 0 {
+			units = append(units, r)
+		}
+	}
+
+	var chunks []string
+	var cur []rune
+	pending := false
+	for _, u := range units {
+		if pending && len(cur)+1+len(u) > size {
+			chunks = append(chunks, string(cur))
+			if len(cur) > overlap {
+				cur = append([]rune(nil), cur[len(cur)-overlap:]...)
+			}
+			pending = false
+		}
+		if len(cur) > 0 {
+			cur = append(cur, '\n')
+		}
+		cur = append(cur, u...)
+		pending = true
+	}
+	if pending {
+		chunks = append(chunks, string(cur))
+	}
+	return chunks
+}
+
+// Tokens 将文本切分为检索用的词元：拉丁字母数字按词切分，中日韩文字按单字和二元组切分
+func Tokens(text string) []string {
+	var tokens []string
+	var word []rune
+	var prevCJK rune
+
+	flushWord := func() {
+		if len(word) > 0 {
+			tokens = append(tokens, strings.ToLower(string(word)))
+			word = word[:0]
+		}
+	}
+
+	for _, r := range text {
+		switch {
+		case IsCJK(r):
+			flushWord()
+			tokens = append(tokens, string(r))
+			if prevCJK != 0 {
+				tokens = append(tokens, string([]rune{prevCJK, r}))
+			}
+			prevCJK = r
+			continue
+		case unicode.IsLetter(r) || unicode.IsDigit(r):
+			word = append(word, r)
+		default:
+			flushWord()
+		}
+		prevCJK = 0
+	}
+	flushWord()
+	return tokens
+}
+
+// IsCJK 判断字符是否为中日韩表意文字或假名、谚文
+func IsCJK(r rune) bool {
+	return unicode.Is(unicode.Han, r) ||
+		unicode.Is(unicode.Hiragana, r) ||
+		unicode.Is(unicode.Katakana, r) ||
+		unicode.Is(unicode.Hangul, r)
+}
+
+// Truncate 按字符截断文本，超出部分以省略号代替
+func Truncate(s string, n int) string {
+	if utf8.RuneCountInString(s) <= n {
+		return s
+	}
+	r := []rune(s)
+	return string(r[:n]) + "…"
+}
@@ -0,0 +1,249 @@
+// Package translate 按段落翻译文章HTML，保留原有的排版结构，并生成双语对照的版本
+package translate
+
+import (
+	"context"
+	"errors"
+	"strings"
+	"sync"
+	"unicode"
+
+	"golang.org/x/net/html"
+	"golang.org/x/net/html/atom"
+
+	"github.com/gorexlv/cabinet/scissor/pkg/sanitize"
+	"github.com/gorexlv/cabinet/scissor/pkg/textutil"
+)
+
+// 一篇文章最多翻译的段落数
+const maxSegments = 1000
+
+// 双语对照版本中使用的类名，由前端设置左右并排的样式
+const (
+	ClassBilingual = "scissor-bilingual"
+	ClassSource    = "scissor-bilingual-source"
+	ClassTarget    = "scissor-bilingual-target"
+)
+
+// ErrTooManySegments 文章段落过多
+var ErrTooManySegments = errors.New("文章段落过多")
+
+// Func 翻译一段HTML片段，返回保留标签的译文
+type Func func(ctx context.Context, fragment string) (string, error)
+
+// Result 翻译结果
+type Result struct {
+	// Content 译文HTML
+	Content string
+	// Bilingual 双语对照HTML，每个段落内依次包含原文和译文
+	Bilingual string
+	// Segments 翻译的段落数
+	Segments int
+}
+
+// 作为翻译单位的块级元素，不包含其他块级元素时整体翻译
+var blockElements = map[atom.Atom]bool{
+	atom.P: true, atom.Div: true, atom.Section: true, atom.Article: true, atom.Blockquote: true,
+	atom.H1: true, atom.H2: true, atom.H3: true, atom.H4: true, atom.H5: true, atom.H6: true,
+	atom.Li: true, atom.Dt: true, atom.Dd: true, atom.Td: true, atom.Th: true,
+	atom.Figcaption: true, atom.Caption: true, atom.Summary: true,
+	atom.Ul: true, atom.Ol: true, atom.Dl: true, atom.Table: true, atom.Tr: true,
+	atom.Thead: true, atom.Tbody: true, atom.Tfoot: true, atom.Figure: true, atom.Details: true,
+	atom.Pre: true, atom.Hr: true,
+}
+
+// 不翻译的元素
+var skippedElements = map[atom.Atom]bool{
+	atom.Pre: true, atom.Code: true, atom.Kbd: true, atom.Samp: true,
+}
+
+// HTML 按段落翻译HTML正文，最多workers个段落并发翻译。
+// language 为目标语言的 ISO 639-1 代码，已经是目标语言的段落不翻译。
+// 译文经过白名单清理后再写回，模型返回的内容不会引入不安全的标签。
+func HTML(ctx context.Context, content, language string, translate Func, workers int) (*Result, error) {
+	body := &html.Node{Type: html.ElementNode, Data: "body", DataAtom: atom.Body}
+	nodes, err := html.ParseFragment(strings.NewReader(content), body)
+	if err != nil {
+		return nil, err
+	}
+	root := &html.Node{Type: html.ElementNode, Data: "div", DataAtom: atom.Div}
+	for _, n := range nodes {
+		root.AppendChild(n)
+	}
+
+	var segments []*html.Node
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			if c.Type != html.ElementNode || skippedElements[c.DataAtom] {
+				continue
+			}
+			if blockElements[c.DataAtom] && !hasBlock(c) {
+				if needsTranslation(c, language) {
+					segments = append(segments, c)
+				}
+				continue
+			}
+			walk(c)
+		}
+	}
+	walk(root)
+	// 直接位于正文顶层、没有块级元素包裹的文字作为一个段落
+	if len(segments) == 0 && !hasBlock(root) && needsTranslation(root, language) {
+		segments = append(segments, root)
+	}
+	if len(segments) > maxSegments {
+		return nil, ErrTooManySegments
+	}
+
+	translated, err := translateAll(ctx, segments, translate, workers)
+	if err != nil {
+		return nil, err
+	}
+
+	// 先生成译文，再在同一棵树上生成双语对照版本
+	originals := make([][]*html.Node, len(segments))
+	for i, seg := range segments {
+		originals[i] = detachChildren(seg)
+		for _, n := range translated[i] {
+			seg.AppendChild(n)
+		}
+	}
+	result := &Result{
+		Content:  sanitize.HTML(renderChildren(root)),
+		Segments: len(segments),
+	}
+
+	for i, seg := range segments {
+		target := detachChildren(seg)
+		source := &html.Node{Type: html.ElementNode, Data: "span", DataAtom: atom.Span,
+			Attr: []html.Attribute{{Key: "class", Val: ClassSource}}}
+		for _, n := range originals[i] {
+			source.AppendChild(n)
+		}
+		dst := &html.Node{Type: html.ElementNode, Data: "span", DataAtom: atom.Span,
+			Attr: []html.Attribute{{Key: "class", Val: ClassTarget}, {Key: "lang", Val: language}}}
+		for _, n := range target {
+			dst.AppendChild(n)
+		}
+		seg.AppendChild(source)
+		seg.AppendChild(dst)
+		seg.Attr = setClass(seg.Attr, ClassBilingual)
+	}
+	result.Bilingual = renderChildren(root)
+	return result, nil
+}
+
+// translateAll 并发翻译各段落，返回清理后的译文节点
+func translateAll(ctx context.Context, segments []*html.Node, translate Func, workers int) ([][]*html.Node, error) {
+	if workers < 1 {
+		workers = 1
+	}
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+
+	translated := make([][]*html.Node, len(segments))
+	sources := make([]string, len(segments))
+	for i, seg := range segments {
+		sources[i] = renderChildren(seg)
+	}
+
+	var (
+		wg       sync.WaitGroup
+		once     sync.Once
+		firstErr error
+	)
+	jobs := make(chan int)
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				out, err := translate(ctx, sources[i])
+				if err == nil {
+					translated[i], err = parseInto(segments[i], sanitize.HTML(out))
+				}
+				if err != nil {
+					once.Do(func() {
+						firstErr = err
+						cancel()
+					})
+				}
+			}
+		}()
+	}
+
+feed:
+	for i := range segments {
+		select {
+		case jobs <- i:
+		case <-ctx.Done():
+			break feed
+		}
+	}
+	close(jobs)
+	wg.Wait()
+
+	if firstErr != nil {
+		return nil, firstErr
+	}
+	if err := ctx.Err(); err != nil {
+		return nil, err
+	}
+	return translated, nil
+}
+
+// hasBlock 判断元素是否包含块级子元素
+func hasBlock(n *html.Node) bool {
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type == html.ElementNode && (blockElements[c.DataAtom] || hasBlock(c)) {
+			return true
+		}
+	}
+	return false
+}
+
+// needsTranslation 判断段落是否包含需要翻译的文字
+func needsTranslation(n *html.Node, language string) bool {
+	text := strings.TrimSpace(textutil.PlainText(renderChildren(n)))
+	if !strings.ContainsFunc(text, unicode.IsLetter) {
+		return false
+	}
+	return textutil.Language(text) != language
+}
+
+// parseInto 以parent为上下文解析HTML片段
+func parseInto(parent *html.Node, fragment string) ([]*html.Node, error) {
+	ctxNode := &html.Node{Type: html.ElementNode, Data: parent.Data, DataAtom: parent.DataAtom}
+	return html.ParseFragment(strings.NewReader(fragment), ctxNode)
+}
+
+// detachChildren 移除并返回n的全部子节点
+func detachChildren(n *html.Node) []*html.Node {
+	var children []*html.Node
+	for c := n.FirstChild; c != nil; {
+		next := c.NextSibling
+		n.RemoveChild(c)
+		children = append(children, c)
+		c = next
+	}
+	return children
+}
+
+func setClass(attrs []html.Attribute, class string) []html.Attribute {
+	for i, a := range attrs {
+		if a.Key == "class" {
+			attrs[i].Val = strings.TrimSpace(a.Val + " " + class)
+			return attrs
+		}
+	}
+	return append(attrs, html.Attribute{Key: "class", Val: class})
+}
+
+func renderChildren(n *html.Node) string {
+	var b strings.Builder
+	for c := n.FirstChild; c != nil; c = c.NextSibling {
+		html.Render(&b, c)
+	}
+	return b.String()
+}
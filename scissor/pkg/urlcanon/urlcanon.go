@@ -0,0 +1,126 @@
+// Package urlcanon 将同一篇文章的不同链接形式规范化为统一的URL，用于判重
+package urlcanon
+
+import (
+	"net/url"
+	"sort"
+	"strings"
+)
+
+// Rule 针对特定站点的规范化规则，在通用规则之后执行
+type Rule func(u *url.URL)
+
+// 按主机名注册的站点规则
+var rules = map[string]Rule{
+	"mp.weixin.qq.com":   wechatRule,
+	"zhuanlan.zhihu.com": pathOnlyRule,
+	"www.zhihu.com":      pathOnlyRule,
+	"juejin.cn":          pathOnlyRule,
+	"www.jianshu.com":    pathOnlyRule,
+	"sspai.com":          pathOnlyRule,
+	"36kr.com":           pathOnlyRule,
+}
+
+// 各站点通用的跟踪参数
+var trackingParams = map[string]bool{
+	"spm": true, "from": true, "isappinstalled": true, "scene": true,
+	"share_token": true, "sharer_sharetime": true, "sharer_shareid": true,
+	"clicktime": true, "enterid": true, "ref": true,
+	"fbclid": true, "gclid": true, "mc_cid": true, "mc_eid": true,
+}
+
+// Register 注册或覆盖站点规则
+func Register(host string, rule Rule) {
+	rules[strings.ToLower(host)] = rule
+}
+
+// Canonicalize 返回规范化后的URL：统一协议和主机名大小写，去掉默认端口、锚点和跟踪参数，
+// 查询参数按名称排序，再应用站点规则。无法解析的URL原样返回。
+func Canonicalize(raw string) string {
+	u, err := url.Parse(strings.TrimSpace(raw))
+	if err != nil || u.Host == "" {
+		return strings.TrimSpace(raw)
+	}
+
+	u.Scheme = strings.ToLower(u.Scheme)
+	host := strings.ToLower(u.Hostname())
+	port := u.Port()
+	if (u.Scheme == "http" && port == "80") || (u.Scheme == "https" && port == "443") || port == "" {
+		u.Host = host
+	} else {
+		u.Host = host + ":" + port
+	}
+	u.Fragment = ""
+	u.RawFragment = ""
+	u.User = nil
+
+	query := u.Query()
+	for key := range query {
+		lower := strings.ToLower(key)
+		if trackingParams[lower] || strings.HasPrefix(lower, "utm_") {
+			query.Del(key)
+		}
+	}
+	u.RawQuery = encodeSorted(query)
+
+	if rule, ok := rules[host]; ok {
+		rule(u)
+	}
+	if u.Path == "" {
+		u.Path = "/"
+	}
+	return u.String()
+}
+
+// wechatRule 公众号文章由 __biz、mid、idx 唯一确定，chksm、sn 等参数随分享场景变化；
+// 短链接形式 /s/xxx 不需要任何参数
+func wechatRule(u *url.URL) {
+	u.Scheme = "https"
+	if strings.HasPrefix(u.Path, "/s/") {
+		u.RawQuery = ""
+		return
+	}
+
+	query := u.Query()
+	if query.Get("__biz") == "" || query.Get("mid") == "" {
+		return
+	}
+	kept := url.Values{}
+	for _, key := range []string{"__biz", "mid", "idx"} {
+		if v := query.Get(key); v != "" {
+			kept.Set(key, v)
+		}
+	}
+	u.RawQuery = encodeSorted(kept)
+}
+
+// pathOnlyRule 文章由路径唯一确定的站点，丢弃全部查询参数
+func pathOnlyRule(u *url.URL) {
+	u.Scheme = "https"
+	u.RawQuery = ""
+	u.Path = strings.TrimSuffix(u.Path, "/")
+}
+
+func encodeSorted(query url.Values) string {
+	if len(query) == 0 {
+		return ""
+	}
+	keys := make([]string, 0, len(query))
+	for k := range query {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	var b strings.Builder
+	for _, k := range keys {
+		for _, v := range query[k] {
+			if b.Len() > 0 {
+				b.WriteByte('&')
+			}
+			b.WriteString(url.QueryEscape(k))
+			b.WriteByte('=')
+			b.WriteString(url.QueryEscape(v))
+		}
+	}
+	return b.String()
+}
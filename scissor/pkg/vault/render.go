@@ -0,0 +1,145 @@
+package vault
+
+import (
+	"encoding/json"
+	"strings"
+	"time"
+)
+
+func (s *Syncer) render(note *Note) string {
+	if s.Format == FormatLogseq {
+		return renderLogseq(note)
+	}
+	return renderObsidian(note)
+}
+
+// renderObsidian 生成带YAML属性的笔记，摘要使用callout，标签写成 #tag，划线写成引用块
+func renderObsidian(note *Note) string {
+	var b strings.Builder
+	b.WriteString("---\n")
+	yaml(&b, "title", note.Title)
+	yaml(&b, "url", note.URL)
+	if note.Author != "" {
+		yaml(&b, "author", note.Author)
+	}
+	if note.Source != "" {
+		yaml(&b, "source", note.Source)
+	}
+	if !note.PublishedAt.IsZero() {
+		b.WriteString("published: " + date(note.PublishedAt) + "\n")
+	}
+	if !note.SavedAt.IsZero() {
+		b.WriteString("saved: " + date(note.SavedAt) + "\n")
+	}
+	b.WriteString("scissor_id: " + jsonString(note.ID) + "\n")
+	b.WriteString("---\n\n")
+
+	b.WriteString("# " + note.Title + "\n\n")
+	b.WriteString("[原文](" + note.URL + ")\n\n")
+
+	if note.Summary != "" {
+		b.WriteString("> [!summary] AI 摘要\n")
+		for _, line := range lines(note.Summary) {
+			b.WriteString("> " + line + "\n")
+		}
+		b.WriteString("\n")
+	}
+
+	if len(note.Tags) > 0 {
+		tags := make([]string, len(note.Tags))
+		for i, tag := range note.Tags {
+			// Obsidian 标签不能包含空格
+			tags[i] = "#" + strings.Join(strings.Fields(tag), "-")
+		}
+		b.WriteString(strings.Join(tags, " ") + "\n\n")
+	}
+
+	if len(note.Highlights) > 0 {
+		b.WriteString("## 划线\n\n")
+		for _, h := range note.Highlights {
+			for _, line := range lines(h.Text) {
+				b.WriteString("> " + line + "\n")
+			}
+			if h.Note != "" {
+				b.WriteString("\n" + strings.Join(lines(h.Note), "\n") + "\n")
+			}
+			b.WriteString("\n")
+		}
+	}
+	return b.String()
+}
+
+// renderLogseq 生成页面属性加大纲块的笔记
+func renderLogseq(note *Note) string {
+	var b strings.Builder
+	b.WriteString("title:: " + oneLine(note.Title) + "\n")
+	b.WriteString("url:: " + note.URL + "\n")
+	if note.Author != "" {
+		b.WriteString("author:: " + oneLine(note.Author) + "\n")
+	}
+	if note.Source != "" {
+		b.WriteString("source:: " + oneLine(note.Source) + "\n")
+	}
+	if !note.SavedAt.IsZero() {
+		b.WriteString("saved:: " + date(note.SavedAt) + "\n")
+	}
+	b.WriteString("scissor-id:: " + jsonString(note.ID) + "\n\n")
+
+	if len(note.Tags) > 0 {
+		tags := make([]string, len(note.Tags))
+		for i, tag := range note.Tags {
+			if strings.ContainsAny(tag, " \t") {
+				tags[i] = "#[[" + tag + "]]"
+			} else {
+				tags[i] = "#" + tag
+			}
+		}
+		b.WriteString("- " + strings.Join(tags, " ") + "\n")
+	}
+
+	if note.Summary != "" {
+		b.WriteString("- AI 摘要\n")
+		for _, line := range lines(note.Summary) {
+			b.WriteString("\t- " + line + "\n")
+		}
+	}
+
+	if len(note.Highlights) > 0 {
+		b.WriteString("- 划线\n")
+		for _, h := range note.Highlights {
+			b.WriteString("\t- > " + oneLine(h.Text) + "\n")
+			if h.Note != "" {
+				b.WriteString("\t\t- " + oneLine(h.Note) + "\n")
+			}
+		}
+	}
+	return b.String()
+}
+
+func yaml(b *strings.Builder, key, value string) {
+	b.WriteString(key + ": " + jsonString(value) + "\n")
+}
+
+func jsonString(v interface{}) string {
+	data, _ := json.Marshal(v)
+	return string(data)
+}
+
+func date(t time.Time) string {
+	return t.Format("2006-01-02")
+}
+
+// lines 按行拆分并去掉空行
+func lines(s string) []string {
+	var result []string
+	for _, line := range strings.Split(s, "\n") {
+		if line = strings.TrimSpace(line); line != "" {
+			result = append(result, line)
+		}
+	}
+	return result
+}
+
+func oneLine(s string) string {
+	return strings.Join(strings.Fields(s), " ")
+}
@@ -0,0 +1,253 @@
+// Package vault 将文章以 Markdown 笔记的形式同步到 Obsidian 或 Logseq 笔记库。
+//
+// 每篇文章对应一个笔记文件，标记行以上的内容由同步生成，以下的内容归用户所有，
+// 重复同步时只替换标记行以上的部分。同步状态保存在笔记库根目录的 .scissor-sync.json 中，
+// 内容未变化的文章不会重写。
+package vault
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// 支持的笔记库格式
+const (
+	FormatObsidian = "obsidian"
+	FormatLogseq   = "logseq"
+)
+
+// Marker 分隔同步内容和用户内容的标记行
+const Marker = "<!-- scissor: 以下内容不会被同步覆盖 -->"
+
+const stateFile = ".scissor-sync.json"
+
+// Note 一篇文章的笔记内容
+type Note struct {
+	ID          uint
+	Title       string
+	URL         string
+	Author      string
+	Source      string
+	Summary     string
+	Tags        []string
+	PublishedAt time.Time
+	SavedAt     time.Time
+	Highlights  []Highlight
+}
+
+// Highlight 文章中的划线及批注
+type Highlight struct {
+	Text string
+	Note string
+}
+
+// Result 同步结果
+type Result struct {
+	Written   int `json:"written"`
+	Unchanged int `json:"unchanged"`
+	// Conflicts 标记行被删除而跳过的笔记路径
+	Conflicts []string `json:"conflicts,omitempty"`
+}
+
+// Syncer 将笔记写入笔记库目录
+type Syncer struct {
+	// Dir 笔记库根目录
+	Dir    string
+	Format string
+}
+
+// NewSyncer 创建同步器，format为空时使用Obsidian格式
+func NewSyncer(dir, format string) (*Syncer, error) {
+	switch format {
+	case "":
+		format = FormatObsidian
+	case FormatObsidian, FormatLogseq:
+	default:
+		return nil, fmt.Errorf("不支持的笔记库格式: %q", format)
+	}
+	return &Syncer{Dir: dir, Format: format}, nil
+}
+
+type state struct {
+	Notes map[string]*stateEntry `json:"notes"`
+}
+
+type stateEntry struct {
+	// Path 相对笔记库根目录的路径
+	Path string `json:"path"`
+	// Hash 上次写入的同步内容的哈希
+	Hash     string    `json:"hash"`
+	SyncedAt time.Time `json:"synced_at"`
+}
+
+// Sync 写入内容有变化的笔记，并更新同步状态
+func (s *Syncer) Sync(notes []*Note) (*Result, error) {
+	st, err := s.loadState()
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Result{}
+	used := make(map[string]bool)
+	for _, entry := range st.Notes {
+		used[entry.Path] = true
+	}
+
+	var syncErr error
+	for _, note := range notes {
+		if syncErr = s.syncNote(st, note, used, result); syncErr != nil {
+			break
+		}
+	}
+
+	// 出错时也保存已完成部分的状态
+	if err := s.saveState(st); err != nil && syncErr == nil {
+		syncErr = err
+	}
+	return result, syncErr
+}
+
+func (s *Syncer) syncNote(st *state, note *Note, used map[string]bool, result *Result) error {
+	key := strconv.FormatUint(uint64(note.ID), 10)
+	generated := s.render(note)
+	sum := sha1.Sum([]byte(generated))
+	hash := hex.EncodeToString(sum[:])
+
+	entry := st.Notes[key]
+	if entry != nil && entry.Hash == hash {
+		result.Unchanged++
+		return nil
+	}
+
+	var path string
+	if entry != nil {
+		path = entry.Path
+	} else {
+		path = s.newPath(note, used)
+	}
+	full := filepath.Join(s.Dir, filepath.FromSlash(path))
+
+	userPart := "\n"
+	existing, err := os.ReadFile(full)
+	switch {
+	case err == nil:
+		i := strings.Index(string(existing), Marker)
+		if i < 0 {
+			// 用户删除了标记行，无法区分哪些内容可以覆盖
+			result.Conflicts = append(result.Conflicts, path)
+			return nil
+		}
+		userPart = string(existing[i+len(Marker):])
+	case !errors.Is(err, os.ErrNotExist):
+		return err
+	}
+
+	if err := writeFile(full, generated+"\n"+Marker+userPart); err != nil {
+		return err
+	}
+	used[path] = true
+	st.Notes[key] = &stateEntry{Path: path, Hash: hash, SyncedAt: time.Now()}
+	result.Written++
+	return nil
+}
+
+// newPath 为新笔记选择不与已有文件冲突的路径
+func (s *Syncer) newPath(note *Note, used map[string]bool) string {
+	dir := "Scissor"
+	if s.Format == FormatLogseq {
+		dir = "pages"
+	}
+
+	name := fileName(note.Title)
+	for i := 0; ; i++ {
+		candidate := name
+		if i > 0 {
+			candidate = fmt.Sprintf("%s (%d)", name, note.ID)
+			if i > 1 {
+				candidate = fmt.Sprintf("%s (%d-%d)", name, note.ID, i)
+			}
+		}
+		path := dir + "/" + candidate + ".md"
+		if used[path] {
+			continue
+		}
+		if _, err := os.Stat(filepath.Join(s.Dir, filepath.FromSlash(path))); err == nil {
+			continue
+		}
+		return path
+	}
+}
+
+// fileName 去掉文件名中不允许或在笔记软件中有特殊含义的字符
+func fileName(title string) string {
+	name := strings.Map(func(r rune) rune {
+		if strings.ContainsRune(`/\:*?"<>|#^[]`, r) || r < 0x20 {
+			return ' '
+		}
+		return r
+	}, title)
+	name = strings.Join(strings.Fields(name), " ")
+	if runes := []rune(name); len(runes) > 80 {
+		name = strings.TrimSpace(string(runes[:80]))
+	}
+	name = strings.Trim(name, ".")
+	if name == "" {
+		return "untitled"
+	}
+	return name
+}
+
+func (s *Syncer) loadState() (*state, error) {
+	st := &state{Notes: make(map[string]*stateEntry)}
+	data, err := os.ReadFile(filepath.Join(s.Dir, stateFile))
+	if errors.Is(err, os.ErrNotExist) {
+		return st, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, st); err != nil {
+		return nil, fmt.Errorf("读取同步状态失败: %w", err)
+	}
+	if st.Notes == nil {
+		st.Notes = make(map[string]*stateEntry)
+	}
+	return st, nil
+}
+
+func (s *Syncer) saveState(st *state) error {
+	data, err := json.MarshalIndent(st, "", "  ")
+	if err != nil {
+		return err
+	}
+	return writeFile(filepath.Join(s.Dir, stateFile), string(data))
+}
+
+// writeFile 先写入临时文件再重命名，避免笔记软件读到写了一半的文件
+func writeFile(path, content string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0o755); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".scissor-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+
+	if _, err := tmp.WriteString(content); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err := tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
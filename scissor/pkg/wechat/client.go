@@ -1,14 +1,15 @@
 package wechat
 
 import (
+	"bytes"
+	"context"
 	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
-	"os"
-	"path/filepath"
 	"time"
 
+	"github.com/gorexlv/cabinet/scissor/pkg/storage"
 	"github.com/skip2/go-qrcode"
 )
 
@@ -19,27 +20,26 @@ const (
 	qrcodePath = "/sns/oauth2/access_token"
 	// 获取用户信息的API路径
 	userInfoPath = "/sns/userinfo"
-	// 二维码存储路径
-	qrcodeDir = "static/qrcodes"
+	// 二维码在存储中的目录
+	qrcodePrefix = "qrcodes/"
+	// 二维码地址的有效期
+	qrcodeExpires = 10 * time.Minute
 )
 
 // Client 微信客户端
 type Client struct {
 	appID      string
 	appSecret  string
+	storage    storage.Storage
 	httpClient *http.Client
 }
 
-// NewClient 创建新的微信客户端
-func NewClient(appID, appSecret string) *Client {
-	// 确保二维码存储目录存在
-	if err := os.MkdirAll(qrcodeDir, 0755); err != nil {
-		panic(fmt.Sprintf("创建二维码存储目录失败: %v", err))
-	}
-
+// NewClient 创建新的微信客户端，登录二维码保存到store
+func NewClient(appID, appSecret string, store storage.Storage) *Client {
 	return &Client{
 		appID:     appID,
 		appSecret: appSecret,
+		storage:   store,
 		httpClient: &http.Client{
 			Timeout: time.Second * 10,
 		},
@@ -69,11 +69,10 @@ type UserInfo struct {
 	UnionID    string   `json:"unionid"`
 }
 
-// GetQRCode 获取微信登录二维码
-func (c *Client) GetQRCode() (string, error) {
+// GetQRCode 获取微信登录二维码，返回限时有效的图片地址
+func (c *Client) GetQRCode(ctx context.Context) (string, error) {
 	// 生成唯一的文件名
-	filename := fmt.Sprintf("wx_login_%d.png", time.Now().UnixNano())
-	filepath := filepath.Join(qrcodeDir, filename)
+	key := fmt.Sprintf("%swx_login_%d.png", qrcodePrefix, time.Now().UnixNano())
 
 	// 生成授权URL
 	authURL := fmt.Sprintf("https://open.weixin.qq.com/connect/qrconnect?appid=%s&redirect_uri=%s&response_type=code&scope=snsapi_login&state=STATE#wechat_redirect",
@@ -82,13 +81,16 @@ func (c *Client) GetQRCode() (string, error) {
 	)
 
 	// 生成二维码
-	err := qrcode.WriteFile(authURL, qrcode.Medium, 256, filepath)
+	png, err := qrcode.Encode(authURL, qrcode.Medium, 256)
 	if err != nil {
 		return "", fmt.Errorf("生成二维码失败: %v", err)
 	}
+	if err := c.storage.Put(ctx, key, bytes.NewReader(png), "image/png"); err != nil {
+		return "", fmt.Errorf("保存二维码失败: %v", err)
+	}
 
 	// 返回二维码URL
-	return fmt.Sprintf("/static/qrcodes/%s", filename), nil
+	return c.storage.SignedURL(ctx, key, qrcodeExpires)
 }
 
 // CheckLogin 检查微信登录状态
@@ -0,0 +1,103 @@
+package wechat
+
+import (
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/xml"
+	"io"
+	"sort"
+	"strings"
+	"time"
+	"unicode/utf8"
+)
+
+// 公众号消息类型
+const (
+	MsgTypeText  = "text"
+	MsgTypeLink  = "link"
+	MsgTypeEvent = "event"
+)
+
+// 公众号事件类型
+const (
+	EventSubscribe = "subscribe"
+)
+
+// 被动回复的文本上限为2048字节
+const maxReplyBytes = 2048
+
+// Message 公众号推送的普通消息或事件（明文模式）
+type Message struct {
+	ToUserName   string `xml:"ToUserName"`
+	FromUserName string `xml:"FromUserName"`
+	CreateTime   int64  `xml:"CreateTime"`
+	MsgType      string `xml:"MsgType"`
+	MsgID        int64  `xml:"MsgId"`
+	// Content 文本消息内容
+	Content string `xml:"Content"`
+	// Title、Description、URL 链接消息的字段
+	Title       string `xml:"Title"`
+	Description string `xml:"Description"`
+	URL         string `xml:"Url"`
+	// Event 事件类型，MsgType为event时有效
+	Event string `xml:"Event"`
+}
+
+// ParseMessage 解析公众号推送的XML消息
+func ParseMessage(r io.Reader) (*Message, error) {
+	var msg Message
+	if err := xml.NewDecoder(r).Decode(&msg); err != nil {
+		return nil, err
+	}
+	return &msg, nil
+}
+
+// VerifySignature 校验公众号服务器请求的签名：token、timestamp、nonce字典序排序后拼接的SHA1
+func VerifySignature(token, signature, timestamp, nonce string) bool {
+	if token == "" || signature == "" {
+		return false
+	}
+	parts := []string{token, timestamp, nonce}
+	sort.Strings(parts)
+	sum := sha1.Sum([]byte(strings.Join(parts, "")))
+	expected := hex.EncodeToString(sum[:])
+	return subtle.ConstantTimeCompare([]byte(expected), []byte(strings.ToLower(signature))) == 1
+}
+
+type cdata struct {
+	Value string `xml:",cdata"`
+}
+
+type textReply struct {
+	XMLName      xml.Name `xml:"xml"`
+	ToUserName   cdata    `xml:"ToUserName"`
+	FromUserName cdata    `xml:"FromUserName"`
+	CreateTime   int64    `xml:"CreateTime"`
+	MsgType      cdata    `xml:"MsgType"`
+	Content      cdata    `xml:"Content"`
+}
+
+// TextReply 生成回复给消息发送者的被动文本消息，超出长度上限的内容会被截断
+func TextReply(msg *Message, content string) ([]byte, error) {
+	return xml.Marshal(textReply{
+		ToUserName:   cdata{msg.FromUserName},
+		FromUserName: cdata{msg.ToUserName},
+		CreateTime:   time.Now().Unix(),
+		MsgType:      cdata{MsgTypeText},
+		Content:      cdata{truncateBytes(content, maxReplyBytes)},
+	})
+}
+
+// truncateBytes 按字节截断字符串，不截断多字节字符
+func truncateBytes(s string, n int) string {
+	if len(s) <= n {
+		return s
+	}
+	const ellipsis = "…"
+	cut := n - len(ellipsis)
+	for cut > 0 && !utf8.RuneStart(s[cut]) {
+		cut--
+	}
+	return s[:cut] + ellipsis
+}
@@ -1,6 +1,10 @@
 import axios from 'axios'
 import { defineStore } from 'pinia'
 
+const authHeaders = () => ({
+  Authorization: `Bearer ${localStorage.getItem('token')}`,
+})
+
 export const useArticleStore = defineStore('article', {
   state: () => ({
     articles: [],
@@ -23,6 +27,137 @@ export const useArticleStore = defineStore('article', {
       }
     },
 
+    async getArticle(id) {
+      try {
+        const response = await axios.get(`/api/articles/${id}`)
+        return response.data
+      } catch (error) {
+        this.error = error.message
+        throw error
+      }
+    },
+
+    async getRelatedArticles(id, limit = 5) {
+      try {
+        const response = await axios.get(`/api/articles/${id}/related`, {
+          params: { limit },
+          headers: authHeaders(),
+        })
+        return response.data
+      } catch (error) {
+        this.error = error.message
+        throw error
+      }
+    },
+
+    async getSnapshots(id) {
+      try {
+        const response = await axios.get(`/api/articles/${id}/snapshots`, {
+          headers: authHeaders(),
+        })
+        return response.data
+      } catch (error) {
+        this.error = error.message
+        throw error
+      }
+    },
+
+    async createSnapshot(id, format = 'html') {
+      try {
+        const response = await axios.post(
+          `/api/articles/${id}/snapshots`,
+          { format },
+          { headers: authHeaders() }
+        )
+        return response.data
+      } catch (error) {
+        this.error = error.message
+        throw error
+      }
+    },
+
+    async requestTranslation(id, language = 'zh') {
+      try {
+        const response = await axios.post(
+          `/api/articles/${id}/translations`,
+          { language },
+          { headers: authHeaders() }
+        )
+        return response.data
+      } catch (error) {
+        this.error = error.message
+        throw error
+      }
+    },
+
+    // view 为 translated（只显示译文）或 bilingual（双语对照）
+    async getTranslation(id, language = 'zh', view = 'translated') {
+      try {
+        const response = await axios.get(
+          `/api/articles/${id}/translations/${language}`,
+          { params: { view }, headers: authHeaders() }
+        )
+        return response.data
+      } catch (error) {
+        this.error = error.message
+        throw error
+      }
+    },
+
+    // 对当前用户生效的提示词模板，包括系统模板和自定义模板
+    async getPrompts() {
+      try {
+        const response = await axios.get('/api/prompts', {
+          headers: authHeaders(),
+        })
+        return response.data
+      } catch (error) {
+        this.error = error.message
+        throw error
+      }
+    },
+
+    async getEnrichments(id) {
+      try {
+        const response = await axios.get(`/api/articles/${id}/enrichments`, {
+          headers: authHeaders(),
+        })
+        return response.data
+      } catch (error) {
+        this.error = error.message
+        throw error
+      }
+    },
+
+    // 按当前模板重新生成补全任务的输出，summary 和 tags 会更新文章
+    async regenerateEnrichment(id, name) {
+      try {
+        const response = await axios.post(
+          `/api/articles/${id}/enrichments/${name}`,
+          null,
+          { headers: authHeaders() }
+        )
+        return response.data
+      } catch (error) {
+        this.error = error.message
+        throw error
+      }
+    },
+
+    // 快照网页需要登录才能获取，以文本返回后由 iframe 的 srcdoc 显示
+    async getSnapshotPage(snapshotId) {
+      try {
+        const response = await axios.get(`/api/snapshots/${snapshotId}/view`, {
+          headers: authHeaders(),
+          responseType: 'text',
+        })
+        return response.data
+      } catch (error) {
+        this.error = error.message
+        throw error
+      }
+    },
+
     async getRecentArticles() {
       try {
         const response = await axios.get('/api/articles/recent')
@@ -1,6 +1,16 @@
-import axios from 'axios'
 import { defineStore } from 'pinia'
 
+// 解析SSE响应中的一个事件块，返回事件名和JSON数据
+const parseEvent = (block) => {
+  let event = 'message'
+  const data = []
+  for (const line of block.split('\n')) {
+    if (line.startsWith('event:')) event = line.slice(6).trim()
+    else if (line.startsWith('data:')) data.push(line.slice(5).trim())
+  }
+  return { event, data: data.length ? JSON.parse(data.join('\n')) : null }
+}
+
 export const useKimiStore = defineStore('kimi', {
   state: () => ({
     loading: false,
@@ -8,11 +18,42 @@ export const useKimiStore = defineStore('kimi', {
   }),
 
   actions: {
-    async summarize(articleId) {
+    // 流式生成文章摘要，每收到一段文本回调 onDelta，完成后服务端已保存摘要。
+    // 传入 signal 可以中途取消，服务端随之停止生成。
+    async summarize(articleId, { onDelta, signal } = {}) {
       this.loading = true
+      this.error = null
       try {
-        const response = await axios.post(`/api/articles/${articleId}/summarize`)
-        return response.data
+        const response = await fetch(`/api/articles/${articleId}/summarize`, {
+          method: 'POST',
+          headers: {
+            Accept: 'text/event-stream',
+            Authorization: `Bearer ${localStorage.getItem('token')}`,
+          },
+          signal,
+        })
+        if (!response.ok) {
+          const body = await response.json().catch(() => ({}))
+          throw new Error(body.error || `生成摘要失败（${response.status}）`)
+        }
+
+        const reader = response.body.getReader()
+        const decoder = new TextDecoder()
+        let buffer = ''
+        for (;;) {
+          const { value, done } = await reader.read()
+          if (done) break
+          buffer += decoder.decode(value, { stream: true })
+          let index
+          while ((index = buffer.indexOf('\n\n')) >= 0) {
+            const { event, data } = parseEvent(buffer.slice(0, index))
+            buffer = buffer.slice(index + 2)
+            if (event === 'delta') onDelta?.(data.content)
+            else if (event === 'done') return data
+            else if (event === 'error') throw new Error(data.error)
+          }
+        }
+        throw new Error('生成摘要中断')
       } catch (error) {
         this.error = error.message
         throw error
@@ -21,4 +62,4 @@ export const useKimiStore = defineStore('kimi', {
       }
     },
   },
-}) 
\ No newline at end of file
+})